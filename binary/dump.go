@@ -0,0 +1,560 @@
+package binary
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// 以 `wasm-tools dump` 的格式输出二进制数据的内容
+//
+// 每一行包括：字节偏移值、该项目的原始字节（每行最多 4 个字节，超出的部分写在
+// 接下来的行里）以及该项目的说明，指令的说明使用文本格式，并按结构块缩进，比如：
+//
+// 0x0000 | 00 61 73 6d | version 1 (module)
+//        | 01 00 00 00
+// 0x0008 | 01 05       | type section
+// 0x000a | 01          | 1 count
+// 0x000b | 60 00 01 7f | [type 0] (func (result i32))
+// ...
+// ============== func 0 ====================
+// 0x001a | 06          | size of function
+// 0x001b | 00          | 0 local blocks
+// 0x001c | 02 7f       | block (result i32)
+// 0x001e | 41 01       |   i32.const 1
+// 0x0020 | 0b          | end
+// 0x0021 | 0b          | end
+
+const dumpBytesPerLine = 4
+
+type dumper struct {
+	data []byte // 完整的二进制数据
+	sb   strings.Builder
+
+	importCounts [5]int // 各类导入项的数量，用于计算函数、表等项目的索引
+}
+
+// 输出二进制数据的内容，如果数据不合法，则返回出错之前的内容以及 *DecodeError
+func Dump(data []byte) (text string, err error) {
+	d := &dumper{data: data}
+	reader := &wasmReader{data: data, sectionId: SecNoneID}
+
+	defer func() {
+		if r := recover(); r != nil {
+			text = d.sb.String()
+			err = toDecodeError(r, reader)
+		}
+	}()
+
+	d.dumpModule(reader)
+	return d.sb.String(), nil
+}
+
+// 输出从 start 到读取器当前位置之间的字节以及说明
+func (d *dumper) line(start int, r *wasmReader, format string, args ...interface{}) {
+	bytes := d.data[start:r.offset]
+	desc := fmt.Sprintf(format, args...)
+
+	for i := 0; i == 0 || i < len(bytes); i += dumpBytesPerLine {
+		end := i + dumpBytesPerLine
+		if end > len(bytes) {
+			end = len(bytes)
+		}
+
+		hex := make([]string, 0, dumpBytesPerLine)
+		for _, b := range bytes[i:end] {
+			hex = append(hex, fmt.Sprintf("%02x", b))
+		}
+
+		if i == 0 {
+			fmt.Fprintf(&d.sb, "0x%04x | %-11s | %s\n", start, strings.Join(hex, " "), desc)
+		} else {
+			fmt.Fprintf(&d.sb, "       | %s\n", strings.Join(hex, " "))
+		}
+	}
+}
+
+func (d *dumper) dumpModule(r *wasmReader) {
+	start := r.offset
+	if r.readU32() != MagicNumber {
+		r.fail("magic header not detected")
+	}
+	version := r.readU32()
+	if version != Version {
+		r.fail("unknown binary version: %d", version)
+	}
+	d.line(start, r, "version %d (module)", version)
+
+	for r.remaining() > 0 {
+		r.sectionId = SecNoneID
+		start := r.offset
+		sectionId := r.readByte()
+		if sectionId > SecDataCountID {
+			r.fail("invalid section id: %d", sectionId)
+		}
+		r.sectionId = int(sectionId)
+
+		length := r.readVarU32()
+		if uint64(length) > uint64(r.remaining()) {
+			r.fail("section length out of bounds")
+		}
+		d.line(start, r, "%s section", sectionNames[sectionId])
+
+		sectionReader := r.subReader(int(length))
+		d.dumpSection(sectionId, sectionReader)
+		if sectionReader.remaining() != 0 {
+			sectionReader.fail("section parser consumed unexpected length of data")
+		}
+	}
+}
+
+var sectionNames = []string{
+	"custom", "type", "import", "function", "table", "memory",
+	"global", "export", "start", "element", "code", "data", "data count",
+	"tag",
+}
+
+func (d *dumper) dumpSection(sectionId byte, r *wasmReader) {
+	if sectionId == SecCustomID {
+		start := r.offset
+		d.line(start, r, "name: %q", r.readName())
+		if r.remaining() > 0 {
+			start = r.offset
+			r.skip(r.remaining())
+			d.line(start, r, "custom section data")
+		}
+		return
+	}
+
+	if sectionId == SecStartID {
+		start := r.offset
+		d.line(start, r, "start function %d", r.readVarU32())
+		return
+	}
+
+	if sectionId == SecDataCountID {
+		start := r.offset
+		d.line(start, r, "data count %d", r.readVarU32())
+		return
+	}
+
+	start := r.offset
+	count := r.readCount()
+	d.line(start, r, "%d count", count)
+
+	for i := 0; i < count; i++ {
+		switch sectionId {
+		case SecTypeID:
+			start := r.offset
+			d.line(start, r, "[type %d] %s", i, formatFuncType(r.readFuncType()))
+		case SecImportID:
+			d.dumpImport(r)
+		case SecFuncID:
+			start := r.offset
+			d.line(start, r, "[func %d] type %d", d.importCounts[ImportTagFunc]+i, r.readVarU32())
+		case SecTableID:
+			start := r.offset
+			d.line(start, r, "[table %d] %s", d.importCounts[ImportTagTable]+i, formatTableType(r.readTableType()))
+		case SecMemID:
+			start := r.offset
+			d.line(start, r, "[memory %d] %s", d.importCounts[ImportTagMem]+i, formatLimits(r.readLimits()))
+		case SecTagID:
+			start := r.offset
+			d.line(start, r, "[tag %d] type %d", d.importCounts[ImportTagTag]+i, r.readTagType().Type)
+		case SecGlobalID:
+			start := r.offset
+			d.line(start, r, "[global %d] %s", d.importCounts[ImportTagGlobal]+i, formatGlobalType(r.readGlobalType()))
+			d.dumpExpr(r, 1)
+		case SecExportID:
+			start := r.offset
+			name := r.readName()
+			desc := r.readExportDesc()
+			d.line(start, r, "export %q (%s %d)", name, exportKindNames[desc.Tag], desc.Idx)
+		case SecElemID:
+			d.dumpElem(r)
+		case SecCodeID:
+			d.dumpCode(r, d.importCounts[ImportTagFunc]+i)
+		case SecDataID:
+			d.dumpData(r)
+		}
+	}
+}
+
+var exportKindNames = []string{"func", "table", "memory", "global", "tag"}
+
+func (d *dumper) dumpImport(r *wasmReader) {
+	start := r.offset
+	importItem := r.readImport()
+	desc := importItem.Desc
+	idx := d.importCounts[desc.Tag]
+	d.importCounts[desc.Tag]++
+
+	var descText string
+	switch desc.Tag {
+	case ImportTagFunc:
+		descText = fmt.Sprintf("type %d", desc.FuncType)
+	case ImportTagTable:
+		descText = formatTableType(desc.Table)
+	case ImportTagMem:
+		descText = formatLimits(desc.Mem)
+	case ImportTagGlobal:
+		descText = formatGlobalType(desc.Global)
+	case ImportTagTag:
+		descText = fmt.Sprintf("type %d", desc.TagType.Type)
+	}
+
+	d.line(start, r, "import [%s %d] %q %q %s",
+		exportKindNames[desc.Tag], idx, importItem.Module, importItem.Name, descText)
+}
+
+func (d *dumper) dumpElem(r *wasmReader) {
+	start := r.offset
+	flags := r.readVarU32()
+	switch flags {
+	case 0, 4:
+		d.line(start, r, "element table[0]")
+		d.dumpExpr(r, 1)
+	case 1:
+		r.readElemKind()
+		d.line(start, r, "element passive")
+	case 2:
+		d.line(start, r, "element table[%d]", r.readVarU32())
+		d.dumpExpr(r, 1)
+		start = r.offset
+		r.readElemKind()
+		d.line(start, r, "elemkind funcref")
+	case 3:
+		r.readElemKind()
+		d.line(start, r, "element declared")
+	case 5:
+		d.line(start, r, "element passive %s", GetValTypeName(r.readRefType()))
+	case 6:
+		d.line(start, r, "element table[%d]", r.readVarU32())
+		d.dumpExpr(r, 1)
+		start = r.offset
+		d.line(start, r, "reftype %s", GetValTypeName(r.readRefType()))
+	case 7:
+		d.line(start, r, "element declared %s", GetValTypeName(r.readRefType()))
+	default:
+		r.fail("invalid element segment flags: %d", flags)
+	}
+
+	start = r.offset
+	count := r.readCount()
+	d.line(start, r, "%d items", count)
+	for i := 0; i < count; i++ {
+		if flags < 4 {
+			start := r.offset
+			d.line(start, r, "item func %d", r.readVarU32())
+		} else {
+			// 元素的表达式
+			d.dumpExpr(r, 1)
+		}
+	}
+}
+
+func (d *dumper) dumpData(r *wasmReader) {
+	start := r.offset
+	switch flags := r.readVarU32(); flags {
+	case 0:
+		d.line(start, r, "data memory[0]")
+		d.dumpExpr(r, 1)
+	case 1:
+		d.line(start, r, "data passive")
+	case 2:
+		d.line(start, r, "data memory[%d]", r.readVarU32())
+		d.dumpExpr(r, 1)
+	default:
+		r.fail("invalid data segment flags: %d", flags)
+	}
+
+	start = r.offset
+	d.line(start, r, "%d bytes of data", len(r.readBytes()))
+}
+
+func (d *dumper) dumpCode(r *wasmReader, funcIdx int) {
+	fmt.Fprintf(&d.sb, "============== func %d ====================\n", funcIdx)
+
+	start := r.offset
+	length := r.readVarU32()
+	if uint64(length) > uint64(r.remaining()) {
+		r.fail("function body length out of bounds")
+	}
+	d.line(start, r, "size of function")
+
+	codeReader := r.subReader(int(length))
+	start = codeReader.offset
+	count := codeReader.readCount()
+	d.line(start, codeReader, "%d local blocks", count)
+	for i := 0; i < count; i++ {
+		start := codeReader.offset
+		locals := codeReader.readLocals()
+		d.line(start, codeReader, "%d locals of type %s", locals.N, GetValTypeName(locals.Type))
+	}
+
+	d.dumpExpr(codeReader, 0)
+	if codeReader.remaining() != 0 {
+		codeReader.fail("function body consumed unexpected length of data")
+	}
+}
+
+// 输出表达式的指令，直到表达式结尾的 end 指令
+// 因为需要输出每一条指令的偏移值，所以这里不使用 readExpr()，而是逐条读取指令
+func (d *dumper) dumpExpr(r *wasmReader, indent int) {
+	depth := indent
+	for {
+		start := r.offset
+		opcode := r.readByte()
+		if opnames[opcode] == "" {
+			r.fail("illegal opcode: 0x%02x", opcode)
+		}
+
+		switch opcode {
+		case Block, Loop, If, Try:
+			bt := r.readBlockType()
+			d.line(start, r, "%s%s%s", strings.Repeat("  ", depth), opnames[opcode], formatBlockType(bt))
+			depth++
+		case Else_:
+			if depth == indent {
+				r.fail("unexpected else")
+			}
+			d.line(start, r, "%selse", strings.Repeat("  ", depth-1))
+		case Catch_:
+			if depth == indent {
+				r.fail("unexpected catch")
+			}
+			d.line(start, r, "%scatch %d", strings.Repeat("  ", depth-1), r.readVarU32())
+		case CatchAll_:
+			if depth == indent {
+				r.fail("unexpected catch_all")
+			}
+			d.line(start, r, "%scatch_all", strings.Repeat("  ", depth-1))
+		case Delegate_:
+			// delegate 代替了 try 的 end
+			if depth == indent {
+				r.fail("unexpected delegate")
+			}
+			depth--
+			d.line(start, r, "%sdelegate %d", strings.Repeat("  ", depth), r.readVarU32())
+		case End_:
+			if depth == indent {
+				// 表达式结尾的 end
+				d.line(start, r, "%send", strings.Repeat("  ", depth))
+				return
+			}
+			depth--
+			d.line(start, r, "%send", strings.Repeat("  ", depth))
+		default:
+			args := r.readArgs(opcode)
+			d.line(start, r, "%s%s", strings.Repeat("  ", depth), formatInstr(opcode, args))
+		}
+	}
+}
+
+func formatMiscInstr(args MiscArgs) string {
+	name := GetMiscOpname(args.SubOpcode)
+	switch a := args.Args.(type) {
+	case uint32:
+		if args.SubOpcode == MemoryFill {
+			return formatIdx(name, a)
+		}
+		return fmt.Sprintf("%s %d", name, a)
+	case MemoryInitArgs:
+		return fmt.Sprintf("%s %d", formatIdx(name, a.Mem), a.Data)
+	case MemoryCopyArgs:
+		if a.Dst == 0 && a.Src == 0 {
+			return name
+		}
+		return fmt.Sprintf("%s %d %d", name, a.Dst, a.Src)
+	case TableInitArgs:
+		return fmt.Sprintf("%s %d %d", name, a.Table, a.Elem)
+	case TableCopyArgs:
+		return fmt.Sprintf("%s %d %d", name, a.Dst, a.Src)
+	default:
+		return name
+	}
+}
+
+func formatSIMDInstr(args SIMDArgs) string {
+	name := GetSIMDOpname(args.SubOpcode)
+	switch a := args.Args.(type) {
+	case MemArg:
+		return formatMemArg(name, a, GetSIMDNaturalAlign(args.SubOpcode))
+	case MemLaneArgs:
+		return fmt.Sprintf("%s %d", formatMemArg(name, a.MemArg, GetSIMDNaturalAlign(args.SubOpcode)), a.Lane)
+	case V128:
+		return name + " " + FormatV128(a)
+	case [16]LaneIdx:
+		for _, lane := range a {
+			name += fmt.Sprintf(" %d", lane)
+		}
+		return name
+	case LaneIdx:
+		return fmt.Sprintf("%s %d", name, a)
+	default:
+		return name
+	}
+}
+
+func formatAtomicInstr(args AtomicArgs) string {
+	name := GetAtomicOpname(args.SubOpcode)
+	if memArg, ok := args.Args.(MemArg); ok {
+		return formatMemArg(name, memArg, GetAtomicNaturalAlign(args.SubOpcode))
+	}
+	return name
+}
+
+// 以 i32x4 的形状表示 v128 的值，比如 "i32x4 0x00000001 0x00000000 0x00000000 0x00000000"
+func FormatV128(val V128) string {
+	text := "i32x4"
+	for i := 0; i < 16; i += 4 {
+		text += fmt.Sprintf(" 0x%08x", binary.LittleEndian.Uint32(val[i:]))
+	}
+	return text
+}
+
+func formatMemArg(name string, memArg MemArg, naturalAlign uint32) string {
+	text := formatIdx(name, memArg.Mem)
+	if memArg.Offset != 0 {
+		text += fmt.Sprintf(" offset=%d", memArg.Offset)
+	}
+	if memArg.Align != naturalAlign {
+		text += fmt.Sprintf(" align=%d", uint64(1)<<memArg.Align)
+	}
+	return text
+}
+
+// 内存块（表）索引为 0 时省略
+func formatIdx(name string, idx uint32) string {
+	if idx == 0 {
+		return name
+	}
+	return fmt.Sprintf("%s %d", name, idx)
+}
+
+// ---------------- 格式化
+
+// 以文本格式的形式表示类型，用于错误信息等，比如：
+// - FuncType: "(func (param i32) (result i32))"
+// - Limits: "1 2", "1 2 shared", "i64 1 2"
+// - TableType: "1 2 funcref"
+// - GlobalType: "(mut i32)"
+
+func (ft FuncType) String() string {
+	return formatFuncType(ft)
+}
+
+func (limits Limits) String() string {
+	return formatLimits(limits)
+}
+
+func (tt TableType) String() string {
+	return formatTableType(tt)
+}
+
+func (gt GlobalType) String() string {
+	return formatGlobalType(gt)
+}
+
+func formatFuncType(ft FuncType) string {
+	text := "(func"
+	if len(ft.ParamTypes) > 0 {
+		text += " (param " + formatValTypes(ft.ParamTypes) + ")"
+	}
+	if len(ft.ResultTypes) > 0 {
+		text += " (result " + formatValTypes(ft.ResultTypes) + ")"
+	}
+	return text + ")"
+}
+
+func formatValTypes(vec []ValType) string {
+	names := make([]string, len(vec))
+	for i, vt := range vec {
+		names[i] = GetValTypeName(vt)
+	}
+	return strings.Join(names, " ")
+}
+
+func formatLimits(limits Limits) string {
+	text := fmt.Sprintf("%d", limits.Min)
+	if limits.Is64() {
+		text = "i64 " + text
+	}
+	if limits.HasMax() {
+		text += fmt.Sprintf(" %d", limits.Max)
+	}
+	if limits.IsShared() {
+		text += " shared"
+	}
+	return text
+}
+
+func formatTableType(tt TableType) string {
+	return formatLimits(tt.Limits) + " " + GetValTypeName(tt.ElemType)
+}
+
+func formatGlobalType(gt GlobalType) string {
+	if gt.Mut == MutVar {
+		return "(mut " + GetValTypeName(gt.ValType) + ")"
+	}
+	return GetValTypeName(gt.ValType)
+}
+
+func formatBlockType(bt BlockType) string {
+	switch bt {
+	case BlockTypeEmpty:
+		return ""
+	case BlockTypeI32:
+		return " (result i32)"
+	case BlockTypeI64:
+		return " (result i64)"
+	case BlockTypeF32:
+		return " (result f32)"
+	case BlockTypeF64:
+		return " (result f64)"
+	case BlockTypeV128:
+		return " (result v128)"
+	case BlockTypeFuncRef:
+		return " (result funcref)"
+	case BlockTypeExternRef:
+		return " (result externref)"
+	default:
+		return fmt.Sprintf(" (type %d)", bt)
+	}
+}
+
+// 格式化非结构化指令
+func formatInstr(opcode byte, args interface{}) string {
+	switch opcode {
+	case MiscPrefix:
+		return formatMiscInstr(args.(MiscArgs))
+	case SIMDPrefix:
+		return formatSIMDInstr(args.(SIMDArgs))
+	case AtomicPrefix:
+		return formatAtomicInstr(args.(AtomicArgs))
+	case MemorySize, MemoryGrow:
+		return formatIdx(opnames[opcode], args.(uint32))
+	case CallIndirect, ReturnCallIndirect:
+		a := args.(CallIndirectArgs)
+		return fmt.Sprintf("%s (type %d)", formatIdx(opnames[opcode], a.Table), a.Type)
+	case RefNull:
+		return opnames[opcode] + " " + strings.TrimSuffix(GetValTypeName(args.(ValType)), "ref")
+	case SelectT:
+		return opnames[opcode] + " (result " + formatValTypes(args.([]ValType)) + ")"
+	}
+
+	switch a := args.(type) {
+	case nil:
+		return opnames[opcode]
+	case MemArg:
+		return formatMemArg(opnames[opcode], a, GetNaturalAlign(opcode))
+	case BrTableArgs:
+		text := opnames[opcode]
+		for _, label := range a.Labels {
+			text += fmt.Sprintf(" %d", label)
+		}
+		return text + fmt.Sprintf(" %d", a.Default)
+	default:
+		return fmt.Sprintf("%s %v", opnames[opcode], a)
+	}
+}
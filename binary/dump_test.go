@@ -0,0 +1,55 @@
+package binary
+
+import (
+	"strings"
+	"testing"
+	"wasmvm/assert"
+)
+
+func TestDump(t *testing.T) {
+	// (module
+	//   (func (param i32) (result i32)
+	//     (block (result i32) (i32.load offset=4 (local.get 0))))
+	//   (export "f" (func 0)))
+	data := []byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+		0x01, 0x06, 0x01, 0x60, 0x01, 0x7f, 0x01, 0x7f,
+		0x03, 0x02, 0x01, 0x00,
+		0x07, 0x05, 0x01, 0x01, 0x66, 0x00, 0x00,
+		0x0a, 0x0c, 0x01, 0x0a, 0x00, 0x02, 0x7f, 0x20, 0x00, 0x28, 0x02, 0x04, 0x0b, 0x0b,
+	}
+
+	text, err := Dump(data)
+	assert.AssertNil(t, err)
+
+	expected := `0x0000 | 00 61 73 6d | version 1 (module)
+       | 01 00 00 00
+0x0008 | 01 06       | type section
+0x000a | 01          | 1 count
+0x000b | 60 01 7f 01 | [type 0] (func (param i32) (result i32))
+       | 7f
+0x0010 | 03 02       | function section
+0x0012 | 01          | 1 count
+0x0013 | 00          | [func 0] type 0
+0x0014 | 07 05       | export section
+0x0016 | 01          | 1 count
+0x0017 | 01 66 00 00 | export "f" (func 0)
+0x001b | 0a 0c       | code section
+0x001d | 01          | 1 count
+============== func 0 ====================
+0x001e | 0a          | size of function
+0x001f | 00          | 0 local blocks
+0x0020 | 02 7f       | block (result i32)
+0x0022 | 20 00       |   local.get 0
+0x0024 | 28 02 04    |   i32.load offset=4
+0x0027 | 0b          | end
+0x0028 | 0b          | end
+`
+	assert.AssertEqual(t, expected, text)
+
+	// 数据不合法时，返回出错之前的内容以及错误
+	text, err = Dump(data[:len(data)-3])
+	assert.AssertTrue(t, err != nil)
+	assert.AssertTrue(t, strings.HasPrefix(text, "0x0000 | 00 61 73 6d | version 1 (module)\n"))
+	assert.AssertTrue(t, strings.Contains(text, `export "f" (func 0)`))
+}
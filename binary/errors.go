@@ -0,0 +1,63 @@
+package binary
+
+import "fmt"
+
+// 解码错误
+//
+// 当模块的二进制数据不合法（比如数据被截断、段 id 错误、整数溢出等）时，
+// Decode() 和 DecodeFile() 会返回这个类型的错误
+type DecodeError struct {
+	Offset    int    // 出错的位置，即相对于二进制数据开头的字节偏移值
+	SectionId int    // 出错时正在解码的段的 id，SecNoneID 表示不在任何段之内（比如模块头部）
+	Reason    string // 出错的原因
+}
+
+// 表示当前不在任何段之内
+const SecNoneID = -1
+
+func (e *DecodeError) Error() string {
+	if e.SectionId == SecNoneID {
+		return fmt.Sprintf("decode error at offset 0x%x: %s", e.Offset, e.Reason)
+	}
+	return fmt.Sprintf("decode error at offset 0x%x in section %d: %s",
+		e.Offset, e.SectionId, e.Reason)
+}
+
+// 将 Decode() 过程当中 recover 得到的值转为 *DecodeError
+//
+// 正常情况下解码器只会抛出 *DecodeError，其他的值（比如 Go 运行时错误）
+// 意味着解码器自身存在缺陷，但仍然将其转为解码错误，以保证
+// 不合法的数据不会导致宿主程序崩溃
+func toDecodeError(value interface{}, r *wasmReader) *DecodeError {
+	if e, ok := value.(*DecodeError); ok {
+		return e
+	}
+	return &DecodeError{
+		Offset:    r.offset,
+		SectionId: r.sectionId,
+		Reason:    fmt.Sprint(value),
+	}
+}
+
+// 验证错误
+//
+// 模块的二进制数据格式正确，但内容不符合规范（比如指令的操作数类型不匹配、
+// 索引值超出范围、导出项名称重复等）时，Validate() 会返回这个类型的错误
+type ValidationError struct {
+	FuncIdx  int    // 出错的函数的索引（包括导入函数在内），-1 表示错误不在函数体之内
+	InstrIdx int    // 出错的指令在函数体内的序号（按指令出现的先后顺序从 0 开始计数，包括结构块内部的指令）
+	Offset   int    // 出错的指令在模块二进制数据里的位置，-1 表示未知（比如错误不在函数体之内，或者模块是从文本格式解析的）
+	Reason   string // 出错的原因
+}
+
+func (e *ValidationError) Error() string {
+	if e.FuncIdx < 0 {
+		return "validation error: " + e.Reason
+	}
+	if e.Offset >= 0 {
+		return fmt.Sprintf("validation error in func %d at instruction %d (offset 0x%x): %s",
+			e.FuncIdx, e.InstrIdx, e.Offset, e.Reason)
+	}
+	return fmt.Sprintf("validation error in func %d at instruction %d: %s",
+		e.FuncIdx, e.InstrIdx, e.Reason)
+}
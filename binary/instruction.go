@@ -1,34 +1,73 @@
 package binary
 
+import "fmt"
+
 // 值类型
 //
-// 虚拟机只支持 4 种类型：i32, i64, f32, f64
+// 虚拟机支持 4 种数值类型：i32, i64, f32, f64，
+// 1 种向量类型：v128（128 位的 SIMD 向量），
+// 以及 2 种引用类型：funcref（函数引用）, externref（宿主提供的不透明引用）
 //
 // 其中 i32， i64 在内部是指 `无符号的整数`，
 // 但部分指令是需要将 `无符号整数` 解析为 `有符号整数` 再进行运算，
 // 比如 `lt_u`, `lt_s`，所有有些地方会出现 u32, u64, s32, s64 等名称。
 //
 // https://webassembly.github.io/spec/core/syntax/values.html#integers
+// https://webassembly.github.io/spec/core/syntax/types.html#vector-types
+// https://webassembly.github.io/spec/core/syntax/types.html#reference-types
 
 type ValType = byte
 
 const (
-	ValTypeI32 ValType = 0x7f // i32
-	ValTypeI64 ValType = 0x7E // i64
-	ValTypeF32 ValType = 0x7D // f32
-	ValTypeF64 ValType = 0x7C // f64
+	ValTypeI32       ValType = 0x7f // i32
+	ValTypeI64       ValType = 0x7E // i64
+	ValTypeF32       ValType = 0x7D // f32
+	ValTypeF64       ValType = 0x7C // f64
+	ValTypeV128      ValType = 0x7B // v128
+	ValTypeFuncRef   ValType = 0x70 // funcref
+	ValTypeExternRef ValType = 0x6F // externref
 )
 
+// 是否引用类型
+func IsRefType(vt ValType) bool {
+	return vt == ValTypeFuncRef || vt == ValTypeExternRef
+}
+
+// 获取值类型在文本格式里的名称，比如 "i32"
+func GetValTypeName(vt ValType) string {
+	switch vt {
+	case ValTypeI32:
+		return "i32"
+	case ValTypeI64:
+		return "i64"
+	case ValTypeF32:
+		return "f32"
+	case ValTypeF64:
+		return "f64"
+	case ValTypeV128:
+		return "v128"
+	case ValTypeFuncRef:
+		return "funcref"
+	case ValTypeExternRef:
+		return "externref"
+	default:
+		return fmt.Sprintf("<0x%02x>", vt)
+	}
+}
+
 // 指令块（的返回值）类型
 
 type BlockType = int32 // leb128 编码
 
 const (
-	BlockTypeI32   BlockType = -1  // ()->(i32)
-	BlockTypeI64   BlockType = -2  // ()->(i64)
-	BlockTypeF32   BlockType = -3  // ()->(f32)
-	BlockTypeF64   BlockType = -4  // ()->(f64)
-	BlockTypeEmpty BlockType = -64 // ()->()
+	BlockTypeI32       BlockType = -1  // ()->(i32)
+	BlockTypeI64       BlockType = -2  // ()->(i64)
+	BlockTypeF32       BlockType = -3  // ()->(f32)
+	BlockTypeF64       BlockType = -4  // ()->(f64)
+	BlockTypeV128      BlockType = -5  // ()->(v128)
+	BlockTypeFuncRef   BlockType = -16 // ()->(funcref)
+	BlockTypeExternRef BlockType = -17 // ()->(externref)
+	BlockTypeEmpty     BlockType = -64 // ()->()
 )
 
 type Expr = []Instruction
@@ -59,7 +98,7 @@ type Instruction struct {
 // i64.const: 0x42 + i64  // 参数是一个 leb128 int64（**有符号**）
 // f32.const: 0x43 + f32  // 参数是一个定长 4 字节 float32
 // f64.const: 0x44 + f64  // 参数是一个定长 8 字节 float64
-// trunc_sat: 0xFC + byte // 参数是一个 byte
+// trunc_sat: 0xFC + sub_opcode:uint32 // 见后面的 0xFC 前缀指令
 //
 // (module
 // 	(func
@@ -114,11 +153,14 @@ type Instruction struct {
 
 // ---------------- 内存指令
 //
-// xxx.load?_?:  0x28..0x35 + align:uint32 + offset:uint32
-// xxx.store?:   0x36..0x3e + align:uint32 + offset:uint32
+// xxx.load?_?:  0x28..0x35 + align:uint32 + (mem_block_idx:uint32)? + offset:uint32
+// xxx.store?:   0x36..0x3e + align:uint32 + (mem_block_idx:uint32)? + offset:uint32
 // memory.size:  0x3F + mem_block_idx:uint32
 // memory.grow:  0x40 + mem_block_idx:uint32
 //
+// 多内存块（multi-memory）：访问的内存块索引不为 0 时，align 的第 6 位（0x40）置 1，
+// 后面紧跟着内存块索引，索引为 0 时省略。
+//
 // (module
 // 	(memory 1 8)
 // 	(data (offset (i32.const 100)) "hello")
@@ -151,7 +193,226 @@ type Instruction struct {
 
 type MemArg struct {
 	Align  uint32
-	Offset uint32
+	Offset uint64 // 64 位的内存的偏移值可以超出 uint32 的范围
+	Mem    MemIdx // 内存块索引
+}
+
+// MemArg 的 align 里表示带有内存块索引的标志位
+const memArgMemFlag = 0x40
+
+// ---------------- 0xFC 前缀指令
+//
+// 饱和截断指令以及批量内存（bulk memory）指令共用 0xFC 前缀，
+// 前缀后面是 leb128 uint32 编码的子操作码，然后是各指令的立即数：
+//
+// <i32|i64>.trunc_sat_<f32|f64>_<s|u>: 0xFC + 0..7
+// memory.init:	0xFC + 8 + data_idx + mem_idx
+// data.drop:	0xFC + 9 + data_idx
+// memory.copy:	0xFC + 10 + mem_idx + mem_idx		;; 目标和源内存块索引
+// memory.fill:	0xFC + 11 + mem_idx
+// table.init:	0xFC + 12 + elem_idx + table_idx
+// elem.drop:	0xFC + 13 + elem_idx
+// table.copy:	0xFC + 14 + table_idx + table_idx	;; 目标表和源表的索引
+// table.grow:	0xFC + 15 + table_idx
+// table.size:	0xFC + 16 + table_idx
+// table.fill:	0xFC + 17 + table_idx
+//
+// (module
+// 	(memory 1)
+// 	(data $d "hello")
+// 	(func
+// 		(memory.init $d (i32.const 0) (i32.const 0) (i32.const 5))
+// 		(data.drop $d)
+// 		(memory.copy (i32.const 10) (i32.const 0) (i32.const 5))
+// 		(memory.fill (i32.const 20) (i32.const 0xff) (i32.const 5))
+// 	)
+// )
+//
+// 0x001d | 41 00       | I32Const { value: 0 }
+// 0x001f | 41 00       | I32Const { value: 0 }
+// 0x0021 | 41 05       | I32Const { value: 5 }
+// 0x0023 | fc 08 00 00 | MemoryInit { data_index: 0, mem: 0 }
+// 0x0027 | fc 09 00    | DataDrop { data_index: 0 }
+// ...
+// 0x0030 | fc 0a 00 00 | MemoryCopy { dst_mem: 0, src_mem: 0 }
+// ...
+// 0x003a | fc 0b 00    | MemoryFill { mem: 0 }
+
+type MiscArgs struct {
+	SubOpcode uint32      // 子操作码
+	Args      interface{} // 立即数，没有立即数的指令为 nil
+}
+
+// 各指令的立即数：
+// - memory.init: MemoryInitArgs
+// - data.drop: DataIdx
+// - memory.copy: MemoryCopyArgs
+// - memory.fill: MemIdx
+// - elem.drop: ElemIdx
+// - table.grow, table.size, table.fill: TableIdx
+// - table.init: TableInitArgs
+// - table.copy: TableCopyArgs
+
+type MemoryInitArgs struct {
+	Data DataIdx
+	Mem  MemIdx
+}
+
+type MemoryCopyArgs struct {
+	Dst MemIdx
+	Src MemIdx
+}
+
+type TableInitArgs struct {
+	Elem  ElemIdx
+	Table TableIdx
+}
+
+type TableCopyArgs struct {
+	Dst TableIdx
+	Src TableIdx
+}
+
+// ---------------- 0xFD 前缀指令（SIMD 指令）
+//
+// SIMD 指令共用 0xFD 前缀，前缀后面是 leb128 uint32 编码的子操作码，然后是各指令的立即数：
+//
+// v128.load*, v128.store:			0xFD + sub_opcode + memarg
+// v128.load*_lane, v128.store*_lane:	0xFD + sub_opcode + memarg + lane_idx:byte
+// v128.const:						0xFD + 12 + 16 个字节（小端格式）
+// i8x16.shuffle:					0xFD + 13 + 16 个 lane_idx:byte
+// *.extract_lane*, *.replace_lane:	0xFD + sub_opcode + lane_idx:byte
+// 其他指令：						0xFD + sub_opcode
+//
+// v128 的 16 个字节可以按照不同的 `形状`（shape）解释为多个 `通道`（lane），
+// 比如 i32x4 表示 4 个 i32，f64x2 表示 2 个 f64，通道 0 位于最低的地址（字节）。
+//
+// (module
+// 	(memory 1)
+// 	(func
+// 		(i32.const 0)
+// 		(v128.load offset=16 (i32.const 0))
+// 		(v128.const i32x4 1 2 3 4)
+// 		(i32x4.add)
+// 		(i32x4.extract_lane 3)
+// 		(i32x4.splat)
+// 		(v128.store)
+// 	)
+// )
+//
+// 0x001c | 41 00       | I32Const { value: 0 }
+// 0x001e | 41 00       | I32Const { value: 0 }
+// 0x0020 | fd 00 04 10 | V128Load { memarg: MemArg { align: 4, offset: 16, memory: 0 } }
+// 0x0024 | fd 0c 01 00 | V128Const { value: V128([1, 0, 0, 0, 2, 0, 0, 0, 3, 0, 0, 0, 4, 0, 0, 0]) }
+//        | 00 00 02 00
+//        | 00 00 03 00
+//        | 00 00 04 00
+//        | 00 00
+// 0x0036 | fd ae 01    | I32x4Add
+// 0x0039 | fd 1b 03    | I32x4ExtractLane { lane: 3 }
+// 0x003c | fd 11       | I32x4Splat
+// 0x003e | fd 0b 04 00 | V128Store { memarg: MemArg { align: 4, offset: 0, memory: 0 } }
+// 0x0042 | 0b          | End
+//
+// https://webassembly.github.io/spec/core/syntax/instructions.html#vector-instructions
+
+type SIMDArgs struct {
+	SubOpcode uint32      // 子操作码
+	Args      interface{} // 立即数，没有立即数的指令为 nil
+}
+
+// 各指令的立即数：
+// - v128.load*, v128.store: MemArg
+// - v128.load*_lane, v128.store*_lane: MemLaneArgs
+// - v128.const: V128
+// - i8x16.shuffle: [16]LaneIdx
+// - *.extract_lane*, *.replace_lane: LaneIdx
+
+// 128 位的向量值，按照小端格式存储，即第 0 个字节是最低位的字节
+//
+// 同时也是 v128 类型的值在宿主那一侧（即 WasmVal）的数据类型
+type V128 [16]byte
+
+// 通道的索引
+type LaneIdx = byte
+
+type MemLaneArgs struct {
+	MemArg MemArg
+	Lane   LaneIdx
+}
+
+// 获取 SIMD 加载和存储指令的自然对齐值，即 log2(一次访问的字节数)
+func GetSIMDNaturalAlign(subOpcode uint32) uint32 {
+	switch subOpcode {
+	case V128Load8Splat, V128Load8Lane, V128Store8Lane:
+		return 0
+	case V128Load16Splat, V128Load16Lane, V128Store16Lane:
+		return 1
+	case V128Load32Splat, V128Load32Zero, V128Load32Lane, V128Store32Lane:
+		return 2
+	case V128Load, V128Store:
+		return 4
+	default: // v128.load8x8_s 等扩展加载指令，以及 64 位的 splat、zero 和 lane 指令
+		return 3
+	}
+}
+
+// ---------------- 0xFE 前缀指令（原子指令）
+//
+// 原子指令（threads 提案）共用 0xFE 前缀，前缀后面是 leb128 uint32 编码的子操作码，然后是各指令的立即数：
+//
+// atomic.fence:	0xFE + 3 + 0x00（保留的字节）
+// 其他指令：		0xFE + sub_opcode + memarg
+//
+// 原子指令的 memarg 的对齐值必须等于自然对齐值，而且有效地址没有对齐时会发生陷阱。
+// 读-改-写（rmw）指令返回内存里原来的值，窄的 rmw 指令（比如 rmw8）将原值零扩展。
+//
+// (module
+// 	(memory 1 1 shared)
+// 	(func (result i32)
+// 		(i32.atomic.rmw.add (i32.const 0) (i32.const 1))
+// 		(drop)
+// 		(memory.atomic.notify (i32.const 0) (i32.const 1))
+// 	)
+// )
+//
+// 0x0018 | 41 00       | I32Const { value: 0 }
+// 0x001a | 41 01       | I32Const { value: 1 }
+// 0x001c | fe 1e 02 00 | I32AtomicRmwAdd { memarg: MemArg { align: 2, offset: 0, memory: 0 } }
+// 0x0020 | 1a          | Drop
+// 0x0021 | 41 00       | I32Const { value: 0 }
+// 0x0023 | 41 01       | I32Const { value: 1 }
+// 0x0025 | fe 00 02 00 | MemoryAtomicNotify { memarg: MemArg { align: 2, offset: 0, memory: 0 } }
+// 0x0029 | 0b          | End
+//
+// https://github.com/WebAssembly/threads/blob/main/proposals/threads/Overview.md
+
+type AtomicArgs struct {
+	SubOpcode uint32      // 子操作码
+	Args      interface{} // 立即数，atomic.fence 为 nil，其他指令为 MemArg
+}
+
+// 获取原子指令的自然对齐值，即 log2(一次访问的字节数)
+func GetAtomicNaturalAlign(subOpcode uint32) uint32 {
+	switch subOpcode {
+	case MemoryAtomicNotify, MemoryAtomicWait32:
+		return 2
+	case MemoryAtomicWait64:
+		return 3
+	case AtomicFence:
+		return 0
+	}
+
+	// 从 0x10 开始，每 7 个指令为一组，组内的顺序都是
+	// i32, i64, i32 8 位, i32 16 位, i64 8 位, i64 16 位, i64 32 位
+	return [7]uint32{2, 3, 0, 1, 0, 1, 2}[(subOpcode-I32AtomicLoad)%7]
+}
+
+// 获取内存指令的自然对齐值，即 log2(一次访问的字节数)，
+// 文本格式里省略 align 时使用的就是这个值
+func GetNaturalAlign(opcode byte) uint32 {
+	_, align := getMemoryAccessType(opcode)
+	return align
 }
 
 // ---------------- 结构化控制指令
@@ -352,8 +613,13 @@ type BrTableArgs struct {
 
 // ---------------- 函数调用指令
 //
-// call:			0x10 + func_idx
-// call_indirect:	0x11 + type_idx + table_idx ;; table_idx 暂时只能是 0x00
+// call:					0x10 + func_idx
+// call_indirect:			0x11 + type_idx + table_idx
+// return_call:				0x12 + func_idx
+// return_call_indirect:	0x13 + type_idx + table_idx
+//
+// return_call 和 return_call_indirect 是尾调用指令，相当于 call 之后紧接着 return，
+// 它们的立即数跟 call 和 call_indirect 一样。
 //
 // (module
 //     (type $ft1 (func))
@@ -372,9 +638,107 @@ type BrTableArgs struct {
 // 0x0030 | 11 01 00    | CallIndirect { index: 1, table_index: 0, table_byte: 0 }
 // 0x0033 | 0b          | End
 
+type CallIndirectArgs struct {
+	Type  TypeIdx
+	Table TableIdx
+}
+
+// ---------------- 异常处理指令
+//
+// try:			0x06 + block_type + <instr> + <catch_clause> + (end | delegate)
+// catch:		0x07 + tag_idx + <instr>
+// catch_all:	0x19 + <instr>
+// delegate:	0x18 + label_idx		;; 代替 try 的 end，同时也不能有 catch 子句
+// throw:		0x08 + tag_idx
+// rethrow:		0x09 + label_idx		;; 标签必须指向一个 catch 或者 catch_all 子句
+//
+// try 指令跟 block 类似，当 try 的主体抛出异常时，依次检查各个 catch 子句，
+// 如果异常的标签跟 catch 的标签相同，则把异常的实参压入操作数栈然后执行该子句；
+// catch_all 子句可以捕获任意的异常（不压入实参）；
+// delegate 则把异常交给外层第 label_idx 层（的 try）处理。
+//
+// (module
+//     (tag $e (param i32))
+//     (func (result i32)
+//         (try (result i32)
+//             (do (throw $e (i32.const 1)))
+//             (catch $e)
+//             (catch_all (i32.const 0))
+//         )
+//     )
+// )
+//
+// 0x001f | 06 7f       | Try { blockty: Type(I32) }
+// 0x0021 | 41 01       |   I32Const { value: 1 }
+// 0x0023 | 08 00       |   Throw { tag_index: 0 }
+// 0x0025 | 07 00       | Catch { tag_index: 0 }
+// 0x0027 | 19          | CatchAll
+// 0x0028 | 41 00       |   I32Const { value: 0 }
+// 0x002a | 0b          | End
+// 0x002b | 0b          | End
+
+type TryArgs struct {
+	BT       BlockType // 块的返回值类型
+	Instrs   []Instruction
+	Catches  []CatchClause // catch 以及 catch_all 子句，catch_all 只能位于最后
+	Delegate *LabelIdx     // 非 nil 时表示 try 以 delegate 结束
+}
+
+type CatchClause struct {
+	Tag    TagIdx // 仅当 All == false 时有效
+	All    bool   // 是否 catch_all 子句
+	Instrs []Instruction
+}
+
+// ---------------- 引用指令和表指令
+//
+// ref.null:	0xD0 + ref_type		;; 0x70 (funcref) 或者 0x6F (externref)
+// ref.is_null:	0xD1
+// ref.func:	0xD2 + func_idx
+// table.get:	0x25 + table_idx
+// table.set:	0x26 + table_idx
+// select:		0x1C + <val_type>	;; 带类型的 select，类型列表目前只能有 1 项
+//
+// 不带类型的 select 指令（0x1B）只能用于数值类型，选择引用类型的值时
+// 必须使用带类型的 select 指令。
+//
+// (module
+// 	(table $t 2 funcref)
+// 	(func $f
+// 		(table.set $t (i32.const 0) (ref.func $f))
+// 		(ref.is_null (table.get $t (i32.const 1)))
+// 		(drop)
+// 	)
+// 	(elem declare func $f)
+// )
+//
+// 0x0023 | 41 00       | I32Const { value: 0 }
+// 0x0025 | d2 00       | RefFunc { function_index: 0 }
+// 0x0027 | 26 00       | TableSet { table: 0 }
+// 0x0029 | 41 01       | I32Const { value: 1 }
+// 0x002b | 25 00       | TableGet { table: 0 }
+// 0x002d | d1          | RefIsNull
+// 0x002e | 1a          | Drop
+// 0x002f | 0b          | End
+//
+// 各指令的立即数：
+// - ref.null: ValType
+// - ref.func: FuncIdx
+// - table.get, table.set: TableIdx
+// - select（带类型）: []ValType
+
 func (instr Instruction) GetOpname() string {
+	if args, ok := instr.Args.(MiscArgs); ok && instr.Opcode == MiscPrefix {
+		return GetMiscOpname(args.SubOpcode)
+	}
+	if args, ok := instr.Args.(SIMDArgs); ok && instr.Opcode == SIMDPrefix {
+		return GetSIMDOpname(args.SubOpcode)
+	}
+	if args, ok := instr.Args.(AtomicArgs); ok && instr.Opcode == AtomicPrefix {
+		return GetAtomicOpname(args.SubOpcode)
+	}
 	return opnames[instr.Opcode]
 }
 func (instr Instruction) String() string {
-	return opnames[instr.Opcode]
+	return instr.GetOpname()
 }
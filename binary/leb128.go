@@ -49,49 +49,50 @@ import "errors"
 // 返回：
 // 1. 解码后的整数，如需解码 uint32，则强行将返回值转为 uint32
 // 2. 实际消耗掉的字节数
-func decodeVarUint(data []byte, bitWidth int) (uint64, int) {
+// 3. 错误（数据不完整、溢出等）
+func decodeVarUint(data []byte, bitWidth int) (uint64, int, error) {
 	result := uint64(0)
 	for i, b := range data {
 		if i == bitWidth/7 {
 			// 到达最后一个字节
 			if b&0b1000_0000 != 0 {
 				// 最后一个字节的索引 7 比特应该为 0
-				panic(errors.New("data too long"))
+				return 0, 0, errors.New("integer representation too long")
 			}
 
 			if b>>(bitWidth-i*7) != 0 {
 				// 超出 bitWidth 的部分应该为 0，否则就溢出了
-				panic(errors.New("int overflow"))
+				return 0, 0, errors.New("integer too large")
 			}
 		}
 
 		result |= (uint64(b) & 0b0111_1111) << (i * 7)
 		if b&0b1000_0000 == 0 {
-			return result, i + 1
+			return result, i + 1, nil
 		}
 	}
 
-	panic(errors.New("unexpected the end of leb128"))
+	return 0, 0, errors.New("unexpected end of leb128")
 }
 
-func decodeVarInt(data []byte, bitWidth int) (int64, int) {
+func decodeVarInt(data []byte, bitWidth int) (int64, int, error) {
 	result := int64(0)
 	for i, b := range data {
 		if i == bitWidth/7 {
 			// 到达最后一个字节
 			if b&0b10000000 != 0 {
 				// 最后一个字节的索引 7 比特应该为 0
-				panic(errors.New("data too long"))
+				return 0, 0, errors.New("integer representation too long")
 			}
 
 			if b&0b0100_0000 == 0 && b>>(bitWidth-i*7-1) != 0 {
 				// 当前为正数，则超出 bitWidth 的部分应该为 0，否则就溢出了
-				panic(errors.New("int overflow"))
+				return 0, 0, errors.New("integer too large")
 			}
 
 			if b&0b0100_0000 != 0 && int8(b|0b1000_0000)>>(bitWidth-i*7-1) != -1 {
 				// 当前为负数，则超出 bitWidth 的部分应该全为 1，否则不合法
-				panic(errors.New("invalid negative"))
+				return 0, 0, errors.New("integer too large")
 			}
 		}
 
@@ -101,9 +102,42 @@ func decodeVarInt(data []byte, bitWidth int) (int64, int) {
 				// 如果索引 6 比特为 1，表示负数，需要把高位都补上 1
 				result = result | (-1 << ((i + 1) * 7))
 			}
-			return result, i + 1
+			return result, i + 1, nil
 		}
 	}
 
-	panic(errors.New("unexpected the end of leb128"))
+	return 0, 0, errors.New("unexpected end of leb128")
+}
+
+// 编码 uint32 或者 uint64
+//
+// 每次取出低 7 位，如果剩余的数值不为 0，则将当前字节的
+// 最高位设置为 1，表示还有后续的字节
+func encodeVarUint(val uint64) []byte {
+	buf := []byte{}
+	for {
+		b := byte(val & 0b0111_1111)
+		val >>= 7
+		if val == 0 {
+			return append(buf, b)
+		}
+		buf = append(buf, b|0b1000_0000)
+	}
+}
+
+// 编码 int32 或者 int64
+//
+// 跟无符号整数类似，不过结束的条件是：剩余的数值全部为
+// 符号位（即全 0 或者全 1），并且当前字节的索引 6 比特跟符号位一致
+func encodeVarInt(val int64) []byte {
+	buf := []byte{}
+	for {
+		b := byte(val & 0b0111_1111)
+		val >>= 7 // 算术右移，保留符号位
+		if (val == 0 && b&0b0100_0000 == 0) ||
+			(val == -1 && b&0b0100_0000 != 0) {
+			return append(buf, b)
+		}
+		buf = append(buf, b|0b1000_0000)
+	}
 }
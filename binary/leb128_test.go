@@ -1,6 +1,7 @@
 package binary
 
 import (
+	"math"
 	"testing"
 	"wasmvm/assert"
 )
@@ -25,15 +26,56 @@ func TestDecodeVarInt(t *testing.T) {
 	testDecodeVarInt32(t, data, int32(-123456), 3)
 }
 
+func TestDecodeVarIntError(t *testing.T) {
+	// 数据不完整
+	_, _, err := decodeVarUint([]byte{0x80, 0x80}, 32)
+	assert.AssertTrue(t, err != nil)
+
+	// 超出 uint32 的范围
+	_, _, err = decodeVarUint([]byte{0xff, 0xff, 0xff, 0xff, 0x1f}, 32)
+	assert.AssertTrue(t, err != nil)
+
+	// 超出 int32 的范围
+	_, _, err = decodeVarInt([]byte{0xff, 0xff, 0xff, 0xff, 0x4f}, 32)
+	assert.AssertTrue(t, err != nil)
+}
+
 func testDecodeVarUint32(t *testing.T, data []byte, expected_value uint32, expected_bytes int) {
-	value, bytes := decodeVarUint(data, 32)
+	value, bytes, err := decodeVarUint(data, 32)
 
+	assert.AssertNil(t, err)
 	assert.AssertEqual(t, expected_value, uint32(value))
 	assert.AssertEqual(t, expected_bytes, bytes)
 }
 func testDecodeVarInt32(t *testing.T, data []byte, expected_value int32, expected_bytes int) {
-	value, bytes := decodeVarInt(data, 32)
+	value, bytes, err := decodeVarInt(data, 32)
 
+	assert.AssertNil(t, err)
 	assert.AssertEqual(t, expected_value, int32(value))
 	assert.AssertEqual(t, expected_bytes, bytes)
 }
+
+func TestEncodeVarUint(t *testing.T) {
+	assert.AssertSliceEqual(t, []byte{0x00}, encodeVarUint(0))
+	assert.AssertSliceEqual(t, []byte{0x7f}, encodeVarUint(127))
+	assert.AssertSliceEqual(t, []byte{0x80, 0x01}, encodeVarUint(128))
+	assert.AssertSliceEqual(t, []byte{0xE5, 0x8E, 0x26}, encodeVarUint(624485))
+	assert.AssertSliceEqual(t, []byte{0xff, 0xff, 0xff, 0xff, 0x0f}, encodeVarUint(0xffff_ffff))
+}
+
+func TestEncodeVarInt(t *testing.T) {
+	assert.AssertSliceEqual(t, []byte{0x00}, encodeVarInt(0))
+	assert.AssertSliceEqual(t, []byte{0x3f}, encodeVarInt(63))
+	assert.AssertSliceEqual(t, []byte{0xc0, 0x00}, encodeVarInt(64))
+	assert.AssertSliceEqual(t, []byte{0x7f}, encodeVarInt(-1))
+	assert.AssertSliceEqual(t, []byte{0x40}, encodeVarInt(-64))
+	assert.AssertSliceEqual(t, []byte{0xC0, 0xBB, 0x78}, encodeVarInt(-123456))
+
+	// 编码之后再解码，应该得到原来的数值
+	for _, n := range []int64{math.MinInt64, math.MinInt32, -65, 65, math.MaxInt32, math.MaxInt64} {
+		value, bytes, err := decodeVarInt(encodeVarInt(n), 64)
+		assert.AssertNil(t, err)
+		assert.AssertEqual(t, n, value)
+		assert.AssertEqual(t, len(encodeVarInt(n)), bytes)
+	}
+}
@@ -7,10 +7,12 @@ package binary
 //   func_sec? +
 //   table_sec? +
 //   mem_sec? +
+//   tag_sec? +
 //   global_sec? +
 //   export_sec? +
 //   start_sec? +
 //   elem_sec? +
+//   data_count_sec? +
 //   code_sec? +
 //   data_sec?
 
@@ -31,31 +33,55 @@ type Module struct {
 	ElemSec    []Elem      // 编号 9: 元素段，跟表格段合在一起实现函数间接调用
 	CodeSec    []Code      // 编号 10: 函数主体段，跟函数列表段合在一起实现完整的函数
 	DataSec    []Data      // 编号 11: （内存初始）数据段，跟内存描述段合在一起形成完整的初始数据
+
+	DataCountSec *uint32   // 编号 12: 数据计数段，位于元素段和代码段之间
+	TagSec       []TagType // 编号 13: 标签段（异常处理），位于内存段和全局段之间
 }
 
 const (
-	SecCustomID = iota // 0
-	SecTypeID          // 1
-	SecImportID        // 2
-	SecFuncID          // 3
-	SecTableID         // 4
-	SecMemID           // 5
-	SecGlobalID        // 6
-	SecExportID        // 7
-	SecStartID         // 8
-	SecElemID          // 9
-	SecCodeID          // 10
-	SecDataID          // 11
+	SecCustomID    = iota // 0
+	SecTypeID             // 1
+	SecImportID           // 2
+	SecFuncID             // 3
+	SecTableID            // 4
+	SecMemID              // 5
+	SecGlobalID           // 6
+	SecExportID           // 7
+	SecStartID            // 8
+	SecElemID             // 9
+	SecCodeID             // 10
+	SecDataID             // 11
+	SecDataCountID        // 12
+	SecTagID              // 13
 )
 
+// 非自定义段必须按照这个顺序出现（数据计数段和标签段的编号虽然较大，但分别位于代码段和全局段之前）
+var sectionOrder = []byte{
+	SecTypeID, SecImportID, SecFuncID, SecTableID, SecMemID, SecTagID, SecGlobalID,
+	SecExportID, SecStartID, SecElemID, SecDataCountID, SecCodeID, SecDataID,
+}
+
+// 获取段在模块里的出现次序，无效的段 id 返回 -1
+func getSectionOrder(sectionId byte) int {
+	for i, id := range sectionOrder {
+		if id == sectionId {
+			return i
+		}
+	}
+	return -1
+}
+
 // 类型别名（仅为了提高代码可读性）
 
 type (
 	TypeIdx   = uint32 // 函数类型索引（内部、导入函数共用）
 	FuncIdx   = uint32 // 函数索引（内部、导入函数共用）
 	GlobalIdx = uint32 // 全局变量索引
-	TableIdx  = uint32 // 表索引，目前的值只能是 0
-	MemIdx    = uint32 // 内存索引，目前的值只能是 0
+	TableIdx  = uint32 // 表索引（内部、导入表共用）
+	MemIdx    = uint32 // 内存索引（内部、导入内存共用）
+	ElemIdx   = uint32 // 元素项索引
+	DataIdx   = uint32 // 数据项索引
+	TagIdx    = uint32 // 标签索引（内部、导入标签共用）
 	LocalIdx  = uint32 // （每个函数的）局部变量索引
 	LabelIdx  = uint32 // （每个函数内部）跳转标签的索引
 )
@@ -97,7 +123,7 @@ type SecHeader struct {
 //
 // <val_type>: count:uint32 + data_type:byte{0,}
 //
-// 因为数据类型只有 4 种，所以 data_type 的数据类型是 byte
+// 因为数据类型只有 6 种，所以 data_type 的数据类型是 byte
 //
 // 文本格式
 //
@@ -125,7 +151,7 @@ const FtTag = 0x60
 // 之前有一个 uint32 描述字节数组的长度（这个长度是只字符串正文内容的长度，所以
 // 当然不包括这个描述数字本身占用的空间）
 //
-// import_desc: tag:byte + (func_type_idx | table_type | mem_type | global_type)
+// import_desc: tag:byte + (func_type_idx | table_type | mem_type | global_type | tag_type)
 //
 // 文本格式：
 //
@@ -162,6 +188,7 @@ const (
 	ImportTagTable  = 1
 	ImportTagMem    = 2
 	ImportTagGlobal = 3
+	ImportTagTag    = 4
 )
 
 // 导入项描述
@@ -171,6 +198,7 @@ type ImportDesc struct {
 	Table    TableType  // 仅当 tag == 1 时有效
 	Mem      MemType    // 仅当 tag == 2 时有效
 	Global   GlobalType // 仅当 tag == 3 时有效
+	TagType  TagType    // 仅当 tag == 4 时有效
 }
 
 // 函数（列表）段
@@ -223,11 +251,14 @@ type ImportDesc struct {
 // 也就是说元素段存储的是表的（初始化）数据
 //
 // table_sec: 0x04 + byte_count:uint32 + <table_type> // 目前仅支持一个 table_type
-// table_type: 0x70 + limits
+// table_type: ref_type + limits
+//
+// 表项的类型可以是 funcref（0x70）或者 externref（0x6F），只有 funcref 类型的表
+// 可以用于 call_indirect 指令，externref 类型的表用于保存宿主提供的引用。
 //
 // (func $f1)
 // (func $f2)
-// (table 1 10 funcref)						;; 表的类型暂时只能是 "funcref"
+// (table 1 10 funcref)
 // (elem (offset (i32.const 1)) $f1 $f2)	;; 元素项的偏移值需要使用（const）表达式
 //
 // 元素项也可以内联到表段里：
@@ -236,12 +267,15 @@ type ImportDesc struct {
 // 	(elem $f1 $f2)				;; 自动决定了偏移值为 0
 // )
 
-const FuncRef = 0x70
+const (
+	FuncRef   = ValTypeFuncRef
+	ExternRef = ValTypeExternRef
+)
 
 // 表项目
 type TableType struct {
-	ElemType byte   // 表项目的类型 目前只支持 0x70
-	Limits   Limits // 限制值
+	ElemType ValType // 表项目的类型，FuncRef 或者 ExternRef
+	Limits   Limits  // 限制值
 }
 
 // 限制类型（用于描述元素数量/内存页数的上下限）
@@ -251,16 +285,51 @@ type TableType struct {
 // min 是下限值，max 是上限值
 // 当 tag == 0 时，表示省略了上限，只有 min 值
 // 当 tag == 1 时，表示上下限都指出
+// 当 tag == 3 时，表示上下限都指出，并且是共享内存（threads 提案），
+// 共享内存必须指出上限，而表不能共享
+// 当 tag 的第 2 位为 1 时（即 tag 为 4 ~ 7），表示 64 位的内存（memory64 提案），
+// 此时 min 和 max 都是 u64，而且访问内存的地址也是 i64
 //
 // 示例：
 // 00 01      ; 下限值为 1，省略了上限（所以上限的字节也不会有）
 // 01 01 02   ; 下限值为 1，上限值为 2
+// 03 01 02   ; 下限值为 1，上限值为 2，共享内存
+// 05 01 02   ; 下限值为 1，上限值为 2，64 位的内存
 
 // 限制值
 type Limits struct {
-	Tag byte   // 限制值的类型，0 表示只有 min 值，1 表示有 min 和 max 值
-	Min uint32 // min，即下限
-	Max uint32 // max，即上限，是可选的，省略上限时，该位置对应的字节也不会有
+	Tag byte   // 限制值的类型，0 表示只有 min 值，1 表示有 min 和 max 值，第 1 位表示共享，第 2 位表示 64 位
+	Min uint64 // min，即下限，除了 64 位的内存，都不会超出 uint32 的范围
+	Max uint64 // max，即上限，是可选的，省略上限时，该位置对应的字节也不会有
+}
+
+const (
+	LimitsTagMax    = 0x01 // 有 max 值
+	LimitsTagShared = 0x02 // 共享内存
+	LimitsTag64     = 0x04 // 64 位的内存
+)
+
+// 是否指出了上限
+func (l Limits) HasMax() bool {
+	return l.Tag&LimitsTagMax != 0
+}
+
+// 是否共享内存
+func (l Limits) IsShared() bool {
+	return l.Tag&LimitsTagShared != 0
+}
+
+// 是否 64 位的内存
+func (l Limits) Is64() bool {
+	return l.Tag&LimitsTag64 != 0
+}
+
+// 内存的地址（索引）类型，64 位的内存为 i64，其他为 i32
+func (l Limits) AddrType() ValType {
+	if l.Is64() {
+		return ValTypeI64
+	}
+	return ValTypeI32
 }
 
 // ---------------- 内存段
@@ -271,6 +340,7 @@ type Limits struct {
 // 文本格式
 //
 // (memory 1 16)						;; 指定 limit 值，即 min 和 max
+// (memory i64 1 16)					;; 64 位的内存
 // (data (offset (i32.const 10)) "foo")	;; 数据偏移量需要使用（const）表达式
 // (data (offset (i32.const 20)) "bar")
 //
@@ -288,10 +358,38 @@ type Limits struct {
 type MemType = Limits
 
 const (
-	PageSize     = 65536 // 每页内存 64KB
-	MaxPageCount = 65536
+	PageSize       = 65536   // 每页内存 64KB
+	MaxPageCount   = 65536   // 32 位的内存的最大页面数，即 4GiB
+	MaxPageCount64 = 1 << 48 // 64 位的内存的最大页面数，即 2^64 字节
 )
 
+// ---------------- 标签段（异常处理）
+
+// 标签用于区分不同种类的异常，throw 指令抛出的异常由标签以及标签的参数组成，
+// catch 指令根据标签捕获异常。标签的类型是一个函数类型，参数即异常携带的数据，
+// 返回值列表必须为空。
+//
+// tag_sec: 0x0D + byte_count:uint32 + <tag_type>
+// tag_type: attribute:byte + type_idx:uint32
+//
+// 其中 attribute 目前只能是 0（表示异常）。
+//
+// 文本格式
+//
+// (tag $e0)						;; 不携带数据的异常
+// (tag $e1 (param i32 i64))		;; 携带 i32 和 i64 两个数据的异常
+// (tag $e2 (type $ft1))
+// (import "env" "e3" (tag $e3 (param i32)))
+// (tag $e4 (export "e4") (param f32))
+
+// 标签的类型
+type TagType struct {
+	Attribute byte    // 只能是 0（TagAttributeException）
+	Type      TypeIdx // 函数类型的索引
+}
+
+const TagAttributeException = 0
+
 // ---------------- 全局段
 
 // 全局段列出模块所有全局变量/常量
@@ -337,10 +435,10 @@ const (
 
 // ---------------- 导出段
 
-// 可以导出：函数、表、内存、全局变量
+// 可以导出：函数、表、内存、全局变量、标签
 // export_sec: 0x07 + byte_count:uint32 + <export>
 // export: name:string + export_desc
-// export_desc: tag:byte + (func_idx | table_idx | mem_idx | global_idx)
+// export_desc: tag:byte + (func_idx | table_idx | mem_idx | global_idx | tag_idx)
 //
 // 文本格式：
 //
@@ -370,12 +468,13 @@ const (
 	ExportTagTable  = 1
 	ExportTagMem    = 2
 	ExportTagGlobal = 3
+	ExportTagTag    = 4
 )
 
 // 导出项描述
 type ExportDesc struct {
 	Tag byte   // 导出项类型
-	Idx uint32 // 函数、表、内存块、全局项的索引
+	Idx uint32 // 函数、表、内存块、全局项、标签的索引
 }
 
 // ---------------- 起始段
@@ -400,19 +499,67 @@ type ExportDesc struct {
 // 3. 函数索引列表
 //
 // elem_sec: 0x09 + byte_count:uint32 + <elem>
-// elem: table_idx + offset_expr + <func_idx>
+// elem: flags:uint32 + ...
+//
+// 元素项有 3 种模式：
+// - 主动（active）：实例化时将函数索引写入表的指定位置；
+// - 被动（passive）：实例化时不写入表，而是由 table.init 指令写入；
+// - 声明（declarative）：不会写入表，仅用于声明函数可以被引用。
+//
+// flags 决定了元素项的模式以及后面的内容：
+// - 0: offset_expr + <func_idx>                          ;; 主动，表索引为 0
+// - 1: elem_kind + <func_idx>                            ;; 被动
+// - 2: table_idx + offset_expr + elem_kind + <func_idx>  ;; 主动
+// - 3: elem_kind + <func_idx>                            ;; 声明
+// - 4: offset_expr + <expr>                              ;; 主动，表索引为 0，类型为 funcref
+// - 5: ref_type + <expr>                                 ;; 被动
+// - 6: table_idx + offset_expr + ref_type + <expr>       ;; 主动
+// - 7: ref_type + <expr>                                 ;; 声明
+//
+// 其中 elem_kind 只能是 0x00（即 funcref）。
+// flags 为 4~7 的元素项使用常量表达式（ref.null、ref.func 或者 global.get）
+// 列表代替函数索引列表，所以可以包含空引用，也可以是 externref 类型。
+//
+// 文本格式
+//
+// (elem (i32.const 0) $f1 $f2)                         ;; 主动
+// (elem $e func $f1 $f2)                               ;; 被动
+// (elem declare func $f1)                              ;; 声明
+// (elem (i32.const 0) funcref (ref.func $f1) (ref.null func))
+// (elem externref (ref.null extern))
+
+// 元素项的模式
+const (
+	SegmentModeActive      byte = 0 // 主动
+	SegmentModePassive     byte = 1 // 被动
+	SegmentModeDeclarative byte = 2 // 声明，只用于元素项
+)
+
+// 元素项的 elem_kind
+const ElemKindFuncRef = 0x00
 
 // 元素项目
 type Elem struct {
-	Table  TableIdx  // 表索引
-	Offset Expr      // 偏移值表达式（指令/字节码）
+	Mode   byte      // 模式
+	Table  TableIdx  // 表索引，仅当模式为主动时有效
+	Offset Expr      // 偏移值表达式（指令/字节码），仅当模式为主动时有效
+	Type   ValType   // 元素的类型，使用函数索引列表时只能是 FuncRef
 	Init   []FuncIdx // 函数索引列表
+	Exprs  []Expr    // 表达式列表，不为 nil 时代替 Init（即 flags 为 4~7 的元素项）
 
 	// 注
 	// Init 这个列表表示：从指定的偏移值开始连续的一系列函数的索引的排列
 	// 这一组函数之间并没有必然的关联，只是恰好紧密地排在一起而已。
 }
 
+// 元素的数量
+func (elem Elem) Len() int {
+	if elem.Exprs != nil {
+		return len(elem.Exprs)
+	}
+	return len(elem.Init)
+}
+
 // ---------------- 代码段
 
 // code_sec: 0x0a + byte_count:uint32 + <code>
@@ -445,10 +592,18 @@ type Elem struct {
 
 // 代码项目（一个函数一个代码项目）
 type Code struct {
-	Locals []Locals // 局部变量组列表，连续多个相同类型的局部变量被分为一组
+	Locals []Locals // 局部变量组列表，连续多个相同类型的局部变量被分为一组，总数不超过 MaxLocalCount
 	Expr   Expr     // 指令/字节码
+
+	// 每条指令的操作码在模块二进制数据里的位置，按指令出现的先后顺序排列（包括结构块内部的指令），
+	// 由解码器填写，用于报告验证错误的位置。从文本格式解析的模块没有这项数据
+	Offsets []uint32
 }
 
+// 每个函数最多可以声明的局部变量（不包括参数）的数量，跟其他引擎的限制相同，
+// 防止模块用很少的字节声明大量的局部变量而耗尽宿主的内存
+const MaxLocalCount = 50000
+
 // 局部变量组
 type Locals struct {
 	N    uint32  // 数量
@@ -468,6 +623,12 @@ func (module Module) GetBlockType(bt BlockType) FuncType { // name: convertBlock
 		return FuncType{ResultTypes: []ValType{ValTypeF32}}
 	case BlockTypeF64: // -4
 		return FuncType{ResultTypes: []ValType{ValTypeF64}}
+	case BlockTypeV128: // -5
+		return FuncType{ResultTypes: []ValType{ValTypeV128}}
+	case BlockTypeFuncRef: // -16
+		return FuncType{ResultTypes: []ValType{ValTypeFuncRef}}
+	case BlockTypeExternRef: // -17
+		return FuncType{ResultTypes: []ValType{ValTypeExternRef}}
 	case BlockTypeEmpty: // -64
 		return FuncType{}
 	default:
@@ -502,15 +663,37 @@ func (code Code) GetLocalCount() uint64 {
 // - 21 0A			; "!\n"
 //
 // data_sec: 0x0b + byte_count:uint32 + <data>
-// data: mem_idx + offset_expr + byte{0,}
+// data: flags:uint32 + ...
+//
+// 跟元素项类似，数据项也分为主动（active）和被动（passive）两种模式，
+// 被动的数据项在实例化时不会写入内存，而是由 memory.init 指令写入。
+//
+// flags 决定了数据项的模式以及后面的内容：
+// - 0: offset_expr + <byte>            ;; 主动，内存块索引为 0
+// - 1: <byte>                          ;; 被动
+// - 2: mem_idx + offset_expr + <byte>  ;; 主动
+//
+// 文本格式
+//
+// (data (i32.const 10) "foo")          ;; 主动
+// (data $d "bar")                      ;; 被动
 
 // 数据项目
 type Data struct {
-	Mem    MemIdx // 内存块索引
-	Offset Expr   // 偏移值表达式（指令/字节码）
+	Mode   byte   // 模式，只能是 SegmentModeActive 或者 SegmentModePassive
+	Mem    MemIdx // 内存块索引，仅当模式为主动时有效
+	Offset Expr   // 偏移值表达式（指令/字节码），仅当模式为主动时有效
 	Init   []byte // 内容
 }
 
+// ---------------- 数据计数段
+//
+// 数据计数段记录数据项的数量，用于在解码代码段时（数据段位于代码段之后）
+// 就能检查 memory.init 和 data.drop 指令的数据项索引。
+// 函数里使用了这两条指令时，模块必须包含数据计数段。
+//
+// data_count_sec: 0x0c + byte_count:uint32 + count:uint32
+
 // ---------------- 自定义段
 //
 // 自定义段可以出现多次，出现的位置也不限。
@@ -0,0 +1,247 @@
+package binary
+
+// 名称段
+//
+// 名称段是一个名称为 "name" 的自定义段，用于记录模块、函数、局部变量等
+// 项目的名称（即文本格式里的标识符，比如 `$f1` 的名称为 "f1"），不参与运算。
+//
+// 名称段由若干个子段组成，子段需要按 id 从小到大排列：
+//
+// name_sec: 0x00 + byte_count:uint32 + "name" + subsection*
+// subsection: id:byte + byte_count:uint32 + content
+//
+// 子段 id：
+// 0: 模块名称，content: name:string
+// 1: 函数名称，content: name_map
+// 2: 局部变量名称，content: indirect_name_map
+// 3: 标签名称，content: indirect_name_map
+// 4: 类型名称，content: name_map
+// 5: 表名称，content: name_map
+// 6: 内存名称，content: name_map
+// 7: 全局变量名称，content: name_map
+// 8: 元素项名称，content: name_map
+// 9: 数据项名称，content: name_map
+//
+// name_map: <name_assoc>
+// name_assoc: idx:uint32 + name:string
+// indirect_name_map: <indirect_name_assoc>
+// indirect_name_assoc: idx:uint32 + name_map
+//
+// 示例：
+//
+// (module
+//     (type $ft0 (func (param i32) (result i32)))
+//     (func $inc (type $ft0) (param $x i32) (result i32) ...)
+// )
+//
+// - 01 07 01 00 03 69 6e 63       | 函数名称 [0] "inc"
+// - 02 06 01 00 01 00 01 78       | 局部变量名称 func[0]: [0] "x"
+// - 04 06 01 00 03 66 74 30       | 类型名称 [0] "ft0"
+
+const NameSecName = "name"
+
+const (
+	NameSubsecModuleID = iota // 0
+	NameSubsecFuncID          // 1
+	NameSubsecLocalID         // 2
+	NameSubsecLabelID         // 3
+	NameSubsecTypeID          // 4
+	NameSubsecTableID         // 5
+	NameSubsecMemID           // 6
+	NameSubsecGlobalID        // 7
+	NameSubsecElemID          // 8
+	NameSubsecDataID          // 9
+)
+
+type NameSec struct {
+	ModuleName  string          // 子段 0，空字符串表示模块没有名称
+	FuncNames   NameMap         // 子段 1
+	LocalNames  IndirectNameMap // 子段 2，索引是函数索引
+	LabelNames  IndirectNameMap // 子段 3，索引是函数索引，二级索引是函数内的结构化指令的序号
+	TypeNames   NameMap         // 子段 4
+	TableNames  NameMap         // 子段 5
+	MemNames    NameMap         // 子段 6
+	GlobalNames NameMap         // 子段 7
+	ElemNames   NameMap         // 子段 8
+	DataNames   NameMap         // 子段 9
+}
+
+// 名称列表，项目需按索引从小到大排列
+type NameMap []NameAssoc
+
+type NameAssoc struct {
+	Idx  uint32
+	Name string
+}
+
+// 二级名称列表，项目需按索引从小到大排列
+type IndirectNameMap []IndirectNameAssoc
+
+type IndirectNameAssoc struct {
+	Idx   uint32
+	Names NameMap
+}
+
+// 名称段是否不包含任何名称
+func (ns NameSec) IsEmpty() bool {
+	return ns.ModuleName == "" &&
+		len(ns.FuncNames) == 0 && len(ns.LocalNames) == 0 &&
+		len(ns.LabelNames) == 0 && len(ns.TypeNames) == 0 &&
+		len(ns.TableNames) == 0 && len(ns.MemNames) == 0 &&
+		len(ns.GlobalNames) == 0 && len(ns.ElemNames) == 0 &&
+		len(ns.DataNames) == 0
+}
+
+// 获取模块的名称段
+// 模块没有名称段，或者名称段的格式不正确时，返回空的名称段（名称段不影响模块的运行）
+func (module Module) GetNameSec() NameSec {
+	for _, customSec := range module.CustomSecs {
+		if customSec.Name == NameSecName {
+			ns, err := DecodeNameSec(customSec.Bytes)
+			if err != nil {
+				return NameSec{}
+			}
+			return ns
+		}
+	}
+	return NameSec{}
+}
+
+// 解码名称段的内容（即名称为 "name" 的自定义段的 Bytes），未知的子段会被忽略
+func DecodeNameSec(data []byte) (ns NameSec, err error) {
+	reader := &wasmReader{data: data, sectionId: SecCustomID}
+
+	defer func() {
+		if r := recover(); r != nil {
+			ns = NameSec{}
+			err = toDecodeError(r, reader)
+		}
+	}()
+
+	reader.readNameSec(&ns)
+	return
+}
+
+func (r *wasmReader) readNameSec(ns *NameSec) {
+	lastId := -1
+	for r.remaining() > 0 {
+		id := int(r.readByte())
+		if id <= lastId {
+			r.fail("out of order name subsection: %d", id)
+		}
+		lastId = id
+
+		sub := r.subReader(int(r.readVarU32()))
+		switch id {
+		case NameSubsecModuleID:
+			ns.ModuleName = sub.readName()
+		case NameSubsecFuncID:
+			ns.FuncNames = sub.readNameMap()
+		case NameSubsecLocalID:
+			ns.LocalNames = sub.readIndirectNameMap()
+		case NameSubsecLabelID:
+			ns.LabelNames = sub.readIndirectNameMap()
+		case NameSubsecTypeID:
+			ns.TypeNames = sub.readNameMap()
+		case NameSubsecTableID:
+			ns.TableNames = sub.readNameMap()
+		case NameSubsecMemID:
+			ns.MemNames = sub.readNameMap()
+		case NameSubsecGlobalID:
+			ns.GlobalNames = sub.readNameMap()
+		case NameSubsecElemID:
+			ns.ElemNames = sub.readNameMap()
+		case NameSubsecDataID:
+			ns.DataNames = sub.readNameMap()
+		default:
+			sub.skip(sub.remaining())
+		}
+
+		if sub.remaining() != 0 {
+			sub.fail("name subsection consumed unexpected length of data")
+		}
+	}
+}
+
+func (r *wasmReader) readNameMap() NameMap {
+	nameMap := make(NameMap, r.readCount())
+	for i := range nameMap {
+		nameMap[i] = NameAssoc{Idx: r.readVarU32(), Name: r.readName()}
+	}
+	return nameMap
+}
+
+func (r *wasmReader) readIndirectNameMap() IndirectNameMap {
+	indirectNameMap := make(IndirectNameMap, r.readCount())
+	for i := range indirectNameMap {
+		indirectNameMap[i] = IndirectNameAssoc{Idx: r.readVarU32(), Names: r.readNameMap()}
+	}
+	return indirectNameMap
+}
+
+// 在名称列表里查找指定索引的名称，找不到时返回空字符串
+func (nameMap NameMap) Get(idx uint32) string {
+	for _, assoc := range nameMap {
+		if assoc.Idx == idx {
+			return assoc.Name
+		}
+	}
+	return ""
+}
+
+// 在二级名称列表里查找指定索引的名称列表，找不到时返回 nil
+func (indirectNameMap IndirectNameMap) Get(idx uint32) NameMap {
+	for _, assoc := range indirectNameMap {
+		if assoc.Idx == idx {
+			return assoc.Names
+		}
+	}
+	return nil
+}
+
+// 将名称段编码为自定义段，空的子段会被省略
+func EncodeNameSec(ns NameSec) CustomSec {
+	w := &wasmWriter{}
+
+	if ns.ModuleName != "" {
+		w.writeSection(NameSubsecModuleID, func(sw *wasmWriter) { sw.writeName(ns.ModuleName) })
+	}
+
+	w.writeNameMapSubsec(NameSubsecFuncID, ns.FuncNames)
+	w.writeIndirectNameMapSubsec(NameSubsecLocalID, ns.LocalNames)
+	w.writeIndirectNameMapSubsec(NameSubsecLabelID, ns.LabelNames)
+	w.writeNameMapSubsec(NameSubsecTypeID, ns.TypeNames)
+	w.writeNameMapSubsec(NameSubsecTableID, ns.TableNames)
+	w.writeNameMapSubsec(NameSubsecMemID, ns.MemNames)
+	w.writeNameMapSubsec(NameSubsecGlobalID, ns.GlobalNames)
+	w.writeNameMapSubsec(NameSubsecElemID, ns.ElemNames)
+	w.writeNameMapSubsec(NameSubsecDataID, ns.DataNames)
+
+	return CustomSec{Name: NameSecName, Bytes: w.buf}
+}
+
+func (w *wasmWriter) writeNameMapSubsec(id byte, nameMap NameMap) {
+	if len(nameMap) > 0 {
+		w.writeSection(id, func(sw *wasmWriter) { sw.writeNameMap(nameMap) })
+	}
+}
+
+func (w *wasmWriter) writeIndirectNameMapSubsec(id byte, indirectNameMap IndirectNameMap) {
+	if len(indirectNameMap) > 0 {
+		w.writeSection(id, func(sw *wasmWriter) {
+			sw.writeVarU32(uint32(len(indirectNameMap)))
+			for _, assoc := range indirectNameMap {
+				sw.writeVarU32(assoc.Idx)
+				sw.writeNameMap(assoc.Names)
+			}
+		})
+	}
+}
+
+func (w *wasmWriter) writeNameMap(nameMap NameMap) {
+	w.writeVarU32(uint32(len(nameMap)))
+	for _, assoc := range nameMap {
+		w.writeVarU32(assoc.Idx)
+		w.writeName(assoc.Name)
+	}
+}
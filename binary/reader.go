@@ -2,74 +2,140 @@ package binary
 
 import (
 	"encoding/binary"
-	"errors"
 	"fmt"
 	"io/ioutil"
 	"math"
+	"unicode/utf8"
 )
 
 type wasmReader struct {
-	data []byte // 待读取的二进制数据
+	data      []byte // 待读取的二进制数据
+	offset    int    // data[0] 位于整个模块二进制数据里的位置，用于报告错误的位置
+	sectionId int    // 当前正在解码的段的 id，用于报告错误，-1 表示正在解码模块头部
+
+	instrOffsets []uint32 // 已读取的指令的位置，见 Code.Offsets
+	hasDataCount bool     // 是否已经读取过数据计数段，memory.init 和 data.drop 指令要求模块有这个段
 }
 
-func DecodeFile(filename string) Module {
+func DecodeFile(filename string) (Module, error) {
 	data, err := ioutil.ReadFile(filename)
 	if err != nil {
-		panic(err)
+		return Module{}, err
 	}
 	return Decode(data)
 }
 
-func Decode(data []byte) Module {
-	module := Module{}
-	reader := &wasmReader{data: data}
+// 解码模块
+//
+// 解码过程中遇到的所有错误（包括数据被截断、段 id 错误、索引值溢出等）
+// 都会以 *DecodeError 的形式返回，而不会导致程序崩溃
+func Decode(data []byte) (module Module, err error) {
+	reader := &wasmReader{data: data, sectionId: SecNoneID}
+
+	defer func() {
+		if r := recover(); r != nil {
+			module = Module{}
+			err = toDecodeError(r, reader)
+		}
+	}()
+
 	reader.readModule(&module)
-	return module
+	return
 }
 
 // ---------------- 辅助读取函数
 
+// 报告解码错误
+// 解码器的各个读取函数在遇到错误时通过 panic 抛出 *DecodeError，
+// 然后由 Decode() 统一转为返回值
+func (r *wasmReader) fail(format string, args ...interface{}) {
+	panic(&DecodeError{
+		Offset:    r.offset,
+		SectionId: r.sectionId,
+		Reason:    fmt.Sprintf(format, args...),
+	})
+}
+
+// 检查剩余的数据是否足够 n 个字节
+func (r *wasmReader) require(n int) {
+	if n < 0 || n > len(r.data) {
+		r.fail("unexpected end")
+	}
+}
+
+// 消耗掉 n 个字节
+func (r *wasmReader) skip(n int) {
+	r.data = r.data[n:]
+	r.offset += n
+}
+
+// 读取 n 个字节
+func (r *wasmReader) readN(n int) []byte {
+	r.require(n)
+	bytes := r.data[:n]
+	r.skip(n)
+	return bytes
+}
+
+// 创建一个只能读取接下来 n 个字节的子解析器，同时当前解析器消耗掉这 n 个字节
+// 用于解析有长度前缀的段和代码项，防止内部的解析越界
+func (r *wasmReader) subReader(n int) *wasmReader {
+	sub := &wasmReader{offset: r.offset, sectionId: r.sectionId, hasDataCount: r.hasDataCount}
+	sub.data = r.readN(n)
+	return sub
+}
+
 // 读取一个字节
 func (r *wasmReader) readByte() byte {
-	b := r.data[0]
-	r.data = r.data[1:]
-	return b
+	return r.readN(1)[0]
 }
 
 // 读取固定长度的 uint32
 func (r *wasmReader) readU32() uint32 {
-	n := binary.LittleEndian.Uint32(r.data)
-	r.data = r.data[4:] // 消耗掉 4 bytes
-	return n
+	return binary.LittleEndian.Uint32(r.readN(4))
 }
 
 // 读取固定长度的 float32
 func (r *wasmReader) readF32() float32 {
-	n := binary.LittleEndian.Uint32(r.data)
-	r.data = r.data[4:] // 消耗掉 4 bytes
+	n := binary.LittleEndian.Uint32(r.readN(4))
 	return math.Float32frombits(n)
 }
 
 // 读取固定长度的 float64
 func (r *wasmReader) readF64() float64 {
-	n := binary.LittleEndian.Uint64(r.data)
-	r.data = r.data[8:] // 消耗掉 8 bytes
+	n := binary.LittleEndian.Uint64(r.readN(8))
 	return math.Float64frombits(n)
 }
 
 // 读取变长（leb128）uint32
 func (r *wasmReader) readVarU32() uint32 {
-	value, bytes := decodeVarUint(r.data, 32)
-	r.data = r.data[bytes:]
+	value, bytes, err := decodeVarUint(r.data, 32)
+	if err != nil {
+		r.fail(err.Error())
+	}
+	r.skip(bytes)
 	return uint32(value)
 }
 
+// 读取变长（leb128）uint64
+func (r *wasmReader) readVarU64() uint64 {
+	value, bytes, err := decodeVarUint(r.data, 64)
+	if err != nil {
+		r.fail(err.Error())
+	}
+	r.skip(bytes)
+	return value
+}
+
 // 读取变长（leb128）signed int32
 // 注：大部分指令使用 unsigned int，但有些使用 signed int，比如
 // const 指令的立即数和 block type
 func (r *wasmReader) readVarS32() int32 {
-	value, bytes := decodeVarInt(r.data, 32)
-	r.data = r.data[bytes:]
+	value, bytes, err := decodeVarInt(r.data, 32)
+	if err != nil {
+		r.fail(err.Error())
+	}
+	r.skip(bytes)
 	return int32(value)
 }
 
@@ -77,13 +143,27 @@ func (r *wasmReader) readVarS32() int32 {
 // 注：大部分指令使用 unsigned int，但有些使用 signed int，比如
 // const 指令的立即数和 block type
 func (r *wasmReader) readVarS64() int64 {
-	value, bytes := decodeVarInt(r.data, 64)
-	r.data = r.data[bytes:]
+	value, bytes, err := decodeVarInt(r.data, 64)
+	if err != nil {
+		r.fail(err.Error())
+	}
+	r.skip(bytes)
 	return value
 }
 
+// 读取列表的项目数量
+// 因为列表的每个项目至少占用 1 个字节，所以项目数量不可能
+// 超过剩余的数据长度，这样可以防止恶意数据导致分配巨大的内存
+func (r *wasmReader) readCount() int {
+	count := r.readVarU32()
+	if uint64(count) > uint64(r.remaining()) {
+		r.fail("too many items: %d", count)
+	}
+	return int(count)
+}
+
 func (r *wasmReader) readVarU32Array() []uint32 {
-	vec := make([]uint32, r.readVarU32())
+	vec := make([]uint32, r.readCount())
 	for i := range vec {
 		vec[i] = r.readVarU32()
 	}
@@ -94,24 +174,20 @@ func (r *wasmReader) readVarU32Array() []uint32 {
 // 项目内容的长度位于开头的一个 uint32 数字
 func (r *wasmReader) readBytes() []byte {
 	length := r.readVarU32()
-	bytes := r.data[:length]
-	r.data = r.data[length:]
-	return bytes
+	if uint64(length) > uint64(r.remaining()) {
+		r.fail("length out of bounds")
+	}
+	return r.readN(int(length))
 }
 
 // 读取字符串
 // 字符串的长度位于开头的一个 uint32 数字
 func (r *wasmReader) readName() string {
 	data := r.readBytes()
-	return string(data)
-}
-
-func (r *wasmReader) readZero() byte {
-	b := r.readByte()
-	if b != 0 {
-		panic(errors.New("expected zero"))
+	if !utf8.Valid(data) {
+		r.fail("malformed UTF-8 encoding")
 	}
-	return 0
+	return string(data)
 }
 
 // 获取剩余的数据的长度（字节数）
@@ -123,56 +199,86 @@ func (r *wasmReader) remaining() int {
 
 func (r *wasmReader) readModule(m *Module) {
 	m.Magic = r.readU32()
+	if m.Magic != MagicNumber {
+		r.fail("magic header not detected")
+	}
+
 	m.Version = r.readU32()
+	if m.Version != Version {
+		r.fail("unknown binary version: %d", m.Version)
+	}
 
 	r.readSections(m)
 }
 
 func (r *wasmReader) readSections(m *Module) {
-	// 记录上一次/最后一次解析段 id，用于确保段 id
+	// 记录上一次/最后一次解析的段的次序，用于确保段
 	// 是按照正确顺序出现
-	lastSectionId := byte(0)
+	lastSectionOrder := -1
 
 	for r.remaining() > 0 {
+		r.sectionId = SecNoneID
 		sectionId := r.readByte()
+
+		if sectionId != SecCustomID {
+			// 除了自定义段，其他段按照 sectionOrder 的顺序出现，而且最多只能出现一次
+			order := getSectionOrder(sectionId)
+			if order < 0 {
+				r.fail("invalid section id: %d", sectionId)
+			}
+			if order <= lastSectionOrder {
+				r.fail("unexpected section id: %d", sectionId)
+			}
+			lastSectionOrder = order
+		}
+
+		r.sectionId = int(sectionId)
+
+		// 当前段的长度，注意这里已经消耗了当前段的长度数据
+		length := r.readVarU32()
+		if uint64(length) > uint64(r.remaining()) {
+			r.fail("section length out of bounds")
+		}
+
+		// 使用只能读取当前段数据的子解析器来解析当前段，
+		// 以防止段的解析过程越界读取到下一个段的数据
+		sectionReader := r.subReader(int(length))
+
 		if sectionId == SecCustomID {
 			// 自定义段的出现顺序不固定，而且可能出现多次
 			m.CustomSecs = append(m.CustomSecs,
-				r.readCustomSec())
+				sectionReader.readCustomSec())
 		} else {
-			// 除了自定义段，其他段的 id 出现顺序是按照从小到大的顺序出现
-			if sectionId > SecDataID || sectionId <= lastSectionId {
-				panic(errors.New("invalid section id"))
-			}
-			lastSectionId = sectionId
-
-			// 当前段的长度，注意这里已经消耗了当前段的长度数据
-			length := r.readVarU32()
-			// 当前剩余的长度
-			lastRemainBytes := r.remaining()
-
-			r.readNonCustomSec(sectionId, m)
+			sectionReader.readNonCustomSec(sectionId, m)
+			r.hasDataCount = m.DataCountSec != nil
+		}
 
-			// 检查段解析过程是否正确地解析完当前段的所有数据
-			remainBytes := r.remaining()
-			if remainBytes != lastRemainBytes-int(length) {
-				panic(errors.New("section parser consumed unexpected length of data"))
-			}
+		// 检查段解析过程是否正确地解析完当前段的所有数据
+		if sectionReader.remaining() != 0 {
+			sectionReader.fail("section parser consumed unexpected length of data")
 		}
 	}
+
+	r.sectionId = SecNoneID
+	if len(m.FuncSec) != len(m.CodeSec) {
+		r.fail("function and code section have inconsistent lengths")
+	}
+	if m.DataCountSec != nil && int(*m.DataCountSec) != len(m.DataSec) {
+		r.fail("data count and data section have inconsistent lengths")
+	}
 }
 
 // ---------------- 解码自定义段
 
 func (r *wasmReader) readCustomSec() CustomSec {
-	// 自定义段的数据长度由段 id 后的第一个 uint32 数字指出
-
-	// 消耗整个 custom section 的有效数据，然后构造新的解析器
-	sectionReader := wasmReader{data: r.readBytes()}
-	return CustomSec{
-		Name:  sectionReader.readName(),
-		Bytes: sectionReader.data,
+	// 自定义段的数据长度由段 id 后的第一个 uint32 数字指出，
+	// 当前解析器只包含整个 custom section 的有效数据
+	sec := CustomSec{
+		Name:  r.readName(),
+		Bytes: r.data,
 	}
+	r.skip(r.remaining())
+	return sec
 }
 
 // ---------------- 解码非自定义段（的入口）
@@ -189,6 +295,8 @@ func (r *wasmReader) readNonCustomSec(sectionId byte, m *Module) {
 		m.TableSec = r.readTableSec()
 	case SecMemID:
 		m.MemSec = r.readMemSec()
+	case SecTagID:
+		m.TagSec = r.readTagSec()
 	case SecGlobalID:
 		m.GlobalSec = r.readGlobalSec()
 	case SecExportID:
@@ -201,6 +309,9 @@ func (r *wasmReader) readNonCustomSec(sectionId byte, m *Module) {
 		m.CodeSec = r.readCodeSec()
 	case SecDataID:
 		m.DataSec = r.readDataSec()
+	case SecDataCountID:
+		count := r.readVarU32()
+		m.DataCountSec = &count
 	}
 }
 
@@ -210,7 +321,7 @@ func (r *wasmReader) readNonCustomSec(sectionId byte, m *Module) {
 // 第一个字节是段的类型 id
 
 func (r *wasmReader) readTypeSec() []FuncType {
-	vec := make([]FuncType, r.readVarU32())
+	vec := make([]FuncType, r.readCount())
 	for i := range vec {
 		vec[i] = r.readFuncType()
 	}
@@ -218,45 +329,54 @@ func (r *wasmReader) readTypeSec() []FuncType {
 }
 
 func (r *wasmReader) readFuncType() FuncType {
-	ft := FuncType{
-		Tag:         r.readByte(),
-		ParamTypes:  r.readValTypes(),
-		ResultTypes: r.readValTypes(),
+	tag := r.readByte()
+	if tag != FtTag {
+		r.fail("invalid function type tag: %d", tag)
 	}
 
-	if ft.Tag != FtTag {
-		panic(fmt.Errorf("invalid function type tag: %d", ft.Tag))
+	return FuncType{
+		Tag:         tag,
+		ParamTypes:  r.readValTypes(),
+		ResultTypes: r.readValTypes(),
 	}
-
-	return ft
 }
 
 func (r *wasmReader) readValTypes() []ValType {
-	vec := make([]ValType, r.readVarU32())
+	vec := make([]ValType, r.readCount())
 	for i := range vec {
 		vec[i] = r.readValType()
 	}
 	return vec
 }
 
-// 因为数据类型只有 4 种，所以 data_type 的类型是 byte
-func (reader *wasmReader) readValType() ValType {
-	b := reader.readByte()
+// 因为数据类型只有 7 种，所以 data_type 的类型是 byte
+func (r *wasmReader) readValType() ValType {
+	b := r.readByte()
 
 	if b != ValTypeI32 &&
 		b != ValTypeI64 &&
 		b != ValTypeF32 &&
-		b != ValTypeF64 {
-		panic(fmt.Errorf("invalid data type: %d", b))
+		b != ValTypeF64 &&
+		b != ValTypeV128 &&
+		!IsRefType(b) {
+		r.fail("invalid data type: %d", b)
 	}
 
 	return b
 }
 
+func (r *wasmReader) readRefType() ValType {
+	b := r.readByte()
+	if !IsRefType(b) {
+		r.fail("malformed reference type: %d", b)
+	}
+	return b
+}
+
 // ---------------- 解码导入段
 
 func (r *wasmReader) readImportSec() []Import {
-	vec := make([]Import, r.readVarU32())
+	vec := make([]Import, r.readCount())
 	for i := range vec {
 		vec[i] = r.readImport()
 	}
@@ -286,8 +406,11 @@ func (r *wasmReader) readImportDesc() ImportDesc {
 	case ImportTagGlobal:
 		// 全局变量项目
 		desc.Global = r.readGlobalType()
+	case ImportTagTag:
+		// 异常标签项目
+		desc.TagType = r.readTagType()
 	default:
-		panic(fmt.Errorf("invalid import desc tag: %d", desc.Tag))
+		r.fail("invalid import desc tag: %d", desc.Tag)
 	}
 	return desc
 }
@@ -295,7 +418,7 @@ func (r *wasmReader) readImportDesc() ImportDesc {
 // ---------------- 解码函数（列表）段
 
 func (r *wasmReader) readFuncSec() []TypeIdx {
-	vec := make([]TypeIdx, r.readVarU32())
+	vec := make([]TypeIdx, r.readCount())
 	for i := range vec {
 		vec[i] = r.readVarU32()
 	}
@@ -305,7 +428,7 @@ func (r *wasmReader) readFuncSec() []TypeIdx {
 // ---------------- 解码表（列表）段
 
 func (r *wasmReader) readTableSec() []TableType {
-	vec := make([]TableType, r.readVarU32())
+	vec := make([]TableType, r.readCount())
 	for i := range vec {
 		vec[i] = r.readTableType()
 	}
@@ -313,25 +436,25 @@ func (r *wasmReader) readTableSec() []TableType {
 }
 
 func (r *wasmReader) readTableType() TableType {
-	tt := TableType{
-		ElemType: r.readByte(),
-		Limits:   r.readLimits(),
-	}
-	if tt.ElemType != FuncRef {
-		panic(fmt.Errorf("invalid elemtype: %d", tt.ElemType))
+	tableType := TableType{ElemType: r.readRefType(), Limits: r.readLimits()}
+
+	// 表不能共享，也不能是 64 位的
+	if tableType.Limits.Tag > LimitsTagMax {
+		r.fail("invalid table limits flags: %d", tableType.Limits.Tag)
 	}
-	return tt
+	return tableType
 }
 
 func (r *wasmReader) readLimits() Limits {
-	limits := Limits{
-		Tag: r.readByte(),
-		Min: r.readVarU32(),
+	limits := Limits{Tag: r.readByte()}
+	if limits.Tag > LimitsTagMax|LimitsTagShared|LimitsTag64 {
+		r.fail("invalid limits flags: %d", limits.Tag)
 	}
+	limits.Min = r.readLimit(limits.Is64())
 
-	// 仅当 tag == 1 时，才有 max 数据
-	if limits.Tag == 1 {
-		limits.Max = r.readVarU32()
+	// 仅当 tag 的第 0 位为 1 时，才有 max 数据
+	if limits.HasMax() {
+		limits.Max = r.readLimit(limits.Is64())
 	}
 
 	// 当 tag == 0 时，max 值为 0，表示
@@ -340,20 +463,49 @@ func (r *wasmReader) readLimits() Limits {
 	return limits
 }
 
+// 64 位的内存的限制值是 u64，其他是 u32
+func (r *wasmReader) readLimit(is64 bool) uint64 {
+	if is64 {
+		return r.readVarU64()
+	}
+	return uint64(r.readVarU32())
+}
+
 // ---------------- 解码内存块（列表）段
 
 func (r *wasmReader) readMemSec() []MemType {
-	vec := make([]MemType, r.readVarU32())
+	vec := make([]MemType, r.readCount())
 	for i := range vec {
 		vec[i] = r.readLimits()
 	}
 	return vec
 }
 
+// ---------------- 解码标签段
+
+func (r *wasmReader) readTagSec() []TagType {
+	vec := make([]TagType, r.readCount())
+	for i := range vec {
+		vec[i] = r.readTagType()
+	}
+	return vec
+}
+
+func (r *wasmReader) readTagType() TagType {
+	tt := TagType{
+		Attribute: r.readByte(),
+		Type:      r.readVarU32(),
+	}
+	if tt.Attribute != TagAttributeException {
+		r.fail("invalid tag attribute: %d", tt.Attribute)
+	}
+	return tt
+}
+
 // ---------------- 解码全局变量段
 
 func (r *wasmReader) readGlobalSec() []Global {
-	vec := make([]Global, r.readVarU32())
+	vec := make([]Global, r.readCount())
 	for i := range vec {
 		vec[i] = Global{
 			Type: r.readGlobalType(),
@@ -370,7 +522,7 @@ func (r *wasmReader) readGlobalType() GlobalType {
 	}
 	if gt.Mut != MutConst &&
 		gt.Mut != MutVar {
-		panic(errors.New("invalid global mutability type"))
+		r.fail("invalid global mutability type")
 	}
 	return gt
 }
@@ -378,7 +530,7 @@ func (r *wasmReader) readGlobalType() GlobalType {
 func (r *wasmReader) readExpr() Expr {
 	insts, end := r.readInstructions()
 	if end != End_ {
-		panic(errors.New("invalid expression end"))
+		r.fail("invalid expression end")
 	}
 	return insts
 }
@@ -386,31 +538,32 @@ func (r *wasmReader) readExpr() Expr {
 // ---------------- 解码导出段
 
 func (r *wasmReader) readExportSec() []Export {
-	vec := make([]Export, r.readVarU32())
+	vec := make([]Export, r.readCount())
 	for i := range vec {
 		vec[i] = r.readExport()
 	}
 	return vec
 }
 
-func (reader *wasmReader) readExport() Export {
+func (r *wasmReader) readExport() Export {
 	return Export{
-		Name: reader.readName(),
-		Desc: reader.readExportDesc(),
+		Name: r.readName(),
+		Desc: r.readExportDesc(),
 	}
 }
 
-func (reader *wasmReader) readExportDesc() ExportDesc {
+func (r *wasmReader) readExportDesc() ExportDesc {
 	desc := ExportDesc{
-		Tag: reader.readByte(),
-		Idx: reader.readVarU32(),
+		Tag: r.readByte(),
+		Idx: r.readVarU32(),
 	}
 
 	if desc.Tag != ExportTagFunc && // func_idx
 		desc.Tag != ExportTagTable && // table_idx
 		desc.Tag != ExportTagMem && // mem_idx
-		desc.Tag != ExportTagGlobal { // global_idx
-		panic(errors.New("invalid export desc tag"))
+		desc.Tag != ExportTagGlobal && // global_idx
+		desc.Tag != ExportTagTag { // tag_idx
+		r.fail("invalid export desc tag")
 	}
 	return desc
 }
@@ -425,7 +578,7 @@ func (r *wasmReader) readStartSec() *uint32 {
 // ---------------- 解码元素段（表的初始内容）
 
 func (r *wasmReader) readElemSec() []Elem {
-	vec := make([]Elem, r.readVarU32())
+	vec := make([]Elem, r.readCount())
 	for i := range vec {
 		vec[i] = r.readElem()
 	}
@@ -433,15 +586,47 @@ func (r *wasmReader) readElemSec() []Elem {
 }
 
 func (r *wasmReader) readElem() Elem {
-	return Elem{
-		Table:  r.readVarU32(),      // 表索引
-		Offset: r.readExpr(),        // 偏移值的表达式
-		Init:   r.readFuncIndices(), // 函数索引
+	elem := Elem{Type: FuncRef}
+	flags := r.readVarU32()
+	switch flags {
+	case 0, 4:
+		elem.Offset = r.readExpr() // 偏移值的表达式
+	case 1, 5:
+		elem.Mode = SegmentModePassive
+	case 2, 6:
+		elem.Table = r.readVarU32() // 表索引
+		elem.Offset = r.readExpr()
+	case 3, 7:
+		elem.Mode = SegmentModeDeclarative
+	default:
+		r.fail("invalid element segment flags: %d", flags)
+	}
+
+	if flags < 4 {
+		if flags != 0 {
+			r.readElemKind()
+		}
+		elem.Init = r.readFuncIndices() // 函数索引
+	} else {
+		if flags != 4 {
+			elem.Type = r.readRefType()
+		}
+		elem.Exprs = make([]Expr, r.readCount()) // 元素的表达式
+		for i := range elem.Exprs {
+			elem.Exprs[i] = r.readExpr()
+		}
+	}
+	return elem
+}
+
+func (r *wasmReader) readElemKind() {
+	if kind := r.readByte(); kind != ElemKindFuncRef {
+		r.fail("invalid elemkind: %d", kind)
 	}
 }
 
 func (r *wasmReader) readFuncIndices() []FuncIdx {
-	vec := make([]FuncIdx, r.readVarU32())
+	vec := make([]FuncIdx, r.readCount())
 	for i := range vec {
 		vec[i] = r.readVarU32()
 	}
@@ -451,7 +636,7 @@ func (r *wasmReader) readFuncIndices() []FuncIdx {
 // ---------------- 解码（函数）代码段
 
 func (r *wasmReader) readCodeSec() []Code {
-	vec := make([]Code, r.readVarU32())
+	vec := make([]Code, r.readCount())
 	for i := range vec {
 		vec[i] = r.readCode()
 	}
@@ -459,27 +644,39 @@ func (r *wasmReader) readCodeSec() []Code {
 }
 
 func (r *wasmReader) readCode() Code {
-	codeReader := wasmReader{data: r.readBytes()}
-	code := Code{
-		Locals: codeReader.readLocalsVec(),
-		Expr:   codeReader.readExpr(),
+	length := r.readVarU32()
+	if uint64(length) > uint64(r.remaining()) {
+		r.fail("function body length out of bounds")
 	}
 
+	codeReader := r.subReader(int(length))
+	locals := codeReader.readLocalsVec()
+
 	// 检查局部变量的数量是否溢出
 	total := uint64(0)
-	for _, locals := range code.Locals {
-		total += uint64(locals.N)
+	for _, item := range locals {
+		total += uint64(item.N)
+	}
+
+	if total > MaxLocalCount {
+		codeReader.fail("too many locals: %d", total)
 	}
 
-	if total >= math.MaxUint32 {
-		panic(fmt.Errorf("too many locals: %d", total))
+	code := Code{
+		Locals: locals,
+		Expr:   codeReader.readExpr(),
+	}
+	code.Offsets = codeReader.instrOffsets
+
+	if codeReader.remaining() != 0 {
+		codeReader.fail("function body consumed unexpected length of data")
 	}
 
 	return code
 }
 
 func (r *wasmReader) readLocalsVec() []Locals {
-	vec := make([]Locals, r.readVarU32())
+	vec := make([]Locals, r.readCount())
 	for i := range vec {
 		vec[i] = r.readLocals()
 	}
@@ -496,7 +693,7 @@ func (r *wasmReader) readLocals() Locals {
 // ---------------- 解码数据段（内存块初始内容）
 
 func (r *wasmReader) readDataSec() []Data {
-	vec := make([]Data, r.readVarU32())
+	vec := make([]Data, r.readCount())
 	for i := range vec {
 		vec[i] = r.readData()
 	}
@@ -504,11 +701,20 @@ func (r *wasmReader) readDataSec() []Data {
 }
 
 func (r *wasmReader) readData() Data {
-	return Data{
-		Mem:    r.readVarU32(),
-		Offset: r.readExpr(),
-		Init:   r.readBytes(),
+	var data Data
+	switch flags := r.readVarU32(); flags {
+	case 0:
+		data.Offset = r.readExpr()
+	case 1:
+		data.Mode = SegmentModePassive
+	case 2:
+		data.Mem = r.readVarU32()
+		data.Offset = r.readExpr()
+	default:
+		r.fail("invalid data segment flags: %d", flags)
 	}
+	data.Init = r.readBytes()
+	return data
 }
 
 // ---------------- 解码指令
@@ -516,7 +722,10 @@ func (r *wasmReader) readData() Data {
 func (r *wasmReader) readInstructions() (insts []Instruction, end byte) {
 	for {
 		inst := r.readInstruction()
-		if inst.Opcode == Else_ || inst.Opcode == End_ {
+		if inst.Opcode == Else_ || inst.Opcode == End_ ||
+			inst.Opcode == Catch_ || inst.Opcode == CatchAll_ || inst.Opcode == Delegate_ {
+			// 结束标记不是指令，移除其位置（结束标记没有内嵌的指令，所以是最后一项）
+			r.instrOffsets = r.instrOffsets[:len(r.instrOffsets)-1]
 			end = inst.Opcode
 			return
 		}
@@ -526,7 +735,11 @@ func (r *wasmReader) readInstructions() (insts []Instruction, end byte) {
 }
 
 func (r *wasmReader) readInstruction() (inst Instruction) {
+	r.instrOffsets = append(r.instrOffsets, uint32(r.offset))
 	inst.Opcode = r.readByte()
+	if opnames[inst.Opcode] == "" {
+		r.fail("illegal opcode: 0x%02x", inst.Opcode)
+	}
 	inst.Args = r.readArgs(inst.Opcode)
 	return
 }
@@ -548,8 +761,12 @@ func (r *wasmReader) readArgs(opcode byte) interface{} {
 		return r.readF32()
 	case F64Const:
 		return r.readF64()
-	case TruncSat:
-		return r.readByte()
+	case MiscPrefix:
+		return r.readMiscArgs()
+	case SIMDPrefix:
+		return r.readSIMDArgs()
+	case AtomicPrefix:
+		return r.readAtomicArgs()
 
 	// 变量指令
 
@@ -558,10 +775,21 @@ func (r *wasmReader) readArgs(opcode byte) interface{} {
 	case GlobalGet, GlobalSet:
 		return r.readVarU32() // global_idx
 
+	// 引用指令和表指令
+
+	case RefNull:
+		return r.readRefType()
+	case RefFunc:
+		return r.readVarU32() // func_idx
+	case TableGet, TableSet:
+		return r.readVarU32() // table_idx
+	case SelectT:
+		return r.readValTypes()
+
 	// 内存指令
 
 	case MemorySize, MemoryGrow:
-		return r.readZero()
+		return r.readVarU32() // mem_idx
 
 	// 结构化控制指令
 
@@ -569,6 +797,15 @@ func (r *wasmReader) readArgs(opcode byte) interface{} {
 		return r.readBlockArgs()
 	case If:
 		return r.readIfArgs()
+	case Try:
+		return r.readTryArgs()
+
+	// 异常处理指令
+
+	case Throw:
+		return r.readVarU32() // tag_idx
+	case Rethrow:
+		return r.readVarU32() // label_idx
 
 	// 跳转指令
 
@@ -579,9 +816,9 @@ func (r *wasmReader) readArgs(opcode byte) interface{} {
 
 	// 函数调用指令
 
-	case Call:
+	case Call, ReturnCall:
 		return r.readVarU32() // func_idx
-	case CallIndirect:
+	case CallIndirect, ReturnCallIndirect:
 		return r.readCallIndirectArgs()
 
 	default:
@@ -598,7 +835,7 @@ func (r *wasmReader) readBlockArgs() (args BlockArgs) {
 	args.BT = r.readBlockType()
 	args.Instrs, end = r.readInstructions()
 	if end != End_ {
-		panic(errors.New("invalid block end"))
+		r.fail("invalid block end")
 	}
 	return
 }
@@ -607,9 +844,10 @@ func (r *wasmReader) readBlockType() int32 {
 	bt := r.readVarS32()
 	if bt < 0 {
 		if bt != BlockTypeI32 && bt != BlockTypeI64 &&
-			bt != BlockTypeF32 && bt != BlockTypeF64 &&
+			bt != BlockTypeF32 && bt != BlockTypeF64 && bt != BlockTypeV128 &&
+			bt != BlockTypeFuncRef && bt != BlockTypeExternRef &&
 			bt != BlockTypeEmpty {
-			panic(errors.New("invalid block type"))
+			r.fail("invalid block type")
 		}
 	}
 	return bt
@@ -622,12 +860,47 @@ func (r *wasmReader) readIfArgs() (args IfArgs) {
 	if end == Else_ {
 		args.Instrs2, end = r.readInstructions()
 		if end != End_ {
-			panic(errors.New("invalid else block"))
+			r.fail("invalid else block")
 		}
 	}
 	return
 }
 
+// 注：
+// catch 和 delegate 这两个标记的立即数（tag_idx 和 label_idx）由
+// readTryArgs 读取，readInstructions 遇到这些标记时只读取了操作码
+func (r *wasmReader) readTryArgs() (args TryArgs) {
+	var end byte
+	args.BT = r.readBlockType()
+	args.Instrs, end = r.readInstructions()
+
+	for end == Catch_ || end == CatchAll_ {
+		if len(args.Catches) > 0 && args.Catches[len(args.Catches)-1].All {
+			r.fail("catch after catch_all")
+		}
+
+		clause := CatchClause{All: end == CatchAll_}
+		if !clause.All {
+			clause.Tag = r.readVarU32()
+		}
+		clause.Instrs, end = r.readInstructions()
+		args.Catches = append(args.Catches, clause)
+	}
+
+	switch end {
+	case End_:
+	case Delegate_:
+		if len(args.Catches) > 0 {
+			r.fail("delegate after catch")
+		}
+		label := r.readVarU32()
+		args.Delegate = &label
+	default:
+		r.fail("invalid try end")
+	}
+	return
+}
+
 func (r *wasmReader) readBrTableArgs() BrTableArgs {
 	return BrTableArgs{
 		Labels:  r.readVarU32Array(), // label_idx:uint32 label_idx:uint32 ...
@@ -635,15 +908,91 @@ func (r *wasmReader) readBrTableArgs() BrTableArgs {
 	}
 }
 
-func (r *wasmReader) readCallIndirectArgs() uint32 {
-	typeIdx := r.readVarU32()
-	r.readZero() // 表索引暂时只能是 0
-	return typeIdx
+func (r *wasmReader) readCallIndirectArgs() CallIndirectArgs {
+	return CallIndirectArgs{Type: r.readVarU32(), Table: r.readVarU32()}
+}
+
+func (r *wasmReader) checkDataCount() {
+	if !r.hasDataCount {
+		r.fail("data count section required")
+	}
+}
+
+func (r *wasmReader) readMiscArgs() MiscArgs {
+	args := MiscArgs{SubOpcode: r.readVarU32()}
+	switch args.SubOpcode {
+	case MemoryInit:
+		r.checkDataCount()
+		args.Args = MemoryInitArgs{Data: r.readVarU32(), Mem: r.readVarU32()}
+	case DataDrop:
+		r.checkDataCount()
+		args.Args = r.readVarU32() // data_idx
+	case MemoryCopy:
+		args.Args = MemoryCopyArgs{Dst: r.readVarU32(), Src: r.readVarU32()}
+	case MemoryFill:
+		args.Args = r.readVarU32() // mem_idx
+	case TableInit:
+		args.Args = TableInitArgs{Elem: r.readVarU32(), Table: r.readVarU32()}
+	case ElemDrop:
+		args.Args = r.readVarU32() // elem_idx
+	case TableCopy:
+		args.Args = TableCopyArgs{Dst: r.readVarU32(), Src: r.readVarU32()}
+	case TableGrow, TableSize, TableFill:
+		args.Args = r.readVarU32() // table_idx
+	default:
+		if GetMiscOpname(args.SubOpcode) == "" {
+			r.fail("illegal opcode: 0xfc %d", args.SubOpcode)
+		}
+	}
+	return args
+}
+
+func (r *wasmReader) readSIMDArgs() SIMDArgs {
+	args := SIMDArgs{SubOpcode: r.readVarU32()}
+	switch sub := args.SubOpcode; {
+	case sub <= V128Store || sub == V128Load32Zero || sub == V128Load64Zero:
+		args.Args = r.readMemArg()
+	case sub >= V128Load8Lane && sub <= V128Store64Lane:
+		args.Args = MemLaneArgs{MemArg: r.readMemArg(), Lane: r.readByte()}
+	case sub == V128Const:
+		var val V128
+		copy(val[:], r.readN(16))
+		args.Args = val
+	case sub == I8x16Shuffle:
+		var lanes [16]LaneIdx
+		copy(lanes[:], r.readN(16))
+		args.Args = lanes
+	case sub >= I8x16ExtractLaneS && sub <= F64x2ReplaceLane:
+		args.Args = r.readByte() // lane_idx
+	default:
+		if GetSIMDOpname(sub) == "" {
+			r.fail("illegal opcode: 0xfd %d", sub)
+		}
+	}
+	return args
+}
+
+func (r *wasmReader) readAtomicArgs() AtomicArgs {
+	args := AtomicArgs{SubOpcode: r.readVarU32()}
+	switch sub := args.SubOpcode; {
+	case GetAtomicOpname(sub) == "":
+		r.fail("illegal opcode: 0xfe %d", sub)
+	case sub == AtomicFence:
+		if r.readByte() != 0 {
+			r.fail("zero byte expected")
+		}
+	default:
+		args.Args = r.readMemArg()
+	}
+	return args
 }
 
 func (r *wasmReader) readMemArg() MemArg {
-	return MemArg{
-		Align:  r.readVarU32(),
-		Offset: r.readVarU32(),
+	memArg := MemArg{Align: r.readVarU32()}
+	if memArg.Align&memArgMemFlag != 0 {
+		memArg.Align &^= memArgMemFlag
+		memArg.Mem = r.readVarU32()
 	}
+	memArg.Offset = r.readVarU64() // 对于 32 位的内存，验证时会检查是否超出 uint32 的范围
+	return memArg
 }
@@ -49,7 +49,8 @@ func TestReadFunctionSection(t *testing.T) {
 	// 	)
 	// )
 
-	m := DecodeFile(wasmFilePath)
+	m, err := DecodeFile(wasmFilePath)
+	assert.AssertNil(t, err)
 
 	// 检查幻数和版本
 	// 0x0000 | 00 61 73 6d | version 1 (Module)
@@ -173,7 +174,8 @@ func TestReadMultipleSections(t *testing.T) {
 	// 	(export "__heap_base" (global 2))
 	// )
 
-	m := DecodeFile(wasmFilePath)
+	m, err := DecodeFile(wasmFilePath)
+	assert.AssertNil(t, err)
 
 	// 检查幻数和版本
 	assert.AssertEqual(t, MagicNumber, m.Magic)
@@ -305,3 +307,130 @@ func TestReadMultipleSections(t *testing.T) {
 
 	// 自定义段内容不检查
 }
+
+func TestDecodeError(t *testing.T) {
+	// 幻数错误
+	_, err := Decode([]byte{0x00, 0x61, 0x73, 0x6e, 0x01, 0x00, 0x00, 0x00})
+	assertDecodeError(t, err, 4, SecNoneID)
+
+	// 数据被截断
+	_, err = Decode([]byte{0x00, 0x61, 0x73})
+	assertDecodeError(t, err, 0, SecNoneID)
+
+	// 段 id 错误
+	_, err = Decode([]byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x0e, 0x00})
+	assertDecodeError(t, err, 9, SecNoneID)
+
+	// 段的内容长度超出实际的数据长度
+	_, err = Decode([]byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x01, 0x05, 0x01, 0x60})
+	assertDecodeError(t, err, 10, SecTypeID)
+
+	// 段的内容比段的长度声明的要长
+	_, err = Decode([]byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x01, 0x02, 0x01, 0x60, 0x00, 0x00})
+	assertDecodeError(t, err, 12, SecTypeID)
+
+	// 项目数量远远超出实际的数据长度
+	_, err = Decode([]byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x03, 0x05, 0xff, 0xff, 0xff, 0xff, 0x0f})
+	assertDecodeError(t, err, 15, SecFuncID)
+
+	// 数据计数段出现在代码段之后
+	_, err = Decode([]byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x0a, 0x01, 0x00, 0x0c, 0x01, 0x00})
+	assertDecodeError(t, err, 12, SecNoneID)
+
+	// 数据计数段声明的数量跟数据段的项目数量不一致
+	_, err = Decode([]byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x0c, 0x01, 0x01})
+	assertDecodeError(t, err, 11, SecNoneID)
+
+	// 不支持的 0xFC 子操作码
+	_, err = Decode([]byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+		0x01, 0x04, 0x01, 0x60, 0x00, 0x00, // type section
+		0x03, 0x02, 0x01, 0x00, // function section
+		0x0a, 0x06, 0x01, 0x04, 0x00, 0xfc, 0x7f, 0x0b}) // code section
+	assertDecodeError(t, err, 25, SecCodeID)
+
+	// 8 个字节的函数体声明了 0xfffffff0 个局部变量
+	_, err = Decode([]byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+		0x01, 0x04, 0x01, 0x60, 0x00, 0x00, // type section
+		0x03, 0x02, 0x01, 0x00, // function section
+		0x0a, 0x0a, 0x01, 0x08, 0x01, 0xf0, 0xff, 0xff, 0xff, 0x0f, 0x7f, 0x0b}) // code section
+	assertDecodeError(t, err, 29, SecCodeID)
+}
+
+func TestReadBulkMemorySegments(t *testing.T) {
+	currentDir, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+
+	wasmFilePath := filepath.Join(currentDir, "..", "test", "resources", "reader", "test-read-bulk-memory.wasm")
+
+	// "test-read-bulk-memory.wasm" 有被动、主动和声明式的元素段，
+	// 以及主动和被动的数据段，函数里使用了所有的 bulk memory 指令
+
+	m, err := DecodeFile(wasmFilePath)
+	assert.AssertNil(t, err)
+	assert.AssertNil(t, Validate(m))
+
+	// 0x0031 | 0c 01       | data count section
+	// 0x0033 | 02          | data count 2
+	assert.AssertEqual(t, uint32(2), *m.DataCountSec)
+
+	assert.AssertEqual(t, 3, len(m.ElemSec))
+	assert.AssertEqual(t, SegmentModePassive, m.ElemSec[0].Mode)
+	assert.AssertEqual(t, SegmentModeActive, m.ElemSec[1].Mode)
+	assert.AssertEqual(t, SegmentModeDeclarative, m.ElemSec[2].Mode)
+	assert.AssertSliceEqual(t, []FuncIdx{0}, m.ElemSec[0].Init)
+
+	assert.AssertEqual(t, 2, len(m.DataSec))
+	assert.AssertEqual(t, SegmentModeActive, m.DataSec[0].Mode)
+	assert.AssertEqual(t, SegmentModePassive, m.DataSec[1].Mode)
+	assert.AssertSliceEqual(t, []byte("bar"), m.DataSec[1].Init)
+
+	expr := m.CodeSec[0].Expr
+	assert.AssertEqual(t, MiscPrefix, expr[3].Opcode)
+	assert.AssertEqual(t, MemoryInit, expr[3].Args.(MiscArgs).SubOpcode)
+	assert.AssertEqual(t, MemoryInitArgs{Data: 1, Mem: 0}, expr[3].Args.(MiscArgs).Args.(MemoryInitArgs))
+	assert.AssertEqual(t, "memory.init", expr[3].GetOpname())
+	assert.AssertEqual(t, MemoryFill, expr[12].Args.(MiscArgs).SubOpcode)
+	assert.AssertEqual(t, TableInitArgs{Elem: 0, Table: 0}, expr[16].Args.(MiscArgs).Args.(TableInitArgs))
+	assert.AssertEqual(t, TableCopyArgs{Dst: 0, Src: 0}, expr[21].Args.(MiscArgs).Args.(TableCopyArgs))
+}
+
+// 将一个正常的模块截断为任意长度，都不应该导致崩溃，
+// 截断位置刚好位于段的边界时得到的是合法的模块，其他情况都应该返回解码错误
+func TestDecodeTruncatedData(t *testing.T) {
+	currentDir, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+
+	wasmFilePath := filepath.Join(currentDir, "..", "test", "resources", "reader", "test-read-section-2.wasm")
+	data, err := os.ReadFile(wasmFilePath)
+	if err != nil {
+		panic(err)
+	}
+
+	failures := 0
+	for length := 0; length < len(data); length++ {
+		_, err := Decode(data[:length])
+		if err != nil {
+			if _, ok := err.(*DecodeError); !ok {
+				t.Fatalf("length: %d, expected decode error, actual: %v", length, err)
+			}
+			failures++
+		}
+	}
+
+	assert.AssertTrue(t, failures > len(data)/2)
+}
+
+func assertDecodeError(t *testing.T, err error, offset int, sectionId int) {
+	t.Helper()
+
+	decodeErr, ok := err.(*DecodeError)
+	if !ok {
+		t.Fatalf("expected decode error, actual: %v", err)
+	}
+	assert.AssertEqual(t, offset, decodeErr.Offset)
+	assert.AssertEqual(t, sectionId, decodeErr.SectionId)
+}
@@ -0,0 +1,1272 @@
+package binary
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// 模块验证
+//
+// 解码器只检查二进制数据的格式，而验证器则按照规范检查模块的内容是否合法，
+// 比如：
+// - 各种索引值（类型、函数、表、内存、全局变量、局部变量、标签）是否超出范围
+// - 函数体内每条指令的操作数类型是否正确，结构块、函数的返回值是否正确
+// - 常量表达式（全局变量的初始值、元素项和数据项的偏移值）是否只包含常量指令
+// - 函数体内的 ref.func 指令引用的函数是否已经在模块的其它地方声明过
+// - 导出项的名称是否重复
+//
+// 通过验证的模块在执行过程中不会出现操作数栈的类型错乱。
+//
+// https://webassembly.github.io/spec/core/valid/index.html
+// https://webassembly.github.io/spec/core/appendix/algorithm.html
+
+// 验证模块，验证通过则返回 nil，否则返回 *ValidationError
+func Validate(m Module) (err error) {
+	v := &validator{module: m, funcIdx: -1, instrIdx: -1, refs: map[FuncIdx]bool{}}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(*ValidationError); ok {
+				err = e
+			} else {
+				err = v.newError(fmt.Sprint(r))
+			}
+		}
+	}()
+
+	v.validateModule()
+	return nil
+}
+
+// 验证过程中用于表示 `未知类型` 的值类型
+// 当指令位于 unreachable、br 等指令之后（即不可到达的代码），操作数栈
+// 可以弹出任意类型的操作数
+const valTypeUnknown ValType = 0
+
+type validator struct {
+	module Module
+
+	funcs   []FuncType   // 所有函数（包括导入函数）的类型
+	tables  []TableType  // 所有表（包括导入的表）
+	mems    []MemType    // 所有内存块（包括导入的内存块）
+	globals []GlobalType // 所有全局变量（包括导入的全局变量）
+	tags    []FuncType   // 所有异常标签（包括导入的标签）的类型
+
+	importedGlobalCount int // 导入的全局变量的数量，常量表达式只能读取导入的全局变量
+
+	// 已声明的函数引用，即在元素项、导出项以及全局变量的初始值里出现过的函数，
+	// 函数体内的 ref.func 指令只能引用这些函数
+	refs map[FuncIdx]bool
+
+	// 当前正在验证的函数
+	funcIdx  int
+	instrIdx int
+	params   []ValType      // 参数的类型
+	locals   []Locals       // 局部变量组，不展开以免大量的局部变量占用内存
+	localEnd []uint64       // 每个局部变量组之后的局部变量索引（包括参数），用于二分查找
+	offsets  []uint32       // 函数体内每条指令的位置，见 Code.Offsets
+	opds     []ValType      // 操作数栈（只记录类型）
+	ctrls    []controlFrame // 控制栈
+}
+
+// 验证过程中的控制帧
+type controlFrame struct {
+	opcode      byte
+	startTypes  []ValType // 参数类型
+	endTypes    []ValType // 返回值类型
+	height      int       // 进入结构块时操作数栈的高度
+	unreachable bool      // 当前结构块剩余的指令是否不可到达
+}
+
+// 跳转到当前控制帧时需要的操作数类型
+// 对于 loop，跳转目标是结构块的开头，所以需要的是参数，
+// 对于 block/if 以及函数，跳转目标是结构块的结尾，所以需要的是返回值。
+func (f *controlFrame) labelTypes() []ValType {
+	if f.opcode == Loop {
+		return f.startTypes
+	}
+	return f.endTypes
+}
+
+func (v *validator) fail(format string, args ...interface{}) {
+	panic(v.newError(fmt.Sprintf(format, args...)))
+}
+
+func (v *validator) newError(reason string) *ValidationError {
+	offset := -1
+	if v.instrIdx >= 0 && v.instrIdx < len(v.offsets) {
+		offset = int(v.offsets[v.instrIdx])
+	}
+	return &ValidationError{
+		FuncIdx:  v.funcIdx,
+		InstrIdx: v.instrIdx,
+		Offset:   offset,
+		Reason:   reason,
+	}
+}
+
+// ---------------- 验证模块
+
+func (v *validator) validateModule() {
+	m := v.module
+
+	for _, importItem := range m.ImportSec {
+		v.validateImport(importItem)
+	}
+
+	for _, typeIdx := range m.FuncSec {
+		v.funcs = append(v.funcs, v.getType(typeIdx))
+	}
+
+	for _, tableType := range m.TableSec {
+		v.validateTableType(tableType)
+		v.tables = append(v.tables, tableType)
+	}
+
+	for _, memType := range m.MemSec {
+		v.validateMemType(memType)
+		v.mems = append(v.mems, memType)
+	}
+
+	for _, tagType := range m.TagSec {
+		v.tags = append(v.tags, v.validateTagType(tagType))
+	}
+
+	for _, global := range m.GlobalSec {
+		v.validateConstExpr(global.Init, global.Type.ValType)
+		v.globals = append(v.globals, global.Type)
+	}
+
+	v.validateExports()
+
+	if m.StartSec != nil {
+		ft := v.getFunc(*m.StartSec)
+		if len(ft.ParamTypes) != 0 || len(ft.ResultTypes) != 0 {
+			v.fail("start function must have type [] -> []")
+		}
+	}
+
+	// 被动和声明模式的元素项、被动的数据项没有表（内存块）索引和偏移值
+	for _, elem := range m.ElemSec {
+		if elem.Mode == SegmentModeActive {
+			if v.getTable(elem.Table).ElemType != elem.Type {
+				v.fail("type mismatch")
+			}
+			v.validateConstExpr(elem.Offset, ValTypeI32)
+		}
+		if elem.Exprs == nil && elem.Type != FuncRef {
+			v.fail("type mismatch")
+		}
+		for _, funcIdx := range elem.Init {
+			v.getFunc(funcIdx)
+			v.refs[funcIdx] = true
+		}
+		for _, expr := range elem.Exprs {
+			v.validateConstExpr(expr, elem.Type)
+		}
+	}
+
+	for _, data := range m.DataSec {
+		if data.Mode == SegmentModeActive {
+			v.validateConstExpr(data.Offset, v.getMem(data.Mem).AddrType())
+		}
+	}
+
+	importedFuncCount := len(v.funcs) - len(m.FuncSec)
+	for i, code := range m.CodeSec {
+		v.validateFunc(importedFuncCount+i, code)
+	}
+}
+
+func (v *validator) validateImport(importItem Import) {
+	desc := importItem.Desc
+	switch desc.Tag {
+	case ImportTagFunc:
+		v.funcs = append(v.funcs, v.getType(desc.FuncType))
+	case ImportTagTable:
+		v.validateTableType(desc.Table)
+		v.tables = append(v.tables, desc.Table)
+	case ImportTagMem:
+		v.validateMemType(desc.Mem)
+		v.mems = append(v.mems, desc.Mem)
+	case ImportTagGlobal:
+		v.globals = append(v.globals, desc.Global)
+		v.importedGlobalCount++
+	case ImportTagTag:
+		v.tags = append(v.tags, v.validateTagType(desc.TagType))
+	}
+}
+
+// 异常标签的类型是一个没有返回值的函数类型，参数即异常的实参
+func (v *validator) validateTagType(tagType TagType) FuncType {
+	ft := v.getType(tagType.Type)
+	if len(ft.ResultTypes) != 0 {
+		v.fail("non-empty tag result type")
+	}
+	return ft
+}
+
+func (v *validator) validateTableType(tableType TableType) {
+	limits := tableType.Limits
+	if limits.HasMax() && limits.Min > limits.Max {
+		v.fail("size minimum must not be greater than maximum")
+	}
+	if limits.IsShared() {
+		v.fail("tables cannot be shared")
+	}
+	if limits.Is64() {
+		v.fail("64-bit tables are not supported")
+	}
+}
+
+func (v *validator) validateMemType(memType MemType) {
+	maxPageCount, maxSize := uint64(MaxPageCount), "4GiB"
+	if memType.Is64() {
+		maxPageCount, maxSize = MaxPageCount64, "16EiB"
+	}
+
+	if memType.Min > maxPageCount {
+		v.fail("memory size must be at most %d pages (%s)", maxPageCount, maxSize)
+	}
+	if memType.HasMax() {
+		if memType.Max > maxPageCount {
+			v.fail("memory size must be at most %d pages (%s)", maxPageCount, maxSize)
+		}
+		if memType.Min > memType.Max {
+			v.fail("size minimum must not be greater than maximum")
+		}
+	} else if memType.IsShared() {
+		v.fail("shared memory must have maximum")
+	}
+}
+
+func (v *validator) validateExports() {
+	names := map[string]bool{}
+	for _, exportItem := range v.module.ExportSec {
+		if names[exportItem.Name] {
+			v.fail("duplicate export name: %s", exportItem.Name)
+		}
+		names[exportItem.Name] = true
+
+		idx := exportItem.Desc.Idx
+		switch exportItem.Desc.Tag {
+		case ExportTagFunc:
+			v.getFunc(idx)
+			v.refs[idx] = true
+		case ExportTagTable:
+			v.getTable(idx)
+		case ExportTagMem:
+			v.getMem(idx)
+		case ExportTagGlobal:
+			v.getGlobal(idx)
+		case ExportTagTag:
+			v.getTag(idx)
+		}
+	}
+}
+
+// 验证常量表达式
+//
+// 常量表达式只允许包含 xxx.const、ref.null、ref.func 指令以及读取（导入的、不可变的）
+// 全局变量的 global.get 指令，而且运算结果必须刚好是一个指定类型的数值。
+//
+// 常量表达式里的 ref.func 指令同时也是函数引用的声明。
+func (v *validator) validateConstExpr(expr Expr, expected ValType) {
+	stack := []ValType{}
+	for _, inst := range expr {
+		switch inst.Opcode {
+		case I32Const:
+			stack = append(stack, ValTypeI32)
+		case I64Const:
+			stack = append(stack, ValTypeI64)
+		case F32Const:
+			stack = append(stack, ValTypeF32)
+		case F64Const:
+			stack = append(stack, ValTypeF64)
+		case SIMDPrefix:
+			if inst.Args.(SIMDArgs).SubOpcode != V128Const {
+				v.fail("constant expression required")
+			}
+			stack = append(stack, ValTypeV128)
+		case GlobalGet:
+			idx := inst.Args.(uint32)
+			if int(idx) >= v.importedGlobalCount {
+				v.fail("unknown global %d", idx)
+			}
+			if v.globals[idx].Mut != MutConst {
+				v.fail("constant expression required")
+			}
+			stack = append(stack, v.globals[idx].ValType)
+		case RefNull:
+			stack = append(stack, inst.Args.(ValType))
+		case RefFunc:
+			idx := inst.Args.(uint32)
+			v.getFunc(idx)
+			v.refs[idx] = true
+			stack = append(stack, ValTypeFuncRef)
+		default:
+			v.fail("constant expression required")
+		}
+	}
+
+	if len(stack) != 1 || stack[0] != expected {
+		v.fail("type mismatch")
+	}
+}
+
+// ---------------- 索引检查
+
+func (v *validator) getType(idx TypeIdx) FuncType {
+	if int(idx) >= len(v.module.TypeSec) {
+		v.fail("unknown type %d", idx)
+	}
+	return v.module.TypeSec[idx]
+}
+
+func (v *validator) getFunc(idx FuncIdx) FuncType {
+	if int(idx) >= len(v.funcs) {
+		v.fail("unknown function %d", idx)
+	}
+	return v.funcs[idx]
+}
+
+func (v *validator) getTable(idx TableIdx) TableType {
+	if int(idx) >= len(v.tables) {
+		v.fail("unknown table %d", idx)
+	}
+	return v.tables[idx]
+}
+
+func (v *validator) getMem(idx MemIdx) MemType {
+	if int(idx) >= len(v.mems) {
+		v.fail("unknown memory %d", idx)
+	}
+	return v.mems[idx]
+}
+
+func (v *validator) getGlobal(idx GlobalIdx) GlobalType {
+	if int(idx) >= len(v.globals) {
+		v.fail("unknown global %d", idx)
+	}
+	return v.globals[idx]
+}
+
+func (v *validator) getTag(idx TagIdx) FuncType {
+	if int(idx) >= len(v.tags) {
+		v.fail("unknown tag %d", idx)
+	}
+	return v.tags[idx]
+}
+
+func (v *validator) getElem(idx ElemIdx) Elem {
+	if int(idx) >= len(v.module.ElemSec) {
+		v.fail("unknown elem segment %d", idx)
+	}
+	return v.module.ElemSec[idx]
+}
+
+// memory.init 和 data.drop 指令要求模块包含数据计数段
+func (v *validator) getData(idx DataIdx) Data {
+	if v.module.DataCountSec == nil {
+		v.fail("data count section required")
+	}
+	if int(idx) >= len(v.module.DataSec) {
+		v.fail("unknown data segment %d", idx)
+	}
+	return v.module.DataSec[idx]
+}
+
+func (v *validator) getLocal(idx LocalIdx) ValType {
+	if int(idx) < len(v.params) {
+		return v.params[idx]
+	}
+	i := sort.Search(len(v.localEnd), func(i int) bool { return uint64(idx) < v.localEnd[i] })
+	if i == len(v.localEnd) {
+		v.fail("unknown local %d", idx)
+	}
+	return v.locals[i].Type
+}
+
+func (v *validator) getBlockType(bt BlockType) FuncType {
+	if bt >= 0 {
+		v.getType(uint32(bt))
+	}
+	return v.module.GetBlockType(bt)
+}
+
+// ---------------- 操作数栈和控制栈
+
+func (v *validator) pushVal(vt ValType) {
+	v.opds = append(v.opds, vt)
+}
+
+func (v *validator) pushVals(vts []ValType) {
+	for _, vt := range vts {
+		v.pushVal(vt)
+	}
+}
+
+func (v *validator) popVal() ValType {
+	frame := &v.ctrls[len(v.ctrls)-1]
+	if len(v.opds) == frame.height {
+		if frame.unreachable {
+			return valTypeUnknown
+		}
+		v.fail("type mismatch")
+	}
+
+	vt := v.opds[len(v.opds)-1]
+	v.opds = v.opds[:len(v.opds)-1]
+	return vt
+}
+
+func (v *validator) popExpect(expected ValType) ValType {
+	actual := v.popVal()
+	if actual != expected && actual != valTypeUnknown && expected != valTypeUnknown {
+		v.fail("type mismatch")
+	}
+	if actual == valTypeUnknown {
+		return expected
+	}
+	return actual
+}
+
+// 按相反的顺序弹出一组操作数
+func (v *validator) popVals(vts []ValType) []ValType {
+	popped := make([]ValType, len(vts))
+	for i := len(vts) - 1; i >= 0; i-- {
+		popped[i] = v.popExpect(vts[i])
+	}
+	return popped
+}
+
+func (v *validator) pushCtrl(opcode byte, in []ValType, out []ValType) {
+	v.ctrls = append(v.ctrls, controlFrame{
+		opcode:     opcode,
+		startTypes: in,
+		endTypes:   out,
+		height:     len(v.opds),
+	})
+	v.pushVals(in)
+}
+
+func (v *validator) popCtrl() controlFrame {
+	frame := v.ctrls[len(v.ctrls)-1]
+	v.popVals(frame.endTypes)
+	if len(v.opds) != frame.height {
+		v.fail("type mismatch")
+	}
+	v.ctrls = v.ctrls[:len(v.ctrls)-1]
+	return frame
+}
+
+func (v *validator) getLabel(idx LabelIdx) *controlFrame {
+	if int(idx) >= len(v.ctrls) {
+		v.fail("unknown label %d", idx)
+	}
+	return &v.ctrls[len(v.ctrls)-1-int(idx)]
+}
+
+// 将当前结构块剩余的指令标记为不可到达
+func (v *validator) setUnreachable() {
+	frame := &v.ctrls[len(v.ctrls)-1]
+	v.opds = v.opds[:frame.height]
+	frame.unreachable = true
+}
+
+// ---------------- 验证函数
+
+func (v *validator) validateFunc(funcIdx int, code Code) {
+	v.funcIdx = funcIdx
+	v.instrIdx = -1
+
+	ft := v.funcs[funcIdx]
+
+	v.params = ft.ParamTypes
+	v.locals = code.Locals
+	v.localEnd = make([]uint64, len(code.Locals))
+	end := uint64(len(ft.ParamTypes))
+	for i, locals := range code.Locals {
+		end += uint64(locals.N)
+		v.localEnd[i] = end
+	}
+	v.offsets = code.Offsets
+
+	v.opds = nil
+	v.ctrls = nil
+
+	// 函数体视为一个结构块，其参数已经存放在局部变量里，所以
+	// 进入时操作数栈为空
+	v.pushCtrl(Call, nil, ft.ResultTypes)
+	v.validateInstrs(code.Expr)
+	v.popCtrl()
+
+	v.funcIdx = -1
+	v.instrIdx = -1
+	v.offsets = nil
+}
+
+func (v *validator) validateInstrs(instrs []Instruction) {
+	for _, inst := range instrs {
+		v.instrIdx++
+		v.validateInstr(inst)
+	}
+}
+
+func (v *validator) validateInstr(inst Instruction) {
+	opcode := inst.Opcode
+
+	if params, results, ok := getNumericSignature(opcode, inst.Args); ok {
+		v.popVals(params)
+		v.pushVals(results)
+		return
+	}
+
+	if opcode >= I32Load && opcode <= I64Store32 {
+		v.validateMemoryAccess(opcode, inst.Args.(MemArg))
+		return
+	}
+
+	switch opcode {
+
+	// 控制指令
+
+	case Unreachable:
+		v.setUnreachable()
+	case Nop:
+		// 无操作
+	case Block, Loop:
+		args := inst.Args.(BlockArgs)
+		ft := v.getBlockType(args.BT)
+		v.popVals(ft.ParamTypes)
+		v.pushCtrl(opcode, ft.ParamTypes, ft.ResultTypes)
+		v.validateInstrs(args.Instrs)
+		frame := v.popCtrl()
+		v.pushVals(frame.endTypes)
+	case If:
+		args := inst.Args.(IfArgs)
+		ft := v.getBlockType(args.BT)
+		v.popExpect(ValTypeI32)
+		v.popVals(ft.ParamTypes)
+		v.pushCtrl(If, ft.ParamTypes, ft.ResultTypes)
+		v.validateInstrs(args.Instrs1)
+		frame := v.popCtrl()
+
+		// 省略了 else 分支的 if 结构相当于 else 分支为空，
+		// 所以对于空的 else 分支，参数类型必须跟返回值类型一致
+		v.pushCtrl(Else_, frame.startTypes, frame.endTypes)
+		v.validateInstrs(args.Instrs2)
+		frame = v.popCtrl()
+		v.pushVals(frame.endTypes)
+	case Try:
+		args := inst.Args.(TryArgs)
+		ft := v.getBlockType(args.BT)
+		v.popVals(ft.ParamTypes)
+		v.pushCtrl(Try, ft.ParamTypes, ft.ResultTypes)
+		v.validateInstrs(args.Instrs)
+		frame := v.popCtrl()
+
+		// catch 子句开始时操作数栈上是异常的实参，catch_all 子句则没有操作数，
+		// 各个子句的返回值类型跟 try 块的一致
+		for _, clause := range args.Catches {
+			if clause.All {
+				v.pushCtrl(CatchAll_, nil, frame.endTypes)
+			} else {
+				v.pushCtrl(Catch_, v.getTag(clause.Tag).ParamTypes, frame.endTypes)
+			}
+			v.validateInstrs(clause.Instrs)
+			v.popCtrl()
+		}
+
+		// delegate 的标签是相对于 try 块外层的
+		if args.Delegate != nil {
+			v.getLabel(*args.Delegate)
+		}
+		v.pushVals(frame.endTypes)
+	case Throw:
+		ft := v.getTag(inst.Args.(uint32))
+		v.popVals(ft.ParamTypes)
+		v.setUnreachable()
+	case Rethrow:
+		frame := v.getLabel(inst.Args.(uint32))
+		if frame.opcode != Catch_ && frame.opcode != CatchAll_ {
+			v.fail("invalid rethrow label")
+		}
+		v.setUnreachable()
+	case Br:
+		frame := v.getLabel(inst.Args.(uint32))
+		v.popVals(frame.labelTypes())
+		v.setUnreachable()
+	case BrIf:
+		frame := v.getLabel(inst.Args.(uint32))
+		v.popExpect(ValTypeI32)
+		labelTypes := frame.labelTypes()
+		v.popVals(labelTypes)
+		v.pushVals(labelTypes)
+	case BrTable:
+		args := inst.Args.(BrTableArgs)
+		v.popExpect(ValTypeI32)
+		arity := len(v.getLabel(args.Default).labelTypes())
+		for _, label := range args.Labels {
+			labelTypes := v.getLabel(label).labelTypes()
+			if len(labelTypes) != arity {
+				v.fail("type mismatch")
+			}
+			// 不可到达的代码里从栈底弹出的未知类型要原样压回，
+			// 否则各个标签的类型会互相冲突（比如一个是 f32，另一个是 f64）
+			popped := make([]ValType, arity)
+			for i := arity - 1; i >= 0; i-- {
+				popped[i] = v.popVal()
+				if popped[i] != labelTypes[i] && popped[i] != valTypeUnknown {
+					v.fail("type mismatch")
+				}
+			}
+			v.pushVals(popped)
+		}
+		v.popVals(v.getLabel(args.Default).labelTypes())
+		v.setUnreachable()
+	case Return:
+		v.popVals(v.ctrls[0].endTypes)
+		v.setUnreachable()
+	case Call:
+		ft := v.getFunc(inst.Args.(uint32))
+		v.popVals(ft.ParamTypes)
+		v.pushVals(ft.ResultTypes)
+	case CallIndirect:
+		args := inst.Args.(CallIndirectArgs)
+		if v.getTable(args.Table).ElemType != FuncRef {
+			v.fail("type mismatch")
+		}
+		ft := v.getType(args.Type)
+		v.popExpect(ValTypeI32)
+		v.popVals(ft.ParamTypes)
+		v.pushVals(ft.ResultTypes)
+	case ReturnCall:
+		v.validateTailCall(v.getFunc(inst.Args.(uint32)))
+	case ReturnCallIndirect:
+		args := inst.Args.(CallIndirectArgs)
+		if v.getTable(args.Table).ElemType != FuncRef {
+			v.fail("type mismatch")
+		}
+		ft := v.getType(args.Type)
+		v.popExpect(ValTypeI32)
+		v.validateTailCall(ft)
+
+	// 操作数（参数）指令
+
+	case Drop:
+		v.popVal()
+	case Select:
+		// 不带类型的 select 指令只能用于数值类型
+		v.popExpect(ValTypeI32)
+		t1 := v.popVal()
+		t2 := v.popVal()
+		if IsRefType(t1) || IsRefType(t2) {
+			v.fail("type mismatch")
+		}
+		if t1 != valTypeUnknown && t2 != valTypeUnknown && t1 != t2 {
+			v.fail("type mismatch")
+		}
+		if t1 == valTypeUnknown {
+			v.pushVal(t2)
+		} else {
+			v.pushVal(t1)
+		}
+	case SelectT:
+		vts := inst.Args.([]ValType)
+		if len(vts) != 1 {
+			v.fail("invalid result arity")
+		}
+		v.popExpect(ValTypeI32)
+		v.popExpect(vts[0])
+		v.popExpect(vts[0])
+		v.pushVal(vts[0])
+
+	// 变量指令
+
+	case LocalGet:
+		v.pushVal(v.getLocal(inst.Args.(uint32)))
+	case LocalSet:
+		v.popExpect(v.getLocal(inst.Args.(uint32)))
+	case LocalTee:
+		vt := v.getLocal(inst.Args.(uint32))
+		v.popExpect(vt)
+		v.pushVal(vt)
+	case GlobalGet:
+		v.pushVal(v.getGlobal(inst.Args.(uint32)).ValType)
+	case GlobalSet:
+		gt := v.getGlobal(inst.Args.(uint32))
+		if gt.Mut != MutVar {
+			v.fail("global is immutable")
+		}
+		v.popExpect(gt.ValType)
+
+	// 表指令
+
+	case TableGet:
+		tt := v.getTable(inst.Args.(uint32))
+		v.popExpect(ValTypeI32)
+		v.pushVal(tt.ElemType)
+	case TableSet:
+		tt := v.getTable(inst.Args.(uint32))
+		v.popExpect(tt.ElemType)
+		v.popExpect(ValTypeI32)
+
+	// 引用指令
+
+	case RefNull:
+		v.pushVal(inst.Args.(ValType))
+	case RefIsNull:
+		if vt := v.popVal(); vt != valTypeUnknown && !IsRefType(vt) {
+			v.fail("type mismatch")
+		}
+		v.pushVal(ValTypeI32)
+	case RefFunc:
+		idx := inst.Args.(uint32)
+		v.getFunc(idx)
+		if !v.refs[idx] {
+			v.fail("undeclared function reference")
+		}
+		v.pushVal(ValTypeFuncRef)
+
+	// 内存指令
+
+	case MemorySize:
+		v.pushVal(v.getMem(inst.Args.(uint32)).AddrType())
+	case MemoryGrow:
+		addrType := v.getMem(inst.Args.(uint32)).AddrType()
+		v.popExpect(addrType)
+		v.pushVal(addrType)
+
+	// 常量指令
+
+	case I32Const:
+		v.pushVal(ValTypeI32)
+	case I64Const:
+		v.pushVal(ValTypeI64)
+	case F32Const:
+		v.pushVal(ValTypeF32)
+	case F64Const:
+		v.pushVal(ValTypeF64)
+
+	// 批量内存指令和表指令
+
+	case MiscPrefix:
+		v.validateMiscInstr(inst.Args.(MiscArgs))
+
+	// SIMD 指令
+
+	case SIMDPrefix:
+		v.validateSIMDInstr(inst.Args.(SIMDArgs))
+
+	// 原子指令
+
+	case AtomicPrefix:
+		v.validateAtomicInstr(inst.Args.(AtomicArgs))
+
+	default:
+		v.fail("unsupported instruction: %s", inst.GetOpname())
+	}
+}
+
+// 尾调用相当于调用目标函数之后紧接着 return，所以目标函数的返回值类型
+// 必须跟当前函数的返回值类型一致
+func (v *validator) validateTailCall(ft FuncType) {
+	endTypes := v.ctrls[0].endTypes
+	if len(ft.ResultTypes) != len(endTypes) {
+		v.fail("type mismatch")
+	}
+	for i, vt := range ft.ResultTypes {
+		if vt != endTypes[i] {
+			v.fail("type mismatch")
+		}
+	}
+	v.popVals(ft.ParamTypes)
+	v.setUnreachable()
+}
+
+// 验证 0xFC 前缀的批量内存指令和表指令（饱和截断指令已经作为数值指令验证）
+func (v *validator) validateMiscInstr(args MiscArgs) {
+	i32 := ValTypeI32
+
+	switch args.SubOpcode {
+	case MemoryInit:
+		memoryInitArgs := args.Args.(MemoryInitArgs)
+		addrType := v.getMem(memoryInitArgs.Mem).AddrType()
+		v.getData(memoryInitArgs.Data)
+		v.popVals([]ValType{addrType, i32, i32})
+	case DataDrop:
+		v.getData(args.Args.(uint32))
+	case MemoryCopy:
+		memoryCopyArgs := args.Args.(MemoryCopyArgs)
+		dst := v.getMem(memoryCopyArgs.Dst).AddrType()
+		src := v.getMem(memoryCopyArgs.Src).AddrType()
+
+		// 在 32 位和 64 位的内存之间复制时，字节数为 i32
+		n := ValTypeI64
+		if dst == i32 || src == i32 {
+			n = i32
+		}
+		v.popVals([]ValType{dst, src, n})
+	case MemoryFill:
+		addrType := v.getMem(args.Args.(uint32)).AddrType()
+		v.popVals([]ValType{addrType, i32, addrType})
+	case TableInit:
+		tableInitArgs := args.Args.(TableInitArgs)
+		tt := v.getTable(tableInitArgs.Table)
+		if v.getElem(tableInitArgs.Elem).Type != tt.ElemType {
+			v.fail("type mismatch")
+		}
+		v.popVals([]ValType{i32, i32, i32})
+	case ElemDrop:
+		v.getElem(args.Args.(uint32))
+	case TableCopy:
+		tableCopyArgs := args.Args.(TableCopyArgs)
+		dst := v.getTable(tableCopyArgs.Dst)
+		if v.getTable(tableCopyArgs.Src).ElemType != dst.ElemType {
+			v.fail("type mismatch")
+		}
+		v.popVals([]ValType{i32, i32, i32})
+	case TableGrow:
+		tt := v.getTable(args.Args.(uint32))
+		v.popExpect(i32)
+		v.popExpect(tt.ElemType)
+		v.pushVal(i32)
+	case TableSize:
+		v.getTable(args.Args.(uint32))
+		v.pushVal(i32)
+	case TableFill:
+		tt := v.getTable(args.Args.(uint32))
+		v.popExpect(i32)
+		v.popExpect(tt.ElemType)
+		v.popExpect(i32)
+	default:
+		v.fail("unsupported instruction: %s", GetMiscOpname(args.SubOpcode))
+	}
+}
+
+// 验证 0xFD 前缀的 SIMD 指令
+func (v *validator) validateSIMDInstr(args SIMDArgs) {
+	v128 := ValTypeV128
+	sub := args.SubOpcode
+
+	switch {
+	case sub <= V128Store || sub == V128Load32Zero || sub == V128Load64Zero:
+		addrType := v.validateSIMDMemArg(sub, args.Args.(MemArg))
+		if sub == V128Store {
+			v.popVals([]ValType{addrType, v128})
+		} else {
+			v.popExpect(addrType)
+			v.pushVal(v128)
+		}
+	case sub >= V128Load8Lane && sub <= V128Store64Lane:
+		memLaneArgs := args.Args.(MemLaneArgs)
+		addrType := v.validateSIMDMemArg(sub, memLaneArgs.MemArg)
+		if memLaneArgs.Lane >= 16>>GetSIMDNaturalAlign(sub) {
+			v.fail("invalid lane index")
+		}
+		v.popVals([]ValType{addrType, v128})
+		if sub <= V128Load64Lane {
+			v.pushVal(v128)
+		}
+	case sub == I8x16Shuffle:
+		for _, lane := range args.Args.([16]LaneIdx) {
+			if lane >= 32 {
+				v.fail("invalid lane index")
+			}
+		}
+		v.popVals([]ValType{v128, v128})
+		v.pushVal(v128)
+	case sub >= I8x16ExtractLaneS && sub <= F64x2ReplaceLane:
+		laneType, laneCount := getSIMDLaneType(sub)
+		if args.Args.(LaneIdx) >= laneCount {
+			v.fail("invalid lane index")
+		}
+		if isSIMDReplaceLane(sub) {
+			v.popVals([]ValType{v128, laneType})
+			v.pushVal(v128)
+		} else {
+			v.popExpect(v128)
+			v.pushVal(laneType)
+		}
+	default:
+		params, result := getSIMDSignature(sub)
+		v.popVals(params)
+		v.pushVal(result)
+	}
+}
+
+func (v *validator) validateSIMDMemArg(subOpcode uint32, memArg MemArg) ValType {
+	addrType := v.getMemArgAddrType(memArg)
+	if memArg.Align > GetSIMDNaturalAlign(subOpcode) {
+		v.fail("alignment must not be larger than natural")
+	}
+	return addrType
+}
+
+// 验证 0xFE 前缀的原子指令
+//
+// 原子指令的对齐值必须等于自然对齐值，而且原子指令也可以用于非共享的内存
+func (v *validator) validateAtomicInstr(args AtomicArgs) {
+	i32, i64 := ValTypeI32, ValTypeI64
+	sub := args.SubOpcode
+
+	if sub == AtomicFence {
+		return
+	}
+
+	memArg := args.Args.(MemArg)
+	addrType := v.getMemArgAddrType(memArg)
+	if memArg.Align != GetAtomicNaturalAlign(sub) {
+		v.fail("alignment must be exactly natural")
+	}
+
+	switch sub {
+	case MemoryAtomicNotify:
+		v.popVals([]ValType{addrType, i32})
+		v.pushVal(i32)
+		return
+	case MemoryAtomicWait32:
+		v.popVals([]ValType{addrType, i32, i64})
+		v.pushVal(i32)
+		return
+	case MemoryAtomicWait64:
+		v.popVals([]ValType{addrType, i64, i64})
+		v.pushVal(i32)
+		return
+	}
+
+	// 从 0x10 开始，每 7 个指令为一组，组内的第 0, 2, 3 个指令的操作数为 i32，其余为 i64
+	t := i64
+	if n := (sub - I32AtomicLoad) % 7; n == 0 || n == 2 || n == 3 {
+		t = i32
+	}
+
+	switch {
+	case sub <= I64AtomicLoad32U:
+		v.popExpect(addrType)
+		v.pushVal(t)
+	case sub <= I64AtomicStore32:
+		v.popVals([]ValType{addrType, t})
+	case sub <= I64AtomicRmw32XchgU:
+		v.popVals([]ValType{addrType, t})
+		v.pushVal(t)
+	default: // cmpxchg
+		v.popVals([]ValType{addrType, t, t})
+		v.pushVal(t)
+	}
+}
+
+// 获取读写通道指令（extract_lane 和 replace_lane）的通道的类型和通道的数量
+func getSIMDLaneType(subOpcode uint32) (ValType, byte) {
+	switch subOpcode {
+	case I8x16ExtractLaneS, I8x16ExtractLaneU, I8x16ReplaceLane:
+		return ValTypeI32, 16
+	case I16x8ExtractLaneS, I16x8ExtractLaneU, I16x8ReplaceLane:
+		return ValTypeI32, 8
+	case I32x4ExtractLane, I32x4ReplaceLane:
+		return ValTypeI32, 4
+	case I64x2ExtractLane, I64x2ReplaceLane:
+		return ValTypeI64, 2
+	case F32x4ExtractLane, F32x4ReplaceLane:
+		return ValTypeF32, 4
+	default: // F64x2ExtractLane, F64x2ReplaceLane
+		return ValTypeF64, 2
+	}
+}
+
+func isSIMDReplaceLane(subOpcode uint32) bool {
+	switch subOpcode {
+	case I8x16ReplaceLane, I16x8ReplaceLane, I32x4ReplaceLane,
+		I64x2ReplaceLane, F32x4ReplaceLane, F64x2ReplaceLane:
+		return true
+	}
+	return false
+}
+
+// 获取没有立即数（v128.const 除外）的 SIMD 指令的操作数类型和结果类型
+func getSIMDSignature(subOpcode uint32) (params []ValType, result ValType) {
+	i32, i64, f32, f64, v128 := ValTypeI32, ValTypeI64, ValTypeF32, ValTypeF64, ValTypeV128
+
+	switch subOpcode {
+	case V128Const:
+		return nil, v128
+	case I8x16Splat, I16x8Splat, I32x4Splat:
+		return []ValType{i32}, v128
+	case I64x2Splat:
+		return []ValType{i64}, v128
+	case F32x4Splat:
+		return []ValType{f32}, v128
+	case F64x2Splat:
+		return []ValType{f64}, v128
+	case V128Bitselect:
+		return []ValType{v128, v128, v128}, v128
+	case V128AnyTrue,
+		I8x16AllTrue, I16x8AllTrue, I32x4AllTrue, I64x2AllTrue,
+		I8x16Bitmask, I16x8Bitmask, I32x4Bitmask, I64x2Bitmask:
+		return []ValType{v128}, i32
+	case I8x16Shl, I8x16ShrS, I8x16ShrU,
+		I16x8Shl, I16x8ShrS, I16x8ShrU,
+		I32x4Shl, I32x4ShrS, I32x4ShrU,
+		I64x2Shl, I64x2ShrS, I64x2ShrU:
+		return []ValType{v128, i32}, v128
+	case V128Not,
+		F32x4DemoteF64x2Zero, F64x2PromoteLowF32x4,
+		I8x16Abs, I8x16Neg, I8x16PopCnt,
+		I16x8Abs, I16x8Neg, I32x4Abs, I32x4Neg, I64x2Abs, I64x2Neg,
+		F32x4Abs, F32x4Neg, F32x4Sqrt, F32x4Ceil, F32x4Floor, F32x4Trunc, F32x4Nearest,
+		F64x2Abs, F64x2Neg, F64x2Sqrt, F64x2Ceil, F64x2Floor, F64x2Trunc, F64x2Nearest,
+		I16x8ExtaddPairwiseI8x16S, I16x8ExtaddPairwiseI8x16U,
+		I32x4ExtaddPairwiseI16x8S, I32x4ExtaddPairwiseI16x8U,
+		I16x8ExtendLowI8x16S, I16x8ExtendHighI8x16S, I16x8ExtendLowI8x16U, I16x8ExtendHighI8x16U,
+		I32x4ExtendLowI16x8S, I32x4ExtendHighI16x8S, I32x4ExtendLowI16x8U, I32x4ExtendHighI16x8U,
+		I64x2ExtendLowI32x4S, I64x2ExtendHighI32x4S, I64x2ExtendLowI32x4U, I64x2ExtendHighI32x4U,
+		I32x4TruncSatF32x4S, I32x4TruncSatF32x4U, F32x4ConvertI32x4S, F32x4ConvertI32x4U,
+		I32x4TruncSatF64x2SZero, I32x4TruncSatF64x2UZero, F64x2ConvertLowI32x4S, F64x2ConvertLowI32x4U:
+		return []ValType{v128}, v128
+	default:
+		// 其余的都是二元运算指令，包括比较指令
+		return []ValType{v128, v128}, v128
+	}
+}
+
+// 验证加载和存储指令
+func (v *validator) validateMemoryAccess(opcode byte, memArg MemArg) {
+	addrType := v.getMemArgAddrType(memArg)
+
+	vt, naturalAlign := getMemoryAccessType(opcode)
+
+	// align 是对齐字节数的对数，不能超过数据本身的宽度
+	if memArg.Align > naturalAlign {
+		v.fail("alignment must not be larger than natural")
+	}
+
+	if opcode <= I64Load32U {
+		// 加载指令
+		v.popExpect(addrType)
+		v.pushVal(vt)
+	} else {
+		// 存储指令
+		v.popExpect(vt)
+		v.popExpect(addrType)
+	}
+}
+
+// 检查 memarg 的内存块索引和偏移值，返回内存的地址类型
+// 32 位的内存的偏移值不能超出 uint32 的范围
+func (v *validator) getMemArgAddrType(memArg MemArg) ValType {
+	memType := v.getMem(memArg.Mem)
+	if !memType.Is64() && memArg.Offset > math.MaxUint32 {
+		v.fail("offset out of range")
+	}
+	return memType.AddrType()
+}
+
+// 获取加载/存储指令的数据类型，以及数据宽度（字节数）的对数
+func getMemoryAccessType(opcode byte) (ValType, uint32) {
+	switch opcode {
+	case I32Load, I32Store:
+		return ValTypeI32, 2
+	case I64Load, I64Store:
+		return ValTypeI64, 3
+	case F32Load, F32Store:
+		return ValTypeF32, 2
+	case F64Load, F64Store:
+		return ValTypeF64, 3
+	case I32Load8S, I32Load8U, I32Store8:
+		return ValTypeI32, 0
+	case I32Load16S, I32Load16U, I32Store16:
+		return ValTypeI32, 1
+	case I64Load8S, I64Load8U, I64Store8:
+		return ValTypeI64, 0
+	case I64Load16S, I64Load16U, I64Store16:
+		return ValTypeI64, 1
+	default: // I64Load32S, I64Load32U, I64Store32
+		return ValTypeI64, 2
+	}
+}
+
+// 获取数值指令（包括比较、一元运算、二元运算以及类型转换指令）的操作数类型和结果类型
+// 对于非数值指令，返回的 ok 值为 false
+func getNumericSignature(opcode byte, args interface{}) (params []ValType, results []ValType, ok bool) {
+	i32, i64, f32, f64 := ValTypeI32, ValTypeI64, ValTypeF32, ValTypeF64
+
+	sig := func(p []ValType, r ValType) ([]ValType, []ValType, bool) {
+		return p, []ValType{r}, true
+	}
+
+	switch {
+	case opcode == I32Eqz:
+		return sig([]ValType{i32}, i32)
+	case opcode >= I32Eq && opcode <= I32GeU:
+		return sig([]ValType{i32, i32}, i32)
+	case opcode == I64Eqz:
+		return sig([]ValType{i64}, i32)
+	case opcode >= I64Eq && opcode <= I64GeU:
+		return sig([]ValType{i64, i64}, i32)
+	case opcode >= F32Eq && opcode <= F32Ge:
+		return sig([]ValType{f32, f32}, i32)
+	case opcode >= F64Eq && opcode <= F64Ge:
+		return sig([]ValType{f64, f64}, i32)
+	case opcode >= I32Clz && opcode <= I32PopCnt:
+		return sig([]ValType{i32}, i32)
+	case opcode >= I32Add && opcode <= I32Rotr:
+		return sig([]ValType{i32, i32}, i32)
+	case opcode >= I64Clz && opcode <= I64PopCnt:
+		return sig([]ValType{i64}, i64)
+	case opcode >= I64Add && opcode <= I64Rotr:
+		return sig([]ValType{i64, i64}, i64)
+	case opcode >= F32Abs && opcode <= F32Sqrt:
+		return sig([]ValType{f32}, f32)
+	case opcode >= F32Add && opcode <= F32CopySign:
+		return sig([]ValType{f32, f32}, f32)
+	case opcode >= F64Abs && opcode <= F64Sqrt:
+		return sig([]ValType{f64}, f64)
+	case opcode >= F64Add && opcode <= F64CopySign:
+		return sig([]ValType{f64, f64}, f64)
+	}
+
+	switch opcode {
+	case I32WrapI64:
+		return sig([]ValType{i64}, i32)
+	case I32TruncF32S, I32TruncF32U, I32ReinterpretF32:
+		return sig([]ValType{f32}, i32)
+	case I32TruncF64S, I32TruncF64U:
+		return sig([]ValType{f64}, i32)
+	case I64ExtendI32S, I64ExtendI32U:
+		return sig([]ValType{i32}, i64)
+	case I64TruncF32S, I64TruncF32U:
+		return sig([]ValType{f32}, i64)
+	case I64TruncF64S, I64TruncF64U, I64ReinterpretF64:
+		return sig([]ValType{f64}, i64)
+	case F32ConvertI32S, F32ConvertI32U, F32ReinterpretI32:
+		return sig([]ValType{i32}, f32)
+	case F32ConvertI64S, F32ConvertI64U:
+		return sig([]ValType{i64}, f32)
+	case F32DemoteF64:
+		return sig([]ValType{f64}, f32)
+	case F64ConvertI32S, F64ConvertI32U:
+		return sig([]ValType{i32}, f64)
+	case F64ConvertI64S, F64ConvertI64U, F64ReinterpretI64:
+		return sig([]ValType{i64}, f64)
+	case F64PromoteF32:
+		return sig([]ValType{f32}, f64)
+	case I32Extend8S, I32Extend16S:
+		return sig([]ValType{i32}, i32)
+	case I64Extend8S, I64Extend16S, I64Extend32S:
+		return sig([]ValType{i64}, i64)
+	case MiscPrefix:
+		switch args.(MiscArgs).SubOpcode {
+		case I32TruncSatF32S, I32TruncSatF32U:
+			return sig([]ValType{f32}, i32)
+		case I32TruncSatF64S, I32TruncSatF64U:
+			return sig([]ValType{f64}, i32)
+		case I64TruncSatF32S, I64TruncSatF32U:
+			return sig([]ValType{f32}, i64)
+		case I64TruncSatF64S, I64TruncSatF64U:
+			return sig([]ValType{f64}, i64)
+		}
+	}
+
+	return nil, nil, false
+}
+
+// 获取指令从操作数栈弹出以及压入的操作数的数量，用于解释器在预编译时
+// 计算操作数栈的高度（只关心数量，不关心类型，所以不需要模块的其他信息）
+//
+// 结构控制指令、分支指令、函数调用指令以及异常处理指令的操作数的数量
+// 取决于块类型、函数类型或者标签类型，对于这些指令返回的 ok 值为 false
+func GetOperandCount(inst Instruction) (pops int, pushes int, ok bool) {
+	opcode := inst.Opcode
+
+	if params, results, ok := getNumericSignature(opcode, inst.Args); ok {
+		return len(params), len(results), true
+	}
+
+	if opcode >= I32Load && opcode <= I64Store32 {
+		if opcode <= I64Load32U {
+			return 1, 1, true
+		}
+		return 2, 0, true
+	}
+
+	switch opcode {
+	case Nop:
+		return 0, 0, true
+	case Drop:
+		return 1, 0, true
+	case Select, SelectT:
+		return 3, 1, true
+	case LocalGet, GlobalGet, RefNull, RefFunc, MemorySize,
+		I32Const, I64Const, F32Const, F64Const:
+		return 0, 1, true
+	case LocalSet, GlobalSet:
+		return 1, 0, true
+	case LocalTee, TableGet, RefIsNull, MemoryGrow:
+		return 1, 1, true
+	case TableSet:
+		return 2, 0, true
+	case MiscPrefix:
+		switch inst.Args.(MiscArgs).SubOpcode {
+		case DataDrop, ElemDrop:
+			return 0, 0, true
+		case TableGrow:
+			return 2, 1, true
+		case TableSize:
+			return 0, 1, true
+		default: // memory.init/copy/fill, table.init/copy/fill
+			return 3, 0, true
+		}
+	case SIMDPrefix:
+		pops, pushes := getSIMDOperandCount(inst.Args.(SIMDArgs).SubOpcode)
+		return pops, pushes, true
+	case AtomicPrefix:
+		pops, pushes := getAtomicOperandCount(inst.Args.(AtomicArgs).SubOpcode)
+		return pops, pushes, true
+	}
+
+	return 0, 0, false
+}
+
+func getSIMDOperandCount(sub uint32) (pops int, pushes int) {
+	switch {
+	case sub == V128Store:
+		return 2, 0
+	case sub < V128Store || sub == V128Load32Zero || sub == V128Load64Zero:
+		return 1, 1
+	case sub >= V128Load8Lane && sub <= V128Load64Lane:
+		return 2, 1
+	case sub >= V128Store8Lane && sub <= V128Store64Lane:
+		return 2, 0
+	case sub == I8x16Shuffle:
+		return 2, 1
+	case sub >= I8x16ExtractLaneS && sub <= F64x2ReplaceLane:
+		if isSIMDReplaceLane(sub) {
+			return 2, 1
+		}
+		return 1, 1
+	default:
+		params, _ := getSIMDSignature(sub)
+		return len(params), 1
+	}
+}
+
+func getAtomicOperandCount(sub uint32) (pops int, pushes int) {
+	switch {
+	case sub == AtomicFence:
+		return 0, 0
+	case sub == MemoryAtomicNotify:
+		return 2, 1
+	case sub == MemoryAtomicWait32 || sub == MemoryAtomicWait64:
+		return 3, 1
+	case sub <= I64AtomicLoad32U:
+		return 1, 1
+	case sub <= I64AtomicStore32:
+		return 2, 0
+	case sub <= I64AtomicRmw32XchgU:
+		return 2, 1
+	default: // cmpxchg
+		return 3, 1
+	}
+}
@@ -0,0 +1,221 @@
+package binary
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"wasmvm/assert"
+)
+
+func TestValidateModule(t *testing.T) {
+	currentDir, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+
+	testResourcesDir := filepath.Join(currentDir, "..", "test", "resources", "reader")
+
+	for _, fileName := range []string{"test-read-section-1.wasm", "test-read-section-2.wasm"} {
+		m, err := DecodeFile(filepath.Join(testResourcesDir, fileName))
+		assert.AssertNil(t, err)
+		assert.AssertNil(t, Validate(m))
+	}
+}
+
+func TestValidateFunctionBody(t *testing.T) {
+	// (func (param i32) (result i32) (local.get 0) (i32.const 1) (i32.add))
+	assert.AssertNil(t, Validate(newTestModule(
+		FuncType{Tag: FtTag, ParamTypes: []ValType{ValTypeI32}, ResultTypes: []ValType{ValTypeI32}},
+		Instruction{LocalGet, uint32(0)},
+		Instruction{I32Const, int32(1)},
+		Instruction{I32Add, nil},
+	)))
+
+	// 操作数类型不匹配
+	// (func (result i32) (i64.const 1) (i32.const 1) (i32.add))
+	assertValidationError(t, Validate(newTestModule(
+		FuncType{Tag: FtTag, ResultTypes: []ValType{ValTypeI32}},
+		Instruction{I64Const, int64(1)},
+		Instruction{I32Const, int32(1)},
+		Instruction{I32Add, nil},
+	)), 0, 2)
+
+	// 返回值数量不正确
+	// (func (result i32) (i32.const 1) (i32.const 2))
+	assertValidationError(t, Validate(newTestModule(
+		FuncType{Tag: FtTag, ResultTypes: []ValType{ValTypeI32}},
+		Instruction{I32Const, int32(1)},
+		Instruction{I32Const, int32(2)},
+	)), 0, 1)
+
+	// 局部变量索引超出范围
+	// (func (param i32) (local.get 1) (drop))
+	assertValidationError(t, Validate(newTestModule(
+		FuncType{Tag: FtTag, ParamTypes: []ValType{ValTypeI32}},
+		Instruction{LocalGet, uint32(1)},
+		Instruction{Drop, nil},
+	)), 0, 0)
+
+	// 跳转标签超出范围
+	// (func (block (br 2)))
+	assertValidationError(t, Validate(newTestModule(
+		FuncType{Tag: FtTag},
+		Instruction{Block, BlockArgs{BT: BlockTypeEmpty, Instrs: []Instruction{{Br, uint32(2)}}}},
+	)), 0, 1)
+
+	// 位于 br 之后的不可到达的指令可以弹出任意类型的操作数
+	// (func (result i32) (block (result i32) (br 1 (i32.const 1)) (i64.add)) )
+	assert.AssertNil(t, Validate(newTestModule(
+		FuncType{Tag: FtTag, ResultTypes: []ValType{ValTypeI32}},
+		Instruction{Block, BlockArgs{BT: BlockTypeI32, Instrs: []Instruction{
+			{I32Const, int32(1)},
+			{Br, uint32(1)},
+			{I64Add, nil},
+			{I32WrapI64, nil},
+		}}},
+	)))
+
+	// 省略了 else 分支的 if 结构不能有返回值
+	// (func (result i32) (if (result i32) (i32.const 1) (then (i32.const 2))))
+	assertValidationError(t, Validate(newTestModule(
+		FuncType{Tag: FtTag, ResultTypes: []ValType{ValTypeI32}},
+		Instruction{I32Const, int32(1)},
+		Instruction{If, IfArgs{BT: BlockTypeI32, Instrs1: []Instruction{{I32Const, int32(2)}}}},
+	)), 0, 2)
+
+	// 内存对齐值超出数据的宽度
+	// (func (i32.const 0) (i32.load align=8) (drop))
+	assertValidationError(t, Validate(newTestModule(
+		FuncType{Tag: FtTag},
+		Instruction{I32Const, int32(0)},
+		Instruction{I32Load, MemArg{Align: 3}},
+		Instruction{Drop, nil},
+	)), 0, 1)
+
+	// 使用 data.drop 指令时必须有数据计数段
+	// (func (data.drop 0)) (data "")
+	m := newTestModule(FuncType{Tag: FtTag},
+		Instruction{MiscPrefix, MiscArgs{SubOpcode: DataDrop, Args: uint32(0)}})
+	m.DataSec = []Data{{Mode: SegmentModePassive}}
+	assertValidationError(t, Validate(m), 0, 0)
+
+	dataCount := uint32(1)
+	m.DataCountSec = &dataCount
+	assert.AssertNil(t, Validate(m))
+
+	// 元素项索引超出范围
+	// (func (elem.drop 0))
+	assertValidationError(t, Validate(newTestModule(
+		FuncType{Tag: FtTag},
+		Instruction{MiscPrefix, MiscArgs{SubOpcode: ElemDrop, Args: uint32(0)}},
+	)), 0, 0)
+}
+
+// 局部变量按组查找类型，不会展开
+func TestValidateLocals(t *testing.T) {
+	m := newTestModule(
+		FuncType{Tag: FtTag, ParamTypes: []ValType{ValTypeI64}, ResultTypes: []ValType{ValTypeF64}},
+		Instruction{LocalGet, uint32(0)},
+		Instruction{LocalGet, uint32(3)},
+		Instruction{LocalGet, uint32(MaxLocalCount - 1)},
+		Instruction{Drop, nil},
+		Instruction{Drop, nil},
+		Instruction{Drop, nil},
+		Instruction{LocalGet, uint32(MaxLocalCount)},
+	)
+	m.CodeSec[0].Locals = []Locals{{N: 2, Type: ValTypeI32}, {N: 0, Type: ValTypeI64}, {N: MaxLocalCount - 2, Type: ValTypeF64}}
+	assert.AssertNil(t, Validate(m))
+
+	// 局部变量的类型不是 i32
+	m.CodeSec[0].Expr[6] = Instruction{LocalGet, uint32(2)}
+	assertValidationError(t, Validate(m), 0, 6)
+
+	// 局部变量索引超出范围
+	m.CodeSec[0].Expr[6] = Instruction{LocalGet, uint32(MaxLocalCount + 1)}
+	assertValidationError(t, Validate(m), 0, 6)
+}
+
+// 解码得到的模块，验证错误包含出错的指令在二进制数据里的位置
+func TestValidationErrorOffset(t *testing.T) {
+	// (func (result i32) (block (i64.const 1)) (i32.const 1) (i32.add))
+	data := Encode(newTestModule(
+		FuncType{Tag: FtTag, ResultTypes: []ValType{ValTypeI32}},
+		Instruction{Block, BlockArgs{BT: BlockTypeEmpty, Instrs: []Instruction{{I64Const, int64(1)}}}},
+		Instruction{I64Const, int64(1)},
+		Instruction{I32Const, int32(1)},
+		Instruction{I32Add, nil},
+	))
+	m, err := Decode(data)
+	assert.AssertNil(t, err)
+	assert.AssertEqual(t, 5, len(m.CodeSec[0].Offsets))
+
+	err = Validate(m)
+	assertValidationError(t, err, 0, 1)
+	offset := err.(*ValidationError).Offset
+	assert.AssertEqual(t, int(m.CodeSec[0].Offsets[1]), offset)
+	assert.AssertEqual(t, I64Const, data[offset])
+	assert.AssertEqual(t, I32Add, data[m.CodeSec[0].Offsets[4]])
+}
+
+func TestValidateModuleFields(t *testing.T) {
+	// 导出项名称重复
+	m := newTestModule(FuncType{Tag: FtTag})
+	m.ExportSec = []Export{
+		{Name: "f", Desc: ExportDesc{Tag: ExportTagFunc, Idx: 0}},
+		{Name: "f", Desc: ExportDesc{Tag: ExportTagFunc, Idx: 0}},
+	}
+	assertValidationError(t, Validate(m), -1, -1)
+
+	// 导出项索引超出范围
+	m = newTestModule(FuncType{Tag: FtTag})
+	m.ExportSec = []Export{{Name: "g", Desc: ExportDesc{Tag: ExportTagGlobal, Idx: 0}}}
+	assertValidationError(t, Validate(m), -1, -1)
+
+	// 常量表达式包含非常量指令
+	m = newTestModule(FuncType{Tag: FtTag})
+	m.GlobalSec = []Global{{
+		Type: GlobalType{ValType: ValTypeI32, Mut: MutVar},
+		Init: []Instruction{{I32Const, int32(1)}, {I32Const, int32(2)}, {I32Add, nil}},
+	}}
+	assertValidationError(t, Validate(m), -1, -1)
+
+	// 修改不可变的全局变量
+	m = newTestModule(FuncType{Tag: FtTag},
+		Instruction{I32Const, int32(1)},
+		Instruction{GlobalSet, uint32(0)})
+	m.GlobalSec = []Global{{
+		Type: GlobalType{ValType: ValTypeI32, Mut: MutConst},
+		Init: []Instruction{{I32Const, int32(1)}},
+	}}
+	assertValidationError(t, Validate(m), 0, 1)
+
+	// 起始函数不能有参数和返回值
+	m = newTestModule(FuncType{Tag: FtTag, ParamTypes: []ValType{ValTypeI32}})
+	startIdx := uint32(0)
+	m.StartSec = &startIdx
+	assertValidationError(t, Validate(m), -1, -1)
+}
+
+// 创建只有一个函数的模块
+func newTestModule(ft FuncType, instrs ...Instruction) Module {
+	return Module{
+		Magic:    MagicNumber,
+		Version:  Version,
+		TypeSec:  []FuncType{ft},
+		FuncSec:  []TypeIdx{0},
+		CodeSec:  []Code{{Expr: instrs}},
+		MemSec:   []MemType{{Min: 1}},
+		TableSec: []TableType{{ElemType: FuncRef}},
+	}
+}
+
+func assertValidationError(t *testing.T, err error, funcIdx int, instrIdx int) {
+	t.Helper()
+
+	validationErr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected validation error, actual: %v", err)
+	}
+	assert.AssertEqual(t, funcIdx, validationErr.FuncIdx)
+	assert.AssertEqual(t, instrIdx, validationErr.InstrIdx)
+}
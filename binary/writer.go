@@ -0,0 +1,541 @@
+package binary
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// 编码器，将 Module 编码为二进制格式（*.wasm）
+//
+// 编码器是解码器（reader.go）的逆过程，各个写入函数跟解码器的读取函数一一对应。
+//
+// 注：
+// - 值为 nil 的段不会被写入，值为空列表的段会被写入为空段，这样
+//   解码得到的模块再次编码之后，段的数量和顺序保持不变；
+// - 解码器不记录自定义段出现的位置，所以自定义段统一写在所有段的后面。
+
+type wasmWriter struct {
+	buf []byte // 已编码的二进制数据
+}
+
+func Encode(m Module) []byte {
+	w := &wasmWriter{}
+	w.writeModule(m)
+	return w.buf
+}
+
+// ---------------- 辅助写入函数
+
+// 写入一个字节
+func (w *wasmWriter) writeByte(b byte) {
+	w.buf = append(w.buf, b)
+}
+
+// 写入固定长度的 uint32
+func (w *wasmWriter) writeU32(n uint32) {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], n)
+	w.buf = append(w.buf, b[:]...)
+}
+
+// 写入固定长度的 float32
+func (w *wasmWriter) writeF32(f float32) {
+	w.writeU32(math.Float32bits(f))
+}
+
+// 写入固定长度的 float64
+func (w *wasmWriter) writeF64(f float64) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(f))
+	w.buf = append(w.buf, b[:]...)
+}
+
+// 写入变长（leb128）uint32
+func (w *wasmWriter) writeVarU32(n uint32) {
+	w.buf = append(w.buf, encodeVarUint(uint64(n))...)
+}
+
+// 写入变长（leb128）uint64
+func (w *wasmWriter) writeVarU64(n uint64) {
+	w.buf = append(w.buf, encodeVarUint(n)...)
+}
+
+// 写入变长（leb128）signed int32
+func (w *wasmWriter) writeVarS32(n int32) {
+	w.buf = append(w.buf, encodeVarInt(int64(n))...)
+}
+
+// 写入变长（leb128）signed int64
+func (w *wasmWriter) writeVarS64(n int64) {
+	w.buf = append(w.buf, encodeVarInt(n)...)
+}
+
+func (w *wasmWriter) writeVarU32Array(vec []uint32) {
+	w.writeVarU32(uint32(len(vec)))
+	for _, n := range vec {
+		w.writeVarU32(n)
+	}
+}
+
+// 写入字节数组
+// 内容的长度写在开头
+func (w *wasmWriter) writeBytes(data []byte) {
+	w.writeVarU32(uint32(len(data)))
+	w.buf = append(w.buf, data...)
+}
+
+// 写入字符串
+func (w *wasmWriter) writeName(name string) {
+	w.writeBytes([]byte(name))
+}
+
+// ---------------- 编码模块
+
+func (w *wasmWriter) writeModule(m Module) {
+	w.writeU32(m.Magic)
+	w.writeU32(m.Version)
+
+	if m.TypeSec != nil {
+		w.writeSection(SecTypeID, func(sw *wasmWriter) { sw.writeTypeSec(m.TypeSec) })
+	}
+	if m.ImportSec != nil {
+		w.writeSection(SecImportID, func(sw *wasmWriter) { sw.writeImportSec(m.ImportSec) })
+	}
+	if m.FuncSec != nil {
+		w.writeSection(SecFuncID, func(sw *wasmWriter) { sw.writeVarU32Array(m.FuncSec) })
+	}
+	if m.TableSec != nil {
+		w.writeSection(SecTableID, func(sw *wasmWriter) { sw.writeTableSec(m.TableSec) })
+	}
+	if m.MemSec != nil {
+		w.writeSection(SecMemID, func(sw *wasmWriter) { sw.writeMemSec(m.MemSec) })
+	}
+	if m.TagSec != nil {
+		w.writeSection(SecTagID, func(sw *wasmWriter) { sw.writeTagSec(m.TagSec) })
+	}
+	if m.GlobalSec != nil {
+		w.writeSection(SecGlobalID, func(sw *wasmWriter) { sw.writeGlobalSec(m.GlobalSec) })
+	}
+	if m.ExportSec != nil {
+		w.writeSection(SecExportID, func(sw *wasmWriter) { sw.writeExportSec(m.ExportSec) })
+	}
+	if m.StartSec != nil {
+		w.writeSection(SecStartID, func(sw *wasmWriter) { sw.writeVarU32(*m.StartSec) })
+	}
+	if m.ElemSec != nil {
+		w.writeSection(SecElemID, func(sw *wasmWriter) { sw.writeElemSec(m.ElemSec) })
+	}
+	if m.DataCountSec != nil {
+		w.writeSection(SecDataCountID, func(sw *wasmWriter) { sw.writeVarU32(*m.DataCountSec) })
+	}
+	if m.CodeSec != nil {
+		w.writeSection(SecCodeID, func(sw *wasmWriter) { sw.writeCodeSec(m.CodeSec) })
+	}
+	if m.DataSec != nil {
+		w.writeSection(SecDataID, func(sw *wasmWriter) { sw.writeDataSec(m.DataSec) })
+	}
+
+	for _, customSec := range m.CustomSecs {
+		w.writeSection(SecCustomID, func(sw *wasmWriter) {
+			sw.writeName(customSec.Name)
+			sw.buf = append(sw.buf, customSec.Bytes...)
+		})
+	}
+}
+
+// 写入一个段
+// 因为段的开头需要记录段内容的长度，所以先使用一个新的编码器
+// 编码段的内容，然后再写入段 id、长度以及内容
+func (w *wasmWriter) writeSection(sectionId byte, writeContent func(sw *wasmWriter)) {
+	sectionWriter := &wasmWriter{}
+	writeContent(sectionWriter)
+
+	w.writeByte(sectionId)
+	w.writeBytes(sectionWriter.buf)
+}
+
+// ---------------- 编码（函数）类型段
+
+func (w *wasmWriter) writeTypeSec(vec []FuncType) {
+	w.writeVarU32(uint32(len(vec)))
+	for _, ft := range vec {
+		w.writeFuncType(ft)
+	}
+}
+
+func (w *wasmWriter) writeFuncType(ft FuncType) {
+	w.writeByte(FtTag)
+	w.writeValTypes(ft.ParamTypes)
+	w.writeValTypes(ft.ResultTypes)
+}
+
+func (w *wasmWriter) writeValTypes(vec []ValType) {
+	w.writeVarU32(uint32(len(vec)))
+	w.buf = append(w.buf, vec...)
+}
+
+// ---------------- 编码导入段
+
+func (w *wasmWriter) writeImportSec(vec []Import) {
+	w.writeVarU32(uint32(len(vec)))
+	for _, importItem := range vec {
+		w.writeName(importItem.Module)
+		w.writeName(importItem.Name)
+		w.writeImportDesc(importItem.Desc)
+	}
+}
+
+func (w *wasmWriter) writeImportDesc(desc ImportDesc) {
+	w.writeByte(desc.Tag)
+	switch desc.Tag {
+	case ImportTagFunc:
+		w.writeVarU32(desc.FuncType)
+	case ImportTagTable:
+		w.writeTableType(desc.Table)
+	case ImportTagMem:
+		w.writeLimits(desc.Mem)
+	case ImportTagGlobal:
+		w.writeGlobalType(desc.Global)
+	case ImportTagTag:
+		w.writeTagType(desc.TagType)
+	}
+}
+
+// ---------------- 编码表段
+
+func (w *wasmWriter) writeTableSec(vec []TableType) {
+	w.writeVarU32(uint32(len(vec)))
+	for _, tt := range vec {
+		w.writeTableType(tt)
+	}
+}
+
+func (w *wasmWriter) writeTableType(tt TableType) {
+	w.writeByte(tt.ElemType)
+	w.writeLimits(tt.Limits)
+}
+
+func (w *wasmWriter) writeLimits(limits Limits) {
+	w.writeByte(limits.Tag)
+	w.writeVarU64(limits.Min) // u32 和 u64 的 leb128 编码是相同的
+
+	// 仅当 tag 的第 0 位为 1 时，才有 max 数据
+	if limits.HasMax() {
+		w.writeVarU64(limits.Max)
+	}
+}
+
+// ---------------- 编码内存块段
+
+func (w *wasmWriter) writeMemSec(vec []MemType) {
+	w.writeVarU32(uint32(len(vec)))
+	for _, mt := range vec {
+		w.writeLimits(mt)
+	}
+}
+
+// ---------------- 编码标签段
+
+func (w *wasmWriter) writeTagSec(vec []TagType) {
+	w.writeVarU32(uint32(len(vec)))
+	for _, tt := range vec {
+		w.writeTagType(tt)
+	}
+}
+
+func (w *wasmWriter) writeTagType(tt TagType) {
+	w.writeByte(tt.Attribute)
+	w.writeVarU32(tt.Type)
+}
+
+// ---------------- 编码全局变量段
+
+func (w *wasmWriter) writeGlobalSec(vec []Global) {
+	w.writeVarU32(uint32(len(vec)))
+	for _, global := range vec {
+		w.writeGlobalType(global.Type)
+		w.writeExpr(global.Init)
+	}
+}
+
+func (w *wasmWriter) writeGlobalType(gt GlobalType) {
+	w.writeByte(gt.ValType)
+	w.writeByte(gt.Mut)
+}
+
+func (w *wasmWriter) writeExpr(expr Expr) {
+	w.writeInstructions(expr)
+	w.writeByte(End_)
+}
+
+// ---------------- 编码导出段
+
+func (w *wasmWriter) writeExportSec(vec []Export) {
+	w.writeVarU32(uint32(len(vec)))
+	for _, exportItem := range vec {
+		w.writeName(exportItem.Name)
+		w.writeByte(exportItem.Desc.Tag)
+		w.writeVarU32(exportItem.Desc.Idx)
+	}
+}
+
+// ---------------- 编码元素段
+
+func (w *wasmWriter) writeElemSec(vec []Elem) {
+	w.writeVarU32(uint32(len(vec)))
+	for _, elem := range vec {
+		// 使用表达式列表的元素项的 flags 为 4~7，其后的 elem_kind 换成 ref_type
+		var flags uint32
+		var kind ValType = ElemKindFuncRef
+		if elem.Exprs != nil {
+			flags = 4
+			kind = elem.Type
+		}
+
+		switch {
+		case elem.Mode == SegmentModePassive:
+			w.writeVarU32(flags + 1)
+			w.writeByte(kind)
+		case elem.Mode == SegmentModeDeclarative:
+			w.writeVarU32(flags + 3)
+			w.writeByte(kind)
+		case elem.Table == 0 && (elem.Exprs == nil || elem.Type == FuncRef):
+			w.writeVarU32(flags)
+			w.writeExpr(elem.Offset)
+		default:
+			w.writeVarU32(flags + 2)
+			w.writeVarU32(elem.Table)
+			w.writeExpr(elem.Offset)
+			w.writeByte(kind)
+		}
+
+		if elem.Exprs != nil {
+			w.writeVarU32(uint32(len(elem.Exprs)))
+			for _, expr := range elem.Exprs {
+				w.writeExpr(expr)
+			}
+		} else {
+			w.writeVarU32Array(elem.Init)
+		}
+	}
+}
+
+// ---------------- 编码（函数）代码段
+
+func (w *wasmWriter) writeCodeSec(vec []Code) {
+	w.writeVarU32(uint32(len(vec)))
+	for _, code := range vec {
+		// 代码项的开头需要记录代码项的长度，所以先编码代码项的内容
+		codeWriter := &wasmWriter{}
+		codeWriter.writeVarU32(uint32(len(code.Locals)))
+		for _, locals := range code.Locals {
+			codeWriter.writeVarU32(locals.N)
+			codeWriter.writeByte(locals.Type)
+		}
+		codeWriter.writeExpr(code.Expr)
+
+		w.writeBytes(codeWriter.buf)
+	}
+}
+
+// ---------------- 编码数据段
+
+func (w *wasmWriter) writeDataSec(vec []Data) {
+	w.writeVarU32(uint32(len(vec)))
+	for _, data := range vec {
+		switch {
+		case data.Mode == SegmentModePassive:
+			w.writeVarU32(1)
+		case data.Mem == 0:
+			w.writeVarU32(0)
+			w.writeExpr(data.Offset)
+		default:
+			w.writeVarU32(2)
+			w.writeVarU32(data.Mem)
+			w.writeExpr(data.Offset)
+		}
+		w.writeBytes(data.Init)
+	}
+}
+
+// ---------------- 编码指令
+
+func (w *wasmWriter) writeInstructions(instrs []Instruction) {
+	for _, inst := range instrs {
+		w.writeInstruction(inst)
+	}
+}
+
+func (w *wasmWriter) writeInstruction(inst Instruction) {
+	w.writeByte(inst.Opcode)
+	w.writeArgs(inst.Opcode, inst.Args)
+}
+
+// 写入指令的操作数，跟 wasmReader.readArgs() 对应
+func (w *wasmWriter) writeArgs(opcode byte, args interface{}) {
+	switch opcode {
+
+	// 数值指令
+
+	case I32Const:
+		w.writeVarS32(args.(int32))
+	case I64Const:
+		w.writeVarS64(args.(int64))
+	case F32Const:
+		w.writeF32(args.(float32))
+	case F64Const:
+		w.writeF64(args.(float64))
+	case MiscPrefix:
+		w.writeMiscArgs(args.(MiscArgs))
+	case SIMDPrefix:
+		w.writeSIMDArgs(args.(SIMDArgs))
+	case AtomicPrefix:
+		w.writeAtomicArgs(args.(AtomicArgs))
+
+	// 变量指令
+
+	case LocalGet, LocalSet, LocalTee, GlobalGet, GlobalSet:
+		w.writeVarU32(args.(uint32))
+
+	// 引用指令和表指令
+
+	case RefNull:
+		w.writeByte(args.(ValType))
+	case RefFunc, TableGet, TableSet:
+		w.writeVarU32(args.(uint32))
+	case SelectT:
+		w.writeValTypes(args.([]ValType))
+
+	// 内存指令
+
+	case MemorySize, MemoryGrow:
+		w.writeVarU32(args.(uint32))
+
+	// 结构化控制指令
+
+	case Block, Loop:
+		blockArgs := args.(BlockArgs)
+		w.writeVarS32(blockArgs.BT)
+		w.writeInstructions(blockArgs.Instrs)
+		w.writeByte(End_)
+	case If:
+		ifArgs := args.(IfArgs)
+		w.writeVarS32(ifArgs.BT)
+		w.writeInstructions(ifArgs.Instrs1)
+		if ifArgs.Instrs2 != nil {
+			w.writeByte(Else_)
+			w.writeInstructions(ifArgs.Instrs2)
+		}
+		w.writeByte(End_)
+	case Try:
+		tryArgs := args.(TryArgs)
+		w.writeVarS32(tryArgs.BT)
+		w.writeInstructions(tryArgs.Instrs)
+		for _, clause := range tryArgs.Catches {
+			if clause.All {
+				w.writeByte(CatchAll_)
+			} else {
+				w.writeByte(Catch_)
+				w.writeVarU32(clause.Tag)
+			}
+			w.writeInstructions(clause.Instrs)
+		}
+		if tryArgs.Delegate != nil {
+			w.writeByte(Delegate_) // delegate 代替了 end
+			w.writeVarU32(*tryArgs.Delegate)
+		} else {
+			w.writeByte(End_)
+		}
+
+	// 异常处理指令
+
+	case Throw, Rethrow:
+		w.writeVarU32(args.(uint32))
+
+	// 跳转指令
+
+	case Br, BrIf:
+		w.writeVarU32(args.(uint32))
+	case BrTable:
+		brTableArgs := args.(BrTableArgs)
+		w.writeVarU32Array(brTableArgs.Labels)
+		w.writeVarU32(brTableArgs.Default)
+
+	// 函数调用指令
+
+	case Call, ReturnCall:
+		w.writeVarU32(args.(uint32))
+	case CallIndirect, ReturnCallIndirect:
+		callIndirectArgs := args.(CallIndirectArgs)
+		w.writeVarU32(callIndirectArgs.Type)
+		w.writeVarU32(callIndirectArgs.Table)
+
+	default:
+		// 内存指令（续）
+		if opcode >= I32Load && opcode <= I64Store32 {
+			w.writeMemArg(args.(MemArg))
+		}
+	}
+}
+
+func (w *wasmWriter) writeMemArg(memArg MemArg) {
+	if memArg.Mem != 0 {
+		w.writeVarU32(memArg.Align | memArgMemFlag)
+		w.writeVarU32(memArg.Mem)
+	} else {
+		w.writeVarU32(memArg.Align)
+	}
+	w.writeVarU64(memArg.Offset)
+}
+
+func (w *wasmWriter) writeMiscArgs(args MiscArgs) {
+	w.writeVarU32(args.SubOpcode)
+	switch args.SubOpcode {
+	case MemoryInit:
+		memoryInitArgs := args.Args.(MemoryInitArgs)
+		w.writeVarU32(memoryInitArgs.Data)
+		w.writeVarU32(memoryInitArgs.Mem)
+	case DataDrop, ElemDrop, MemoryFill:
+		w.writeVarU32(args.Args.(uint32))
+	case MemoryCopy:
+		memoryCopyArgs := args.Args.(MemoryCopyArgs)
+		w.writeVarU32(memoryCopyArgs.Dst)
+		w.writeVarU32(memoryCopyArgs.Src)
+	case TableInit:
+		tableInitArgs := args.Args.(TableInitArgs)
+		w.writeVarU32(tableInitArgs.Elem)
+		w.writeVarU32(tableInitArgs.Table)
+	case TableCopy:
+		tableCopyArgs := args.Args.(TableCopyArgs)
+		w.writeVarU32(tableCopyArgs.Dst)
+		w.writeVarU32(tableCopyArgs.Src)
+	case TableGrow, TableSize, TableFill:
+		w.writeVarU32(args.Args.(uint32))
+	}
+}
+
+func (w *wasmWriter) writeSIMDArgs(args SIMDArgs) {
+	w.writeVarU32(args.SubOpcode)
+	switch a := args.Args.(type) {
+	case MemArg:
+		w.writeMemArg(a)
+	case MemLaneArgs:
+		w.writeMemArg(a.MemArg)
+		w.writeByte(a.Lane)
+	case V128:
+		w.buf = append(w.buf, a[:]...)
+	case [16]LaneIdx:
+		w.buf = append(w.buf, a[:]...)
+	case LaneIdx:
+		w.writeByte(a)
+	}
+}
+
+func (w *wasmWriter) writeAtomicArgs(args AtomicArgs) {
+	w.writeVarU32(args.SubOpcode)
+	if args.SubOpcode == AtomicFence {
+		w.writeByte(0) // 保留的字节
+	} else {
+		w.writeMemArg(args.Args.(MemArg))
+	}
+}
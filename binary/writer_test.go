@@ -0,0 +1,151 @@
+package binary
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"wasmvm/assert"
+)
+
+// 解码 test/resources 里的所有 wasm 文件，然后再编码，
+// 得到的二进制数据应该跟原文件一致，再次解码得到的模块也应该跟原模块一致
+func TestEncodeRoundTrip(t *testing.T) {
+	currentDir, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+
+	fileNames, err := filepath.Glob(filepath.Join(currentDir, "..", "test", "resources", "*", "*.wasm"))
+	if err != nil {
+		panic(err)
+	}
+	assert.AssertTrue(t, len(fileNames) > 0)
+
+	for _, fileName := range fileNames {
+		data, err := os.ReadFile(fileName)
+		if err != nil {
+			panic(err)
+		}
+
+		m, err := Decode(data)
+		assert.AssertNil(t, err)
+
+		encoded := Encode(m)
+		if !bytes.Equal(data, encoded) {
+			t.Fatalf("%s: encoded data is different from the original file", filepath.Base(fileName))
+		}
+
+		m2, err := Decode(encoded)
+		assert.AssertNil(t, err)
+		if !reflect.DeepEqual(m, m2) {
+			t.Fatalf("%s: decoded module is different from the original module", filepath.Base(fileName))
+		}
+	}
+}
+
+func TestEncodeModule(t *testing.T) {
+	// (module
+	//   (func (param i32) (result i32)
+	//     (block (result i32) (local.get 0))
+	//     (if (result i32) (then (i32.const 1)) (else (i32.const -1)))))
+	m := newTestModule(
+		FuncType{Tag: FtTag, ParamTypes: []ValType{ValTypeI32}, ResultTypes: []ValType{ValTypeI32}},
+		Instruction{Block, BlockArgs{BT: BlockTypeI32, Instrs: []Instruction{{LocalGet, uint32(0)}}}},
+		Instruction{If, IfArgs{BT: BlockTypeI32,
+			Instrs1: []Instruction{{I32Const, int32(1)}},
+			Instrs2: []Instruction{{I32Const, int32(-1)}}}},
+	)
+	m.ExportSec = []Export{{Name: "f", Desc: ExportDesc{Tag: ExportTagFunc, Idx: 0}}}
+	m.CustomSecs = []CustomSec{{Name: "foo", Bytes: []byte{1, 2, 3}}}
+
+	m2, err := Decode(Encode(m))
+	assert.AssertNil(t, err)
+	assert.AssertNil(t, Validate(m2))
+	assert.AssertEqual(t, "f", m2.ExportSec[0].Name)
+	assert.AssertEqual(t, "foo", m2.CustomSecs[0].Name)
+	assert.AssertSliceEqual(t, []byte{1, 2, 3}, m2.CustomSecs[0].Bytes)
+
+	ifArgs := m2.CodeSec[0].Expr[1].Args.(IfArgs)
+	assert.AssertEqual(t, int32(-1), ifArgs.Instrs2[0].Args.(int32))
+}
+
+// 使用表达式列表的元素项（flags 4~7）
+func TestEncodeElemExprs(t *testing.T) {
+	m := newTestModule(FuncType{Tag: FtTag})
+	m.TableSec = []TableType{{ElemType: ExternRef, Limits: Limits{Min: 1}}}
+	refFunc := Expr{{RefFunc, uint32(0)}}
+	refNull := Expr{{RefNull, ExternRef}}
+	offset := Expr{{I32Const, int32(0)}}
+	m.ElemSec = []Elem{
+		{Type: ExternRef, Offset: offset, Exprs: []Expr{refNull}},
+		{Type: FuncRef, Mode: SegmentModePassive, Exprs: []Expr{refFunc, {{RefNull, FuncRef}}}},
+		{Type: FuncRef, Mode: SegmentModeDeclarative, Exprs: []Expr{refFunc}},
+	}
+
+	data := Encode(m)
+	m2, err := Decode(data)
+	assert.AssertNil(t, err)
+	assert.AssertNil(t, Validate(m2))
+	assert.AssertTrue(t, bytes.Equal(data, Encode(m2)))
+	assert.AssertTrue(t, reflect.DeepEqual(m.ElemSec, m2.ElemSec))
+}
+
+// 多个内存块时，内存指令里非 0 的内存索引
+func TestEncodeMemIdx(t *testing.T) {
+	m := newTestModule(FuncType{Tag: FtTag},
+		Instruction{I32Const, int32(0)},
+		Instruction{I32Load, MemArg{Align: 2, Offset: 4, Mem: 1}},
+		Instruction{Drop, nil},
+		Instruction{MemorySize, uint32(1)},
+		Instruction{Drop, nil},
+		Instruction{I32Const, int32(0)},
+		Instruction{I32Const, int32(0)},
+		Instruction{I32Const, int32(0)},
+		Instruction{MiscPrefix, MiscArgs{SubOpcode: MemoryCopy, Args: MemoryCopyArgs{Dst: 0, Src: 1}}},
+	)
+	m.MemSec = []MemType{{Min: 1}, {Min: 1}}
+
+	data := Encode(m)
+	m2, err := Decode(data)
+	assert.AssertNil(t, err)
+	assert.AssertNil(t, Validate(m2))
+	assert.AssertTrue(t, bytes.Equal(data, Encode(m2)))
+	assert.AssertTrue(t, reflect.DeepEqual(m.CodeSec[0].Expr, m2.CodeSec[0].Expr))
+
+	// 内存索引超出范围
+	m.MemSec = m.MemSec[:1]
+	assert.AssertTrue(t, Validate(m) != nil)
+}
+
+// SIMD 指令的各种立即数
+func TestEncodeSIMD(t *testing.T) {
+	var lanes [16]LaneIdx
+	for i := range lanes {
+		lanes[i] = LaneIdx(31 - i)
+	}
+
+	m := newTestModule(FuncType{Tag: FtTag, ResultTypes: []ValType{ValTypeI32}},
+		Instruction{I32Const, int32(0)},
+		Instruction{I32Const, int32(0)},
+		Instruction{SIMDPrefix, SIMDArgs{SubOpcode: V128Load, Args: MemArg{Align: 4, Offset: 16}}},
+		Instruction{SIMDPrefix, SIMDArgs{SubOpcode: V128Load32Lane, Args: MemLaneArgs{MemArg: MemArg{Align: 2, Offset: 8}, Lane: 3}}},
+		Instruction{SIMDPrefix, SIMDArgs{SubOpcode: V128Const, Args: V128{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}}},
+		Instruction{SIMDPrefix, SIMDArgs{SubOpcode: I8x16Shuffle, Args: lanes}},
+		Instruction{SIMDPrefix, SIMDArgs{SubOpcode: I32x4Neg}},
+		Instruction{SIMDPrefix, SIMDArgs{SubOpcode: I32x4ExtractLane, Args: LaneIdx(2)}},
+	)
+	m.MemSec = []MemType{{Min: 1}}
+
+	data := Encode(m)
+	m2, err := Decode(data)
+	assert.AssertNil(t, err)
+	assert.AssertNil(t, Validate(m2))
+	assert.AssertTrue(t, bytes.Equal(data, Encode(m2)))
+	assert.AssertTrue(t, reflect.DeepEqual(m.CodeSec[0].Expr, m2.CodeSec[0].Expr))
+
+	// 通道索引超出范围
+	m.CodeSec[0].Expr[7] = Instruction{SIMDPrefix, SIMDArgs{SubOpcode: I32x4ExtractLane, Args: LaneIdx(4)}}
+	assert.AssertTrue(t, Validate(m) != nil)
+}
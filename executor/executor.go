@@ -11,13 +11,128 @@ func NewModule(m binary.Module) instance.Module {
 	return NewModules([]string{"user"}, []binary.Module{m})["user"]
 }
 
+// 使用指定的配置（比如启用燃料计量，或者使用寄存器引擎）实例化模块
+func NewModuleWithConfig(m binary.Module, config interpreter.Config) instance.Module {
+	moduleMap := map[string]instance.Module{}
+	moduleMap["env"] = native.NewEnvModule()
+
+	return NewModulesWithConfig(moduleMap, []string{"user"}, []binary.Module{m}, config)["user"]
+}
+
 func NewModules(names []string, ms []binary.Module) map[string]instance.Module {
 	moduleMap := map[string]instance.Module{}
 	moduleMap["env"] = native.NewEnvModule()
 
+	return NewModulesWithImports(moduleMap, names, ms)
+}
+
+// 实例化一组模块，模块可以导入 moduleMap 里已有的模块实例（比如宿主模块、
+// 之前实例化的模块）的导出项，也可以导入这一组里排在它前面的模块的导出项。
+// 新的模块实例会以指定的名称加入 moduleMap，返回值即 moduleMap。
+func NewModulesWithImports(moduleMap map[string]instance.Module,
+	names []string, ms []binary.Module) map[string]instance.Module {
+
+	return NewModulesWithConfig(moduleMap, names, ms, interpreter.Config{})
+}
+
+// 跟 NewModulesWithImports 相同，但这一组模块都使用指定的配置
+func NewModulesWithConfig(moduleMap map[string]instance.Module,
+	names []string, ms []binary.Module, config interpreter.Config) map[string]instance.Module {
+
+	factories := make([]ModuleFactory, len(ms))
+	for idx, m := range ms {
+		factories[idx] = Interpret(m, config)
+	}
+
+	return NewModulesWithFactories(moduleMap, names, factories)
+}
+
+// 模块实例的构造函数，参数是可供导入的模块实例
+//
+// 除了解释器（见 Interpret），wasm2go 生成的 Go 包的 NewModule 函数也是 ModuleFactory，
+// 所以预先翻译的模块可以跟解释执行的模块放在同一组里实例化，并且互相导入。
+type ModuleFactory = func(mm map[string]instance.Module) instance.Module
+
+// 使用解释器以及指定的配置实例化模块 m
+func Interpret(m binary.Module, config interpreter.Config) ModuleFactory {
+	return func(mm map[string]instance.Module) instance.Module {
+		return interpreter.NewModuleWithConfig(m, mm, config)
+	}
+}
+
+// 跟 NewModulesWithImports 相同，但每个模块由各自的构造函数实例化
+func NewModulesWithFactories(moduleMap map[string]instance.Module,
+	names []string, factories []ModuleFactory) map[string]instance.Module {
+
 	for idx, name := range names {
-		moduleMap[name] = interpreter.NewModule(ms[idx], moduleMap)
+		moduleMap[name] = factories[idx](moduleMap)
 	}
 
 	return moduleMap
 }
+
+// ---------------- 返回错误的版本
+//
+// 以上函数在实例化失败时会 panic，比如链接错误（*instance.LinkError），
+// 或者 start 函数发生陷阱（*instance.Trap），以下对应的 Try 版本则将失败的原因以 error 返回。
+// 一组模块里有模块实例化失败时，排在它前面的模块实例仍然会加入 moduleMap。
+
+func TryNewModule(m binary.Module) (mod instance.Module, err error) {
+	defer catchError(&err)
+	return NewModule(m), nil
+}
+
+func TryNewModuleWithConfig(m binary.Module, config interpreter.Config) (mod instance.Module, err error) {
+	defer catchError(&err)
+	return NewModuleWithConfig(m, config), nil
+}
+
+func TryNewModules(names []string, ms []binary.Module) (mm map[string]instance.Module, err error) {
+	defer catchError(&err)
+	return NewModules(names, ms), nil
+}
+
+func TryNewModulesWithImports(moduleMap map[string]instance.Module,
+	names []string, ms []binary.Module) (mm map[string]instance.Module, err error) {
+
+	defer catchError(&err)
+	return NewModulesWithImports(moduleMap, names, ms), nil
+}
+
+func TryNewModulesWithConfig(moduleMap map[string]instance.Module,
+	names []string, ms []binary.Module, config interpreter.Config) (mm map[string]instance.Module, err error) {
+
+	defer catchError(&err)
+	return NewModulesWithConfig(moduleMap, names, ms, config), nil
+}
+
+func TryNewModulesWithFactories(moduleMap map[string]instance.Module,
+	names []string, factories []ModuleFactory) (mm map[string]instance.Module, err error) {
+
+	defer catchError(&err)
+	return NewModulesWithFactories(moduleMap, names, factories), nil
+}
+
+// 将 panic 的值转换为 error
+func catchError(err *error) {
+	if r := recover(); r != nil {
+		*err = instance.ToError(r)
+	}
+}
+
+// 将同一个模块实例化 n 次，用于在 n 个 goroutine 上分别运行（即多线程）
+//
+// 每个实例拥有各自的栈、全局变量和表，线程之间通过共享内存通信，
+// 所以模块需要从 moduleMap 导入共享内存（可以使用 interpreter.NewSharedMemory 创建），
+// 比如 (import "env" "memory" (memory 1 1 shared))。
+// 每次实例化都会写入主动的数据项，所以多线程的模块通常使用被动的数据项。
+// 同一个实例不能同时在多个 goroutine 上执行，返回的实例不会加入 moduleMap。
+func NewThreads(moduleMap map[string]instance.Module, m binary.Module,
+	n int, config interpreter.Config) []instance.Module {
+
+	threads := make([]instance.Module, n)
+	for i := range threads {
+		threads[i] = interpreter.NewModuleWithConfig(m, moduleMap, config)
+	}
+	return threads
+}
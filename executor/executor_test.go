@@ -1,12 +1,21 @@
 package executor
 
 import (
+	"context"
+	encoding_binary "encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"math"
 	"os"
 	"path/filepath"
+	"runtime"
 	"testing"
+	"time"
 	"wasmvm/assert"
 	"wasmvm/binary"
 	"wasmvm/instance"
+	"wasmvm/interpreter"
+	"wasmvm/native"
 )
 
 // 测试调用本地函数（native function）
@@ -30,6 +39,567 @@ func TestMultipleModules(t *testing.T) {
 			"app", "test_sub", nil))
 }
 
+// 每个模块实例可以使用不同的引擎，比如库模块使用寄存器引擎，应用模块使用栈式引擎
+func TestMixedEngines(t *testing.T) {
+	moduleMap := map[string]instance.Module{"env": native.NewEnvModule()}
+	NewModulesWithConfig(moduleMap, []string{"lib"}, []binary.Module{readModule("test-module-lib.wasm")},
+		interpreter.Config{Engine: interpreter.EngineRegister})
+	mod := NewModulesWithConfig(moduleMap, []string{"app"}, []binary.Module{readModule("test-module-app.wasm")},
+		interpreter.Config{})["app"]
+
+	assert.AssertListEqual(t, wrapList([]int32{77}), mod.EvalFunc("test_add"))
+	assert.AssertListEqual(t, wrapList([]int32{33}), mod.EvalFunc("test_sub"))
+}
+
+// 经由宿主函数再次进入模块时，嵌套的调用共用同一份燃料
+func TestFuelWithReentry(t *testing.T) {
+	var mod instance.Module
+
+	host := native.NewNativeModule()
+	host.RegisterFunc("reenter",
+		[]binary.ValType{binary.ValTypeI32}, []binary.ValType{binary.ValTypeI32},
+		func(args []instance.WasmVal) []instance.WasmVal {
+			return mod.EvalFunc("inc", args...)
+		})
+
+	moduleMap := map[string]instance.Module{"host": host}
+	config := interpreter.Config{ConsumeFuel: true, Fuel: 7}
+	mod = NewModulesWithConfig(moduleMap, []string{"user"},
+		[]binary.Module{readModule("test-executor-fuel.wasm")}, config)["user"]
+
+	// run: 入口 1 + 指令 2，inc: 入口 1 + 指令 3
+	results, err := mod.TryEvalFunc("run", int32(1))
+	assert.AssertNil(t, err)
+	assert.AssertListEqual(t, wrapList([]int32{2}), results)
+
+	meter := mod.(instance.FuelMeter)
+	assert.AssertEqual(t, uint64(0), meter.Fuel())
+
+	meter.AddFuel(6)
+	_, err = mod.TryEvalFunc("run", int32(1))
+	trap, ok := err.(*instance.Trap)
+	assert.AssertTrue(t, ok)
+	assert.AssertEqual(t, instance.TrapOutOfFuel, trap.Code)
+}
+
+// 导入项的类型跟导出项的类型不匹配
+func TestLinkError(t *testing.T) {
+	defer func() {
+		linkErr, ok := recover().(*instance.LinkError)
+		assert.AssertTrue(t, ok)
+		assert.AssertEqual(t, "env", linkErr.Module)
+		assert.AssertEqual(t, "add_i32", linkErr.Field)
+		assert.AssertEqual(t, "incompatible import type", linkErr.Reason)
+		assert.AssertEqual(t, "(func (param i64 i64) (result i64))", linkErr.Expected)
+		assert.AssertEqual(t, "(func (param i32 i32) (result i32))", linkErr.Actual)
+	}()
+
+	NewModule(readModule("test-executor-link-error.wasm"))
+	t.Fatal("expected a link error")
+}
+
+// Try 版本以 error 返回链接错误，而不是 panic
+func TestTryLinkError(t *testing.T) {
+	mod, err := TryNewModule(readModule("test-executor-link-error.wasm"))
+	assert.AssertNil(t, mod)
+	linkErr, ok := err.(*instance.LinkError)
+	assert.AssertTrue(t, ok)
+	assert.AssertEqual(t, "add_i32", linkErr.Field)
+
+	// 链接失败之前的模块仍然加入 moduleMap
+	moduleMap := map[string]instance.Module{"env": native.NewEnvModule()}
+	_, err = TryNewModulesWithImports(moduleMap, []string{"lib", "user"},
+		[]binary.Module{readModule("test-module-lib.wasm"), readModule("test-executor-link-error.wasm")})
+	_, ok = err.(*instance.LinkError)
+	assert.AssertTrue(t, ok)
+	assert.AssertTrue(t, moduleMap["lib"] != nil)
+	assert.AssertTrue(t, moduleMap["user"] == nil)
+
+	mod, err = TryNewModule(readModule("test-executor-native-function.wasm"))
+	assert.AssertNil(t, err)
+	assert.AssertListEqual(t, wrapList([]int32{33}), mod.EvalFunc("test_add"))
+}
+
+// 宿主函数以及模块内部函数的多返回值
+func TestMultiValue(t *testing.T) {
+	host := native.NewNativeModule()
+	host.RegisterFunc("div_rem",
+		[]binary.ValType{binary.ValTypeI64, binary.ValTypeI64},
+		[]binary.ValType{binary.ValTypeI64, binary.ValTypeI64},
+		func(args []instance.WasmVal) []instance.WasmVal {
+			a, b := args[0].(int64), args[1].(int64)
+			return []instance.WasmVal{a / b, a % b}
+		})
+	host.RegisterFunc("mixed",
+		[]binary.ValType{},
+		[]binary.ValType{binary.ValTypeI32, binary.ValTypeI64, binary.ValTypeF32, binary.ValTypeF64},
+		func(args []instance.WasmVal) []instance.WasmVal {
+			return []instance.WasmVal{int32(1), int64(-2), float32(3.5), float64(-4.25)}
+		})
+
+	moduleMap := map[string]instance.Module{"host": host}
+	mod := NewModulesWithImports(moduleMap, []string{"user"},
+		[]binary.Module{readModule("test-executor-multi-value.wasm")})["user"]
+
+	assert.AssertListEqual(t,
+		[]instance.WasmVal{int64(3), int64(2)},
+		mod.EvalFunc("div_rem", int64(17), int64(5)))
+	assert.AssertListEqual(t,
+		[]instance.WasmVal{int32(1), int64(-2), float32(3.5), float64(-4.25)},
+		mod.EvalFunc("mixed"))
+	assert.AssertListEqual(t,
+		[]instance.WasmVal{float64(-1.75)},
+		mod.EvalFunc("mixed_sum"))
+	assert.AssertListEqual(t,
+		[]instance.WasmVal{float32(1.5), int32(7)},
+		mod.EvalFunc("swap", int32(7), float32(1.5)))
+}
+
+// 以 externref 的形式传入宿主的值，然后再取回来
+func TestExternRef(t *testing.T) {
+	type hostObject struct {
+		name string
+	}
+	obj1 := &hostObject{name: "one"}
+	obj2 := &hostObject{name: "two"}
+
+	host := native.NewNativeModule()
+	host.RegisterFunc("describe",
+		[]binary.ValType{binary.ValTypeExternRef}, []binary.ValType{binary.ValTypeI32},
+		func(args []instance.WasmVal) []instance.WasmVal {
+			if args[0] == nil {
+				return []instance.WasmVal{int32(-1)}
+			}
+			return []instance.WasmVal{int32(len(args[0].(*hostObject).name))}
+		})
+
+	moduleMap := map[string]instance.Module{"host": host}
+	mod := NewModulesWithImports(moduleMap, []string{"user"},
+		[]binary.Module{readModule("test-executor-externref.wasm")})["user"]
+
+	assert.AssertTrue(t, mod.EvalFunc("id", obj1)[0] == obj1)
+	assert.AssertTrue(t, mod.EvalFunc("id", nil)[0] == nil)
+	assert.AssertEqual(t, "text", mod.EvalFunc("id", "text")[0].(string))
+
+	mod.EvalFunc("store", int32(0), obj1)
+	mod.EvalFunc("store", int32(1), obj2)
+	assert.AssertTrue(t, mod.EvalFunc("load", int32(0))[0] == obj1)
+	assert.AssertTrue(t, mod.EvalFunc("load", int32(1))[0] == obj2)
+	assert.AssertTrue(t, mod.EvalFunc("last")[0] == obj2)
+
+	// 宿主也可以直接读写导出的表
+	table := mod.GetMember("table").(instance.Table)
+	assert.AssertEqual(t, binary.ExternRef, table.Type().ElemType)
+	assert.AssertTrue(t, table.GetElem(1) == obj2)
+	table.SetElem(1, nil)
+	assert.AssertTrue(t, mod.EvalFunc("load", int32(1))[0] == nil)
+
+	assert.AssertListEqual(t, []instance.WasmVal{int32(3)}, mod.EvalFunc("describe", obj1))
+	assert.AssertListEqual(t, []instance.WasmVal{int32(-1)}, mod.EvalFunc("describe", nil))
+
+	// 返回的 funcref 是可以直接调用的函数
+	f := mod.EvalFunc("answer")[0].(instance.Function)
+	assert.AssertListEqual(t, []instance.WasmVal{int32(42)}, f.Eval())
+}
+
+// 引用只在模块实例里登记，传入的值在调用结束之后不再被引用，
+// 保存在表里的值随着模块实例一起被回收
+func TestExternRefCollected(t *testing.T) {
+	type hostObject struct {
+		name string
+	}
+	host := native.NewNativeModule()
+	host.RegisterFunc("describe",
+		[]binary.ValType{binary.ValTypeExternRef}, []binary.ValType{binary.ValTypeI32},
+		func(args []instance.WasmVal) []instance.WasmVal { return []instance.WasmVal{int32(0)} })
+	moduleMap := map[string]instance.Module{"host": host}
+	m := readModule("test-executor-externref.wasm")
+
+	mod := NewModulesWithImports(moduleMap, []string{"user"}, []binary.Module{m})["user"]
+	passed := make(chan struct{})
+	func() {
+		obj := &hostObject{name: "passed"}
+		runtime.SetFinalizer(obj, func(*hostObject) { close(passed) })
+		mod.EvalFunc("id", obj)
+	}()
+	assertCollected(t, passed)
+	assert.AssertListEqual(t, []instance.WasmVal{nil}, mod.EvalFunc("last"))
+
+	stored := make(chan struct{})
+	func() {
+		mod := NewModulesWithImports(moduleMap, []string{"user"}, []binary.Module{m})["user"]
+		obj := &hostObject{name: "stored"}
+		runtime.SetFinalizer(obj, func(*hostObject) { close(stored) })
+		mod.EvalFunc("store", int32(0), obj)
+	}()
+	assertCollected(t, stored)
+}
+
+func assertCollected(t *testing.T, finalized chan struct{}) {
+	for i := 0; i < 20; i++ {
+		runtime.GC()
+		select {
+		case <-finalized:
+			return
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	t.Fatal("value was not garbage collected")
+}
+
+// 推迟执行 start 函数
+func TestDeferStart(t *testing.T) {
+	logs := []int32{}
+	host := native.NewNativeModule()
+	host.RegisterFunc("log",
+		[]binary.ValType{binary.ValTypeI32}, []binary.ValType{},
+		func(args []instance.WasmVal) []instance.WasmVal {
+			logs = append(logs, args[0].(int32))
+			return nil
+		})
+
+	m := readModule("test-executor-start.wasm")
+	moduleMap := map[string]instance.Module{"host": host}
+	NewModulesWithImports(moduleMap, []string{"user"}, []binary.Module{m})
+	assert.AssertSliceEqual(t, []int32{42}, logs)
+
+	mod := NewModulesWithConfig(moduleMap, []string{"deferred"}, []binary.Module{m},
+		interpreter.Config{DeferStart: true})["deferred"]
+	assert.AssertSliceEqual(t, []int32{42}, logs)
+
+	assert.AssertNil(t, mod.(instance.Starter).Start())
+	assert.AssertSliceEqual(t, []int32{42, 42}, logs)
+}
+
+// start 函数发生陷阱时，Try 版本的实例化函数返回 *instance.Trap
+func TestStartTrap(t *testing.T) {
+	m := readModule("test-executor-start-trap.wasm")
+	for _, config := range []interpreter.Config{{}, {Engine: interpreter.EngineRegister}} {
+		mod, err := TryNewModuleWithConfig(m, config)
+		assert.AssertNil(t, mod)
+		trap, ok := err.(*instance.Trap)
+		assert.AssertTrue(t, ok)
+		assert.AssertEqual(t, instance.TrapIntegerDivideByZero, trap.Code)
+		assert.AssertEqual(t, 2, len(trap.Backtrace))
+	}
+
+	moduleMap := map[string]instance.Module{}
+	_, err := TryNewModulesWithConfig(moduleMap, []string{"user"}, []binary.Module{m}, interpreter.Config{})
+	assert.AssertEqual(t, "trap: integer divide by zero", err.Error())
+	assert.AssertTrue(t, moduleMap["user"] == nil)
+}
+
+// 宿主函数抛出的异常被模块捕获，模块抛出的异常被宿主捕获
+func TestException(t *testing.T) {
+	errorTag := interpreter.NewTag(binary.FuncType{ParamTypes: []binary.ValType{binary.ValTypeI32}})
+	host := native.NewNativeModule()
+	host.Register("error", errorTag)
+	host.RegisterFunc("check",
+		[]binary.ValType{binary.ValTypeI32}, []binary.ValType{},
+		func(args []instance.WasmVal) []instance.WasmVal {
+			if n := args[0].(int32); n < 0 {
+				panic(instance.NewException(errorTag, -n))
+			}
+			return nil
+		})
+
+	moduleMap := map[string]instance.Module{"host": host}
+	mod := NewModulesWithImports(moduleMap, []string{"user"},
+		[]binary.Module{readModule("test-executor-exception.wasm")})["user"]
+
+	assert.AssertListEqual(t, wrapList([]int32{0}), mod.EvalFunc("safe_check", int32(5)))
+	assert.AssertListEqual(t, wrapList([]int32{3}), mod.EvalFunc("safe_check", int32(-3)))
+
+	_, err := mod.TryEvalFunc("add", int64(-1), int64(2))
+	ex, ok := err.(*instance.Exception)
+	assert.AssertTrue(t, ok)
+	assert.AssertTrue(t, ex.Tag == mod.GetMember("overflow"))
+	assert.AssertListEqual(t, wrapList([]int64{1}), ex.Args)
+
+	// 异常抛出之后模块实例仍然可以继续被调用
+	assert.AssertListEqual(t, wrapList([]int64{3}), mod.EvalFunc("add", int64(1), int64(2)))
+
+	defer func() {
+		ex, ok := recover().(*instance.Exception)
+		assert.AssertTrue(t, ok)
+		assert.AssertListEqual(t, wrapList([]int64{0}), ex.Args)
+	}()
+	mod.EvalFunc("add", int64(-2), int64(2))
+}
+
+// 多个线程（模块实例）通过共享内存的原子指令累加计数器，并使用 wait/notify 等待其他线程
+func TestThreads(t *testing.T) {
+	host := native.NewNativeModule()
+	host.Register("memory", interpreter.NewSharedMemory(1, 1))
+	moduleMap := map[string]instance.Module{"env": host}
+
+	threads := NewThreads(moduleMap, readModule("test-executor-threads.wasm"), 5, interpreter.Config{})
+	for _, thread := range threads[1:] {
+		go thread.EvalFunc("add", int32(1000))
+	}
+
+	assert.AssertListEqual(t, wrapList([]int32{4000}), threads[0].EvalFunc("join", int32(4)))
+}
+
+// 没有超时的 memory.atomic.wait32 可以被中断，中断之后线程仍然可以继续使用
+func TestInterruptWait(t *testing.T) {
+	for _, config := range []interpreter.Config{{}, {Engine: interpreter.EngineRegister}} {
+		host := native.NewNativeModule()
+		host.Register("memory", interpreter.NewSharedMemory(1, 1))
+		moduleMap := map[string]instance.Module{"env": host}
+		threads := NewThreads(moduleMap, readModule("test-executor-threads.wasm"), 2, config)
+
+		// 没有其他线程完成，join 会一直等待
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			threads[0].(instance.Interrupter).Interrupt()
+		}()
+		_, err := threads[0].TryEvalFunc("join", int32(100))
+		assert.AssertEqual(t, instance.TrapInterrupted, err.(*instance.Trap).Code)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		_, err = threads[0].EvalFuncContext(ctx, "join", int32(100))
+		cancel()
+		assert.AssertEqual(t, instance.TrapInterrupted, err.(*instance.Trap).Code)
+
+		go threads[1].EvalFunc("add", int32(10))
+		assert.AssertListEqual(t, wrapList([]int32{10}), threads[0].EvalFunc("join", int32(1)))
+	}
+}
+
+// 宿主提供 64 位的内存，模块扩充内存之后，宿主可以读到新增的页面
+func TestMemory64(t *testing.T) {
+	mem := interpreter.NewMemory64(1, 0)
+	host := native.NewNativeModule()
+	host.Register("memory", mem)
+	moduleMap := map[string]instance.Module{"env": host}
+	mod := NewModulesWithImports(moduleMap, []string{"user"},
+		[]binary.Module{readModule("test-executor-memory64.wasm")})["user"]
+
+	assert.AssertListEqual(t, wrapList([]int64{1}), mod.EvalFunc("grow_and_store", int64(2), int32(42)))
+	assert.AssertEqual(t, uint64(3), mem.Size64())
+
+	buf := make([]byte, 1)
+	mem.Read(3*binary.PageSize-1, buf)
+	assert.AssertEqual(t, byte(42), buf[0])
+
+	// 增长失败时返回 -1，内存的大小不变
+	assert.AssertEqual(t, ^uint64(0), mem.Grow64(binary.MaxPageCount64))
+	assert.AssertEqual(t, uint64(3), mem.Size64())
+}
+
+// 寄存器引擎跟栈式引擎的差分测试：使用生成的参数调用所有测试模块的每个导出函数，
+// 两个引擎的返回值、陷阱（包括调用栈）、调用之后的内存和全局变量以及剩余的燃料都应该相同。
+// 每次调用都只给定固定数量的燃料（见 diffFuel），所以无限循环的函数也会确定地结束。
+func TestEngineDifferential(t *testing.T) {
+	currentDir, err := os.Getwd()
+	assert.AssertNil(t, err)
+
+	var fileNames []string
+	for _, dir := range []string{"executor", "interpreter", "wasm2go"} {
+		names, err := filepath.Glob(filepath.Join(currentDir, "..", "test", "resources", dir, "*.wasm"))
+		assert.AssertNil(t, err)
+		fileNames = append(fileNames, names...)
+	}
+
+	for _, fileName := range fileNames {
+		m, err := binary.DecodeFile(fileName)
+		assert.AssertNil(t, err)
+		if binary.Validate(m) != nil {
+			continue
+		}
+
+		for _, export := range m.ExportSec {
+			if export.Desc.Tag != binary.ExportTagFunc {
+				continue
+			}
+			ft := funcType(m, export.Desc.Idx)
+			n := len(diffArgs)
+			if len(ft.ParamTypes) == 0 {
+				n = 1 // 没有参数的函数只需要调用一次
+			}
+			for i := 0; i < n; i++ {
+				args := genArgs(ft.ParamTypes, i)
+				expected := evalOnEngine(m, export.Name, args, interpreter.EngineStack)
+				actual := evalOnEngine(m, export.Name, args, interpreter.EngineRegister)
+				if expected != actual {
+					t.Errorf("%s: %s%v:\nstack:    %s\nregister: %s",
+						filepath.Base(fileName), export.Name, args, expected, actual)
+				}
+			}
+		}
+	}
+}
+
+// 生成参数使用的值，第 i 组参数的第 j 个参数使用第 (i + j) 个值，
+// 每个值都要避免一个参数就导致太长时间的执行（比如循环的次数）
+var diffArgs = []struct {
+	i32 int32
+	i64 int64
+	f32 float32
+	f64 float64
+}{
+	{0, 0, 0, 0},
+	{1, 1, 1.5, -2.25},
+	{7, -7, -0.0, math.Inf(1)},
+	{-1, -1, float32(math.NaN()), math.NaN()},
+	{10, 1 << 40, float32(math.Inf(-1)), 1e300},
+	{300, math.MinInt64, 3.75e-39, -0.5},
+	{math.MinInt32, 20, -1e10, 123.456},
+}
+
+func genArgs(paramTypes []binary.ValType, i int) []instance.WasmVal {
+	args := make([]instance.WasmVal, len(paramTypes))
+	for j, vt := range paramTypes {
+		v := diffArgs[(i+j)%len(diffArgs)]
+		switch vt {
+		case binary.ValTypeI32:
+			args[j] = v.i32
+		case binary.ValTypeI64:
+			args[j] = v.i64
+		case binary.ValTypeF32:
+			args[j] = v.f32
+		case binary.ValTypeF64:
+			args[j] = v.f64
+		case binary.ValTypeV128:
+			var v128 binary.V128
+			byteOrder.PutUint64(v128[:], uint64(v.i64))
+			byteOrder.PutUint32(v128[8:], uint32(v.i32))
+			args[j] = v128
+		default:
+			args[j] = nil // 空引用
+		}
+	}
+	return args
+}
+
+var byteOrder = encoding_binary.LittleEndian
+
+// 差分测试里（包括实例化时执行 start 函数）每次调用的燃料
+const diffFuel = 100000
+
+// 使用新的模块实例调用函数，返回描述调用结果的文本，
+// 以免前一次调用改变的状态（尤其是燃料耗尽的调用）影响之后的比较
+func evalOnEngine(m binary.Module, name string, args []instance.WasmVal, engine interpreter.Engine) string {
+	config := interpreter.Config{ConsumeFuel: true, Fuel: diffFuel, Engine: engine}
+	mod, err := TryNewModulesWithConfig(stubImports(m), []string{"user"}, []binary.Module{m}, config)
+	if err != nil {
+		return "instantiate: " + err.Error()
+	}
+	user := mod["user"]
+	results, err := user.TryEvalFunc(name, args...)
+
+	output := ""
+	for _, result := range results {
+		output += " " + formatVal(result)
+	}
+	if trap, ok := err.(*instance.Trap); ok && trap.Code == instance.TrapOutOfFuel {
+		// 合并的指令一次性消耗燃料，所以燃料耗尽时正在执行的指令可能不同
+		output += " " + trap.Error()
+	} else if ok {
+		output += " " + trap.Trace()
+	} else if err != nil {
+		output += " " + err.Error()
+	}
+
+	for _, export := range m.ExportSec {
+		switch member := user.GetMember(export.Name).(type) {
+		case instance.Memory:
+			buf := make([]byte, uint64(member.Size())*binary.PageSize)
+			member.Read(0, buf)
+			output += fmt.Sprintf(" memory %s: %08x", export.Name, crc32.ChecksumIEEE(buf))
+		case instance.Global:
+			output += fmt.Sprintf(" global %s: %s", export.Name, formatVal(member.Get()))
+		}
+	}
+	return output + fmt.Sprintf(" fuel %d", user.(instance.FuelMeter).Fuel())
+}
+
+func funcType(m binary.Module, funcIdx uint32) binary.FuncType {
+	for _, imp := range m.ImportSec {
+		if imp.Desc.Tag == binary.ImportTagFunc {
+			if funcIdx == 0 {
+				return m.TypeSec[imp.Desc.FuncType]
+			}
+			funcIdx--
+		}
+	}
+	return m.TypeSec[m.FuncSec[funcIdx]]
+}
+
+func formatVal(val instance.WasmVal) string {
+	switch v := val.(type) {
+	case float32:
+		return fmt.Sprintf("f32:%08x", math.Float32bits(v))
+	case float64:
+		return fmt.Sprintf("f64:%016x", math.Float64bits(v))
+	case instance.Function:
+		return "funcref"
+	default:
+		return fmt.Sprintf("%T:%v", v, v)
+	}
+}
+
+// 为模块的导入项生成桩：导入的函数返回零值，内存、表、全局变量和异常标签按导入的类型创建，
+// 共享内存上的等待立即返回（见 singleThreadMemory）
+func stubImports(m binary.Module) map[string]instance.Module {
+	type host interface {
+		instance.Module
+		Register(name string, value interface{})
+		RegisterFunc(name string, paramTypes []binary.ValType, resultTypes []binary.ValType, func_ native.GoFunc)
+	}
+
+	hosts := map[string]host{}
+	for _, imp := range m.ImportSec {
+		h, ok := hosts[imp.Module]
+		if !ok {
+			h = native.NewNativeModule()
+			hosts[imp.Module] = h
+		}
+
+		switch desc := imp.Desc; desc.Tag {
+		case binary.ImportTagFunc:
+			ft := m.TypeSec[desc.FuncType]
+			h.RegisterFunc(imp.Name, ft.ParamTypes, ft.ResultTypes, func(args []instance.WasmVal) []instance.WasmVal {
+				return genArgs(ft.ResultTypes, 0)
+			})
+		case binary.ImportTagTable:
+			h.Register(imp.Name, interpreter.NewTableWithType(desc.Table.ElemType,
+				uint32(desc.Table.Limits.Min), uint32(desc.Table.Limits.Max)))
+		case binary.ImportTagMem:
+			mem := desc.Mem
+			if mem.Is64() {
+				h.Register(imp.Name, interpreter.NewMemory64(mem.Min, mem.Max))
+			} else if mem.Tag&binary.LimitsTagShared != 0 {
+				h.Register(imp.Name, singleThreadMemory{interpreter.NewSharedMemory(uint32(mem.Min), uint32(mem.Max))})
+			} else {
+				h.Register(imp.Name, interpreter.NewMemory(uint32(mem.Min), uint32(mem.Max)))
+			}
+		case binary.ImportTagGlobal:
+			gt := desc.Global
+			h.Register(imp.Name, interpreter.NewGlobal(gt.ValType, gt.Mut == binary.MutVar, genArgs([]binary.ValType{gt.ValType}, 0)[0]))
+		case binary.ImportTagTag:
+			h.Register(imp.Name, interpreter.NewTag(m.TypeSec[desc.TagType.Type]))
+		}
+	}
+
+	mm := map[string]instance.Module{}
+	for name, h := range hosts {
+		mm[name] = h
+	}
+	return mm
+}
+
+// 差分测试只有一个线程，等待的值不可能被其他线程改变，也不会被唤醒，
+// 所以不阻塞，值相等时直接返回超时，以便等待其他线程的函数跟无限循环一样由燃料结束
+type singleThreadMemory struct {
+	instance.SharedMemory
+}
+
+func (m singleThreadMemory) Wait(address uint64, expected []byte, timeout int64, cancel <-chan struct{}) uint32 {
+	return m.SharedMemory.Wait(address, expected, 0, cancel)
+}
+
 func testFunc(fileName string, funcName string, args []instance.WasmVal) []instance.WasmVal {
 	m := readModule(fileName)
 	mod := NewModule(m)
@@ -63,7 +633,12 @@ func readModule(fileName string) binary.Module {
 	testResourcesDir := filepath.Join(currentDir, "..", "test", "resources", "executor")
 	wasmFilePath := filepath.Join(testResourcesDir, fileName)
 
-	return binary.DecodeFile(wasmFilePath)
+	m, err := binary.DecodeFile(wasmFilePath)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
 }
 
 func wrapList[T comparable](items []T) []instance.WasmVal {
@@ -0,0 +1,29 @@
+package instance
+
+// 异常（exception）
+//
+// wasm 的 throw 指令抛出的异常，虚拟机内部以 panic(*Exception) 的方式抛出，
+// 模块内的 try 指令的 catch 子句可以捕获标签相同的异常，catch_all 子句
+// 可以捕获任意的异常。
+//
+// 未被捕获的异常会从 Module.EvalFunc() 以及 Function.Eval() 抛出，
+// 宿主可以使用 recover() 捕获，而 Module.TryEvalFunc() 和
+// Function.TryEval() 则以 error 的形式返回异常。
+//
+// 宿主函数（被模块导入的函数）也可以使用 panic(NewException(...)) 抛出异常，
+// 模块里的 try 指令可以捕获这个异常。
+//
+// 注：陷阱不是异常，不会被 catch 以及 catch_all 子句捕获。
+
+type Exception struct {
+	Tag  Tag
+	Args []WasmVal // 异常的实参，数量和类型跟标签的参数一致
+}
+
+func NewException(tag Tag, args ...WasmVal) *Exception {
+	return &Exception{Tag: tag, Args: args}
+}
+
+func (e *Exception) Error() string {
+	return "uncaught exception"
+}
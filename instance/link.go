@@ -0,0 +1,26 @@
+package instance
+
+import "fmt"
+
+// 链接错误
+//
+// 实例化模块时，如果导入项找不到，或者导出项的类型跟导入项声明的类型
+// 不匹配，则发生链接错误。
+type LinkError struct {
+	Module string // 导入项的模块名称
+	Field  string // 导入项的名称
+	Reason string // 出错的原因，比如 "unknown import"、"incompatible import type"
+
+	// 导入项声明的类型以及导出项的实际类型，以文本格式的形式表示，
+	// 比如 "(func (param i32))"、"(memory 1 2)"，找不到导出项时 Actual 为空
+	Expected string
+	Actual   string
+}
+
+func (e *LinkError) Error() string {
+	if e.Actual == "" {
+		return fmt.Sprintf("link error: %s: %s.%s", e.Reason, e.Module, e.Field)
+	}
+	return fmt.Sprintf("link error: %s: %s.%s: expected %s, got %s",
+		e.Reason, e.Module, e.Field, e.Expected, e.Actual)
+}
@@ -1,35 +1,72 @@
 package instance
 
-import "wasmvm/binary"
+import (
+	"context"
+	"wasmvm/binary"
+)
 
 // 模块实例
 type Module interface {
 	// 根据名称获取导出项
-	// 导出项只能是：函数、表、内存、全局变量
+	// 导出项只能是：函数、表、内存、全局变量、异常标签
 	GetMember(name string) interface{} // name: getExportItem
 
 	// 辅助函数
 	EvalFunc(name string, args ...WasmVal) []WasmVal
 
+	// 跟 EvalFunc 相同，但发生陷阱时返回 *Trap 而不是 panic
+	TryEvalFunc(name string, args ...WasmVal) ([]WasmVal, error)
+
+	// 跟 TryEvalFunc 相同，但 ctx 被取消（或者超时）时中止执行，
+	// 并返回 TrapInterrupted 陷阱
+	EvalFuncContext(ctx context.Context, name string, args ...WasmVal) ([]WasmVal, error)
+
 	GetGlobalVal(name string) WasmVal
 	SetGlobalVal(name string, value WasmVal)
 }
 
 type WasmVal = interface{}
 
+// 燃料计量
+// 由虚拟机创建的模块实例实现了这个接口，见 interpreter.Config
+type FuelMeter interface {
+	AddFuel(fuel uint64) // 补充燃料
+	Fuel() uint64        // 剩余的燃料
+	FuelEnabled() bool   // 是否启用了燃料计量
+}
+
+// 中断正在执行的模块实例
+// 由虚拟机创建的模块实例实现了这个接口，Interrupt() 可以在其他 goroutine 里调用
+type Interrupter interface {
+	Interrupt()
+}
+
+// 执行 start 段指定的函数
+// 由虚拟机创建的模块实例实现了这个接口，仅当实例化时配置了
+// interpreter.Config.DeferStart 才需要手动调用，重复调用时不会再次执行
+type Starter interface {
+	Start() error // 发生陷阱时返回 *Trap
+}
+
 // 导出项 -- 函数
 type Function interface {
 	Type() binary.FuncType
 	Eval(args ...WasmVal) []WasmVal
+
+	// 跟 Eval 相同，但发生陷阱时返回 *Trap 而不是 panic
+	TryEval(args ...WasmVal) ([]WasmVal, error)
 }
 
 // 导出项 -- 表
+//
+// 表项的值是引用：对于 funcref 表是 Function，对于 externref 表是任意的 Go 值，
+// 空引用为 nil
 type Table interface {
 	Type() binary.TableType
 	Size() uint32
-	Grow(increaseNumber uint32)
-	GetElem(idx uint32) Function
-	SetElem(idx uint32, elem Function)
+	Grow(increaseNumber uint32) uint32 // 新增的表项为空引用，返回原来的大小，失败时会返回被转为 uint32 的 -1
+	GetElem(idx uint32) WasmVal
+	SetElem(idx uint32, elem WasmVal)
 }
 
 // 导出项 -- 内存
@@ -41,6 +78,35 @@ type Memory interface {
 	Write(offset uint64, buf []byte)
 }
 
+// 导出项 -- 64 位的内存（memory64 提案）
+//
+// 64 位的内存的页面数可以超出 uint32 的范围，所以使用 Size64() 和 Grow64()
+// 代替 Size() 和 Grow()，失败时 Grow64() 返回被转为 uint64 的 -1。
+// 虚拟机创建的内存（包括 32 位的内存）都实现了这个接口
+type Memory64 interface {
+	Memory
+	Size64() uint64
+	Grow64(increaseNumber uint64) uint64
+}
+
+// 导出项 -- 共享内存（threads 提案）
+//
+// 共享内存可以同时被多个 goroutine 上的模块实例访问，所有的方法都是并发安全的。
+// 原子指令在 Atomic() 里完成读-改-写，memory.atomic.wait32/64 和
+// memory.atomic.notify 指令分别对应 Wait() 和 Notify()
+type SharedMemory interface {
+	Memory
+	Atomic(f func()) // 在持有原子操作的锁的情况下执行 f，各原子操作之间互斥
+
+	// 如果地址 address 处的值等于 expected，则阻塞当前 goroutine 直到被 Notify() 唤醒、
+	// 超时（timeout 单位为纳秒，负数表示不超时）或者 cancel 被关闭（cancel 可以为 nil）。
+	// 返回 0 表示被唤醒，1 表示值不相等，2 表示超时，3 表示被取消（调用者应该发生陷阱）
+	Wait(address uint64, expected []byte, timeout int64, cancel <-chan struct{}) uint32
+
+	// 唤醒最多 count 个在地址 address 处等待的 goroutine，返回被唤醒的数量
+	Notify(address uint64, count uint32) uint32
+}
+
 type Global interface {
 	Type() binary.GlobalType
 	GetAsU64() uint64      // 内部使用，name: GetRaw()
@@ -48,3 +114,12 @@ type Global interface {
 	Get() WasmVal
 	Set(value WasmVal)
 }
+
+// 导出项 -- 异常标签
+//
+// 标签的类型是一个没有返回值的函数类型，参数即异常的实参。
+// 异常按标签实例（而不是标签的类型）匹配，所以不同模块之间只能通过
+// 导入导出同一个标签来抛出和捕获对方的异常
+type Tag interface {
+	Type() binary.FuncType
+}
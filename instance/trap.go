@@ -0,0 +1,103 @@
+package instance
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 陷阱（trap）
+//
+// 执行指令时发生的运行时错误（比如除数为 0、访问的内存地址越界等）称为陷阱，
+// 陷阱会中止当前的调用，虚拟机内部以 panic(*Trap) 的方式抛出陷阱，
+// 而 Module.TryEvalFunc() 和 Function.TryEval() 则以 error 的形式返回陷阱。
+
+type TrapCode int
+
+const (
+	TrapUnreachable              TrapCode = iota // 执行了 unreachable 指令
+	TrapMemoryOutOfBounds                        // 内存访问越界
+	TrapTableOutOfBounds                         // 表的索引越界
+	TrapIndirectCallTypeMismatch                 // call_indirect 的目标函数的类型不匹配
+	TrapUninitializedElement                     // call_indirect 的目标表项未初始化
+	TrapIntegerOverflow                          // 整数溢出（除法以及浮点数转换为整数）
+	TrapIntegerDivideByZero                      // 整数除以 0
+	TrapInvalidConversion                        // 无法转换为整数（NaN）
+	TrapStackExhausted                           // 调用栈耗尽
+	TrapOutOfFuel                                // 燃料耗尽
+	TrapInterrupted                              // 执行被中断（context 被取消或者超时，或者调用了 Interrupt()）
+	TrapUnalignedAtomic                          // 原子指令的有效地址没有对齐
+	TrapExpectedSharedMemory                     // 在非共享的内存上执行 memory.atomic.wait32/64
+)
+
+// 陷阱信息跟 WebAssembly 规范测试里的一致
+var trapMessages = map[TrapCode]string{
+	TrapUnreachable:              "unreachable",
+	TrapMemoryOutOfBounds:        "out of bounds memory access",
+	TrapTableOutOfBounds:         "undefined element",
+	TrapIndirectCallTypeMismatch: "indirect call type mismatch",
+	TrapUninitializedElement:     "uninitialized element",
+	TrapIntegerOverflow:          "integer overflow",
+	TrapIntegerDivideByZero:      "integer divide by zero",
+	TrapInvalidConversion:        "invalid conversion to integer",
+	TrapStackExhausted:           "call stack exhausted",
+	TrapOutOfFuel:                "out of fuel",
+	TrapInterrupted:              "interrupted",
+	TrapUnalignedAtomic:          "unaligned atomic",
+	TrapExpectedSharedMemory:     "expected shared memory",
+}
+
+func (c TrapCode) String() string {
+	if msg, ok := trapMessages[c]; ok {
+		return msg
+	}
+	return fmt.Sprintf("trap code %d", int(c))
+}
+
+type Trap struct {
+	Code TrapCode
+
+	// 发生陷阱时的调用栈（wasm backtrace），第一个元素是发生陷阱的函数，
+	// 最后一个元素是最外层（即从宿主调用进来）的函数
+	Backtrace []Frame
+}
+
+// 调用栈里的一个函数
+type Frame struct {
+	FuncIdx  uint32 // 函数的索引（包括导入函数在内）
+	FuncName string // 名称段里的函数名称，可以为空
+	PC       int    // 正在执行的指令在函数体内的序号（计数方式跟 binary.ValidationError.InstrIdx 相同）
+}
+
+func NewTrap(code TrapCode) *Trap {
+	return &Trap{Code: code}
+}
+
+func (t *Trap) Error() string {
+	return "trap: " + t.Code.String()
+}
+
+// 输出陷阱信息以及调用栈，比如：
+//
+//	trap: integer divide by zero
+//	  0: func 2 <div> at pc 3
+//	  1: func 5 <main> at pc 10
+func (t *Trap) Trace() string {
+	var sb strings.Builder
+	sb.WriteString(t.Error())
+	for i, frame := range t.Backtrace {
+		sb.WriteString(fmt.Sprintf("\n  %d: func %d", i, frame.FuncIdx))
+		if frame.FuncName != "" {
+			sb.WriteString(fmt.Sprintf(" <%s>", frame.FuncName))
+		}
+		sb.WriteString(fmt.Sprintf(" at pc %d", frame.PC))
+	}
+	return sb.String()
+}
+
+// 将 recover 得到的值转为 error，供 TryEval 等方法使用
+func ToError(value interface{}) error {
+	if err, ok := value.(error); ok {
+		return err
+	}
+	return fmt.Errorf("%v", value)
+}
@@ -0,0 +1,79 @@
+package interpreter
+
+// 模块实例的配置
+//
+// Config 的零值即默认配置，NewModule() 使用的就是默认配置。
+type Config struct {
+	// 燃料计量（fuel metering）
+	//
+	// 启用之后，每执行一条指令都会消耗一定数量的燃料，燃料不足时发生
+	// instance.TrapOutOfFuel 陷阱，并且剩余的燃料清零。从宿主调用模块内部的函数
+	// （包括经由外部函数再次调用当前模块的函数）的入口视为一条 call 指令。
+	// 同一个模块实例的所有调用（包括嵌套的调用）共用同一份燃料，
+	// 可以在两次调用之间使用 instance.FuelMeter 接口补充或者查询剩余的燃料。
+	ConsumeFuel bool
+
+	// 初始的燃料
+	Fuel uint64
+
+	// 各指令消耗的燃料，未列出的指令消耗 1 个单位，比如：
+	//
+	//	map[byte]uint64{binary.Call: 10, binary.MemoryGrow: 100}
+	//
+	// 对于有前缀的指令（比如 0xFC 前缀的饱和截断指令），按前缀计算
+	FuelCosts map[byte]uint64
+
+	// 调用栈的最大深度（即调用帧的最大数量），超出时发生
+	// instance.TrapStackExhausted 陷阱，为 0 时使用 DefaultMaxCallDepth
+	MaxCallDepth int
+
+	// 操作数栈（包括局部变量）的最大槽位数量，超出时发生
+	// instance.TrapStackExhausted 陷阱，为 0 时使用 DefaultMaxStackSlots
+	MaxStackSlots int
+
+	// 实例化时不执行 start 段指定的函数，而是由调用者通过
+	// instance.Starter 接口手动执行，用于调试
+	DeferStart bool
+
+	// 执行函数的引擎，零值为栈式引擎，见 vm_register.go
+	Engine Engine
+}
+
+// 执行函数的引擎
+type Engine int
+
+const (
+	EngineStack    Engine = iota // 栈式引擎，指令经由操作数栈传递操作数
+	EngineRegister               // 寄存器引擎，指令直接读写调用帧的寄存器（局部变量以及运算槽位）
+)
+
+const (
+	DefaultMaxCallDepth  = 10000
+	DefaultMaxStackSlots = 1 << 20 // 每个槽位占用 16 个字节，即 16 MiB
+)
+
+func (config Config) maxCallDepth() int {
+	if config.MaxCallDepth > 0 {
+		return config.MaxCallDepth
+	}
+	return DefaultMaxCallDepth
+}
+
+func (config Config) maxStackSlots() int {
+	if config.MaxStackSlots > 0 {
+		return config.MaxStackSlots
+	}
+	return DefaultMaxStackSlots
+}
+
+// 根据配置的燃料表生成每个操作码消耗的燃料
+func newFuelCosts(config Config) *[256]uint64 {
+	var costs [256]uint64
+	for i := range costs {
+		costs[i] = 1
+	}
+	for opcode, cost := range config.FuelCosts {
+		costs[opcode] = cost
+	}
+	return &costs
+}
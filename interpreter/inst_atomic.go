@@ -0,0 +1,247 @@
+package interpreter
+
+import (
+	"errors"
+	"wasmvm/binary"
+	"wasmvm/instance"
+)
+
+// ======== 0xFE 前缀指令（原子指令）
+//
+// 原子指令（threads 提案）用于多个线程（即多个模块实例）通过共享内存通信：
+//
+// - i32.atomic.load, i64.atomic.store 等加载和存储指令
+// - i32.atomic.rmw.add, i64.atomic.rmw8.xchg_u 等读-改-写指令，返回内存里原来的值
+// - i32.atomic.rmw.cmpxchg 等比较并交换指令，弹出期望值和替换值，仅当原来的值
+//   等于期望值时才写入替换值，同样返回原来的值
+// - memory.atomic.wait32/64 阻塞当前线程，memory.atomic.notify 唤醒等待的线程
+// - atomic.fence 内存屏障
+//
+// 原子指令的有效地址必须按访问的字节数对齐，否则发生陷阱。
+// 对于共享内存，读-改-写在 instance.SharedMemory.Atomic() 里完成；而非共享的内存
+// 只能被一个线程访问，所以直接读写即可。
+//
+// 窄的指令（比如 i64.atomic.rmw8.add_u）只读写低端的若干个字节，读取的值零扩展。
+//
+// https://github.com/WebAssembly/threads/blob/main/proposals/threads/Overview.md
+
+var atomicInstructionTable = make([]instructionExecFunc, binary.GetAtomicOpcodeCount())
+
+func atomicInstr(v *vm, args interface{}) {
+	atomicArgs := args.(binary.AtomicArgs)
+	if int(atomicArgs.SubOpcode) >= len(atomicInstructionTable) ||
+		atomicInstructionTable[atomicArgs.SubOpcode] == nil {
+		panic(errors.New("unreachable"))
+	}
+	atomicInstructionTable[atomicArgs.SubOpcode](v, atomicArgs.Args)
+}
+
+func init() {
+	atomicInstructionTable[binary.MemoryAtomicNotify] = memoryAtomicNotify
+	atomicInstructionTable[binary.MemoryAtomicWait32] = memoryAtomicWait(4)
+	atomicInstructionTable[binary.MemoryAtomicWait64] = memoryAtomicWait(8)
+	atomicInstructionTable[binary.AtomicFence] = atomicFence
+
+	// 从 i32.atomic.load 开始，每 7 个指令为一组，组内的顺序都是
+	// i32, i64, i32 8 位, i32 16 位, i64 8 位, i64 16 位, i64 32 位
+	widths := [7]int{4, 8, 1, 2, 1, 2, 4}
+	for i, width := range widths {
+		is64 := i == 1 || i >= 4
+		sub := uint32(i)
+
+		atomicInstructionTable[binary.I32AtomicLoad+sub] = atomicLoad(width, is64)
+		atomicInstructionTable[binary.I32AtomicStore+sub] = atomicStore(width, is64)
+		atomicInstructionTable[binary.I32AtomicRmwAdd+sub] = atomicRMW(width, is64,
+			func(old, val uint64) uint64 { return old + val })
+		atomicInstructionTable[binary.I32AtomicRmwSub+sub] = atomicRMW(width, is64,
+			func(old, val uint64) uint64 { return old - val })
+		atomicInstructionTable[binary.I32AtomicRmwAnd+sub] = atomicRMW(width, is64,
+			func(old, val uint64) uint64 { return old & val })
+		atomicInstructionTable[binary.I32AtomicRmwOr+sub] = atomicRMW(width, is64,
+			func(old, val uint64) uint64 { return old | val })
+		atomicInstructionTable[binary.I32AtomicRmwXor+sub] = atomicRMW(width, is64,
+			func(old, val uint64) uint64 { return old ^ val })
+		atomicInstructionTable[binary.I32AtomicRmwXchg+sub] = atomicRMW(width, is64,
+			func(old, val uint64) uint64 { return val })
+		atomicInstructionTable[binary.I32AtomicRmwCmpxchg+sub] = atomicCmpxchg(width, is64)
+	}
+}
+
+// -------- 加载、存储以及读-改-写指令
+
+func atomicLoad(width int, is64 bool) instructionExecFunc {
+	return func(v *vm, memArg interface{}) {
+		mem, eaddr := getAtomicAddress(v, memArg, width)
+		var val uint64
+		atomically(mem, func() {
+			val = readN(mem, eaddr, width)
+		})
+		pushAtomicVal(v, is64, val)
+	}
+}
+
+func atomicStore(width int, is64 bool) instructionExecFunc {
+	return func(v *vm, memArg interface{}) {
+		val := popAtomicVal(v, is64)
+		mem, eaddr := getAtomicAddress(v, memArg, width)
+		atomically(mem, func() {
+			writeN(mem, eaddr, width, val)
+		})
+	}
+}
+
+func atomicRMW(width int, is64 bool, op func(old, val uint64) uint64) instructionExecFunc {
+	return func(v *vm, memArg interface{}) {
+		val := popAtomicVal(v, is64)
+		mem, eaddr := getAtomicAddress(v, memArg, width)
+		var old uint64
+		atomically(mem, func() {
+			old = readN(mem, eaddr, width)
+			writeN(mem, eaddr, width, op(old, val))
+		})
+		pushAtomicVal(v, is64, old)
+	}
+}
+
+func atomicCmpxchg(width int, is64 bool) instructionExecFunc {
+	return func(v *vm, memArg interface{}) {
+		replacement := popAtomicVal(v, is64)
+		expected := popAtomicVal(v, is64)
+		mem, eaddr := getAtomicAddress(v, memArg, width)
+
+		// 期望值也只比较低端的 width 个字节
+		mask := ^uint64(0) >> (64 - width*8)
+		var old uint64
+		atomically(mem, func() {
+			old = readN(mem, eaddr, width)
+			if old == expected&mask {
+				writeN(mem, eaddr, width, replacement)
+			}
+		})
+		pushAtomicVal(v, is64, old)
+	}
+}
+
+// -------- 等待和唤醒
+//
+// memory.atomic.wait32/64 依次弹出超时时间（i64，单位为纳秒，负数表示不超时）、
+// 期望值以及地址，如果地址处的值等于期望值，则阻塞当前线程直到被唤醒或者超时，
+// 返回 0（被唤醒）、1（值不相等）或者 2（超时）。
+// 在非共享的内存上等待会发生陷阱（因为不可能有其他线程来唤醒）。
+//
+// memory.atomic.notify 依次弹出唤醒的数量（u32）以及地址，唤醒最多指定数量的
+// 在该地址上等待的线程，返回实际唤醒的数量。非共享的内存上没有等待的线程，所以总是返回 0。
+
+func memoryAtomicWait(width int) instructionExecFunc {
+	return func(v *vm, memArg interface{}) {
+		timeout := v.operandStack.popS64()
+		expected := popAtomicVal(v, width == 8)
+		mem, eaddr := getAtomicAddress(v, memArg, width)
+
+		shared, ok := mem.(instance.SharedMemory)
+		if !ok {
+			panic(instance.NewTrap(instance.TrapExpectedSharedMemory))
+		}
+
+		var buf [8]byte
+		byteOrder.PutUint64(buf[:], expected)
+
+		// 等待的过程中也可以被中断，被中断时发生跟循环、调用相同的陷阱
+		interrupt := v.interruptSignal()
+		v.checkInterrupt()
+		cancel, stop := mergeSignals(interrupt, v.done)
+		defer stop()
+
+		result := shared.Wait(eaddr, buf[:width], timeout, cancel)
+		if result == 3 {
+			v.checkInterrupt()
+		}
+		v.operandStack.pushU32(result)
+	}
+}
+
+// 返回在 a 或者 b 被关闭时被关闭的通道，调用 stop 之后不再监听
+func mergeSignals(a, b <-chan struct{}) (merged <-chan struct{}, stop func()) {
+	if b == nil {
+		return a, func() {}
+	}
+
+	ch := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-a:
+		case <-b:
+		case <-done:
+			return
+		}
+		close(ch)
+	}()
+	return ch, func() { close(done) }
+}
+
+func memoryAtomicNotify(v *vm, memArg interface{}) {
+	count := v.operandStack.popU32()
+	mem, eaddr := getAtomicAddress(v, memArg, 4)
+
+	if shared, ok := mem.(instance.SharedMemory); ok {
+		v.operandStack.pushU32(shared.Notify(eaddr, count))
+	} else {
+		v.operandStack.pushU32(0)
+	}
+}
+
+// 共享内存的每次读写都需要获取内存的锁，已经保证了各线程看到的读写顺序是一致的，
+// 所以内存屏障不需要做任何事
+func atomicFence(v *vm, args interface{}) {
+}
+
+// -------- 辅助函数
+
+// 计算原子指令的有效地址，先检查访问范围，然后检查地址是否对齐
+func getAtomicAddress(v *vm, memArg interface{}, width int) (instance.Memory, uint64) {
+	mem, eaddr := getEffectiveAddress(v, memArg)
+	checkMemoryRange(mem, eaddr, uint64(width))
+	if eaddr%uint64(width) != 0 {
+		panic(instance.NewTrap(instance.TrapUnalignedAtomic))
+	}
+	return mem, eaddr
+}
+
+// 对于共享内存，在持有原子操作的锁的情况下执行 f，其他内存则直接执行
+func atomically(mem instance.Memory, f func()) {
+	if shared, ok := mem.(instance.SharedMemory); ok {
+		shared.Atomic(f)
+	} else {
+		f()
+	}
+}
+
+// 读取 width 个字节，零扩展为 uint64
+func readN(mem instance.Memory, eaddr uint64, width int) uint64 {
+	var buf [8]byte
+	mem.Read(eaddr, buf[:width])
+	return byteOrder.Uint64(buf[:])
+}
+
+// 写入 val 的低端 width 个字节
+func writeN(mem instance.Memory, eaddr uint64, width int, val uint64) {
+	var buf [8]byte
+	byteOrder.PutUint64(buf[:], val)
+	mem.Write(eaddr, buf[:width])
+}
+
+func popAtomicVal(v *vm, is64 bool) uint64 {
+	if is64 {
+		return v.operandStack.popU64()
+	}
+	return uint64(v.operandStack.popU32())
+}
+
+func pushAtomicVal(v *vm, is64 bool, val uint64) {
+	if is64 {
+		v.operandStack.pushU64(val)
+	} else {
+		v.operandStack.pushU32(uint32(val))
+	}
+}
@@ -1,7 +1,5 @@
 package interpreter
 
-import "wasmvm/binary"
-
 // ======== 控制指令
 
 // -------- 流程控制指令
@@ -10,45 +8,11 @@ import "wasmvm/binary"
 // if
 // else
 // end
-
-func block(v *vm, args interface{}) {
-	// 可以对比 callInteralFunc() 函数的实现，基本上一样
-
-	blockArgs := args.(binary.BlockArgs)
-
-	// 比较合适的名称：
-	// funcType := v.module.convertBlockTypeIntoFunctionType(blockArgs.BT)
-	funcType := v.module.GetBlockType(blockArgs.BT)
-	expr := blockArgs.Instrs
-
-	v.enterBlock(binary.Block, funcType, expr)
-
-	// 注：block 没有自己的局部变量空槽
-}
-
-func loop(v *vm, args interface{}) {
-	// loop() 函数基本上跟 block() 一样
-
-	blockArgs := args.(binary.BlockArgs)
-
-	funcType := v.module.GetBlockType(blockArgs.BT)
-	expr := blockArgs.Instrs
-
-	v.enterBlock(binary.Loop, funcType, expr)
-}
-
-func if_(v *vm, args interface{}) {
-	ifArgs := args.(binary.IfArgs)
-
-	funcType := v.module.GetBlockType(ifArgs.BT)
-
-	// if 结构的两个分支共用同一个 block type
-	if v.operandStack.popBool() {
-		v.enterBlock(binary.If, funcType, ifArgs.Instrs1)
-	} else {
-		v.enterBlock(binary.If, funcType, ifArgs.Instrs2)
-	}
-}
+//
+// 结构块在预编译时已经被展开为扁平的指令（见 vm_compile.go）：
+// block 和 loop 编译之后是空指令，if 编译之后是条件跳转（条件为假时跳到 else 分支
+// 的开头或者结构块的结尾），then 分支的结尾插入一条跳到结构块结尾的伪指令，
+// 所以执行时不需要创建控制帧。
 
 // -------- 分支/跳转指令
 // br
@@ -85,54 +49,37 @@ func if_(v *vm, args interface{}) {
 //
 // "br 指令对于 `控制块`" 跟
 // "return 指令对于 `函数`" 的处理方式是一样
-
-func br(v *vm, args interface{}) {
-	relative_depth := int(args.(uint32))
-
-	// 先放心弹出 relative_depth 个控制帧
-	// 注：
-	// 该数值从 0 开始，当 relative_depth 为 N 时，实际上有 N+1 层
-	// 结构块，先直接弹出 N 层，最后一层则需判断是 block/if 还是 loop
-	// 指令，前者则跳到结构末尾，后者则跳到结构开头。
-
-	for i := 0; i < relative_depth; i++ {
-		v.controlStack.popControlFrame()
-	}
-
-	if frame := v.controlStack.topControlFrame(); frame.opcode == binary.Loop {
-		// 目标层是一个 loop 结构
-		// 需要弹出目标层参数所需数量的操作数，然后跳到目标层的第一行指令
-		v.resetBlock(frame)
-		frame.pc = 0 // todo:: 考虑把这个方法挪进 resetBlock 函数
-	} else {
-		// 目标层是一个 if 或者 block 结构，或者是函数
-		v.exitBlock()
+//
+// 跳转目标在预编译时已经计算好：
+// - 对于 block/if 结构，跳到结构块结尾之后的第一条指令，保留返回值所需数量的操作数；
+// - 对于 loop 结构，跳到结构块的第一条指令，保留参数所需数量的操作数；
+// - 对于函数，跳到函数结尾的 return 伪指令，保留返回值所需数量的操作数。
+// 目标结构块的栈底到栈顶之间的其余操作数（即残留的操作数）都被丢弃。
+
+func br(v *vm, frame *controlFrame, target *branchTarget) {
+	v.operandStack.dropKeep(frame.bp+target.height, target.arity)
+	frame.pc = target.pc
+
+	if target.loop {
+		// 跳回循环的开头，检查是否需要中断执行
+		v.checkInterrupt()
 	}
 }
 
-func brIf(v *vm, args interface{}) {
-	// br_if 指令先从操作数栈顶弹出一个有符号的整数（int32），非 0 则执行 br 操作，
-	// 等于 0 则什么都不做（仅仅消耗掉栈顶的一个操作数）
-	if v.operandStack.popBool() {
-		br(v, args)
-	}
-}
+// br_if 指令先从操作数栈顶弹出一个有符号的整数（int32），非 0 则执行 br 操作，
+// 等于 0 则什么都不做（仅仅消耗掉栈顶的一个操作数），见 vm.execFrame()
 
-func brTable(v *vm, args interface{}) {
+func brTable(v *vm, frame *controlFrame, targets []branchTarget) {
 	// br_table 指令先从操作数栈顶弹出一个 uint32 整数，这个数将作为
 	// br_table 后面的整数列表的索引，获取跳转的目标。如果该索引超出了
 	// 列表范围，则跳转目标的 br_table 指令的最末尾一个参数（即默认目标）
 
-	brTableArgs := args.(binary.BrTableArgs)
 	idx := int(v.operandStack.popU32())
-	if idx < len(brTableArgs.Labels) {
-		br(v, brTableArgs.Labels[idx])
+	if idx < len(targets)-1 {
+		br(v, frame, &targets[idx])
 	} else {
-		br(v, brTableArgs.Default)
+		br(v, frame, &targets[len(targets)-1])
 	}
 }
 
-func return_(v *vm, _ interface{}) {
-	blockRelativeDepth := v.controlStack.getRelativeDepth()
-	br(v, uint32(blockRelativeDepth))
-}
+// return 指令相当于跳到函数的结尾，见 vm.exitFunc()
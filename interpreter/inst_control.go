@@ -1,7 +1,7 @@
 package interpreter
 
 import (
-	"errors"
+	"wasmvm/instance"
 )
 
 // ======== 控制指令
@@ -13,7 +13,7 @@ import (
 // https://developer.mozilla.org/en-US/docs/WebAssembly/Reference/Control_flow/nop
 
 func unreachable(v *vm, _ interface{}) {
-	panic(errors.New("unreachable"))
+	panic(instance.NewTrap(instance.TrapUnreachable))
 }
 
 func nop(v *vm, _ interface{}) {
@@ -0,0 +1,40 @@
+package interpreter
+
+import "wasmvm/instance"
+
+// ======== 异常处理指令
+
+// -------- try 指令
+//
+// try 指令跟 block 指令基本一样，编译之后是空指令，try 块的主体以及各个
+// catch 子句的位置记录在函数的 try 表里，异常的捕获过程见 vm_stack_control.go
+// 的 catchException() 方法
+//
+// (try (result i32)
+//
+//	(do (throw $e (i32.const 1)))	;; 抛出异常
+//	(catch $e)						;; 异常的实参 1 被压入操作数栈，作为 try 块的返回值
+//	(catch_all (i32.const 0))
+//
+// )
+
+// -------- throw 指令
+//
+// throw tag_idx:uint32
+//
+// 从操作数栈弹出标签的参数所需数量的操作数，作为异常的实参，然后抛出异常
+func throw(v *vm, args interface{}) {
+	tag := v.tags[args.(uint32)]
+	exArgs := popArgs(v, tag.Type())
+	panic(instance.NewException(tag, exArgs...))
+}
+
+// -------- rethrow 指令
+//
+// rethrow label_idx:uint32
+//
+// 重新抛出目标 catch（或者 catch_all）子句捕获的异常，
+// 目标子句的嵌套深度在预编译时已经计算好
+func rethrow(frame *controlFrame, depth int) {
+	panic(frame.getException(depth))
+}
@@ -3,6 +3,7 @@ package interpreter
 import (
 	"errors"
 	"wasmvm/binary"
+	"wasmvm/instance"
 )
 
 // 调用函数的过程
@@ -75,13 +76,9 @@ import (
 // 函数索引值是包括 "导入的函数" 以及 "当前模块定义的函数（即内部函数）"，而且先计算
 // 导入的函数，比如一个模块有 3 个函数导入和 2 个内部函数，则第一个内部函数的索引值为 3。
 //
-// call(vm, args) 函数仅仅创建了一个调用帧，并不会自动开始
+// call 指令的函数索引在预编译时已经被解码，由 vm.execFrame() 调用 callFunc()，
+// 对于内部函数，callFunc() 仅仅创建了一个调用帧，并不会自动开始
 // 执行函数当中的指令（字节码）
-func call(v *vm, args interface{}) {
-	idx := int(args.(uint32))
-	f := v.funcs[idx]
-	callFunc(v, f)
-}
 
 // 辅助函数
 
@@ -95,21 +92,14 @@ func callFunc(v *vm, f vmFunc) {
 }
 
 func callInternalFunc(v *vm, f vmFunc /* func_idx int*/) { // name: callFunction
-	// funcTypeIdx := v.module.FuncSec[func_idx]
-	// funcType := v.module.TypeSec[funcTypeIdx]
-	// code := v.module.CodeSec[func_idx]
-	// expr := code.Expr
-
-	funcType := f.type_
-	code := f.code
-	expr := code.Expr
-
 	// 创建被进入新的调用帧
-	v.enterBlock(binary.Call, funcType, expr)
+	v.enterFunc(f)
+
+	// 进入函数时检查是否需要中断执行
+	v.checkInterrupt()
 
 	// 分配局部变量空槽
-	localCount := int(code.GetLocalCount())
-	for i := 0; i < localCount; i++ {
+	for i := 0; i < f.compiled.localCount; i++ {
 		v.operandStack.pushU64(0) // 局部变量的空槽初始值为 0
 	}
 }
@@ -144,7 +134,7 @@ func popArgs(v *vm, funcType binary.FuncType) []interface{} {
 	// --- 栈底 ---    --- 栈顶 ---
 
 	for i := paramCount - 1; i >= 0; i-- {
-		args[i] = wrapU64(funcType.ParamTypes[i], v.operandStack.popU64())
+		args[i] = v.wrapSlot(funcType.ParamTypes[i], v.operandStack.popSlot())
 	}
 	return args
 }
@@ -153,8 +143,8 @@ func pushResults(v *vm, ft binary.FuncType, results []interface{}) {
 	if len(ft.ResultTypes) != len(results) {
 		panic(errors.New("incorrect length of return values"))
 	}
-	for _, result := range results {
-		v.operandStack.pushU64(unwrapU64(ft.ResultTypes[0], result))
+	for i, result := range results {
+		v.operandStack.pushSlot(v.unwrapSlot(ft.ResultTypes[i], result))
 	}
 }
 
@@ -162,26 +152,80 @@ func pushResults(v *vm, ft binary.FuncType, results []interface{}) {
 //
 // call_indirect type_idx:uint32 table_idx:uint32
 //
-// 其中 table_idx 的值目前只能是 0
+// 以下情况会发生陷阱：
+// - 表项的索引超出了表的范围：undefined element
+// - 表项未初始化：uninitialized element
+// - 表项的函数类型跟 type_idx 指定的类型不一致：indirect call type mismatch
 //
 func callIndirect(v *vm, args interface{}) {
+	f, funcType := getIndirectFunc(v, args.(binary.CallIndirectArgs))
+	callIndirectFunc(v, f, funcType)
+}
+
+// -------- return_call 和 return_call_indirect 尾调用
+//
+// return_call func_idx:uint32
+// return_call_indirect type_idx:uint32 table_idx:uint32
+//
+// 尾调用相当于 call 之后紧接着 return，不同的是尾调用会先移除当前函数的调用帧，
+// 然后再调用目标函数，即目标函数的调用帧取代了当前函数的调用帧，
+// 所以尾递归的函数可以在固定的控制栈和操作数栈空间里运行。
+//
+// 示例：
+//
+// (func $loop (param $n i32) (param $acc i64) (result i64)
+//     (if (result i64) (i32.eqz (local.get $n))
+//         (then (local.get $acc))
+//         (else
+//             (return_call $loop
+//                 (i32.sub (local.get $n) (i32.const 1))
+//                 (i64.add (local.get $acc) (i64.const 1))))))
+//
+// 注：
+// 如果目标函数是外部函数，则移除当前调用帧之后直接调用外部函数，
+// 外部函数的返回值刚好位于当前函数返回值的位置。
+func returnCall(v *vm, args interface{}) {
+	f := v.funcs[int(args.(uint32))]
+	v.leaveCallFrame(len(f.type_.ParamTypes))
+	callFunc(v, f)
+}
+
+func returnCallIndirect(v *vm, args interface{}) {
+	// 先检查目标函数（可能会发生陷阱），然后再移除当前调用帧
+	f, funcType := getIndirectFunc(v, args.(binary.CallIndirectArgs))
+	v.leaveCallFrame(len(funcType.ParamTypes))
+	callIndirectFunc(v, f, funcType)
+}
+
+// 辅助函数
+
+// 读取 call_indirect 和 return_call_indirect 的目标函数
+func getIndirectFunc(v *vm, callIndirectArgs binary.CallIndirectArgs) (instance.Function, binary.FuncType) {
+	table := v.tables[callIndirectArgs.Table]
+
 	i := v.operandStack.popU32() // 读取目标表项的索引
-	if i >= v.table.Size() {
-		panic(errors.New("out of element range"))
+	if i >= table.Size() {
+		panic(instance.NewTrap(instance.TrapTableOutOfBounds))
+	}
+
+	f, _ := table.GetElem(i).(instance.Function)
+	if f == nil {
+		panic(instance.NewTrap(instance.TrapUninitializedElement))
 	}
 
-	f := v.table.GetElem(i)
+	funcType := v.module.TypeSec[callIndirectArgs.Type]
 
-	typeIdx := args.(uint32)
-	funcType := v.module.TypeSec[typeIdx]
+	// call_indirect 指令的 type_idx 参数用于防止调用错了函数，
+	// 目标函数可能来自别的模块，所以按结构（即参数和返回值的类型列表）比较函数类型，
+	// 而不是比较类型索引
+	if !isFuncTypeMatch(funcType, f.Type()) {
+		panic(instance.NewTrap(instance.TrapIndirectCallTypeMismatch))
+	}
 
-	// todo::
-	// 这里需要检查函数类型（函数的签名）是否匹配
-	// if f.type_.GetSignature() != funcType.GetSignature() {
-	// 	panic(errors.New("function type mismatch in indirect call"))
-	// }
-	// call_indirect 指令的 type_idx 参数用于防止调用错了函数
+	return f, funcType
+}
 
+func callIndirectFunc(v *vm, f instance.Function, funcType binary.FuncType) {
 	// callFunc(v, f)
 
 	// 检查是否同一个模块里的内部函数
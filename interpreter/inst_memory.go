@@ -2,8 +2,8 @@ package interpreter
 
 import (
 	encoding_binary "encoding/binary"
-	"errors"
 	"wasmvm/binary"
+	"wasmvm/instance"
 )
 
 // ======== 内存指令
@@ -20,7 +20,9 @@ import (
 // 成功则返回旧的页面数量
 // 失败（比如超出限制值的 max）则返回 -1
 //
-// 内存还有其他几个操作：
+// 对于 64 位的内存（memory64 提案），页面数、增加量以及访问内存的地址都是 uint64
+//
+// 内存还有其他几个操作（批量内存指令，见后面）：
 // - The `memory.fill` instruction sets all values in a region to a given byte.
 // - The `memory.copy` instruction copies data from a source memory region to
 //   a possibly overlapping destination region.
@@ -32,22 +34,23 @@ import (
 //
 // https://webassembly.github.io/spec/core/syntax/instructions.html#syntax-instr-memory
 
-func memorySize(v *vm, args interface{}) {
-	mem_block_idx := args.(byte)
-	if mem_block_idx != 0 {
-		panic(errors.New("invalid memory index"))
+func memorySize(v *vm, mem_block_idx uint32, _ uint32) {
+	mem := v.memories[mem_block_idx]
+	if mem.Type().Is64() {
+		v.operandStack.pushU64(memSize(mem))
+	} else {
+		v.operandStack.pushU32(mem.Size())
 	}
-
-	v.operandStack.pushU32(v.memory.Size())
 }
 
-func memoryGrow(v *vm, args interface{}) {
-	mem_block_idx := args.(byte)
-	if mem_block_idx != 0 {
-		panic(errors.New("invalid memory index"))
+func memoryGrow(v *vm, mem_block_idx uint32, _ uint32) {
+	mem := v.memories[mem_block_idx]
+	if mem.Type().Is64() {
+		v.operandStack.pushU64(mem.(instance.Memory64).Grow64(v.operandStack.popU64()))
+		return
 	}
 
-	previousSize := v.memory.Grow(v.operandStack.popU32())
+	previousSize := mem.Grow(v.operandStack.popU32())
 	// 虽然 grow 指令有可能会返回 -1，但仅表示失败，所以指令的返回值
 	// 仍然以 u32 类型压入栈
 	v.operandStack.pushU32(previousSize)
@@ -89,74 +92,113 @@ func memoryGrow(v *vm, args interface{}) {
 //
 // f32.load
 // f64.load
+//
+// 预编译之后，加载和存储指令的 MemArg 被解码为 compiledInstr 的 a（内存索引）和
+// imm（offset），由 vm.execFrame() 经由 memoryAccessTable 直接执行，不需要对 interface{}
+// 进行类型断言。instructionTable 里的版本只是解码 MemArg 之后调用同一个函数，见 withMemArg()
+
+type memoryAccessFunc = func(v *vm, memIdx uint32, offset uint64)
+
+var memoryAccessTable = [256]memoryAccessFunc{
+	binary.I32Load:    i32Load,
+	binary.I32Load8S:  i32Load8S,
+	binary.I32Load8U:  i32Load8U,
+	binary.I32Load16S: i32Load16S,
+	binary.I32Load16U: i32Load16U,
+	binary.I64Load:    i64Load,
+	binary.I64Load8S:  i64Load8S,
+	binary.I64Load8U:  i64Load8U,
+	binary.I64Load16S: i64Load16S,
+	binary.I64Load16U: i64Load16U,
+	binary.I64Load32S: i64Load32S,
+	binary.I64Load32U: i64Load32U,
+	binary.F32Load:    f32Load,
+	binary.F64Load:    f64Load,
+	binary.I32Store:   i32Store,
+	binary.I32Store8:  i32Store8,
+	binary.I32Store16: i32Store16,
+	binary.I64Store:   i64Store,
+	binary.I64Store8:  i64Store8,
+	binary.I64Store16: i64Store16,
+	binary.I64Store32: i64Store32,
+	binary.F32Store:   f32Store,
+	binary.F64Store:   f64Store,
+}
+
+func withMemArg(f memoryAccessFunc) instructionExecFunc {
+	return func(v *vm, args interface{}) {
+		memArg := args.(binary.MemArg)
+		f(v, memArg.Mem, memArg.Offset)
+	}
+}
 
-func i32Load(v *vm, memArg interface{}) {
-	val := readU32(v, memArg)
+func i32Load(v *vm, memIdx uint32, offset uint64) {
+	val := readU32(v, memIdx, offset)
 	v.operandStack.pushU32(val)
 }
 
-func i32Load8S(v *vm, memArg interface{}) {
-	val := readU8(v, memArg)
+func i32Load8S(v *vm, memIdx uint32, offset uint64) {
+	val := readU8(v, memIdx, offset)
 	v.operandStack.pushS32(int32(int8(val)))
 }
 
-func i32Load8U(v *vm, memArg interface{}) {
-	val := readU8(v, memArg)
+func i32Load8U(v *vm, memIdx uint32, offset uint64) {
+	val := readU8(v, memIdx, offset)
 	v.operandStack.pushU32(uint32(val))
 }
 
-func i32Load16S(v *vm, memArg interface{}) {
-	val := readU16(v, memArg)
+func i32Load16S(v *vm, memIdx uint32, offset uint64) {
+	val := readU16(v, memIdx, offset)
 	v.operandStack.pushS32(int32(int16(val)))
 }
 
-func i32Load16U(v *vm, memArg interface{}) {
-	val := readU16(v, memArg)
+func i32Load16U(v *vm, memIdx uint32, offset uint64) {
+	val := readU16(v, memIdx, offset)
 	v.operandStack.pushU32(uint32(val))
 }
 
-func i64Load(v *vm, memArg interface{}) {
-	val := readU64(v, memArg)
+func i64Load(v *vm, memIdx uint32, offset uint64) {
+	val := readU64(v, memIdx, offset)
 	v.operandStack.pushU64(val)
 }
 
-func i64Load8S(v *vm, memArg interface{}) {
-	val := readU8(v, memArg)
+func i64Load8S(v *vm, memIdx uint32, offset uint64) {
+	val := readU8(v, memIdx, offset)
 	v.operandStack.pushS64(int64(int8(val)))
 }
 
-func i64Load8U(v *vm, memArg interface{}) {
-	val := readU8(v, memArg)
+func i64Load8U(v *vm, memIdx uint32, offset uint64) {
+	val := readU8(v, memIdx, offset)
 	v.operandStack.pushU64(uint64(val))
 }
 
-func i64Load16S(v *vm, memArg interface{}) {
-	val := readU16(v, memArg)
+func i64Load16S(v *vm, memIdx uint32, offset uint64) {
+	val := readU16(v, memIdx, offset)
 	v.operandStack.pushS64(int64(int16(val)))
 }
 
-func i64Load16U(v *vm, memArg interface{}) {
-	val := readU16(v, memArg)
+func i64Load16U(v *vm, memIdx uint32, offset uint64) {
+	val := readU16(v, memIdx, offset)
 	v.operandStack.pushU64(uint64(val))
 }
 
-func i64Load32S(v *vm, memArg interface{}) {
-	val := readU32(v, memArg)
+func i64Load32S(v *vm, memIdx uint32, offset uint64) {
+	val := readU32(v, memIdx, offset)
 	v.operandStack.pushS64(int64(int32(val)))
 }
 
-func i64Load32U(v *vm, memArg interface{}) {
-	val := readU32(v, memArg)
+func i64Load32U(v *vm, memIdx uint32, offset uint64) {
+	val := readU32(v, memIdx, offset)
 	v.operandStack.pushU64(uint64(val))
 }
 
-func f32Load(v *vm, memArg interface{}) {
-	val := readU32(v, memArg)
+func f32Load(v *vm, memIdx uint32, offset uint64) {
+	val := readU32(v, memIdx, offset)
 	v.operandStack.pushU32(val)
 }
 
-func f64Load(v *vm, memArg interface{}) {
-	val := readU64(v, memArg)
+func f64Load(v *vm, memIdx uint32, offset uint64) {
+	val := readU64(v, memIdx, offset)
 	v.operandStack.pushU64(val)
 }
 
@@ -164,42 +206,96 @@ var byteOrder = encoding_binary.LittleEndian
 
 // 辅助函数
 
-func readU8(v *vm, memArg interface{}) byte {
+func readU8(v *vm, memIdx uint32, offset uint64) byte {
 	var buf [1]byte
-	eaddr := getEffectiveAddress(v, memArg)
-	v.memory.Read(eaddr, buf[:])
+	readMem(v, memIdx, offset, buf[:])
 	return buf[0]
 }
 
-func readU16(v *vm, memArg interface{}) uint16 {
+func readU16(v *vm, memIdx uint32, offset uint64) uint16 {
 	var buf [2]byte
-	eaddr := getEffectiveAddress(v, memArg)
-	v.memory.Read(eaddr, buf[:])
+	readMem(v, memIdx, offset, buf[:])
 	return byteOrder.Uint16(buf[:])
 }
 
-func readU32(v *vm, memArg interface{}) uint32 {
+func readU32(v *vm, memIdx uint32, offset uint64) uint32 {
 	var buf [4]byte
-	eaddr := getEffectiveAddress(v, memArg)
-	v.memory.Read(eaddr, buf[:])
+	readMem(v, memIdx, offset, buf[:])
 	return byteOrder.Uint32(buf[:])
 }
 
-func readU64(v *vm, memArg interface{}) uint64 {
+func readU64(v *vm, memIdx uint32, offset uint64) uint64 {
 	var buf [8]byte
-	eaddr := getEffectiveAddress(v, memArg)
-	v.memory.Read(eaddr, buf[:])
+	readMem(v, memIdx, offset, buf[:])
 	return byteOrder.Uint64(buf[:])
 }
 
+// 读写有效地址处的内存，解释器创建的（非共享）内存直接调用 *memory 的方法。
+// 其他内存（共享内存以及宿主实现的内存）经由接口调用，使用复制的缓冲区，
+// 以免调用方（栈上）的缓冲区逃逸到堆上，每次访问内存都需要分配
+func readMem(v *vm, memIdx uint32, offset uint64, buf []byte) {
+	mem, eaddr := effectiveAddress(v, memIdx, offset)
+	if m, ok := mem.(*memory); ok {
+		m.Read(eaddr, buf)
+	} else {
+		tmp := make([]byte, len(buf))
+		mem.Read(eaddr, tmp)
+		copy(buf, tmp)
+	}
+}
+
+func writeMem(v *vm, memIdx uint32, offset uint64, buf []byte) {
+	mem, eaddr := effectiveAddress(v, memIdx, offset)
+	if m, ok := mem.(*memory); ok {
+		m.Write(eaddr, buf)
+	} else {
+		mem.Write(eaddr, append([]byte(nil), buf...))
+	}
+}
+
 // 因为指令中的 offset 立即数是 uint32，而操作数栈弹出的值也是 uint32，
 // 所以有效地址（uint32 + uint32）是一个 33 位的无符号整数，超出了 uint32 的范围，
 // 所以这里使用 uint64 存储有效地址。
-func getEffectiveAddress(v *vm, memArg interface{}) uint64 {
+// 对于 64 位的内存，地址和 offset 都是 uint64，相加溢出时视为越界。
+//
+// 同时返回 memIdx 指定的内存块
+func effectiveAddress(v *vm, memIdx uint32, offset uint64) (instance.Memory, uint64) {
+	mem := v.memories[memIdx]
+	addr := popAddr(v, mem)
+	eaddr := addr + offset
+	if eaddr < addr {
+		panic(instance.NewTrap(instance.TrapMemoryOutOfBounds))
+	}
+	return mem, eaddr
+}
+
+// 跟 effectiveAddress() 相同，用于没有经过预编译的指令（原子指令以及 SIMD 指令）
+func getEffectiveAddress(v *vm, memArg interface{}) (instance.Memory, uint64) {
 	// MemArg 里头的 align 暂时无用
 	realMemArg := memArg.(binary.MemArg)
-	offset := realMemArg.Offset
-	return uint64(v.operandStack.popU32()) + uint64(offset)
+	return effectiveAddress(v, realMemArg.Mem, realMemArg.Offset)
+}
+
+// 弹出内存的地址，64 位的内存的地址是 i64，其他内存的地址是 i32
+func popAddr(v *vm, mem instance.Memory) uint64 {
+	if m, ok := mem.(*memory); ok {
+		if m.type_.Is64() {
+			return v.operandStack.popU64()
+		}
+		return uint64(v.operandStack.popU32())
+	}
+	if mem.Type().Is64() {
+		return v.operandStack.popU64()
+	}
+	return uint64(v.operandStack.popU32())
+}
+
+// 获取内存块的页面数，实现了 instance.Memory64 接口的内存使用 Size64()
+func memSize(mem instance.Memory) uint64 {
+	if mem64, ok := mem.(instance.Memory64); ok {
+		return mem64.Size64()
+	}
+	return uint64(mem.Size())
 }
 
 // -------- 存储指令
@@ -224,77 +320,154 @@ func getEffectiveAddress(v *vm, memArg interface{}) uint64 {
 // f32.store
 // f64.store
 
-func i32Store(v *vm, memArg interface{}) {
+func i32Store(v *vm, memIdx uint32, offset uint64) {
 	val := v.operandStack.popU32()
-	writeU32(v, memArg, val)
+	writeU32(v, memIdx, offset, val)
 }
 
-func i32Store8(v *vm, memArg interface{}) {
+func i32Store8(v *vm, memIdx uint32, offset uint64) {
 	val := v.operandStack.popU32()
-	writeU8(v, memArg, byte(val))
+	writeU8(v, memIdx, offset, byte(val))
 }
 
-func i32Store16(v *vm, memArg interface{}) {
+func i32Store16(v *vm, memIdx uint32, offset uint64) {
 	val := v.operandStack.popU32()
-	writeU16(v, memArg, uint16(val))
+	writeU16(v, memIdx, offset, uint16(val))
 }
 
-func i64Store(v *vm, memArg interface{}) {
+func i64Store(v *vm, memIdx uint32, offset uint64) {
 	val := v.operandStack.popU64()
-	writeU64(v, memArg, val)
+	writeU64(v, memIdx, offset, val)
 }
 
-func i64Store8(v *vm, memArg interface{}) {
+func i64Store8(v *vm, memIdx uint32, offset uint64) {
 	val := v.operandStack.popU64()
-	writeU8(v, memArg, byte(val))
+	writeU8(v, memIdx, offset, byte(val))
 }
 
-func i64Store16(v *vm, memArg interface{}) {
+func i64Store16(v *vm, memIdx uint32, offset uint64) {
 	val := v.operandStack.popU64()
-	writeU16(v, memArg, uint16(val))
+	writeU16(v, memIdx, offset, uint16(val))
 }
 
-func i64Store32(v *vm, memArg interface{}) {
+func i64Store32(v *vm, memIdx uint32, offset uint64) {
 	val := v.operandStack.popU64()
-	writeU32(v, memArg, uint32(val))
+	writeU32(v, memIdx, offset, uint32(val))
 }
 
-func f32Store(v *vm, memArg interface{}) {
+func f32Store(v *vm, memIdx uint32, offset uint64) {
 	val := v.operandStack.popU32()
-	writeU32(v, memArg, val)
+	writeU32(v, memIdx, offset, val)
 }
 
-func f64Store(v *vm, memArg interface{}) {
+func f64Store(v *vm, memIdx uint32, offset uint64) {
 	val := v.operandStack.popU64()
-	writeU64(v, memArg, val)
+	writeU64(v, memIdx, offset, val)
 }
 
 // 辅助函数
 
-func writeU8(v *vm, memArg interface{}, val byte) {
+func writeU8(v *vm, memIdx uint32, offset uint64, val byte) {
 	var buf [1]byte
 	buf[0] = val
-	eaddr := getEffectiveAddress(v, memArg)
-	v.memory.Write(eaddr, buf[:])
+	writeMem(v, memIdx, offset, buf[:])
 }
 
-func writeU16(v *vm, memArg interface{}, n uint16) {
+func writeU16(v *vm, memIdx uint32, offset uint64, n uint16) {
 	var buf [2]byte
 	byteOrder.PutUint16(buf[:], n)
-	eaddr := getEffectiveAddress(v, memArg)
-	v.memory.Write(eaddr, buf[:])
+	writeMem(v, memIdx, offset, buf[:])
 }
 
-func writeU32(v *vm, memArg interface{}, n uint32) {
+func writeU32(v *vm, memIdx uint32, offset uint64, n uint32) {
 	var buf [4]byte
 	byteOrder.PutUint32(buf[:], n)
-	eaddr := getEffectiveAddress(v, memArg)
-	v.memory.Write(eaddr, buf[:])
+	writeMem(v, memIdx, offset, buf[:])
 }
 
-func writeU64(v *vm, memArg interface{}, n uint64) {
+func writeU64(v *vm, memIdx uint32, offset uint64, n uint64) {
 	var buf [8]byte
 	byteOrder.PutUint64(buf[:], n)
-	eaddr := getEffectiveAddress(v, memArg)
-	v.memory.Write(eaddr, buf[:])
+	writeMem(v, memIdx, offset, buf[:])
+}
+
+// -------- 批量内存指令
+//
+// memory.init data_idx mem_idx
+// memory.copy dst_mem_idx src_mem_idx
+// memory.fill mem_idx
+//
+// 这三条指令都从操作数栈依次弹出 n（字节数）、源（对于 memory.fill 是填充的字节值）、
+// 目标地址（d）三个 uint32。
+// 对于 64 位的内存，内存的地址是 uint64；memory.fill 的 n 是 uint64；memory.copy 的 n
+// 仅当源和目标都是 64 位的内存时才是 uint64。
+// 只要访问的范围超出了内存（或者数据项）的大小，即使 n 为 0，也会发生陷阱，
+// 而且发生陷阱时内存不会被修改。
+//
+// data.drop data_idx
+// 丢弃数据项，之后的 memory.init 指令视该数据项的长度为 0
+
+func memoryInit(v *vm, dataIdx uint32, memIdx uint32) {
+	n := uint64(v.operandStack.popU32())
+	s := uint64(v.operandStack.popU32())
+	d := popAddr(v, v.memories[memIdx])
+	v.initMemoryFromData(memIdx, dataIdx, d, s, n)
+}
+
+// 将数据项从 s 开始的 n 个字节写入指定内存块的地址 d，实例化时初始化内存也使用这个方法
+func (v *vm) initMemoryFromData(memIdx uint32, dataIdx uint32, d uint64, s uint64, n uint64) {
+	data := v.dataSegs[dataIdx]
+	if s+n > uint64(len(data)) {
+		panic(instance.NewTrap(instance.TrapMemoryOutOfBounds))
+	}
+	v.memories[memIdx].Write(d, data[s:s+n])
+}
+
+func dataDrop(v *vm, dataIdx uint32, _ uint32) {
+	v.dataSegs[dataIdx] = nil
+}
+
+func memoryCopy(v *vm, dstIdx uint32, srcIdx uint32) {
+	src := v.memories[srcIdx]
+	dst := v.memories[dstIdx]
+
+	var n uint64
+	if src.Type().Is64() && dst.Type().Is64() {
+		n = v.operandStack.popU64()
+	} else {
+		n = uint64(v.operandStack.popU32())
+	}
+	s := popAddr(v, src)
+	d := popAddr(v, dst)
+
+	checkMemoryRange(src, s, n)
+	checkMemoryRange(dst, d, n)
+
+	// 源和目标的范围可能重叠，所以先读出全部数据再写入
+	buf := make([]byte, n)
+	src.Read(s, buf)
+	dst.Write(d, buf)
+}
+
+func memoryFill(v *vm, memIdx uint32, _ uint32) {
+	mem := v.memories[memIdx]
+	n := popAddr(v, mem)
+	val := byte(v.operandStack.popU32())
+	d := popAddr(v, mem)
+
+	checkMemoryRange(mem, d, n)
+
+	buf := make([]byte, n)
+	for i := range buf {
+		buf[i] = val
+	}
+	mem.Write(d, buf)
+}
+
+// 在分配缓冲区之前检查访问的范围，防止 n 过大时分配巨大的内存
+func checkMemoryRange(mem instance.Memory, addr uint64, n uint64) {
+	size := memSize(mem) * binary.PageSize
+	if n > size || addr > size-n {
+		panic(instance.NewTrap(instance.TrapMemoryOutOfBounds))
+	}
 }
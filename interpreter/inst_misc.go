@@ -0,0 +1,50 @@
+package interpreter
+
+import (
+	"errors"
+	"wasmvm/binary"
+)
+
+// ======== 0xFC 前缀指令
+//
+// 饱和截断指令、批量内存指令以及部分表指令共用 0xFC 前缀，
+// 按照子操作码在 miscInstructionTable 里查找执行函数
+
+var miscInstructionTable = make([]instructionExecFunc, binary.GetMiscOpcodeCount())
+
+func misc(v *vm, args interface{}) {
+	miscArgs := args.(binary.MiscArgs)
+	if int(miscArgs.SubOpcode) >= len(miscInstructionTable) {
+		panic(errors.New("unreachable"))
+	}
+	miscInstructionTable[miscArgs.SubOpcode](v, miscArgs.Args)
+}
+
+func init() {
+	// 饱和截断指令
+	miscInstructionTable[binary.I32TruncSatF32S] = i32TruncSatF32S
+	miscInstructionTable[binary.I32TruncSatF32U] = i32TruncSatF32U
+	miscInstructionTable[binary.I32TruncSatF64S] = i32TruncSatF64S
+	miscInstructionTable[binary.I32TruncSatF64U] = i32TruncSatF64U
+	miscInstructionTable[binary.I64TruncSatF32S] = i64TruncSatF32S
+	miscInstructionTable[binary.I64TruncSatF32U] = i64TruncSatF32U
+	miscInstructionTable[binary.I64TruncSatF64S] = i64TruncSatF64S
+	miscInstructionTable[binary.I64TruncSatF64U] = i64TruncSatF64U
+
+	// 批量内存指令以及表指令，立即数在预编译时被解码，见 indexedExecFunc
+	miscIndexedInstructionTable[binary.MemoryInit] = memoryInit
+	miscIndexedInstructionTable[binary.DataDrop] = dataDrop
+	miscIndexedInstructionTable[binary.MemoryCopy] = memoryCopy
+	miscIndexedInstructionTable[binary.MemoryFill] = memoryFill
+	miscIndexedInstructionTable[binary.TableInit] = tableInit
+	miscIndexedInstructionTable[binary.ElemDrop] = elemDrop
+	miscIndexedInstructionTable[binary.TableCopy] = tableCopy
+	miscIndexedInstructionTable[binary.TableGrow] = tableGrow
+	miscIndexedInstructionTable[binary.TableSize] = tableSize
+	miscIndexedInstructionTable[binary.TableFill] = tableFill
+	for subOpcode, f := range miscIndexedInstructionTable {
+		if f != nil {
+			miscInstructionTable[subOpcode] = withIndices(f)
+		}
+	}
+}
@@ -1,9 +1,9 @@
 package interpreter
 
 import (
-	"errors"
 	"math"
 	"math/bits"
+	"wasmvm/instance"
 )
 
 // ======== 数值指令
@@ -66,24 +66,36 @@ func i32Mul(v *vm, _ interface{}) {
 
 func i32DivS(v *vm, _ interface{}) {
 	rhs, lhs := v.operandStack.popS32(), v.operandStack.popS32()
+	if rhs == 0 {
+		panic(instance.NewTrap(instance.TrapIntegerDivideByZero))
+	}
 	if lhs == math.MinInt32 && rhs == -1 {
-		panic(errors.New("integer overflow"))
+		panic(instance.NewTrap(instance.TrapIntegerOverflow))
 	}
 	v.operandStack.pushS32(lhs / rhs)
 }
 
 func i32DivU(v *vm, _ interface{}) {
 	rhs, lhs := v.operandStack.popU32(), v.operandStack.popU32()
+	if rhs == 0 {
+		panic(instance.NewTrap(instance.TrapIntegerDivideByZero))
+	}
 	v.operandStack.pushU32(lhs / rhs)
 }
 
 func i32RemS(v *vm, _ interface{}) {
 	rhs, lhs := v.operandStack.popS32(), v.operandStack.popS32()
+	if rhs == 0 {
+		panic(instance.NewTrap(instance.TrapIntegerDivideByZero))
+	}
 	v.operandStack.pushS32(lhs % rhs)
 }
 
 func i32RemU(v *vm, _ interface{}) {
 	rhs, lhs := v.operandStack.popU32(), v.operandStack.popU32()
+	if rhs == 0 {
+		panic(instance.NewTrap(instance.TrapIntegerDivideByZero))
+	}
 	v.operandStack.pushU32(lhs % rhs)
 }
 
@@ -146,24 +158,36 @@ func i64Mul(v *vm, _ interface{}) {
 
 func i64DivS(v *vm, _ interface{}) {
 	rhs, lhs := v.operandStack.popS64(), v.operandStack.popS64()
+	if rhs == 0 {
+		panic(instance.NewTrap(instance.TrapIntegerDivideByZero))
+	}
 	if lhs == math.MinInt64 && rhs == -1 {
-		panic(errors.New("integer overflow"))
+		panic(instance.NewTrap(instance.TrapIntegerOverflow))
 	}
 	v.operandStack.pushS64(lhs / rhs)
 }
 
 func i64DivU(v *vm, _ interface{}) {
 	rhs, lhs := v.operandStack.popU64(), v.operandStack.popU64()
+	if rhs == 0 {
+		panic(instance.NewTrap(instance.TrapIntegerDivideByZero))
+	}
 	v.operandStack.pushU64(lhs / rhs)
 }
 
 func i64RemS(v *vm, _ interface{}) {
 	rhs, lhs := v.operandStack.popS64(), v.operandStack.popS64()
+	if rhs == 0 {
+		panic(instance.NewTrap(instance.TrapIntegerDivideByZero))
+	}
 	v.operandStack.pushS64(lhs % rhs)
 }
 
 func i64RemU(v *vm, _ interface{}) {
 	rhs, lhs := v.operandStack.popU64(), v.operandStack.popU64()
+	if rhs == 0 {
+		panic(instance.NewTrap(instance.TrapIntegerDivideByZero))
+	}
 	v.operandStack.pushU64(lhs % rhs)
 }
 
@@ -231,35 +255,44 @@ func f32Div(v *vm, _ interface{}) {
 
 func f32Min(v *vm, _ interface{}) {
 	rhs, lhs := v.operandStack.popF32(), v.operandStack.popF32()
-	leftNaN := math.IsNaN(float64(lhs))
-	rightNaN := math.IsNaN(float64(rhs))
-	if leftNaN && !rightNaN {
-		v.operandStack.pushF32(lhs)
-		return
-	} else if rightNaN && !leftNaN {
-		v.operandStack.pushF32(rhs)
-		return
-	}
-	v.operandStack.pushF32(float32(math.Min(float64(lhs), float64(rhs))))
+	v.operandStack.pushF32(f32MinOp(lhs, rhs))
 }
 
 func f32Max(v *vm, _ interface{}) {
 	rhs, lhs := v.operandStack.popF32(), v.operandStack.popF32()
-	leftNaN := math.IsNaN(float64(lhs))
-	rightNaN := math.IsNaN(float64(rhs))
-	if leftNaN && !rightNaN {
-		v.operandStack.pushF32(lhs)
-		return
-	} else if rightNaN && !leftNaN {
-		v.operandStack.pushF32(rhs)
-		return
+	v.operandStack.pushF32(f32MaxOp(lhs, rhs))
+}
+
+// 有操作数为 NaN 时，min 和 max 的结果是第一个 NaN 操作数，并且设置 quiet 位，
+// 注意不能直接使用 math.Min/Max，因为它们对 NaN 的处理跟 WebAssembly 不同
+func f32NaNOperand(lhs, rhs float32) (float32, bool) {
+	switch {
+	case lhs != lhs:
+		return math.Float32frombits(math.Float32bits(lhs) | 1<<22), true
+	case rhs != rhs:
+		return math.Float32frombits(math.Float32bits(rhs) | 1<<22), true
+	}
+	return 0, false
+}
+
+func f32MinOp(x, y float32) float32 {
+	if nan, ok := f32NaNOperand(x, y); ok {
+		return nan
+	}
+	return float32(math.Min(float64(x), float64(y)))
+}
+
+func f32MaxOp(x, y float32) float32 {
+	if nan, ok := f32NaNOperand(x, y); ok {
+		return nan
 	}
-	v.operandStack.pushF32(float32(math.Max(float64(lhs), float64(rhs))))
+	return float32(math.Max(float64(x), float64(y)))
 }
 
 func f32CopySign(v *vm, _ interface{}) {
-	from, to := v.operandStack.popF32(), v.operandStack.popF32()
-	v.operandStack.pushF32(float32(math.Copysign(float64(to), float64(from))))
+	// 直接复制符号位，原因同 f32Abs
+	from, to := v.operandStack.popU32(), v.operandStack.popU32()
+	v.operandStack.pushU32(to&^(1<<31) | from&(1<<31))
 }
 
 // f64
@@ -286,30 +319,37 @@ func f64Div(v *vm, _ interface{}) {
 
 func f64Min(v *vm, _ interface{}) {
 	rhs, lhs := v.operandStack.popF64(), v.operandStack.popF64()
-	leftNaN := math.IsNaN(lhs)
-	rightNaN := math.IsNaN(rhs)
-	if leftNaN && !rightNaN {
-		v.operandStack.pushF64(lhs)
-		return
-	} else if rightNaN && !leftNaN {
-		v.operandStack.pushF64(rhs)
-		return
-	}
-	v.operandStack.pushF64(math.Min(lhs, rhs))
+	v.operandStack.pushF64(f64MinOp(lhs, rhs))
 }
 
 func f64Max(v *vm, _ interface{}) {
 	rhs, lhs := v.operandStack.popF64(), v.operandStack.popF64()
-	leftNaN := math.IsNaN(lhs)
-	rightNaN := math.IsNaN(rhs)
-	if leftNaN && !rightNaN {
-		v.operandStack.pushF64(lhs)
-		return
-	} else if rightNaN && !leftNaN {
-		v.operandStack.pushF64(rhs)
-		return
+	v.operandStack.pushF64(f64MaxOp(lhs, rhs))
+}
+
+// 见 f32NaNOperand
+func f64NaNOperand(lhs, rhs float64) (float64, bool) {
+	switch {
+	case lhs != lhs:
+		return math.Float64frombits(math.Float64bits(lhs) | 1<<51), true
+	case rhs != rhs:
+		return math.Float64frombits(math.Float64bits(rhs) | 1<<51), true
+	}
+	return 0, false
+}
+
+func f64MinOp(x, y float64) float64 {
+	if nan, ok := f64NaNOperand(x, y); ok {
+		return nan
+	}
+	return math.Min(x, y)
+}
+
+func f64MaxOp(x, y float64) float64 {
+	if nan, ok := f64NaNOperand(x, y); ok {
+		return nan
 	}
-	v.operandStack.pushF64(math.Max(lhs, rhs))
+	return math.Max(x, y)
 }
 
 func f64CopySign(v *vm, _ interface{}) {
@@ -1,8 +1,8 @@
 package interpreter
 
 import (
-	"errors"
 	"math"
+	"wasmvm/instance"
 )
 
 // ======== 数值指令
@@ -87,10 +87,10 @@ func i64Extend32S(v *vm, _ interface{}) {
 func i32TruncF32S(v *vm, _ interface{}) {
 	f := math.Trunc(float64(v.operandStack.popF32()))
 	if f > math.MaxInt32 || f < math.MinInt32 {
-		panic(errors.New("integer overflow"))
+		panic(instance.NewTrap(instance.TrapIntegerOverflow))
 	}
 	if math.IsNaN(f) {
-		panic(errors.New("invalid conversion to integer"))
+		panic(instance.NewTrap(instance.TrapInvalidConversion))
 	}
 	v.operandStack.pushS32(int32(f))
 }
@@ -98,10 +98,10 @@ func i32TruncF32S(v *vm, _ interface{}) {
 func i32TruncF32U(v *vm, _ interface{}) {
 	f := math.Trunc(float64(v.operandStack.popF32()))
 	if f > math.MaxUint32 || f < 0 {
-		panic(errors.New("integer overflow"))
+		panic(instance.NewTrap(instance.TrapIntegerOverflow))
 	}
 	if math.IsNaN(f) {
-		panic(errors.New("invalid conversion to integer"))
+		panic(instance.NewTrap(instance.TrapInvalidConversion))
 	}
 	v.operandStack.pushU32(uint32(f))
 }
@@ -109,10 +109,10 @@ func i32TruncF32U(v *vm, _ interface{}) {
 func i64TruncF32S(v *vm, _ interface{}) {
 	f := math.Trunc(float64(v.operandStack.popF32()))
 	if f >= math.MaxInt64 || f < math.MinInt64 {
-		panic(errors.New("integer overflow"))
+		panic(instance.NewTrap(instance.TrapIntegerOverflow))
 	}
 	if math.IsNaN(f) {
-		panic(errors.New("invalid conversion to integer"))
+		panic(instance.NewTrap(instance.TrapInvalidConversion))
 	}
 	v.operandStack.pushS64(int64(f))
 }
@@ -120,10 +120,10 @@ func i64TruncF32S(v *vm, _ interface{}) {
 func i64TruncF32U(v *vm, _ interface{}) {
 	f := math.Trunc(float64(v.operandStack.popF32()))
 	if f >= math.MaxUint64 || f < 0 {
-		panic(errors.New("integer overflow"))
+		panic(instance.NewTrap(instance.TrapIntegerOverflow))
 	}
 	if math.IsNaN(f) {
-		panic(errors.New("invalid conversion to integer"))
+		panic(instance.NewTrap(instance.TrapInvalidConversion))
 	}
 	v.operandStack.pushU64(uint64(f))
 }
@@ -131,10 +131,10 @@ func i64TruncF32U(v *vm, _ interface{}) {
 func i32TruncF64S(v *vm, _ interface{}) {
 	f := math.Trunc(v.operandStack.popF64())
 	if f > math.MaxInt32 || f < math.MinInt32 {
-		panic(errors.New("integer overflow"))
+		panic(instance.NewTrap(instance.TrapIntegerOverflow))
 	}
 	if math.IsNaN(f) {
-		panic(errors.New("invalid conversion to integer"))
+		panic(instance.NewTrap(instance.TrapInvalidConversion))
 	}
 	v.operandStack.pushS32(int32(f))
 }
@@ -142,10 +142,10 @@ func i32TruncF64S(v *vm, _ interface{}) {
 func i32TruncF64U(v *vm, _ interface{}) {
 	f := math.Trunc(v.operandStack.popF64())
 	if f > math.MaxUint32 || f < 0 {
-		panic(errors.New("integer overflow"))
+		panic(instance.NewTrap(instance.TrapIntegerOverflow))
 	}
 	if math.IsNaN(f) {
-		panic(errors.New("invalid conversion to integer"))
+		panic(instance.NewTrap(instance.TrapInvalidConversion))
 	}
 	v.operandStack.pushU32(uint32(f))
 }
@@ -153,10 +153,10 @@ func i32TruncF64U(v *vm, _ interface{}) {
 func i64TruncF64S(v *vm, _ interface{}) {
 	f := math.Trunc(v.operandStack.popF64())
 	if f >= math.MaxInt64 || f < math.MinInt64 {
-		panic(errors.New("integer overflow"))
+		panic(instance.NewTrap(instance.TrapIntegerOverflow))
 	}
 	if math.IsNaN(f) {
-		panic(errors.New("invalid conversion to integer"))
+		panic(instance.NewTrap(instance.TrapInvalidConversion))
 	}
 	v.operandStack.pushS64(int64(f))
 }
@@ -164,10 +164,10 @@ func i64TruncF64S(v *vm, _ interface{}) {
 func i64TruncF64U(v *vm, _ interface{}) {
 	f := math.Trunc(v.operandStack.popF64())
 	if f >= math.MaxUint64 || f < 0 {
-		panic(errors.New("integer overflow"))
+		panic(instance.NewTrap(instance.TrapIntegerOverflow))
 	}
 	if math.IsNaN(f) {
-		panic(errors.New("invalid conversion to integer"))
+		panic(instance.NewTrap(instance.TrapInvalidConversion))
 	}
 	v.operandStack.pushU64(uint64(f))
 }
@@ -178,35 +178,44 @@ func i64TruncF64U(v *vm, _ interface{}) {
 // - 将 NaN 转为 0
 // - 将正/负无穷转为整数最大/最小值
 
-func truncSat(v *vm, args interface{}) {
-	switch args.(byte) {
-	case 0: // i32.trunc_sat_f32_s
-		val := truncSatS(float64(v.operandStack.popF32()), 32)
-		v.operandStack.pushS32(int32(val))
-	case 1: // i32.trunc_sat_f32_u
-		val := truncSatU(float64(v.operandStack.popF32()), 32)
-		v.operandStack.pushU32(uint32(val))
-	case 2: // i32.trunc_sat_f64_s
-		val := truncSatS(v.operandStack.popF64(), 32)
-		v.operandStack.pushS32(int32(val))
-	case 3: // i32.trunc_sat_f64_u
-		val := truncSatU(v.operandStack.popF64(), 32)
-		v.operandStack.pushU32(uint32(val))
-	case 4: // i64.trunc_sat_f32_s
-		val := truncSatS(float64(v.operandStack.popF32()), 64)
-		v.operandStack.pushS64(val)
-	case 5: // i64.trunc_sat_f32_u
-		val := truncSatU(float64(v.operandStack.popF32()), 64)
-		v.operandStack.pushU64(val)
-	case 6: // i64.trunc_sat_f64_s
-		val := truncSatS(v.operandStack.popF64(), 64)
-		v.operandStack.pushS64(val)
-	case 7: // i64.trunc_sat_f64_u
-		val := truncSatU(v.operandStack.popF64(), 64)
-		v.operandStack.pushU64(val)
-	default:
-		panic(errors.New("unreachable"))
-	}
+func i32TruncSatF32S(v *vm, _ interface{}) {
+	val := truncSatS(float64(v.operandStack.popF32()), 32)
+	v.operandStack.pushS32(int32(val))
+}
+
+func i32TruncSatF32U(v *vm, _ interface{}) {
+	val := truncSatU(float64(v.operandStack.popF32()), 32)
+	v.operandStack.pushU32(uint32(val))
+}
+
+func i32TruncSatF64S(v *vm, _ interface{}) {
+	val := truncSatS(v.operandStack.popF64(), 32)
+	v.operandStack.pushS32(int32(val))
+}
+
+func i32TruncSatF64U(v *vm, _ interface{}) {
+	val := truncSatU(v.operandStack.popF64(), 32)
+	v.operandStack.pushU32(uint32(val))
+}
+
+func i64TruncSatF32S(v *vm, _ interface{}) {
+	val := truncSatS(float64(v.operandStack.popF32()), 64)
+	v.operandStack.pushS64(val)
+}
+
+func i64TruncSatF32U(v *vm, _ interface{}) {
+	val := truncSatU(float64(v.operandStack.popF32()), 64)
+	v.operandStack.pushU64(val)
+}
+
+func i64TruncSatF64S(v *vm, _ interface{}) {
+	val := truncSatS(v.operandStack.popF64(), 64)
+	v.operandStack.pushS64(val)
+}
+
+func i64TruncSatF64U(v *vm, _ interface{}) {
+	val := truncSatU(v.operandStack.popF64(), 64)
+	v.operandStack.pushU64(val)
 }
 
 func truncSatU(z float64, n int) uint64 {
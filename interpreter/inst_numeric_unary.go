@@ -65,7 +65,8 @@ func i64PopCnt(v *vm, _ interface{}) {
 // f32
 
 func f32Abs(v *vm, _ interface{}) {
-	v.operandStack.pushF32(float32(math.Abs(float64(v.operandStack.popF32()))))
+	// 直接清除符号位，如果先转换为 float64 再计算，NaN 的负载（payload）可能会被改变
+	v.operandStack.pushU32(v.operandStack.popU32() &^ (1 << 31))
 }
 
 func f32Neg(v *vm, _ interface{}) {
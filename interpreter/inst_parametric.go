@@ -9,7 +9,7 @@ package interpreter
 // 弹出栈顶的一个操作数并扔掉
 
 func drop(v *vm, _ interface{}) {
-	v.operandStack.popU64()
+	v.operandStack.popSlot()
 }
 
 // ### select
@@ -30,12 +30,15 @@ func drop(v *vm, _ interface{}) {
 // 其中：
 // 栈顶元素（第一个操作数）必须是 int32，
 // 第二个和第三个操作数的类型必须相同
+//
+// 带类型的 select (result t) 指令（用于引用类型的操作数）的执行过程相同
 
 func select_(v *vm, _ interface{}) {
-	testing, consequent, alternate := v.operandStack.popU32(), v.operandStack.popU64(), v.operandStack.popU64()
+	// 操作数可能是 v128，所以需要弹出和压入整个槽位
+	testing, consequent, alternate := v.operandStack.popU32(), v.operandStack.popSlot(), v.operandStack.popSlot()
 	if testing == 0 {
-		v.operandStack.pushU64(consequent)
+		v.operandStack.pushSlot(consequent)
 	} else {
-		v.operandStack.pushU64(alternate)
+		v.operandStack.pushSlot(alternate)
 	}
 }
@@ -0,0 +1,26 @@
+package interpreter
+
+import "wasmvm/binary"
+
+// ======== 引用指令
+//
+// ref.null ref_type
+// 压入指定类型的空引用，即句柄 0
+//
+// ref.is_null
+// 弹出一个引用，为空引用时压入 1，否则压入 0
+//
+// ref.func func_idx
+// 压入指定函数的引用（句柄），见 value_ref.go
+
+func refNull(v *vm, _ interface{}) {
+	v.operandStack.pushU64(0)
+}
+
+func refIsNull(v *vm, _ interface{}) {
+	v.operandStack.pushBool(v.operandStack.popU64() == 0)
+}
+
+func refFunc(v *vm, args interface{}) {
+	v.operandStack.pushU64(v.unwrapFuncRef(v.funcs[args.(binary.FuncIdx)]))
+}
@@ -0,0 +1,413 @@
+package interpreter
+
+import (
+	"errors"
+	"wasmvm/binary"
+)
+
+// ======== 0xFD 前缀指令（SIMD 指令）
+//
+// SIMD（单指令多数据）指令操作 128 位的 v128 类型的值，根据指令的不同，
+// 一个 v128 值被看作是若干个相同类型的 `通道`（lane）：
+//
+// i8x16	16 个 8 位整数
+// i16x8	8 个 16 位整数
+// i32x4	4 个 32 位整数
+// i64x2	2 个 64 位整数
+// f32x4	4 个 32 位浮点数
+// f64x2	2 个 64 位浮点数
+//
+// 通道按小端序排列，即第 0 个通道位于 v128 的最低端。
+// 大部分 SIMD 指令都是对每个通道分别执行对应的标量运算。
+//
+// 按照子操作码在 simdInstructionTable 里查找执行函数，
+// 整数运算指令见 inst_simd_integer.go，浮点数运算指令见 inst_simd_float.go
+//
+// https://github.com/WebAssembly/simd/blob/main/proposals/simd/SIMD.md
+
+var simdInstructionTable = make([]instructionExecFunc, binary.GetSIMDOpcodeCount())
+
+func simd(v *vm, args interface{}) {
+	simdArgs := args.(binary.SIMDArgs)
+	if int(simdArgs.SubOpcode) >= len(simdInstructionTable) ||
+		simdInstructionTable[simdArgs.SubOpcode] == nil {
+		panic(errors.New("unreachable"))
+	}
+	simdInstructionTable[simdArgs.SubOpcode](v, simdArgs.Args)
+}
+
+// -------- 通道的读写
+//
+// width 是通道的字节数（1, 2, 4 或者 8），通道的值统一使用 uint64 表示，
+// 写入时只保留低端 width 个字节
+
+func getLane(val *binary.V128, width int, idx int) uint64 {
+	switch width {
+	case 1:
+		return uint64(val[idx])
+	case 2:
+		return uint64(byteOrder.Uint16(val[idx*2:]))
+	case 4:
+		return uint64(byteOrder.Uint32(val[idx*4:]))
+	default:
+		return byteOrder.Uint64(val[idx*8:])
+	}
+}
+
+func setLane(val *binary.V128, width int, idx int, x uint64) {
+	switch width {
+	case 1:
+		val[idx] = byte(x)
+	case 2:
+		byteOrder.PutUint16(val[idx*2:], uint16(x))
+	case 4:
+		byteOrder.PutUint32(val[idx*4:], uint32(x))
+	default:
+		byteOrder.PutUint64(val[idx*8:], x)
+	}
+}
+
+// 把通道的值当作有符号整数，符号扩展为 int64
+func signExtendLane(x uint64, width int) int64 {
+	shift := 64 - width*8
+	return int64(x<<shift) >> shift
+}
+
+// 对每个通道执行一元运算
+func simdUnaryOp(width int, op func(x uint64) uint64) instructionExecFunc {
+	return func(v *vm, _ interface{}) {
+		a := v.operandStack.popV128()
+		var r binary.V128
+		for i := 0; i < 16/width; i++ {
+			setLane(&r, width, i, op(getLane(&a, width, i)))
+		}
+		v.operandStack.pushV128(r)
+	}
+}
+
+// 对每对通道执行二元运算，先弹出的是 rhs（即 y），后弹出的是 lhs（即 x）
+func simdBinaryOp(width int, op func(x, y uint64) uint64) instructionExecFunc {
+	return func(v *vm, _ interface{}) {
+		b, a := v.operandStack.popV128(), v.operandStack.popV128()
+		var r binary.V128
+		for i := 0; i < 16/width; i++ {
+			setLane(&r, width, i, op(getLane(&a, width, i), getLane(&b, width, i)))
+		}
+		v.operandStack.pushV128(r)
+	}
+}
+
+// 比较每对通道，结果为真时通道的所有位都是 1，否则都是 0
+func simdCompareOp(width int, cmp func(x, y uint64) bool) instructionExecFunc {
+	return simdBinaryOp(width, func(x, y uint64) uint64 {
+		if cmp(x, y) {
+			return ^uint64(0)
+		}
+		return 0
+	})
+}
+
+// -------- 常量指令
+//
+// v128.const i128	;; 立即数是 16 个字节
+
+func v128Const(v *vm, args interface{}) {
+	v.operandStack.pushV128(args.(binary.V128))
+}
+
+// -------- 通道指令
+//
+// i8x16.shuffle lane_idx*16	;; 从两个操作数的 32 个字节里挑选 16 个字节组成新的值，索引 0~15 表示 lhs 的字节，16~31 表示 rhs 的字节
+// i8x16.swizzle				;; 同上，不过索引来自 rhs 的各个通道，lhs 作为被挑选的字节，索引超出 15 时结果为 0
+//
+// i8x16.splat					;; 将标量复制到每个通道
+// i16x8.splat
+// i32x4.splat
+// i64x2.splat
+// f32x4.splat
+// f64x2.splat
+//
+// i8x16.extract_lane_s lane_idx	;; 读取指定通道的值
+// i8x16.extract_lane_u lane_idx
+// i16x8.extract_lane_s lane_idx
+// i16x8.extract_lane_u lane_idx
+// i32x4.extract_lane lane_idx
+// i64x2.extract_lane lane_idx
+// f32x4.extract_lane lane_idx
+// f64x2.extract_lane lane_idx
+//
+// i8x16.replace_lane lane_idx		;; 从操作数栈弹出一个标量，替换指定通道的值
+// i16x8.replace_lane lane_idx
+// i32x4.replace_lane lane_idx
+// i64x2.replace_lane lane_idx
+// f32x4.replace_lane lane_idx
+// f64x2.replace_lane lane_idx
+
+func i8x16Shuffle(v *vm, args interface{}) {
+	b, a := v.operandStack.popV128(), v.operandStack.popV128()
+	var r binary.V128
+	for i, lane := range args.([16]binary.LaneIdx) {
+		if lane < 16 {
+			r[i] = a[lane]
+		} else {
+			r[i] = b[lane-16]
+		}
+	}
+	v.operandStack.pushV128(r)
+}
+
+func i8x16Swizzle(v *vm, _ interface{}) {
+	s, a := v.operandStack.popV128(), v.operandStack.popV128()
+	var r binary.V128
+	for i, lane := range s {
+		if lane < 16 {
+			r[i] = a[lane]
+		}
+	}
+	v.operandStack.pushV128(r)
+}
+
+// 浮点数的标量以比特位的形式存放在操作数栈，所以 splat 以及读写通道的指令
+// 无需区分整数和浮点数
+
+func simdSplat(width int) instructionExecFunc {
+	return func(v *vm, _ interface{}) {
+		x := v.operandStack.popU64()
+		var r binary.V128
+		for i := 0; i < 16/width; i++ {
+			setLane(&r, width, i, x)
+		}
+		v.operandStack.pushV128(r)
+	}
+}
+
+func simdExtractLane(width int, signed bool) instructionExecFunc {
+	return func(v *vm, args interface{}) {
+		a := v.operandStack.popV128()
+		x := getLane(&a, width, int(args.(binary.LaneIdx)))
+		if signed {
+			v.operandStack.pushS32(int32(signExtendLane(x, width)))
+		} else {
+			v.operandStack.pushU64(x)
+		}
+	}
+}
+
+func simdReplaceLane(width int) instructionExecFunc {
+	return func(v *vm, args interface{}) {
+		x := v.operandStack.popU64()
+		a := v.operandStack.popV128()
+		setLane(&a, width, int(args.(binary.LaneIdx)), x)
+		v.operandStack.pushV128(a)
+	}
+}
+
+// -------- 按位运算指令
+//
+// v128.not
+// v128.and
+// v128.andnot		;; lhs & ^rhs
+// v128.or
+// v128.xor
+// v128.bitselect	;; 从栈顶依次弹出 c, v2, v1，结果为 (v1 & c) | (v2 & ^c)
+// v128.any_true	;; 任意一位为 1 时结果为 1，否则为 0
+//
+// 按位运算不需要区分通道，所以直接对槽位的高低两部分进行运算
+
+func v128Not(v *vm, _ interface{}) {
+	a := v.operandStack.popSlot()
+	v.operandStack.pushSlot(slot{lo: ^a.lo, hi: ^a.hi})
+}
+
+func v128And(v *vm, _ interface{}) {
+	b, a := v.operandStack.popSlot(), v.operandStack.popSlot()
+	v.operandStack.pushSlot(slot{lo: a.lo & b.lo, hi: a.hi & b.hi})
+}
+
+func v128AndNot(v *vm, _ interface{}) {
+	b, a := v.operandStack.popSlot(), v.operandStack.popSlot()
+	v.operandStack.pushSlot(slot{lo: a.lo &^ b.lo, hi: a.hi &^ b.hi})
+}
+
+func v128Or(v *vm, _ interface{}) {
+	b, a := v.operandStack.popSlot(), v.operandStack.popSlot()
+	v.operandStack.pushSlot(slot{lo: a.lo | b.lo, hi: a.hi | b.hi})
+}
+
+func v128Xor(v *vm, _ interface{}) {
+	b, a := v.operandStack.popSlot(), v.operandStack.popSlot()
+	v.operandStack.pushSlot(slot{lo: a.lo ^ b.lo, hi: a.hi ^ b.hi})
+}
+
+func v128Bitselect(v *vm, _ interface{}) {
+	c, b, a := v.operandStack.popSlot(), v.operandStack.popSlot(), v.operandStack.popSlot()
+	v.operandStack.pushSlot(slot{
+		lo: a.lo&c.lo | b.lo&^c.lo,
+		hi: a.hi&c.hi | b.hi&^c.hi,
+	})
+}
+
+func v128AnyTrue(v *vm, _ interface{}) {
+	a := v.operandStack.popSlot()
+	v.operandStack.pushBool(a.lo != 0 || a.hi != 0)
+}
+
+// -------- 内存指令
+//
+// v128.load memarg				;; 加载 16 个字节
+// v128.load8x8_s memarg		;; 加载 8 个字节，每个字节符号扩展为 16 位，得到 i16x8
+// v128.load8x8_u memarg
+// v128.load16x4_s memarg		;; 加载 8 个字节，每 2 个字节符号扩展为 32 位，得到 i32x4
+// v128.load16x4_u memarg
+// v128.load32x2_s memarg		;; 加载 8 个字节，每 4 个字节符号扩展为 64 位，得到 i64x2
+// v128.load32x2_u memarg
+// v128.load8_splat memarg		;; 加载 1 个字节，然后复制到每个通道
+// v128.load16_splat memarg
+// v128.load32_splat memarg
+// v128.load64_splat memarg
+// v128.load32_zero memarg		;; 加载 4 个字节，写入第 0 个通道，其余通道为 0
+// v128.load64_zero memarg
+// v128.store memarg
+//
+// v128.load8_lane memarg lane_idx	;; 从操作数栈依次弹出 v128 和地址，加载 1 个字节并替换指定通道的值
+// v128.load16_lane memarg lane_idx
+// v128.load32_lane memarg lane_idx
+// v128.load64_lane memarg lane_idx
+// v128.store8_lane memarg lane_idx	;; 从操作数栈依次弹出 v128 和地址，将指定通道的值写入内存
+// v128.store16_lane memarg lane_idx
+// v128.store32_lane memarg lane_idx
+// v128.store64_lane memarg lane_idx
+
+func v128Load(v *vm, memArg interface{}) {
+	var val binary.V128
+	mem, eaddr := getEffectiveAddress(v, memArg)
+	mem.Read(eaddr, val[:])
+	v.operandStack.pushV128(val)
+}
+
+// 加载 8 个字节，然后将每个通道扩展为原来的两倍宽度
+func v128LoadExtend(width int, signed bool) instructionExecFunc {
+	return func(v *vm, memArg interface{}) {
+		var val binary.V128
+		mem, eaddr := getEffectiveAddress(v, memArg)
+		mem.Read(eaddr, val[:8])
+		v.operandStack.pushV128(extendLanes(val, width, false, signed))
+	}
+}
+
+func v128LoadSplat(width int) instructionExecFunc {
+	return func(v *vm, memArg interface{}) {
+		var buf binary.V128
+		mem, eaddr := getEffectiveAddress(v, memArg)
+		mem.Read(eaddr, buf[:width])
+		x := getLane(&buf, width, 0)
+		var r binary.V128
+		for i := 0; i < 16/width; i++ {
+			setLane(&r, width, i, x)
+		}
+		v.operandStack.pushV128(r)
+	}
+}
+
+func v128LoadZero(width int) instructionExecFunc {
+	return func(v *vm, memArg interface{}) {
+		var val binary.V128
+		mem, eaddr := getEffectiveAddress(v, memArg)
+		mem.Read(eaddr, val[:width])
+		v.operandStack.pushV128(val)
+	}
+}
+
+func v128Store(v *vm, memArg interface{}) {
+	val := v.operandStack.popV128()
+	mem, eaddr := getEffectiveAddress(v, memArg)
+	mem.Write(eaddr, val[:])
+}
+
+func v128LoadLane(width int) instructionExecFunc {
+	return func(v *vm, args interface{}) {
+		memLaneArgs := args.(binary.MemLaneArgs)
+		val := v.operandStack.popV128()
+		var buf binary.V128
+		mem, eaddr := getEffectiveAddress(v, memLaneArgs.MemArg)
+		mem.Read(eaddr, buf[:width])
+		setLane(&val, width, int(memLaneArgs.Lane), getLane(&buf, width, 0))
+		v.operandStack.pushV128(val)
+	}
+}
+
+func v128StoreLane(width int) instructionExecFunc {
+	return func(v *vm, args interface{}) {
+		memLaneArgs := args.(binary.MemLaneArgs)
+		val := v.operandStack.popV128()
+		var buf binary.V128
+		setLane(&buf, width, 0, getLane(&val, width, int(memLaneArgs.Lane)))
+		mem, eaddr := getEffectiveAddress(v, memLaneArgs.MemArg)
+		mem.Write(eaddr, buf[:width])
+	}
+}
+
+func init() {
+	// 内存指令
+	simdInstructionTable[binary.V128Load] = v128Load
+	simdInstructionTable[binary.V128Load8x8S] = v128LoadExtend(1, true)
+	simdInstructionTable[binary.V128Load8x8U] = v128LoadExtend(1, false)
+	simdInstructionTable[binary.V128Load16x4S] = v128LoadExtend(2, true)
+	simdInstructionTable[binary.V128Load16x4U] = v128LoadExtend(2, false)
+	simdInstructionTable[binary.V128Load32x2S] = v128LoadExtend(4, true)
+	simdInstructionTable[binary.V128Load32x2U] = v128LoadExtend(4, false)
+	simdInstructionTable[binary.V128Load8Splat] = v128LoadSplat(1)
+	simdInstructionTable[binary.V128Load16Splat] = v128LoadSplat(2)
+	simdInstructionTable[binary.V128Load32Splat] = v128LoadSplat(4)
+	simdInstructionTable[binary.V128Load64Splat] = v128LoadSplat(8)
+	simdInstructionTable[binary.V128Store] = v128Store
+	simdInstructionTable[binary.V128Load32Zero] = v128LoadZero(4)
+	simdInstructionTable[binary.V128Load64Zero] = v128LoadZero(8)
+	simdInstructionTable[binary.V128Load8Lane] = v128LoadLane(1)
+	simdInstructionTable[binary.V128Load16Lane] = v128LoadLane(2)
+	simdInstructionTable[binary.V128Load32Lane] = v128LoadLane(4)
+	simdInstructionTable[binary.V128Load64Lane] = v128LoadLane(8)
+	simdInstructionTable[binary.V128Store8Lane] = v128StoreLane(1)
+	simdInstructionTable[binary.V128Store16Lane] = v128StoreLane(2)
+	simdInstructionTable[binary.V128Store32Lane] = v128StoreLane(4)
+	simdInstructionTable[binary.V128Store64Lane] = v128StoreLane(8)
+
+	// 常量指令
+	simdInstructionTable[binary.V128Const] = v128Const
+
+	// 通道指令
+	simdInstructionTable[binary.I8x16Shuffle] = i8x16Shuffle
+	simdInstructionTable[binary.I8x16Swizzle] = i8x16Swizzle
+	simdInstructionTable[binary.I8x16Splat] = simdSplat(1)
+	simdInstructionTable[binary.I16x8Splat] = simdSplat(2)
+	simdInstructionTable[binary.I32x4Splat] = simdSplat(4)
+	simdInstructionTable[binary.I64x2Splat] = simdSplat(8)
+	simdInstructionTable[binary.F32x4Splat] = simdSplat(4)
+	simdInstructionTable[binary.F64x2Splat] = simdSplat(8)
+	simdInstructionTable[binary.I8x16ExtractLaneS] = simdExtractLane(1, true)
+	simdInstructionTable[binary.I8x16ExtractLaneU] = simdExtractLane(1, false)
+	simdInstructionTable[binary.I8x16ReplaceLane] = simdReplaceLane(1)
+	simdInstructionTable[binary.I16x8ExtractLaneS] = simdExtractLane(2, true)
+	simdInstructionTable[binary.I16x8ExtractLaneU] = simdExtractLane(2, false)
+	simdInstructionTable[binary.I16x8ReplaceLane] = simdReplaceLane(2)
+	simdInstructionTable[binary.I32x4ExtractLane] = simdExtractLane(4, false)
+	simdInstructionTable[binary.I32x4ReplaceLane] = simdReplaceLane(4)
+	simdInstructionTable[binary.I64x2ExtractLane] = simdExtractLane(8, false)
+	simdInstructionTable[binary.I64x2ReplaceLane] = simdReplaceLane(8)
+	simdInstructionTable[binary.F32x4ExtractLane] = simdExtractLane(4, false)
+	simdInstructionTable[binary.F32x4ReplaceLane] = simdReplaceLane(4)
+	simdInstructionTable[binary.F64x2ExtractLane] = simdExtractLane(8, false)
+	simdInstructionTable[binary.F64x2ReplaceLane] = simdReplaceLane(8)
+
+	// 按位运算指令
+	simdInstructionTable[binary.V128Not] = v128Not
+	simdInstructionTable[binary.V128And] = v128And
+	simdInstructionTable[binary.V128AndNot] = v128AndNot
+	simdInstructionTable[binary.V128Or] = v128Or
+	simdInstructionTable[binary.V128Xor] = v128Xor
+	simdInstructionTable[binary.V128Bitselect] = v128Bitselect
+	simdInstructionTable[binary.V128AnyTrue] = v128AnyTrue
+
+	initSIMDIntegerInstructions()
+	initSIMDFloatInstructions()
+}
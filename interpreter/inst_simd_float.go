@@ -0,0 +1,217 @@
+package interpreter
+
+import (
+	"math"
+	"wasmvm/binary"
+)
+
+// ======== SIMD 浮点数运算指令
+//
+// 以下指令对于 f32x4 和 f64x2 都适用：
+//
+// abs, neg, sqrt, ceil, floor, trunc, nearest
+// add, sub, mul, div
+// min, max		;; 跟标量的 min, max 一样，任意一个操作数为 NaN 时结果为 NaN，并且 -0 小于 +0
+// pmin, pmax	;; 伪最小值/最大值（pseudo-minimum），即 C++ 的 std::min，定义为 rhs < lhs ? rhs : lhs
+// eq, ne, lt, gt, le, ge
+//
+// 类型转换指令：
+//
+// i32x4.trunc_sat_f32x4_s, i32x4.trunc_sat_f32x4_u
+// f32x4.convert_i32x4_s, f32x4.convert_i32x4_u
+// i32x4.trunc_sat_f64x2_s_zero, i32x4.trunc_sat_f64x2_u_zero	;; 结果写入低端的 2 个通道，高端的 2 个通道为 0
+// f64x2.convert_low_i32x4_s, f64x2.convert_low_i32x4_u			;; 只转换低端的 2 个通道
+// f32x4.demote_f64x2_zero
+// f64x2.promote_low_f32x4
+//
+// 注：
+// 为了保留 NaN 的负载，abs 和 neg 直接修改符号位
+
+func simdF32UnaryOp(op func(x float32) float32) instructionExecFunc {
+	return simdUnaryOp(4, func(x uint64) uint64 {
+		return uint64(math.Float32bits(op(math.Float32frombits(uint32(x)))))
+	})
+}
+
+func simdF64UnaryOp(op func(x float64) float64) instructionExecFunc {
+	return simdUnaryOp(8, func(x uint64) uint64 {
+		return math.Float64bits(op(math.Float64frombits(x)))
+	})
+}
+
+func simdF32BinaryOp(op func(x, y float32) float32) instructionExecFunc {
+	return simdBinaryOp(4, func(x, y uint64) uint64 {
+		return uint64(math.Float32bits(op(math.Float32frombits(uint32(x)), math.Float32frombits(uint32(y)))))
+	})
+}
+
+func simdF64BinaryOp(op func(x, y float64) float64) instructionExecFunc {
+	return simdBinaryOp(8, func(x, y uint64) uint64 {
+		return math.Float64bits(op(math.Float64frombits(x), math.Float64frombits(y)))
+	})
+}
+
+// f32 的比较先转换为 f64，转换不会改变比较的结果
+func simdF32CompareOp(cmp func(x, y float64) bool) instructionExecFunc {
+	return simdCompareOp(4, func(x, y uint64) bool {
+		return cmp(float64(math.Float32frombits(uint32(x))), float64(math.Float32frombits(uint32(y))))
+	})
+}
+
+func simdF64CompareOp(cmp func(x, y float64) bool) instructionExecFunc {
+	return simdCompareOp(8, func(x, y uint64) bool {
+		return cmp(math.Float64frombits(x), math.Float64frombits(y))
+	})
+}
+
+// 将低端的 2 个 f64 通道转换为 32 位的通道，高端的 2 个通道为 0
+func simdNarrowF64x2Zero(op func(x float64) uint32) instructionExecFunc {
+	return func(v *vm, _ interface{}) {
+		a := v.operandStack.popV128()
+		var r binary.V128
+		for i := 0; i < 2; i++ {
+			setLane(&r, 4, i, uint64(op(math.Float64frombits(getLane(&a, 8, i)))))
+		}
+		v.operandStack.pushV128(r)
+	}
+}
+
+// 将低端的 2 个 32 位的通道转换为 f64 通道
+func simdWidenLowToF64x2(op func(x uint32) float64) instructionExecFunc {
+	return func(v *vm, _ interface{}) {
+		a := v.operandStack.popV128()
+		var r binary.V128
+		for i := 0; i < 2; i++ {
+			setLane(&r, 8, i, math.Float64bits(op(uint32(getLane(&a, 4, i)))))
+		}
+		v.operandStack.pushV128(r)
+	}
+}
+
+// 运算函数
+
+// min, max 使用跟标量指令相同的 f32MinOp 等函数
+
+// pmin 和 pmax 只是比较和选择，结果是原样的操作数（包括 NaN 的负载），
+// 所以 f32 不能经由 f64 计算（转换会设置 NaN 的 quiet 位）
+func fpminOp[T float32 | float64](x, y T) T {
+	if y < x {
+		return y
+	}
+	return x
+}
+
+func fpmaxOp[T float32 | float64](x, y T) T {
+	if x < y {
+		return y
+	}
+	return x
+}
+
+// math.Ceil 等取整函数对 NaN 原样返回，而规范要求结果是设置了 quiet 位的 NaN
+func roundOp(round func(x float64) float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if x != x {
+			return math.Float64frombits(math.Float64bits(x) | 1<<51)
+		}
+		return round(x)
+	}
+}
+
+func feqOp(x, y float64) bool { return x == y }
+func fneOp(x, y float64) bool { return x != y }
+func fltOp(x, y float64) bool { return x < y }
+func fgtOp(x, y float64) bool { return x > y }
+func fleOp(x, y float64) bool { return x <= y }
+func fgeOp(x, y float64) bool { return x >= y }
+
+// 将 f64 的运算函数转换为 f32 的运算函数
+//
+// sqrt 和取整运算经由 f64 计算不会改变结果，
+// 而 add, sub, mul, div 则直接使用 float32 计算，以避免二次舍入
+
+func f32UnaryOp(op func(x float64) float64) func(x float32) float32 {
+	return func(x float32) float32 { return float32(op(float64(x))) }
+}
+
+func initSIMDFloatInstructions() {
+	t := simdInstructionTable
+
+	// f32x4
+	t[binary.F32x4Abs] = simdUnaryOp(4, func(x uint64) uint64 { return x &^ (1 << 31) })
+	t[binary.F32x4Neg] = simdUnaryOp(4, func(x uint64) uint64 { return x ^ (1 << 31) })
+	t[binary.F32x4Sqrt] = simdF32UnaryOp(f32UnaryOp(math.Sqrt))
+	t[binary.F32x4Ceil] = simdF32UnaryOp(f32UnaryOp(roundOp(math.Ceil)))
+	t[binary.F32x4Floor] = simdF32UnaryOp(f32UnaryOp(roundOp(math.Floor)))
+	t[binary.F32x4Trunc] = simdF32UnaryOp(f32UnaryOp(roundOp(math.Trunc)))
+	t[binary.F32x4Nearest] = simdF32UnaryOp(f32UnaryOp(roundOp(math.RoundToEven)))
+	t[binary.F32x4Add] = simdF32BinaryOp(func(x, y float32) float32 { return x + y })
+	t[binary.F32x4Sub] = simdF32BinaryOp(func(x, y float32) float32 { return x - y })
+	t[binary.F32x4Mul] = simdF32BinaryOp(func(x, y float32) float32 { return x * y })
+	t[binary.F32x4Div] = simdF32BinaryOp(func(x, y float32) float32 { return x / y })
+	t[binary.F32x4Min] = simdF32BinaryOp(f32MinOp)
+	t[binary.F32x4Max] = simdF32BinaryOp(f32MaxOp)
+	t[binary.F32x4PMin] = simdF32BinaryOp(fpminOp[float32])
+	t[binary.F32x4PMax] = simdF32BinaryOp(fpmaxOp[float32])
+	t[binary.F32x4Eq] = simdF32CompareOp(feqOp)
+	t[binary.F32x4Ne] = simdF32CompareOp(fneOp)
+	t[binary.F32x4Lt] = simdF32CompareOp(fltOp)
+	t[binary.F32x4Gt] = simdF32CompareOp(fgtOp)
+	t[binary.F32x4Le] = simdF32CompareOp(fleOp)
+	t[binary.F32x4Ge] = simdF32CompareOp(fgeOp)
+
+	// f64x2
+	t[binary.F64x2Abs] = simdUnaryOp(8, func(x uint64) uint64 { return x &^ (1 << 63) })
+	t[binary.F64x2Neg] = simdUnaryOp(8, func(x uint64) uint64 { return x ^ (1 << 63) })
+	t[binary.F64x2Sqrt] = simdF64UnaryOp(math.Sqrt)
+	t[binary.F64x2Ceil] = simdF64UnaryOp(roundOp(math.Ceil))
+	t[binary.F64x2Floor] = simdF64UnaryOp(roundOp(math.Floor))
+	t[binary.F64x2Trunc] = simdF64UnaryOp(roundOp(math.Trunc))
+	t[binary.F64x2Nearest] = simdF64UnaryOp(roundOp(math.RoundToEven))
+	t[binary.F64x2Add] = simdF64BinaryOp(func(x, y float64) float64 { return x + y })
+	t[binary.F64x2Sub] = simdF64BinaryOp(func(x, y float64) float64 { return x - y })
+	t[binary.F64x2Mul] = simdF64BinaryOp(func(x, y float64) float64 { return x * y })
+	t[binary.F64x2Div] = simdF64BinaryOp(func(x, y float64) float64 { return x / y })
+	t[binary.F64x2Min] = simdF64BinaryOp(f64MinOp)
+	t[binary.F64x2Max] = simdF64BinaryOp(f64MaxOp)
+	t[binary.F64x2PMin] = simdF64BinaryOp(fpminOp[float64])
+	t[binary.F64x2PMax] = simdF64BinaryOp(fpmaxOp[float64])
+	t[binary.F64x2Eq] = simdF64CompareOp(feqOp)
+	t[binary.F64x2Ne] = simdF64CompareOp(fneOp)
+	t[binary.F64x2Lt] = simdF64CompareOp(fltOp)
+	t[binary.F64x2Gt] = simdF64CompareOp(fgtOp)
+	t[binary.F64x2Le] = simdF64CompareOp(fleOp)
+	t[binary.F64x2Ge] = simdF64CompareOp(fgeOp)
+
+	// 类型转换
+	t[binary.I32x4TruncSatF32x4S] = simdUnaryOp(4, func(x uint64) uint64 {
+		return uint64(truncSatS(float64(math.Float32frombits(uint32(x))), 32))
+	})
+	t[binary.I32x4TruncSatF32x4U] = simdUnaryOp(4, func(x uint64) uint64 {
+		return truncSatU(float64(math.Float32frombits(uint32(x))), 32)
+	})
+	t[binary.F32x4ConvertI32x4S] = simdUnaryOp(4, func(x uint64) uint64 {
+		return uint64(math.Float32bits(float32(int32(x))))
+	})
+	t[binary.F32x4ConvertI32x4U] = simdUnaryOp(4, func(x uint64) uint64 {
+		return uint64(math.Float32bits(float32(uint32(x))))
+	})
+	t[binary.I32x4TruncSatF64x2SZero] = simdNarrowF64x2Zero(func(x float64) uint32 {
+		return uint32(truncSatS(x, 32))
+	})
+	t[binary.I32x4TruncSatF64x2UZero] = simdNarrowF64x2Zero(func(x float64) uint32 {
+		return uint32(truncSatU(x, 32))
+	})
+	t[binary.F32x4DemoteF64x2Zero] = simdNarrowF64x2Zero(func(x float64) uint32 {
+		return math.Float32bits(float32(x))
+	})
+	t[binary.F64x2ConvertLowI32x4S] = simdWidenLowToF64x2(func(x uint32) float64 {
+		return float64(int32(x))
+	})
+	t[binary.F64x2ConvertLowI32x4U] = simdWidenLowToF64x2(func(x uint32) float64 {
+		return float64(x)
+	})
+	t[binary.F64x2PromoteLowF32x4] = simdWidenLowToF64x2(func(x uint32) float64 {
+		return float64(math.Float32frombits(x))
+	})
+}
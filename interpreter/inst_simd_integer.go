@@ -0,0 +1,425 @@
+package interpreter
+
+import (
+	"math/bits"
+	"wasmvm/binary"
+)
+
+// ======== SIMD 整数运算指令
+//
+// 除了特别说明，以下指令对于 i8x16, i16x8, i32x4, i64x2 都适用（部分指令不支持所有形状）：
+//
+// add, sub, mul（不支持 i8x16）, neg, abs
+// add_sat_s, add_sat_u, sub_sat_s, sub_sat_u	;; 饱和运算，结果超出范围时取最接近的边界值（仅 i8x16, i16x8）
+// min_s, min_u, max_s, max_u					;; 不支持 i64x2
+// avgr_u										;; 取平均值并向上取整，即 (x + y + 1) / 2（仅 i8x16, i16x8）
+// i16x8.q15mulr_sat_s							;; Q15 定点数乘法，即 (x * y + 0x4000) >> 15，然后饱和
+// i32x4.dot_i16x8_s							;; 两两相乘后相邻的两个乘积相加
+// i8x16.popcnt
+//
+// eq, ne, lt_s, lt_u, gt_s, gt_u, le_s, le_u, ge_s, ge_u	;; i64x2 没有无符号比较
+//
+// shl, shr_s, shr_u	;; 移位的位数是一个 i32 标量，对通道的位宽求余
+//
+// all_true		;; 所有通道都不为 0 时结果为 1，否则为 0
+// bitmask		;; 把每个通道的最高位（符号位）组合成一个 i32
+//
+// narrow_s, narrow_u			;; 将两个操作数的通道饱和收窄为一半的位宽，然后拼接
+// extend_low_s, extend_high_s	;; 将低半部分或者高半部分的通道扩展为两倍的位宽
+// extend_low_u, extend_high_u
+// extadd_pairwise_s, extadd_pairwise_u	;; 相邻的两个通道扩展为两倍的位宽之后相加
+// extmul_low_s, extmul_high_s			;; 低半部分或者高半部分的通道扩展为两倍的位宽之后相乘
+// extmul_low_u, extmul_high_u
+
+// 有符号的二元运算
+func simdBinaryOpS(width int, op func(x, y int64) int64) instructionExecFunc {
+	return simdBinaryOp(width, func(x, y uint64) uint64 {
+		return uint64(op(signExtendLane(x, width), signExtendLane(y, width)))
+	})
+}
+
+// 有符号的比较
+func simdCompareOpS(width int, cmp func(x, y int64) bool) instructionExecFunc {
+	return simdCompareOp(width, func(x, y uint64) bool {
+		return cmp(signExtendLane(x, width), signExtendLane(y, width))
+	})
+}
+
+// 将有符号整数饱和到指定位宽的有符号整数的范围
+func saturateS(x int64, width int) int64 {
+	min := -(int64(1) << (width*8 - 1))
+	max := int64(1)<<(width*8-1) - 1
+	if x < min {
+		return min
+	} else if x > max {
+		return max
+	}
+	return x
+}
+
+// 将有符号整数饱和到指定位宽的无符号整数的范围
+func saturateU(x int64, width int) int64 {
+	max := int64(1)<<(width*8) - 1
+	if x < 0 {
+		return 0
+	} else if x > max {
+		return max
+	}
+	return x
+}
+
+func simdAddSatS(width int) instructionExecFunc {
+	return simdBinaryOpS(width, func(x, y int64) int64 { return saturateS(x+y, width) })
+}
+
+func simdAddSatU(width int) instructionExecFunc {
+	return simdBinaryOp(width, func(x, y uint64) uint64 { return uint64(saturateU(int64(x+y), width)) })
+}
+
+func simdSubSatS(width int) instructionExecFunc {
+	return simdBinaryOpS(width, func(x, y int64) int64 { return saturateS(x-y, width) })
+}
+
+func simdSubSatU(width int) instructionExecFunc {
+	return simdBinaryOp(width, func(x, y uint64) uint64 { return uint64(saturateU(int64(x)-int64(y), width)) })
+}
+
+func simdAbs(width int) instructionExecFunc {
+	return simdUnaryOp(width, func(x uint64) uint64 {
+		if signExtendLane(x, width) < 0 {
+			return -x
+		}
+		return x
+	})
+}
+
+// 移位，移位的位数对通道的位宽求余
+func simdShiftOp(width int, op func(x uint64, n uint) uint64) instructionExecFunc {
+	return func(v *vm, _ interface{}) {
+		n := uint(v.operandStack.popU32()) % uint(width*8)
+		a := v.operandStack.popV128()
+		var r binary.V128
+		for i := 0; i < 16/width; i++ {
+			setLane(&r, width, i, op(getLane(&a, width, i), n))
+		}
+		v.operandStack.pushV128(r)
+	}
+}
+
+func simdShrS(width int) instructionExecFunc {
+	return simdShiftOp(width, func(x uint64, n uint) uint64 {
+		return uint64(signExtendLane(x, width) >> n)
+	})
+}
+
+func simdAllTrue(width int) instructionExecFunc {
+	return func(v *vm, _ interface{}) {
+		a := v.operandStack.popV128()
+		for i := 0; i < 16/width; i++ {
+			if getLane(&a, width, i) == 0 {
+				v.operandStack.pushBool(false)
+				return
+			}
+		}
+		v.operandStack.pushBool(true)
+	}
+}
+
+func simdBitmask(width int) instructionExecFunc {
+	return func(v *vm, _ interface{}) {
+		a := v.operandStack.popV128()
+		var mask uint32
+		for i := 0; i < 16/width; i++ {
+			if signExtendLane(getLane(&a, width, i), width) < 0 {
+				mask |= 1 << i
+			}
+		}
+		v.operandStack.pushU32(mask)
+	}
+}
+
+// 将两个操作数的通道（位宽为 width）饱和收窄为 width/2，lhs 的通道在低端，rhs 的在高端
+func simdNarrow(width int, signed bool) instructionExecFunc {
+	return func(v *vm, _ interface{}) {
+		b, a := v.operandStack.popV128(), v.operandStack.popV128()
+		count := 16 / width
+		var r binary.V128
+		for i := 0; i < count*2; i++ {
+			var x int64
+			if i < count {
+				x = signExtendLane(getLane(&a, width, i), width)
+			} else {
+				x = signExtendLane(getLane(&b, width, i-count), width)
+			}
+			if signed {
+				x = saturateS(x, width/2)
+			} else {
+				x = saturateU(x, width/2)
+			}
+			setLane(&r, width/2, i, uint64(x))
+		}
+		v.operandStack.pushV128(r)
+	}
+}
+
+// 将低半部分（high 为 false）或者高半部分的通道（位宽为 width）扩展为 width*2
+func extendLanes(a binary.V128, width int, high bool, signed bool) binary.V128 {
+	count := 8 / width
+	offset := 0
+	if high {
+		offset = count
+	}
+	var r binary.V128
+	for i := 0; i < count; i++ {
+		x := getLane(&a, width, offset+i)
+		if signed {
+			x = uint64(signExtendLane(x, width))
+		}
+		setLane(&r, width*2, i, x)
+	}
+	return r
+}
+
+func simdExtend(width int, high bool, signed bool) instructionExecFunc {
+	return func(v *vm, _ interface{}) {
+		v.operandStack.pushV128(extendLanes(v.operandStack.popV128(), width, high, signed))
+	}
+}
+
+func simdExtaddPairwise(width int, signed bool) instructionExecFunc {
+	return func(v *vm, _ interface{}) {
+		a := v.operandStack.popV128()
+		var r binary.V128
+		for i := 0; i < 8/width; i++ {
+			x, y := getLane(&a, width, i*2), getLane(&a, width, i*2+1)
+			if signed {
+				x, y = uint64(signExtendLane(x, width)), uint64(signExtendLane(y, width))
+			}
+			setLane(&r, width*2, i, x+y)
+		}
+		v.operandStack.pushV128(r)
+	}
+}
+
+func simdExtmul(width int, high bool, signed bool) instructionExecFunc {
+	return func(v *vm, _ interface{}) {
+		b, a := v.operandStack.popV128(), v.operandStack.popV128()
+		x, y := extendLanes(a, width, high, signed), extendLanes(b, width, high, signed)
+		var r binary.V128
+		for i := 0; i < 8/width; i++ {
+			setLane(&r, width*2, i, getLane(&x, width*2, i)*getLane(&y, width*2, i))
+		}
+		v.operandStack.pushV128(r)
+	}
+}
+
+func i32x4DotI16x8S(v *vm, _ interface{}) {
+	b, a := v.operandStack.popV128(), v.operandStack.popV128()
+	var r binary.V128
+	for i := 0; i < 4; i++ {
+		var sum int64
+		for j := i * 2; j < i*2+2; j++ {
+			sum += signExtendLane(getLane(&a, 2, j), 2) * signExtendLane(getLane(&b, 2, j), 2)
+		}
+		setLane(&r, 4, i, uint64(sum))
+	}
+	v.operandStack.pushV128(r)
+}
+
+// 运算函数
+
+func addOp(x, y uint64) uint64 { return x + y }
+func subOp(x, y uint64) uint64 { return x - y }
+func mulOp(x, y uint64) uint64 { return x * y }
+
+func negOp(x uint64) uint64 { return -x }
+
+func minSOp(x, y int64) int64 {
+	if x < y {
+		return x
+	}
+	return y
+}
+
+func maxSOp(x, y int64) int64 {
+	if x > y {
+		return x
+	}
+	return y
+}
+
+func minUOp(x, y uint64) uint64 {
+	if x < y {
+		return x
+	}
+	return y
+}
+
+func maxUOp(x, y uint64) uint64 {
+	if x > y {
+		return x
+	}
+	return y
+}
+
+func avgrUOp(x, y uint64) uint64 { return (x + y + 1) / 2 }
+
+func q15mulrSatSOp(x, y int64) int64 { return saturateS((x*y+0x4000)>>15, 2) }
+
+func popcntOp(x uint64) uint64 { return uint64(bits.OnesCount64(x)) }
+
+func shlOp(x uint64, n uint) uint64  { return x << n }
+func shrUOp(x uint64, n uint) uint64 { return x >> n }
+
+func eqOp(x, y uint64) bool  { return x == y }
+func neOp(x, y uint64) bool  { return x != y }
+func ltUOp(x, y uint64) bool { return x < y }
+func gtUOp(x, y uint64) bool { return x > y }
+func leUOp(x, y uint64) bool { return x <= y }
+func geUOp(x, y uint64) bool { return x >= y }
+func ltSOp(x, y int64) bool  { return x < y }
+func gtSOp(x, y int64) bool  { return x > y }
+func leSOp(x, y int64) bool  { return x <= y }
+func geSOp(x, y int64) bool  { return x >= y }
+
+func initSIMDIntegerInstructions() {
+	// i8x16
+	simdInstructionTable[binary.I8x16Eq] = simdCompareOp(1, eqOp)
+	simdInstructionTable[binary.I8x16Ne] = simdCompareOp(1, neOp)
+	simdInstructionTable[binary.I8x16LtS] = simdCompareOpS(1, ltSOp)
+	simdInstructionTable[binary.I8x16LtU] = simdCompareOp(1, ltUOp)
+	simdInstructionTable[binary.I8x16GtS] = simdCompareOpS(1, gtSOp)
+	simdInstructionTable[binary.I8x16GtU] = simdCompareOp(1, gtUOp)
+	simdInstructionTable[binary.I8x16LeS] = simdCompareOpS(1, leSOp)
+	simdInstructionTable[binary.I8x16LeU] = simdCompareOp(1, leUOp)
+	simdInstructionTable[binary.I8x16GeS] = simdCompareOpS(1, geSOp)
+	simdInstructionTable[binary.I8x16GeU] = simdCompareOp(1, geUOp)
+	simdInstructionTable[binary.I8x16Add] = simdBinaryOp(1, addOp)
+	simdInstructionTable[binary.I8x16Sub] = simdBinaryOp(1, subOp)
+	simdInstructionTable[binary.I8x16AddSatS] = simdAddSatS(1)
+	simdInstructionTable[binary.I8x16AddSatU] = simdAddSatU(1)
+	simdInstructionTable[binary.I8x16SubSatS] = simdSubSatS(1)
+	simdInstructionTable[binary.I8x16SubSatU] = simdSubSatU(1)
+	simdInstructionTable[binary.I8x16MinS] = simdBinaryOpS(1, minSOp)
+	simdInstructionTable[binary.I8x16MinU] = simdBinaryOp(1, minUOp)
+	simdInstructionTable[binary.I8x16MaxS] = simdBinaryOpS(1, maxSOp)
+	simdInstructionTable[binary.I8x16MaxU] = simdBinaryOp(1, maxUOp)
+	simdInstructionTable[binary.I8x16AvgrU] = simdBinaryOp(1, avgrUOp)
+	simdInstructionTable[binary.I8x16Neg] = simdUnaryOp(1, negOp)
+	simdInstructionTable[binary.I8x16Abs] = simdAbs(1)
+	simdInstructionTable[binary.I8x16Shl] = simdShiftOp(1, shlOp)
+	simdInstructionTable[binary.I8x16ShrS] = simdShrS(1)
+	simdInstructionTable[binary.I8x16ShrU] = simdShiftOp(1, shrUOp)
+	simdInstructionTable[binary.I8x16AllTrue] = simdAllTrue(1)
+	simdInstructionTable[binary.I8x16Bitmask] = simdBitmask(1)
+	simdInstructionTable[binary.I8x16PopCnt] = simdUnaryOp(1, popcntOp)
+	simdInstructionTable[binary.I8x16NarrowI16x8S] = simdNarrow(2, true)
+	simdInstructionTable[binary.I8x16NarrowI16x8U] = simdNarrow(2, false)
+
+	// i16x8
+	simdInstructionTable[binary.I16x8Eq] = simdCompareOp(2, eqOp)
+	simdInstructionTable[binary.I16x8Ne] = simdCompareOp(2, neOp)
+	simdInstructionTable[binary.I16x8LtS] = simdCompareOpS(2, ltSOp)
+	simdInstructionTable[binary.I16x8LtU] = simdCompareOp(2, ltUOp)
+	simdInstructionTable[binary.I16x8GtS] = simdCompareOpS(2, gtSOp)
+	simdInstructionTable[binary.I16x8GtU] = simdCompareOp(2, gtUOp)
+	simdInstructionTable[binary.I16x8LeS] = simdCompareOpS(2, leSOp)
+	simdInstructionTable[binary.I16x8LeU] = simdCompareOp(2, leUOp)
+	simdInstructionTable[binary.I16x8GeS] = simdCompareOpS(2, geSOp)
+	simdInstructionTable[binary.I16x8GeU] = simdCompareOp(2, geUOp)
+	simdInstructionTable[binary.I16x8Add] = simdBinaryOp(2, addOp)
+	simdInstructionTable[binary.I16x8Sub] = simdBinaryOp(2, subOp)
+	simdInstructionTable[binary.I16x8Mul] = simdBinaryOp(2, mulOp)
+	simdInstructionTable[binary.I16x8AddSatS] = simdAddSatS(2)
+	simdInstructionTable[binary.I16x8AddSatU] = simdAddSatU(2)
+	simdInstructionTable[binary.I16x8SubSatS] = simdSubSatS(2)
+	simdInstructionTable[binary.I16x8SubSatU] = simdSubSatU(2)
+	simdInstructionTable[binary.I16x8MinS] = simdBinaryOpS(2, minSOp)
+	simdInstructionTable[binary.I16x8MinU] = simdBinaryOp(2, minUOp)
+	simdInstructionTable[binary.I16x8MaxS] = simdBinaryOpS(2, maxSOp)
+	simdInstructionTable[binary.I16x8MaxU] = simdBinaryOp(2, maxUOp)
+	simdInstructionTable[binary.I16x8AvgrU] = simdBinaryOp(2, avgrUOp)
+	simdInstructionTable[binary.I16x8Neg] = simdUnaryOp(2, negOp)
+	simdInstructionTable[binary.I16x8Abs] = simdAbs(2)
+	simdInstructionTable[binary.I16x8Shl] = simdShiftOp(2, shlOp)
+	simdInstructionTable[binary.I16x8ShrS] = simdShrS(2)
+	simdInstructionTable[binary.I16x8ShrU] = simdShiftOp(2, shrUOp)
+	simdInstructionTable[binary.I16x8AllTrue] = simdAllTrue(2)
+	simdInstructionTable[binary.I16x8Bitmask] = simdBitmask(2)
+	simdInstructionTable[binary.I16x8Q15MulrSatS] = simdBinaryOpS(2, q15mulrSatSOp)
+	simdInstructionTable[binary.I16x8NarrowI32x4S] = simdNarrow(4, true)
+	simdInstructionTable[binary.I16x8NarrowI32x4U] = simdNarrow(4, false)
+	simdInstructionTable[binary.I16x8ExtendLowI8x16S] = simdExtend(1, false, true)
+	simdInstructionTable[binary.I16x8ExtendLowI8x16U] = simdExtend(1, false, false)
+	simdInstructionTable[binary.I16x8ExtendHighI8x16S] = simdExtend(1, true, true)
+	simdInstructionTable[binary.I16x8ExtendHighI8x16U] = simdExtend(1, true, false)
+	simdInstructionTable[binary.I16x8ExtmulLowI8x16S] = simdExtmul(1, false, true)
+	simdInstructionTable[binary.I16x8ExtmulLowI8x16U] = simdExtmul(1, false, false)
+	simdInstructionTable[binary.I16x8ExtmulHighI8x16S] = simdExtmul(1, true, true)
+	simdInstructionTable[binary.I16x8ExtmulHighI8x16U] = simdExtmul(1, true, false)
+	simdInstructionTable[binary.I16x8ExtaddPairwiseI8x16S] = simdExtaddPairwise(1, true)
+	simdInstructionTable[binary.I16x8ExtaddPairwiseI8x16U] = simdExtaddPairwise(1, false)
+
+	// i32x4
+	simdInstructionTable[binary.I32x4Eq] = simdCompareOp(4, eqOp)
+	simdInstructionTable[binary.I32x4Ne] = simdCompareOp(4, neOp)
+	simdInstructionTable[binary.I32x4LtS] = simdCompareOpS(4, ltSOp)
+	simdInstructionTable[binary.I32x4LtU] = simdCompareOp(4, ltUOp)
+	simdInstructionTable[binary.I32x4GtS] = simdCompareOpS(4, gtSOp)
+	simdInstructionTable[binary.I32x4GtU] = simdCompareOp(4, gtUOp)
+	simdInstructionTable[binary.I32x4LeS] = simdCompareOpS(4, leSOp)
+	simdInstructionTable[binary.I32x4LeU] = simdCompareOp(4, leUOp)
+	simdInstructionTable[binary.I32x4GeS] = simdCompareOpS(4, geSOp)
+	simdInstructionTable[binary.I32x4GeU] = simdCompareOp(4, geUOp)
+	simdInstructionTable[binary.I32x4Add] = simdBinaryOp(4, addOp)
+	simdInstructionTable[binary.I32x4Sub] = simdBinaryOp(4, subOp)
+	simdInstructionTable[binary.I32x4Mul] = simdBinaryOp(4, mulOp)
+	simdInstructionTable[binary.I32x4MinS] = simdBinaryOpS(4, minSOp)
+	simdInstructionTable[binary.I32x4MinU] = simdBinaryOp(4, minUOp)
+	simdInstructionTable[binary.I32x4MaxS] = simdBinaryOpS(4, maxSOp)
+	simdInstructionTable[binary.I32x4MaxU] = simdBinaryOp(4, maxUOp)
+	simdInstructionTable[binary.I32x4Neg] = simdUnaryOp(4, negOp)
+	simdInstructionTable[binary.I32x4Abs] = simdAbs(4)
+	simdInstructionTable[binary.I32x4Shl] = simdShiftOp(4, shlOp)
+	simdInstructionTable[binary.I32x4ShrS] = simdShrS(4)
+	simdInstructionTable[binary.I32x4ShrU] = simdShiftOp(4, shrUOp)
+	simdInstructionTable[binary.I32x4AllTrue] = simdAllTrue(4)
+	simdInstructionTable[binary.I32x4Bitmask] = simdBitmask(4)
+	simdInstructionTable[binary.I32x4DotI16x8S] = i32x4DotI16x8S
+	simdInstructionTable[binary.I32x4ExtendLowI16x8S] = simdExtend(2, false, true)
+	simdInstructionTable[binary.I32x4ExtendLowI16x8U] = simdExtend(2, false, false)
+	simdInstructionTable[binary.I32x4ExtendHighI16x8S] = simdExtend(2, true, true)
+	simdInstructionTable[binary.I32x4ExtendHighI16x8U] = simdExtend(2, true, false)
+	simdInstructionTable[binary.I32x4ExtmulLowI16x8S] = simdExtmul(2, false, true)
+	simdInstructionTable[binary.I32x4ExtmulLowI16x8U] = simdExtmul(2, false, false)
+	simdInstructionTable[binary.I32x4ExtmulHighI16x8S] = simdExtmul(2, true, true)
+	simdInstructionTable[binary.I32x4ExtmulHighI16x8U] = simdExtmul(2, true, false)
+	simdInstructionTable[binary.I32x4ExtaddPairwiseI16x8S] = simdExtaddPairwise(2, true)
+	simdInstructionTable[binary.I32x4ExtaddPairwiseI16x8U] = simdExtaddPairwise(2, false)
+
+	// i64x2
+	simdInstructionTable[binary.I64x2Eq] = simdCompareOp(8, eqOp)
+	simdInstructionTable[binary.I64x2Ne] = simdCompareOp(8, neOp)
+	simdInstructionTable[binary.I64x2LtS] = simdCompareOpS(8, ltSOp)
+	simdInstructionTable[binary.I64x2GtS] = simdCompareOpS(8, gtSOp)
+	simdInstructionTable[binary.I64x2LeS] = simdCompareOpS(8, leSOp)
+	simdInstructionTable[binary.I64x2GeS] = simdCompareOpS(8, geSOp)
+	simdInstructionTable[binary.I64x2Add] = simdBinaryOp(8, addOp)
+	simdInstructionTable[binary.I64x2Sub] = simdBinaryOp(8, subOp)
+	simdInstructionTable[binary.I64x2Mul] = simdBinaryOp(8, mulOp)
+	simdInstructionTable[binary.I64x2Neg] = simdUnaryOp(8, negOp)
+	simdInstructionTable[binary.I64x2Abs] = simdAbs(8)
+	simdInstructionTable[binary.I64x2Shl] = simdShiftOp(8, shlOp)
+	simdInstructionTable[binary.I64x2ShrS] = simdShrS(8)
+	simdInstructionTable[binary.I64x2ShrU] = simdShiftOp(8, shrUOp)
+	simdInstructionTable[binary.I64x2AllTrue] = simdAllTrue(8)
+	simdInstructionTable[binary.I64x2Bitmask] = simdBitmask(8)
+	simdInstructionTable[binary.I64x2ExtendLowI32x4S] = simdExtend(4, false, true)
+	simdInstructionTable[binary.I64x2ExtendLowI32x4U] = simdExtend(4, false, false)
+	simdInstructionTable[binary.I64x2ExtendHighI32x4S] = simdExtend(4, true, true)
+	simdInstructionTable[binary.I64x2ExtendHighI32x4U] = simdExtend(4, true, false)
+	simdInstructionTable[binary.I64x2ExtmulLowI32x4S] = simdExtmul(4, false, true)
+	simdInstructionTable[binary.I64x2ExtmulLowI32x4U] = simdExtmul(4, false, false)
+	simdInstructionTable[binary.I64x2ExtmulHighI32x4S] = simdExtmul(4, true, true)
+	simdInstructionTable[binary.I64x2ExtmulHighI32x4U] = simdExtmul(4, true, false)
+}
@@ -0,0 +1,118 @@
+package interpreter
+
+import "wasmvm/instance"
+
+// ======== 表指令
+//
+// table.init elem_idx table_idx
+// table.copy dst_table_idx src_table_idx
+//
+// 跟批量内存指令类似，这两条指令从操作数栈依次弹出 n（元素数量）、
+// 源索引（s）、目标索引（d）三个 uint32，访问的范围超出了表（或者元素项）
+// 的大小时，即使 n 为 0 也会发生陷阱，而且发生陷阱时表不会被修改。
+//
+// elem.drop elem_idx
+// 丢弃元素项，之后的 table.init 指令视该元素项的长度为 0
+//
+// table.get table_idx
+// table.set table_idx
+// 读取（写入）表项，索引超出表的大小时发生陷阱
+//
+// table.size table_idx
+// table.grow table_idx
+// 跟 memory.size 和 memory.grow 类似，table.grow 从操作数栈依次弹出
+// 新增的表项数量 n 和新表项的初始值，返回原来的大小，失败时返回 -1
+//
+// table.fill table_idx
+// 从操作数栈依次弹出 n、值、起始索引 i，将表从 i 开始的 n 个表项设为该值
+//
+// 表项在操作数栈上是引用的句柄，在表里是引用的 Go 值，见 value_ref.go
+//
+// 表以及元素项的索引在预编译时被解码，见 indexedExecFunc
+
+func tableInit(v *vm, elemIdx uint32, tableIdx uint32) {
+	n := uint64(v.operandStack.popU32())
+	s := uint64(v.operandStack.popU32())
+	d := uint64(v.operandStack.popU32())
+	v.initTableFromElem(tableIdx, elemIdx, d, s, n)
+}
+
+// 将元素项从 s 开始的 n 个引用写入指定表的 d 位置，实例化时初始化表也使用这个方法
+func (v *vm) initTableFromElem(tableIdx uint32, elemIdx uint32, d uint64, s uint64, n uint64) {
+	table := v.tables[tableIdx]
+	elems := v.elemSegs[elemIdx]
+	if s+n > uint64(len(elems)) || d+n > uint64(table.Size()) {
+		panic(instance.NewTrap(instance.TrapTableOutOfBounds))
+	}
+	for i, ref := range elems[s : s+n] {
+		table.SetElem(uint32(d)+uint32(i), ref)
+	}
+}
+
+func elemDrop(v *vm, elemIdx uint32, _ uint32) {
+	v.elemSegs[elemIdx] = nil
+}
+
+func tableCopy(v *vm, dstIdx uint32, srcIdx uint32) {
+	n := uint64(v.operandStack.popU32())
+	s := uint64(v.operandStack.popU32())
+	d := uint64(v.operandStack.popU32())
+
+	src := v.tables[srcIdx]
+	dst := v.tables[dstIdx]
+	if s+n > uint64(src.Size()) || d+n > uint64(dst.Size()) {
+		panic(instance.NewTrap(instance.TrapTableOutOfBounds))
+	}
+
+	// 源和目标的范围可能重叠，所以先读出全部元素再写入
+	elems := make([]instance.WasmVal, n)
+	for i := range elems {
+		elems[i] = src.GetElem(uint32(s) + uint32(i))
+	}
+	for i, ref := range elems {
+		dst.SetElem(uint32(d)+uint32(i), ref)
+	}
+}
+
+func tableGet(v *vm, tableIdx uint32, _ uint32) {
+	i := v.operandStack.popU32()
+	v.operandStack.pushU64(v.refs.toHandle(v.tables[tableIdx].GetElem(i)))
+}
+
+func tableSet(v *vm, tableIdx uint32, _ uint32) {
+	ref := v.refs.fromHandle(v.operandStack.popU64())
+	i := v.operandStack.popU32()
+	v.tables[tableIdx].SetElem(i, ref)
+}
+
+func tableSize(v *vm, tableIdx uint32, _ uint32) {
+	v.operandStack.pushU32(v.tables[tableIdx].Size())
+}
+
+func tableGrow(v *vm, tableIdx uint32, _ uint32) {
+	n := v.operandStack.popU32()
+	ref := v.refs.fromHandle(v.operandStack.popU64())
+
+	table := v.tables[tableIdx]
+	previousSize := table.Grow(n)
+	if int32(previousSize) != -1 {
+		for i := uint32(0); i < n; i++ {
+			table.SetElem(previousSize+i, ref)
+		}
+	}
+	v.operandStack.pushU32(previousSize)
+}
+
+func tableFill(v *vm, tableIdx uint32, _ uint32) {
+	n := uint64(v.operandStack.popU32())
+	ref := v.refs.fromHandle(v.operandStack.popU64())
+	i := uint64(v.operandStack.popU32())
+
+	table := v.tables[tableIdx]
+	if i+n > uint64(table.Size()) {
+		panic(instance.NewTrap(instance.TrapTableOutOfBounds))
+	}
+	for j := uint32(0); j < uint32(n); j++ {
+		table.SetElem(uint32(i)+j, ref)
+	}
+}
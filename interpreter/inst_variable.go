@@ -1,5 +1,7 @@
 package interpreter
 
+import "wasmvm/binary"
+
 // ======== 变量指令
 //
 // 读写局部/全局变量
@@ -11,34 +13,44 @@ package interpreter
 // global.get global_idx:uint32	;; 读取指定索引的全局变量的值，压入操作数栈
 // global.set global_idx:uint32	;; 从操作数栈弹出一个数，写入到指定索引的全局变量；弹出的数的类型必须跟全局变量的一致
 
-func localGet(v *vm, args interface{}) {
-	idx := args.(uint32)
-	val := v.operandStack.getOperand(v.local0Idx + idx)
-	v.operandStack.pushU64(val)
+// 局部变量的索引在预编译时已经被解码，见 vm.execFrame()
+
+func localGet(v *vm, frame *controlFrame, idx uint64) {
+	val := v.operandStack.getOperand(uint32(frame.bp) + uint32(idx))
+	v.operandStack.pushSlot(val)
 }
 
-func localSet(v *vm, args interface{}) {
-	idx := args.(uint32)
-	val := v.operandStack.popU64()
-	v.operandStack.setOperand(v.local0Idx+idx, val)
+func localSet(v *vm, frame *controlFrame, idx uint64) {
+	val := v.operandStack.popSlot()
+	v.operandStack.setOperand(uint32(frame.bp)+uint32(idx), val)
 }
 
-func localTee(v *vm, args interface{}) {
-	idx := args.(uint32)
+func localTee(v *vm, frame *controlFrame, idx uint64) {
 	// val := vm.operandStack.popU64()
 	// vm.operandStack.pushU64(val)
 	val := v.operandStack.peekValue()
-	v.operandStack.setOperand(v.local0Idx+idx, val)
+	v.operandStack.setOperand(uint32(frame.bp)+uint32(idx), val)
 }
 
-func globalGet(v *vm, args interface{}) {
-	idx := args.(uint32)
-	val := v.globals[idx].GetAsU64()
-	v.operandStack.pushU64(val)
+// 全局变量的索引在预编译时也已经被解码（opGlobalGet/opGlobalSet），
+// 经由 instructionTable 执行（比如常量表达式里的 global.get）时才需要类型断言。
+// v128 和引用类型的全局变量无法使用 GetAsU64/SetAsU64 读写，需要经由 Get/Set，
+// 引用在全局变量里是 Go 的值，在操作数栈上是句柄，见 value_ref.go
+
+func globalGet(v *vm, idx uint32) {
+	global := v.globals[idx]
+	if vt := global.Type().ValType; vt == binary.ValTypeV128 || binary.IsRefType(vt) {
+		v.operandStack.pushSlot(v.unwrapSlot(vt, global.Get()))
+		return
+	}
+	v.operandStack.pushU64(global.GetAsU64())
 }
 
-func globalSet(v *vm, args interface{}) {
-	idx := args.(uint32)
-	val := v.operandStack.popU64()
-	v.globals[idx].SetAsU64(val)
+func globalSet(v *vm, idx uint32) {
+	global := v.globals[idx]
+	if vt := global.Type().ValType; vt == binary.ValTypeV128 || binary.IsRefType(vt) {
+		global.Set(v.wrapSlot(vt, v.operandStack.popSlot()))
+		return
+	}
+	global.SetAsU64(v.operandStack.popU64())
 }
@@ -0,0 +1,112 @@
+package interpreter
+
+import "wasmvm/instance"
+
+// 引用类型的值（funcref 和 externref）
+//
+// 表、全局变量以及元素项直接存放引用的 Go 值（函数或者宿主传入的任意值），
+// 表和全局变量可以被多个模块实例共享，值随着它们一起被回收。
+//
+// 操作数栈和局部变量的槽位存放的是整数，所以引用在操作数栈上使用 `句柄`（handle）表示：
+// 引用值登记在模块实例（vm）自己的引用表里，句柄就是它在表里的位置加 1，
+// 句柄 0 表示空引用（ref.null）。引用从表、全局变量、参数等读取到操作数栈时登记，
+// 写回表或者全局变量、作为返回值返回给宿主时再转换为 Go 的值。
+//
+// 在宿主那一侧（即 WasmVal）：
+// - funcref 的值是 instance.Function
+// - externref 的值可以是任意的 Go 值，虚拟机不会读取它的内容
+// - 空引用是 nil
+//
+// 句柄只在调用期间有效：最外层的调用结束之后操作数栈是空的，不再有句柄，
+// 所以引用表被清空（见 vmFunc.eval），登记的值不会一直被模块实例引用。
+// 一个模块实例同一时间只被一个 goroutine 使用，所以引用表不需要加锁。
+
+type refTable struct {
+	vals []interface{}
+	idxs map[interface{}]uint64 // 值到句柄的映射，用于避免在同一次调用里重复登记
+}
+
+// 模块内部函数在引用表里的键，vmFunc 包含函数体，无法直接作为 map 的键
+type funcRefKey struct {
+	vm  *vm
+	idx uint32
+}
+
+func refKey(val interface{}) interface{} {
+	if f, ok := val.(vmFunc); ok {
+		if f.func_ != nil {
+			return refKey(f.func_)
+		}
+		return funcRefKey{vm: f.vm, idx: f.idx}
+	}
+	return val
+}
+
+// 获取值的句柄，值未登记时先登记
+func (t *refTable) toHandle(val interface{}) uint64 {
+	if val == nil {
+		return 0
+	}
+
+	key := refKey(val)
+	if handle, ok := t.lookup(key); ok {
+		return handle
+	}
+
+	t.vals = append(t.vals, val)
+	handle := uint64(len(t.vals))
+	t.store(key, handle)
+	return handle
+}
+
+func (t *refTable) fromHandle(handle uint64) interface{} {
+	if handle == 0 {
+		return nil
+	}
+	return t.vals[handle-1]
+}
+
+// 清空引用表，之前的句柄都不再有效
+func (t *refTable) reset() {
+	if len(t.vals) > 0 {
+		t.vals = nil
+		t.idxs = nil
+	}
+}
+
+// 不可以比较的值（比如 slice，或者包含 slice 的结构体）作为 map 的键时会 panic，
+// 这种值每次都登记为新的句柄
+func (t *refTable) lookup(key interface{}) (handle uint64, ok bool) {
+	defer func() {
+		if recover() != nil {
+			handle, ok = 0, false
+		}
+	}()
+	handle, ok = t.idxs[key]
+	return
+}
+
+func (t *refTable) store(key interface{}, handle uint64) {
+	defer func() {
+		recover()
+	}()
+	if t.idxs == nil {
+		t.idxs = map[interface{}]uint64{}
+	}
+	t.idxs[key] = handle
+}
+
+// 将句柄转换为 funcref 的值，空引用为 nil
+func (v *vm) wrapFuncRef(handle uint64) instance.WasmVal {
+	if handle == 0 {
+		return nil
+	}
+	return v.refs.fromHandle(handle).(instance.Function)
+}
+
+func (v *vm) unwrapFuncRef(val instance.WasmVal) uint64 {
+	if val == nil {
+		return 0
+	}
+	return v.refs.toHandle(val.(instance.Function))
+}
@@ -6,6 +6,34 @@ import (
 	"wasmvm/binary"
 )
 
+// v128 的值占用整个槽位，其余类型的值只占用槽位的低 64 位，
+// 引用类型的值在槽位里是句柄，需要经由模块实例的引用表转换，见 value_ref.go
+
+func (v *vm) wrapSlot(vt binary.ValType, val slot) interface{} {
+	switch vt {
+	case binary.ValTypeV128:
+		return slotToV128(val)
+	case binary.ValTypeFuncRef:
+		return v.wrapFuncRef(val.lo)
+	case binary.ValTypeExternRef:
+		return v.refs.fromHandle(val.lo)
+	}
+	return wrapU64(vt, val.lo)
+}
+
+func (v *vm) unwrapSlot(vt binary.ValType, val interface{}) slot {
+	switch vt {
+	case binary.ValTypeV128:
+		return v128ToSlot(val.(binary.V128))
+	case binary.ValTypeFuncRef:
+		return slot{lo: v.unwrapFuncRef(val)}
+	case binary.ValTypeExternRef:
+		return slot{lo: v.refs.toHandle(val)}
+	}
+	return slot{lo: unwrapU64(vt, val)}
+}
+
+// 数值类型（不包括 v128）的值跟 uint64 相互转换
 func wrapU64(vt binary.ValType, val uint64) interface{} {
 	switch vt {
 	case binary.ValTypeI32:
@@ -1,8 +1,10 @@
 package interpreter
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"wasmvm/binary"
 	"wasmvm/instance"
 )
@@ -12,8 +14,9 @@ type vm struct {
 	controlStack controlStack
 	module       binary.Module
 
-	table  instance.Table  // 目前只允许定义一张表
-	memory instance.Memory // 目前只允许定义一块内存
+	// 所有表和内存块（包括导入的表和内存块），导入的排在前面
+	tables   []instance.Table
+	memories []instance.Memory
 
 	// 统一了模块内（用户自定义）函数和外部函数（本地函数/native function）
 	// 的总函数列表
@@ -22,67 +25,67 @@ type vm struct {
 	// 全局变量表
 	globals []instance.Global
 
-	// 记录第一个局部变量（包括函数参数）在栈中的位置，用于
-	// 方便按索引访问栈中的局部变量，它的值等于从栈顶开始
-	// 第一个 `函数调用帧` 的 BP（base pointer）
-	// 对于函数内的流程控制所产生帧，不更新 local0Idx 的值。
-	local0Idx uint32 // name: currentCallFrameBasePointer
+	// 所有异常标签（包括导入的标签），导入的排在前面
+	tags []instance.Tag
 
-	// 注：
-	// 目前局部变量（包括函数参数）表直接在操作栈中实现
-}
+	// 元素项和数据项的内容，用于 table.init 和 memory.init 指令，
+	// 被丢弃（包括实例化时已经写入的主动项）的项为 nil，
+	// 元素项的内容是引用的 Go 值（见 value_ref.go）
+	elemSegs [][]instance.WasmVal
+	dataSegs [][]byte
 
-func (v *vm) enterBlock(opcode byte, func_type binary.FuncType,
-	instructions []binary.Instruction) {
-	bp := v.operandStack.stackSize() - len(func_type.ParamTypes)
-	frame := newControlFrame(opcode, func_type, instructions, bp)
-	v.controlStack.pushControlFrame(frame)
+	// 名称段里的函数名称，用于生成陷阱的调用栈
+	funcNames binary.NameMap
 
-	if opcode == binary.Call {
-		v.local0Idx = uint32(bp)
-	}
-}
+	config       Config
+	maxCallDepth int // 调用栈的最大深度
 
-func (v *vm) exitBlock() { // name: leaveBlock
-	frame := v.controlStack.popControlFrame() // 消掉当前控制帧
-	v.clearBlock(frame)                       // 做一些离开 `被调用者` 之后的清理工作
-}
+	fuel      uint64       // 剩余的燃料
+	fuelCosts *[256]uint64 // 各操作码消耗的燃料
 
-// todo:: 考虑把 clearBlock 函数合并到 exitBlock
-func (v *vm) clearBlock(frame *controlFrame) {
-	// 这里的 controlFrame 是退出的 `目标层`，而不是 `源层`
+	regs bool // 是否使用寄存器引擎，见 vm_register.go
 
-	// 丢弃自当前函数 bp (base pointer) 以后产生的所有操作数槽，防止 `被调用者` 产生的
-	// 残留数据。
-	residues := v.operandStack.stackSize() - frame.bp - len(frame.bt.ResultTypes)
+	refs refTable // 操作数栈上的引用的句柄，见 value_ref.go
 
-	if residues > 0 {
-		// 先弹出有用的数据（即返回值）
-		returnValues := v.operandStack.popValues(len(frame.bt.ResultTypes))
-		// 丢弃残留数据
-		v.operandStack.popValues(residues)
-		// 再压入有用的数据
-		v.operandStack.pushValues(returnValues)
-	}
+	// 中断，见 vm_interrupt.go
+	interrupted int32           // 不为 0 时表示请求中断，只能使用 atomic 读写
+	done        <-chan struct{} // 当前调用的 context 的 Done()，可以为 nil
+	interruptMu sync.Mutex
+	interruptCh chan struct{} // 请求中断时被关闭，用于唤醒阻塞的 memory.atomic.wait，可以为 nil
 
-	// 如果当前是函数退出，则还需要
-	// 更新 local0Idx 的值
-	if frame.opcode == binary.Call &&
-		v.controlStack.controlDepth() > 0 {
-		lastCallFrame := v.controlStack.topCallFrame()
-		v.local0Idx = uint32(lastCallFrame.bp)
-	}
+	started bool // start 函数是否已经执行，见 vm_start.go
 
+	// 注：
+	// 目前局部变量（包括函数参数）表直接在操作栈中实现，
+	// 第 0 个局部变量位于当前调用帧的 bp（base pointer）
 }
 
-func (v *vm) resetBlock(frame *controlFrame) {
-	// 注意这里要弹出目标层参数所需数量的操作数，而不是 `源层` 的返回值数量的操作数。
-	targetBlockArguments := v.operandStack.popValues(len(frame.bt.ParamTypes))
+// 创建被调用函数的调用帧
+func (v *vm) enterFunc(f vmFunc) {
+	if v.controlStack.controlDepth() >= v.maxCallDepth {
+		panic(instance.NewTrap(instance.TrapStackExhausted))
+	}
 
-	// 丢弃目标层 bp 到栈顶的数据
-	v.operandStack.popValues(v.operandStack.stackSize() - frame.bp)
+	bp := v.operandStack.stackSize() - len(f.type_.ParamTypes)
+	v.controlStack.pushControlFrame(newControlFrame(f.compiled, bp, f.idx))
+}
+
+// 函数返回，移除当前函数的调用帧
+//
+// 丢弃自当前函数 bp (base pointer) 以后产生的所有操作数槽（包括实参和局部变量），
+// 只保留栈顶的 arity 个返回值，让返回值刚好接在调用前的栈顶（除了实参之外的位置）。
+func (v *vm) exitFunc(arity int) {
+	frame := v.controlStack.popControlFrame()
+	v.operandStack.dropKeep(frame.bp, arity)
+}
 
-	v.operandStack.pushValues(targetBlockArguments)
+// 移除当前函数的调用帧，用于尾调用
+//
+// 栈顶的 argCount 个操作数（即目标函数的实参）会被移动到当前函数的 bp 处，
+// 当前函数的实参、局部变量以及运算槽位都被丢弃，接下来调用目标函数时，
+// 目标函数的调用帧就取代了当前函数的调用帧。
+func (v *vm) leaveCallFrame(argCount int) {
+	v.exitFunc(argCount)
 }
 
 type instructionExecFunc = func(v *vm, args interface{})
@@ -90,26 +93,79 @@ type instructionExecFunc = func(v *vm, args interface{})
 // 指令的解析/执行函数表
 var instructionTable = make([]instructionExecFunc, 256)
 
+// 立即数是内存、表、数据项或者元素项的索引的指令（memory.size/grow、批量内存指令以及表指令），
+// 预编译时索引被解码为 compiledInstr 的 a 和 b，执行时不需要对 interface{} 进行类型断言，
+// 只有一个索引的指令忽略 b
+type indexedExecFunc = func(v *vm, a uint32, b uint32)
+
+// 键为操作码（opIndexed）
+var indexedInstructionTable = [256]indexedExecFunc{
+	binary.MemorySize: memorySize,
+	binary.MemoryGrow: memoryGrow,
+	binary.TableGet:   tableGet,
+	binary.TableSet:   tableSet,
+}
+
+// 键为 0xFC 前缀指令的子操作码（opMiscIndexed），在 inst_misc.go 里初始化
+var miscIndexedInstructionTable = make([]indexedExecFunc, binary.GetMiscOpcodeCount())
+
+// 解码指令的索引立即数，两个索引按照指令的文本格式的顺序排列
+func decodeIndices(args interface{}) (a uint32, b uint32) {
+	switch args := args.(type) {
+	case uint32:
+		return args, 0
+	case binary.MemoryInitArgs:
+		return args.Data, args.Mem
+	case binary.MemoryCopyArgs:
+		return args.Dst, args.Src
+	case binary.TableInitArgs:
+		return args.Elem, args.Table
+	case binary.TableCopyArgs:
+		return args.Dst, args.Src
+	}
+	return 0, 0
+}
+
+// 用于没有经过预编译的指令
+func withIndices(f indexedExecFunc) instructionExecFunc {
+	return func(v *vm, args interface{}) {
+		a, b := decodeIndices(args)
+		f(v, a, b)
+	}
+}
+
 func NewModule(m binary.Module, mm map[string]instance.Module) instance.Module {
 	return newVM(m, mm)
 }
 
+func NewModuleWithConfig(m binary.Module, mm map[string]instance.Module, config Config) instance.Module {
+	return newVMWithConfig(m, mm, config)
+}
+
 func newVM(m binary.Module, mm map[string]instance.Module) *vm {
-	v := &vm{module: m}
+	return newVMWithConfig(m, mm, Config{})
+}
+
+func newVMWithConfig(m binary.Module, mm map[string]instance.Module, config Config) *vm {
+	v := &vm{module: m, funcNames: m.GetNameSec().FuncNames}
+	v.setConfig(config)
 	v.linkImports(mm)
+	v.initTags()
 	v.initFuncs()
 	v.initTable()
 	v.initMem()
 	v.initGlobals()
-	// v.execStartFunc()
-	// // v.execFunc(func_idx)
-	// // return v.operandStack.slots, dumpMemory(v.memory)
+	if !config.DeferStart {
+		v.execStartFunc()
+	}
 	return v
 }
 
 func newVMWithInitMemoryData(m binary.Module, mm map[string]instance.Module, init_memory_data []byte) *vm {
-	v := &vm{module: m}
+	v := &vm{module: m, funcNames: m.GetNameSec().FuncNames}
+	v.setConfig(Config{})
 	v.linkImports(mm)
+	v.initTags()
 	v.initFuncs()
 	v.initTable()
 	v.initMemWithInitData(init_memory_data)
@@ -117,136 +173,255 @@ func newVMWithInitMemoryData(m binary.Module, mm map[string]instance.Module, ini
 	return v
 }
 
+func (v *vm) setConfig(config Config) {
+	v.config = config
+	v.maxCallDepth = config.maxCallDepth()
+	v.operandStack.maxSlots = config.maxStackSlots()
+
+	if config.ConsumeFuel {
+		v.fuel = config.Fuel
+		v.fuelCosts = newFuelCosts(config)
+	}
+	v.regs = config.Engine == EngineRegister
+}
+
 func (v *vm) linkImports(mm map[string]instance.Module) {
 	for _, importItem := range v.module.ImportSec {
 		if targetModule := mm[importItem.Module]; targetModule == nil {
-			panic(errors.New("module not found"))
+			panic(v.newLinkError(importItem, "unknown import", ""))
 		} else {
 			v.linkImport(targetModule, importItem)
 		}
 	}
 }
 
+// 链接导入项
+// 导出项的类型必须跟导入项声明的类型匹配（见 WebAssembly 规范的 import subtyping），
+// 否则抛出 *instance.LinkError
 func (v *vm) linkImport(targetModule instance.Module, importItem binary.Import) {
 	targetExportedItem := targetModule.GetMember(importItem.Name)
 
 	if targetExportedItem == nil {
-		panic(fmt.Errorf("unknown import: %s.%s",
-			importItem.Module, importItem.Name))
+		panic(v.newLinkError(importItem, "unknown import", ""))
 	}
 
-	// typeMatched := false
+	typeMatched := false
 	switch x := targetExportedItem.(type) {
 	case instance.Function:
 		if importItem.Desc.Tag == binary.ImportTagFunc {
 			expectedFuncType := v.module.TypeSec[importItem.Desc.FuncType]
-			// if !isFuncTypeMatch(expectedFuncType, x.Type()) {
-			// 	panic(fmt.Errorf("incompatible import type: %s.%s",
-			// 		importItem.Module, importItem.Name))
-			// }
-			v.funcs = append(v.funcs, newExternalFunc(expectedFuncType, x))
+			typeMatched = isFuncTypeMatch(expectedFuncType, x.Type())
+			v.funcs = append(v.funcs, newExternalFunc(uint32(len(v.funcs)), expectedFuncType, x))
 		}
 	case instance.Table:
 		if importItem.Desc.Tag == binary.ImportTagTable {
-			// if !isLimitsMatch(importItem.Desc.Table.Limits, x.Type().Limits) {
-			// 	panic(fmt.Errorf("incompatible import type: %s.%s",
-			// 		importItem.Module, importItem.Name))
-			// }
-			v.table = x
+			typeMatched = isTableTypeMatch(importItem.Desc.Table, getTableType(x))
+			v.tables = append(v.tables, x)
 		}
 	case instance.Memory:
 		if importItem.Desc.Tag == binary.ImportTagMem {
-			// if !isLimitsMatch(importItem.Desc.Mem, x.Type()) {
-			// 	panic(fmt.Errorf("incompatible import type: %s.%s",
-			// 		importItem.Module, importItem.Name))
-			// }
-			v.memory = x
+			typeMatched = isLimitsMatch(importItem.Desc.Mem, getMemType(x))
+			v.memories = append(v.memories, x)
 		}
 	case instance.Global:
 		if importItem.Desc.Tag == binary.ImportTagGlobal {
-			// if !isGlobalTypeMatch(importItem.Desc.Global, x.Type()) {
-			// 	panic(fmt.Errorf("incompatible import type: %s.%s",
-			// 		importItem.Module, importItem.Name))
-			// }
+			typeMatched = isGlobalTypeMatch(importItem.Desc.Global, x.Type())
 			v.globals = append(v.globals, x)
 		}
+	case instance.Tag:
+		// 注：函数也实现了 instance.Tag 接口，所以这个分支需要位于函数分支之后
+		if importItem.Desc.Tag == binary.ImportTagTag {
+			expectedTagType := v.module.TypeSec[importItem.Desc.TagType.Type]
+			typeMatched = isFuncTypeMatch(expectedTagType, x.Type())
+			v.tags = append(v.tags, x)
+		}
+	}
+
+	if !typeMatched {
+		panic(v.newLinkError(importItem, "incompatible import type",
+			formatExternType(targetExportedItem)))
+	}
+}
+
+func (v *vm) newLinkError(importItem binary.Import, reason string, actual string) *instance.LinkError {
+	var expected string
+	switch desc := importItem.Desc; desc.Tag {
+	case binary.ImportTagFunc:
+		expected = v.module.TypeSec[desc.FuncType].String()
+	case binary.ImportTagTable:
+		expected = "(table " + desc.Table.String() + ")"
+	case binary.ImportTagMem:
+		expected = "(memory " + desc.Mem.String() + ")"
+	case binary.ImportTagGlobal:
+		expected = "(global " + desc.Global.String() + ")"
+	case binary.ImportTagTag:
+		expected = "(tag " + v.module.TypeSec[desc.TagType.Type].String() + ")"
+	}
+
+	return &instance.LinkError{
+		Module:   importItem.Module,
+		Field:    importItem.Name,
+		Reason:   reason,
+		Expected: expected,
+		Actual:   actual,
+	}
+}
+
+// 以文本格式的形式表示导出项的类型
+func formatExternType(item interface{}) string {
+	switch x := item.(type) {
+	case instance.Function:
+		return x.Type().String()
+	case instance.Table:
+		return "(table " + getTableType(x).String() + ")"
+	case instance.Memory:
+		return "(memory " + getMemType(x).String() + ")"
+	case instance.Global:
+		return "(global " + x.Type().String() + ")"
+	case instance.Tag:
+		return "(tag " + x.Type().String() + ")"
+	default:
+		return fmt.Sprintf("%T", item)
+	}
+}
+
+// 表和内存的下限以当前的大小为准（导出之后可能已经增长过）
+func getTableType(t instance.Table) binary.TableType {
+	tableType := t.Type()
+	tableType.Limits.Min = uint64(t.Size())
+	return tableType
+}
+
+func getMemType(m instance.Memory) binary.MemType {
+	memType := m.Type()
+	memType.Min = memSize(m)
+	return memType
+}
+
+func isFuncTypeMatch(expected, actual binary.FuncType) bool {
+	return isValTypesEqual(expected.ParamTypes, actual.ParamTypes) &&
+		isValTypesEqual(expected.ResultTypes, actual.ResultTypes)
+}
+
+func isValTypesEqual(a, b []binary.ValType) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
 	}
+	return true
+}
 
-	// if !typeMatched {
-	// 	panic(fmt.Errorf("incompatible import type: %s.%s",
-	// 		importItem.Module, importItem.Name))
-	// }
+func isTableTypeMatch(expected, actual binary.TableType) bool {
+	return expected.ElemType == actual.ElemType &&
+		isLimitsMatch(expected.Limits, actual.Limits)
 }
 
-// func isFuncTypeMatch(expected, actual binary.FuncType) bool {
-// 	return fmt.Sprintf("%s", expected) == fmt.Sprintf("%s", actual)
-// }
-//
-// func isGlobalTypeMatch(expected, actual binary.GlobalType) bool {
-// 	return actual.ValType == expected.ValType &&
-// 		actual.Mut == expected.Mut
-// }
-//
-// func isLimitsMatch(expected, actual binary.Limits) bool {
-// 	return actual.Min >= expected.Min &&
-// 		(expected.Max == 0 || actual.Max > 0 && actual.Max <= expected.Max)
-// }
+func isGlobalTypeMatch(expected, actual binary.GlobalType) bool {
+	return actual.ValType == expected.ValType &&
+		actual.Mut == expected.Mut
+}
+
+// 实际的下限不能小于期望的下限；如果期望有上限，则实际也必须有上限，
+// 并且不能大于期望的上限；共享内存只能匹配共享内存
+func isLimitsMatch(expected, actual binary.Limits) bool {
+	return actual.IsShared() == expected.IsShared() &&
+		actual.Min >= expected.Min &&
+		(!expected.HasMax() || actual.HasMax() && actual.Max <= expected.Max)
+}
 
 func (v *vm) initMem() {
-	// 当前 wasm 只支持创建一个内存块
-	if len(v.module.MemSec) != 0 {
-		v.memory = newMemory(v.module.MemSec[0])
-	} else {
-		if len(v.module.DataSec) > 0 {
-			panic(errors.New("memory not defined"))
+	for _, memType := range v.module.MemSec {
+		if memType.IsShared() {
+			v.memories = append(v.memories, newSharedMemory(memType))
+		} else {
+			v.memories = append(v.memories, newMemory(memType))
 		}
 	}
 
-	// 读取 Data 段，初始化内存块的内容
-	for _, dataItem := range v.module.DataSec {
+	// 读取 Data 段，主动的数据项在实例化时写入内存，然后被丢弃
+	for idx, dataItem := range v.module.DataSec {
+		v.dataSegs = append(v.dataSegs, dataItem.Init)
+		if dataItem.Mode != binary.SegmentModeActive {
+			continue
+		}
+
+		if int(dataItem.Mem) >= len(v.memories) {
+			// 没有定义内存，也没有导入内存
+			panic(errors.New("memory not defined"))
+		}
+
 		// 执行偏移值表达式（通常是一个 i32.const 指令）
 		for _, offsetInst := range dataItem.Offset {
 			v.execInstruction(offsetInst)
 		}
 
 		// 操作数栈的顶端操作数————即偏移值表达式的运算结果————表示内存的有效地址
-		eaddr := v.operandStack.popU64() // 有效地址是 33 位的无符号整数，这里使用 uint64 来存储
-		v.memory.Write(eaddr, dataItem.Init)
+		eaddr := popAddr(v, v.memories[dataItem.Mem])
+		v.initMemoryFromData(dataItem.Mem, uint32(idx), eaddr, 0, uint64(len(dataItem.Init)))
+		v.dataSegs[idx] = nil
 	}
 }
 
 func (v *vm) initMemWithInitData(init_data []byte) {
-	v.memory = newMemoryWithInitData(init_data)
+	v.memories = []instance.Memory{newMemoryWithInitData(init_data)}
 }
 
 func (v *vm) initFuncs() {
+	importedFuncCount := len(v.funcs)
 	for i, ftIdx := range v.module.FuncSec {
 		funcType := v.module.TypeSec[ftIdx]
 		code := v.module.CodeSec[i]
-		v.funcs = append(v.funcs, newInternalFunc(v, funcType, code))
+		v.funcs = append(v.funcs, newInternalFunc(v, uint32(len(v.funcs)), funcType, code))
+	}
+
+	// 所有函数的类型都确定之后再编译函数体（函数体可能会调用排在后面的函数），
+	// 编译需要用到标签的类型，所以标签需要先于函数初始化
+	for i := importedFuncCount; i < len(v.funcs); i++ {
+		v.funcs[i].compiled = v.compileFunc(v.funcs[i])
 	}
 }
 
 func (v *vm) initTable() {
-	// 当前 wasm 只支持创建一张表
-	if len(v.module.TableSec) != 0 {
-		v.table = newTable(v.module.TableSec[0])
-	} else {
-		if len(v.module.ElemSec) > 0 {
-			panic(errors.New("table not defined"))
-		}
+	for _, tableType := range v.module.TableSec {
+		v.tables = append(v.tables, newTable(tableType))
 	}
 
-	for _, elem := range v.module.ElemSec {
-
-		// 执行偏移值表达式（通常是一个 i32.const 指令）
-		for _, offsetInst := range elem.Offset {
-			v.execInstruction(offsetInst)
+	// 主动的元素项在实例化时写入表，然后跟声明的元素项一起被丢弃
+	for idx, elem := range v.module.ElemSec {
+		elems := make([]instance.WasmVal, elem.Len())
+		for i, funcIdx := range elem.Init {
+			elems[i] = v.funcs[funcIdx]
+		}
+		for i, expr := range elem.Exprs {
+			// 执行元素的常量表达式（通常是一个 ref.func 或者 ref.null 指令）
+			for _, inst := range expr {
+				v.execInstruction(inst)
+			}
+			elems[i] = v.refs.fromHandle(v.operandStack.popU64())
 		}
+		v.elemSegs = append(v.elemSegs, elems)
+
+		switch elem.Mode {
+		case binary.SegmentModeActive:
+			if int(elem.Table) >= len(v.tables) {
+				// 没有定义表，也没有导入表
+				panic(errors.New("table not defined"))
+			}
 
-		offset := v.operandStack.popU32()
-		for idx, funcIdx := range elem.Init {
-			v.table.SetElem(offset+uint32(idx), v.funcs[funcIdx])
+			// 执行偏移值表达式（通常是一个 i32.const 指令）
+			for _, offsetInst := range elem.Offset {
+				v.execInstruction(offsetInst)
+			}
+
+			offset := uint64(v.operandStack.popU32())
+			v.initTableFromElem(elem.Table, uint32(idx), offset, 0, uint64(len(elems)))
+			v.elemSegs[idx] = nil
+		case binary.SegmentModeDeclarative:
+			v.elemSegs[idx] = nil
 		}
 	}
 }
@@ -261,55 +436,8 @@ func (v *vm) initGlobals() {
 		}
 
 		v.globals = append(v.globals,
-			newGlobal(globalItem.Type, v.operandStack.popU64()))
-	}
-}
-
-// // 执行 `start 段` 指定的函数
-// func ExecStartFunc(module binary.Module) {
-// 	// 导入函数也会占用函数索引
-// 	func_idx := uint32(*module.StartSec) - uint32(len(module.ImportSec))
-// 	TestFunc(module, func_idx)
-// }
-//
-// // 执行名字为 `main` 的函数
-// func ExecMainFunc(module binary.Module) {
-// 	func_idx := getMainFunc(module)
-// 	TestFunc(module, func_idx)
-// }
-
-// todo:: 可以删除此方法
-func (v *vm) execStartFunc() {
-	if idx := getStartFuncIdx(v); idx != -1 {
-		//v.funcs[idx].call(nil)
-		v.evalFunc(uint32(idx), nil)
-	} else if idx := getMainFuncIdx(v); idx != -1 {
-		// v.funcs[idx].call(nil)
-		v.evalFunc(uint32(idx), nil)
-	} else {
-		panic(errors.New("no start function"))
-	}
-}
-
-// todo:: 可以删除此方法
-func getStartFuncIdx(v *vm) int32 {
-	if v.module.StartSec != nil {
-		return int32(*v.module.StartSec)
-	} else {
-		return -1
-	}
-}
-
-// todo:: 可以删除此方法
-func getMainFuncIdx(v *vm) int32 {
-	for _, exp := range v.module.ExportSec {
-		if exp.Desc.Tag == binary.ImportTagFunc &&
-			exp.Name == "main" {
-			return int32(exp.Desc.Idx)
-		}
+			newGlobal(globalItem.Type, v.wrapSlot(globalItem.Type.ValType, v.operandStack.popSlot())))
 	}
-	// panic(errors.New("no function named \"main\""))
-	return -1
 }
 
 // todo:: 用于单元测试
@@ -354,7 +482,7 @@ func evalModuleFuncAndDumpMemory(module binary.Module, func_idx uint32, args []i
 	// v.initTable()
 	// v.execFunc(func_idx)
 	v := newVM(module, nil)
-	return v.evalFunc(func_idx, args), dumpMemory(v.memory)
+	return v.evalFunc(func_idx, args), dumpMemory(v.memories[0])
 	// return v.operandStack.slots, dumpMemory(v.memory)
 }
 
@@ -379,14 +507,114 @@ func (v *vm) loop() {
 	// 方法会再次被激活，此时的 depth 的初始值就不是 1
 
 	startDepth := v.controlStack.controlDepth()
+	for !v.run(startDepth) {
+		// 异常被 try 指令的 catch 子句捕获，从 catch 子句继续执行
+	}
+}
+
+// 执行指令直到控制栈回到 startDepth 以下，正常结束时返回 true，
+// 抛出的异常被捕获时返回 false，其他的 panic（包括陷阱以及未被捕获的异常）
+// 会继续往外抛出
+func (v *vm) run(startDepth int) (done bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ex, ok := r.(*instance.Exception)
+			if !ok || !v.catchException(ex, startDepth) {
+				panic(r)
+			}
+		}
+	}()
+
 	for v.controlStack.controlDepth() >= startDepth {
-		frame := v.controlStack.topControlFrame()
-		if frame.pc == len(frame.instructions) {
-			v.exitBlock()
+		if v.regs {
+			v.execRegFrame(v.controlStack.topControlFrame())
 		} else {
-			instr := frame.instructions[frame.pc]
-			frame.pc++ // 向前移动一个指令
-			v.execInstruction(instr)
+			v.execFrame(v.controlStack.topControlFrame())
+		}
+	}
+	return true
+}
+
+// 执行栈顶调用帧的指令，直到调用帧发生变化（调用了其他函数或者当前函数返回）
+//
+// 执行指令之前 pc 已经向前移动，所以发生陷阱或者抛出异常时，
+// 正在执行的指令是 pc - 1
+func (v *vm) execFrame(frame *controlFrame) {
+	code := frame.fn.code
+	s := &v.operandStack
+	fuel := v.fuelCosts != nil
+
+	for {
+		in := &code[frame.pc]
+		frame.pc++ // 向前移动一个指令
+		if fuel {
+			v.consumeFuel(in.fuel)
+		}
+
+		switch in.op {
+		case opExec:
+			instructionTable[in.opcode](v, in.args)
+		case opExecCall:
+			instructionTable[in.opcode](v, in.args)
+			return
+		case opNop:
+			// 结构块在编译之后不需要执行任何操作
+		case opIf:
+			if !s.popBool() {
+				frame.pc = in.target.pc
+			}
+		case opJump:
+			frame.pc = in.target.pc
+		case opDropKeep:
+			s.dropKeep(frame.bp+in.target.height, in.target.arity)
+		case opBr:
+			br(v, frame, &in.target)
+		case opBrIf:
+			if s.popBool() {
+				br(v, frame, &in.target)
+			}
+		case opBrTable:
+			brTable(v, frame, frame.fn.brTables[in.imm])
+		case opReturn:
+			v.exitFunc(in.target.arity)
+			return
+		case opCall:
+			callFunc(v, v.funcs[in.imm])
+			return
+		case opRethrow:
+			rethrow(frame, int(in.imm))
+		case opLocalGet:
+			localGet(v, frame, in.imm)
+		case opLocalSet:
+			localSet(v, frame, in.imm)
+		case opLocalTee:
+			localTee(v, frame, in.imm)
+		case opConst:
+			s.pushU64(in.imm)
+		case opGlobalGet:
+			s.pushU64(v.globals[in.imm].GetAsU64())
+		case opGlobalSet:
+			v.globals[in.imm].SetAsU64(s.popU64())
+		case opMemAccess:
+			memoryAccessTable[in.opcode](v, in.a, in.imm)
+		case opIndexed:
+			indexedInstructionTable[in.opcode](v, in.a, in.b)
+		case opMiscIndexed:
+			miscIndexedInstructionTable[in.c](v, in.a, in.b)
+		case opUnary:
+			top := len(s.slots) - 1
+			s.slots[top] = slot{lo: regUnaryOps[in.opcode](s.slots[top].lo)}
+		case opBinary:
+			top := len(s.slots) - 1
+			s.slots[top-1] = slot{lo: regBinaryOps[in.opcode](s.slots[top-1].lo, s.slots[top].lo)}
+			s.slots = s.slots[:top]
+		case opBinaryImm:
+			top := len(s.slots) - 1
+			s.slots[top] = slot{lo: regBinaryOps[in.opcode](s.slots[top].lo, in.imm)}
+		case opBinaryLocal:
+			top := len(s.slots) - 1
+			rhs := s.slots[frame.bp+int(in.imm)].lo
+			s.slots[top] = slot{lo: regBinaryOps[in.opcode](s.slots[top].lo, rhs)}
 		}
 	}
 }
@@ -404,11 +632,13 @@ func (vm *vm) GetMember(name string) interface{} {
 			case binary.ExportTagFunc:
 				return vm.funcs[idx]
 			case binary.ExportTagTable:
-				return vm.table
+				return vm.tables[idx]
 			case binary.ExportTagMem:
-				return vm.memory
+				return vm.memories[idx]
 			case binary.ExportTagGlobal:
 				return vm.globals[idx]
+			case binary.ExportTagTag:
+				return vm.tags[idx]
 			}
 		}
 	}
@@ -425,7 +655,28 @@ func (vm *vm) EvalFunc(name string, args ...instance.WasmVal) []instance.WasmVal
 	panic(fmt.Errorf("function not found: " + name))
 }
 
-func (vm vm) GetGlobalVal(name string) instance.WasmVal {
+func (vm *vm) TryEvalFunc(name string, args ...instance.WasmVal) ([]instance.WasmVal, error) {
+	m := vm.GetMember(name)
+	if m != nil {
+		if f, ok := m.(instance.Function); ok {
+			return f.TryEval(args...)
+		}
+	}
+	return nil, errors.New("function not found: " + name)
+}
+
+func (vm *vm) EvalFuncContext(ctx context.Context, name string, args ...instance.WasmVal) ([]instance.WasmVal, error) {
+	// 嵌套的调用（比如经由外部函数再次调用当前模块）结束之后，恢复外层调用的 context
+	lastDone := vm.done
+	vm.done = ctx.Done()
+	defer func() {
+		vm.done = lastDone
+	}()
+
+	return vm.TryEvalFunc(name, args...)
+}
+
+func (vm *vm) GetGlobalVal(name string) instance.WasmVal {
 	m := vm.GetMember(name)
 	if m != nil {
 		if g, ok := m.(instance.Global); ok {
@@ -435,7 +686,7 @@ func (vm vm) GetGlobalVal(name string) instance.WasmVal {
 	panic(errors.New("global not found: " + name))
 }
 
-func (vm vm) SetGlobalVal(name string, val instance.WasmVal) {
+func (vm *vm) SetGlobalVal(name string, val instance.WasmVal) {
 	m := vm.GetMember(name)
 	if m != nil {
 		if g, ok := m.(instance.Global); ok {
@@ -446,20 +697,24 @@ func (vm vm) SetGlobalVal(name string, val instance.WasmVal) {
 }
 
 func init() {
+	// 注：
+	// 结构控制指令（block/loop/if/try）、分支指令（br/br_if/br_table/return）、
+	// call、rethrow 以及局部变量指令在预编译之后直接由 vm.execFrame() 执行，
+	// 不需要经由指令表，见 vm_compile.go。
+	// 全局变量指令、内存指令以及表指令也会被预编译，指令表里的版本仅用于
+	// 没有经过预编译的指令（比如常量表达式）
+
 	// 控制指令
 	instructionTable[binary.Unreachable] = unreachable
 	instructionTable[binary.Nop] = nop
-	instructionTable[binary.Block] = block
-	instructionTable[binary.Loop] = loop
-	instructionTable[binary.If] = if_
-	instructionTable[binary.Br] = br
-	instructionTable[binary.BrIf] = brIf
-	instructionTable[binary.BrTable] = brTable
-	instructionTable[binary.Return] = return_
+
+	// 异常处理指令
+	instructionTable[binary.Throw] = throw
 
 	// 操作数（参数 parameter）指令
 	instructionTable[binary.Drop] = drop
 	instructionTable[binary.Select] = select_
+	instructionTable[binary.SelectT] = select_
 
 	// 数值指令
 	instructionTable[binary.I32Const] = i32Const
@@ -594,43 +849,38 @@ func init() {
 	instructionTable[binary.I64Extend8S] = i64Extend8S
 	instructionTable[binary.I64Extend16S] = i64Extend16S
 	instructionTable[binary.I64Extend32S] = i64Extend32S
-	instructionTable[binary.TruncSat] = truncSat
-
-	// 内存指令
-	instructionTable[binary.I32Load] = i32Load
-	instructionTable[binary.I64Load] = i64Load
-	instructionTable[binary.F32Load] = f32Load
-	instructionTable[binary.F64Load] = f64Load
-	instructionTable[binary.I32Load8S] = i32Load8S
-	instructionTable[binary.I32Load8U] = i32Load8U
-	instructionTable[binary.I32Load16S] = i32Load16S
-	instructionTable[binary.I32Load16U] = i32Load16U
-	instructionTable[binary.I64Load8S] = i64Load8S
-	instructionTable[binary.I64Load8U] = i64Load8U
-	instructionTable[binary.I64Load16S] = i64Load16S
-	instructionTable[binary.I64Load16U] = i64Load16U
-	instructionTable[binary.I64Load32S] = i64Load32S
-	instructionTable[binary.I64Load32U] = i64Load32U
-	instructionTable[binary.I32Store] = i32Store
-	instructionTable[binary.I64Store] = i64Store
-	instructionTable[binary.F32Store] = f32Store
-	instructionTable[binary.F64Store] = f64Store
-	instructionTable[binary.I32Store8] = i32Store8
-	instructionTable[binary.I32Store16] = i32Store16
-	instructionTable[binary.I64Store8] = i64Store8
-	instructionTable[binary.I64Store16] = i64Store16
-	instructionTable[binary.I64Store32] = i64Store32
-	instructionTable[binary.MemorySize] = memorySize
-	instructionTable[binary.MemoryGrow] = memoryGrow
+	instructionTable[binary.MiscPrefix] = misc
+
+	// 内存指令，见 memoryAccessTable 和 indexedInstructionTable
+	for opcode, f := range memoryAccessTable {
+		if f != nil {
+			instructionTable[opcode] = withMemArg(f)
+		}
+	}
+	instructionTable[binary.MemorySize] = withIndices(memorySize)
+	instructionTable[binary.MemoryGrow] = withIndices(memoryGrow)
 
 	// 函数指令
-	instructionTable[binary.Call] = call
 	instructionTable[binary.CallIndirect] = callIndirect
+	instructionTable[binary.ReturnCall] = returnCall
+	instructionTable[binary.ReturnCallIndirect] = returnCallIndirect
+
+	// 引用指令
+	instructionTable[binary.RefNull] = refNull
+	instructionTable[binary.RefIsNull] = refIsNull
+	instructionTable[binary.RefFunc] = refFunc
+
+	// 表指令
+	instructionTable[binary.TableGet] = withIndices(tableGet)
+	instructionTable[binary.TableSet] = withIndices(tableSet)
 
 	// 变量指令
-	instructionTable[binary.LocalGet] = localGet
-	instructionTable[binary.LocalSet] = localSet
-	instructionTable[binary.LocalTee] = localTee
-	instructionTable[binary.GlobalGet] = globalGet
-	instructionTable[binary.GlobalSet] = globalSet
+	instructionTable[binary.GlobalGet] = func(v *vm, args interface{}) { globalGet(v, args.(uint32)) }
+	instructionTable[binary.GlobalSet] = func(v *vm, args interface{}) { globalSet(v, args.(uint32)) }
+
+	// SIMD 指令
+	instructionTable[binary.SIMDPrefix] = simd
+
+	// 原子指令
+	instructionTable[binary.AtomicPrefix] = atomicInstr
 }
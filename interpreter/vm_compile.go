@@ -0,0 +1,613 @@
+package interpreter
+
+import (
+	"math"
+	"wasmvm/binary"
+)
+
+// 预编译
+//
+// 实例化模块时，每个内部函数的函数体（树状的 binary.Instruction 列表）被编译为
+// 一个扁平的指令数组（[]compiledInstr），执行时只需要一个程序计数器：
+//
+// - 结构块（block/loop/if/try）在执行时不再需要创建控制帧，block、loop 以及 try
+//   编译之后是空指令，if 编译之后是条件跳转；
+// - 分支指令（br/br_if/br_table）的跳转目标被预先计算为目标指令在数组里的位置，
+//   以及目标结构块的栈底高度和需要保留的操作数数量，所以分支就是一次跳转
+//   加上一次（可能为空的）操作数移动；
+// - 局部变量、全局变量、常量、函数调用、加载和存储指令以及带有索引立即数的指令
+//   （memory.size/grow、批量内存指令以及表指令）的立即数被预先解码为 compiledInstr 的
+//   imm、a、b 等字段，执行时不需要再对 interface{} 进行类型断言；
+// - 整数的算术、位运算以及比较指令由 execFrame() 直接执行（regUnaryOps 和
+//   regBinaryOps，跟寄存器引擎共用），常量或者 local.get 跟紧接着的二元运算指令
+//   会被合并为一条指令（opBinaryImm 和 opBinaryLocal），这两条原指令之间不能是分支的目标；
+// - 其余指令仍然经由 instructionTable 执行；
+// - try 块的主体和 catch 子句的位置记录在函数的 try 表里，捕获异常时查表，
+//   见 vm_stack_control.go 的 catchException() 方法。
+//
+// 除了原有的指令，编译时还会插入少量伪指令：then 分支、try 主体以及 catch 子句
+// 结尾处跳到结构块结尾的 opJump，结构块结束时丢弃残留操作数的 opDropKeep，
+// 以及函数结尾的 opReturn。伪指令不计入陷阱调用栈里的指令序号，
+// 所以除了伪指令之外，编译之后的指令跟原函数体的指令（按先序遍历）一一对应。
+//
+// 燃料计量：每条指令执行之前消耗 compiledInstr.fuel 个单位的燃料，即它以及合并到它的
+// 原指令（比如合并指令里的常量，或者寄存器引擎里没有生成指令的 local.get）的燃料之和。
+// 没有生成指令的原指令的燃料计入同一段顺序执行的指令里紧接着的下一条指令（可能是伪指令），
+// 分支的目标之前如果还有尚未计入的燃料，则插入一条消耗这些燃料的 opNop 伪指令，
+// 所以沿着任意一条执行路径消耗的燃料都跟逐条执行原指令时相同。
+//
+// 示例：
+//
+// (func (param $n i32) (result i32)       ;;  0: block        opNop
+//   (local $i i32)                         ;;  1: loop         opNop
+//   (block                                 ;;  2: local.get 1  opLocalGet
+//     (loop                                ;;  3: local.get 0  ┐ opBinaryLocal
+//       (br_if 1 (i32.ge_u                 ;;  4: i32.ge_u     ┘
+//         (local.get $i) (local.get $n)))  ;;  5: br_if 1      opBrIf -> 11
+//       (local.set $i (i32.add             ;;  6: local.get 1  opLocalGet
+//         (local.get $i) (i32.const 1)))   ;;  7: i32.const 1  ┐ opBinaryImm
+//       (br 0)))                           ;;  8: i32.add      ┘
+//   (local.get $i))                        ;;  9: local.set 1  opLocalSet
+//                                          ;; 10: br 0         opBr -> 2
+//                                          ;; 11: local.get 1  opLocalGet
+//                                          ;; 12: （函数结尾）   opReturn
+//
+// （合并之后的指令占据原来第一条指令的位置，数组里的位置跟右边的序号不再一一对应）
+//
+// 操作数栈的高度（包括结构块的栈底高度）都是相对于当前函数的 bp（即第 0 个局部变量
+// 的位置）而言的，编译时按每条指令弹出和压入的操作数数量计算。
+//
+// 使用寄存器引擎（见 Config.Engine）时，编译器在此基础上进一步将栈式的指令
+// 转换为寄存器形式的指令，见 vm_register.go。
+//
+// 性能（`go test ./interpreter -run XXX -bench . -benchtime 1s -count 5` 的中位数，
+// 客户程序见 test/resources/interpreter/test-vm-bench.wat，单位 ns/op，括号里是相对于预编译之前的倍数）：
+//
+//   基准测试               预编译之前        栈式引擎             寄存器引擎
+//   BenchmarkSum            1410090      720359（2.0x）      422380（3.3x）
+//   BenchmarkFib            1538350      815566（1.9x）      458194（3.4x）
+//   BenchmarkFibRec        10231634     3338257（3.1x）     3311155（3.1x）
+//   BenchmarkSumMem         3794543     1450983（2.6x）     1333958（2.8x）
+//   BenchmarkCountGlobal    1237011      566194（2.2x）      503065（2.5x）
+//
+// 栈式引擎在循环类的客户程序上只快了 2 倍左右，没有达到预期的“快几倍”，
+// 主要的开销仍然是每条指令的分派以及操作数栈的读写；寄存器引擎在纯运算的循环上快 3 倍多，
+// 但访问内存和全局变量的程序提升有限。数值只用于比较各个版本的相对快慢，不同的机器上的绝对值会有差异。
+
+// 编译之后的函数
+type compiledFunc struct {
+	code       []compiledInstr
+	localCount int              // 局部变量（不包括参数）的数量
+	brTables   [][]branchTarget // br_table 指令的跳转目标，最后一项是默认目标
+	tries      []tryBlock       // try 结构块，用于捕获异常
+	frameSize  int              // 寄存器的数量（即参数、局部变量以及运算槽位的最大数量），仅用于寄存器引擎
+}
+
+// 编译之后的指令
+type compiledInstr struct {
+	op     byte         // 执行的方式，见 opXxx 常量
+	opcode byte         // 原指令的操作码，用于经由 instructionTable 执行
+	pseudo bool         // 是否编译时插入的伪指令
+	fuel   uint64       // 执行之前消耗的燃料，仅用于燃料计量
+	sp     int32        // 执行之前操作数栈的高度，仅用于寄存器引擎
+	idx    int32        // 对应的原指令在函数体内的序号，用于生成陷阱的调用栈
+	a      uint32       // 寄存器形式的指令的操作数（寄存器的索引，见 vm_register.go），
+	b      uint32       // 或者预先解码的内存、表等的索引
+	c      uint32       //
+	imm    uint64       // 预先解码的立即数
+	target branchTarget // 分支指令的跳转目标
+	args   interface{}  // 原指令的立即数，用于经由 instructionTable 执行的指令
+}
+
+const (
+	opExec     = iota // 经由 instructionTable 执行
+	opExecCall        // 经由 instructionTable 执行，并且可能会切换调用帧（call_indirect 以及尾调用）
+	opNop             // nop, block, loop, try
+	opIf              // if，条件为假时跳到 target.pc（else 分支的开头或者结构块的结尾）
+	opJump            // 伪指令，跳到 target.pc
+	opDropKeep        // 伪指令，结构块结束时丢弃残留的操作数
+	opBr              // br
+	opBrIf            // br_if
+	opBrTable         // br_table，imm 为 compiledFunc.brTables 的索引
+	opReturn          // return，以及函数的结尾（伪指令）
+	opCall            // call，imm 为函数索引
+	opRethrow         // rethrow，imm 为目标 catch 子句的嵌套深度
+	opLocalGet        // local.get，imm 为局部变量的索引
+	opLocalSet        // local.set
+	opLocalTee        // local.tee
+	opConst           // i32/i64/f32/f64.const，imm 为常量的值
+
+	opGlobalGet   // global.get（v128 类型的全局变量除外），imm 为全局变量的索引
+	opGlobalSet   // global.set（v128 类型的全局变量除外）
+	opMemAccess   // 加载和存储指令，a 为内存索引，imm 为 offset，见 memoryAccessTable
+	opIndexed     // a 和 b 为索引，见 indexedInstructionTable
+	opMiscIndexed // 0xFC 前缀的带有索引立即数的指令，a 和 b 为索引，c 为子操作码
+	opUnary       // 整数的一元运算，见 regUnaryOps
+	opBinary      // 整数的二元运算，见 regBinaryOps
+	opBinaryImm   // 常量和紧接着的二元运算合并而成，右操作数为 imm
+	opBinaryLocal // local.get 和紧接着的二元运算合并而成，右操作数为第 imm 个局部变量
+)
+
+// 分支的目标
+type branchTarget struct {
+	pc     int  // 跳转之后执行的第一条指令的位置
+	height int  // 目标结构块的栈底（不包括结构块的参数）的高度
+	arity  int  // 需要保留的（栈顶的）操作数的数量
+	loop   bool // 目标是否 loop 结构块的开头，跳回循环的开头时需要检查是否中断执行
+}
+
+// try 结构块
+type tryBlock struct {
+	start    int            // 主体的第一条指令的位置
+	end      int            // 主体的最后一条指令的下一个位置
+	depth    int            // 结构块的嵌套深度，函数体为 0
+	height   int            // 栈底的高度
+	catches  []catchHandler // catch 以及 catch_all 子句
+	delegate int            // delegate 的目标结构块的嵌套深度，没有 delegate 时为 -1
+}
+
+type catchHandler struct {
+	all bool   // 是否 catch_all 子句
+	tag uint32 // catch 子句的标签索引
+	pc  int    // 子句的第一条指令的位置
+}
+
+// 获取编译之后位于 pc 的指令在原函数体内的序号，用于生成陷阱的调用栈
+func (fn *compiledFunc) getInstrIdx(pc int) int {
+	return int(fn.code[pc].idx)
+}
+
+// 查找主体包含 pc 并且嵌套深度不超过 maxDepth 的最内层的 try 结构块，找不到时返回 nil
+func (fn *compiledFunc) getTryBlock(pc int, maxDepth int) *tryBlock {
+	var found *tryBlock
+	for i := range fn.tries {
+		t := &fn.tries[i]
+		if t.start <= pc && pc < t.end && t.depth <= maxDepth &&
+			(found == nil || t.depth > found.depth) {
+			found = t
+		}
+	}
+	return found
+}
+
+// -------- 编译器
+
+type compiler struct {
+	v        *vm
+	fn       *compiledFunc
+	height   int     // 编译到当前位置时操作数栈的高度
+	labels   []label // 当前位置的各层结构块，第 0 个是函数体
+	instrIdx int     // 已经编译的原指令的数量
+	barrier  int     // 最后一个分支目标的位置，这个位置上的指令不能跟前一条指令合并
+	fuel     uint64  // 已经编译但尚未计入任何指令的原指令的燃料
+
+	// 以下字段仅用于寄存器引擎，见 vm_register.go
+	regs      bool
+	maxHeight int      // 操作数栈的最大高度
+	vals      []regVal // 操作数栈上尚未写入对应寄存器的操作数，按高度索引
+	lastDef   int      // 最后一条指令如果是可以改写目标寄存器的寄存器指令，则为它的位置，否则为 -1
+}
+
+// 编译过程中的结构块
+type label struct {
+	opcode      byte
+	height      int             // 栈底（不包括参数）的高度
+	params      int             // 参数的数量
+	results     int             // 返回值的数量
+	start       int             // 结构块的第一条指令的位置，用于 loop
+	fixups      []int           // 跳到结构块结尾的指令的位置，结构块结束时回填
+	tableFixups []*branchTarget // 跳到结构块结尾的 br_table 目标，结构块结束时回填
+	unreachable bool            // 当前位置是否不可到达
+}
+
+func (v *vm) compileFunc(f vmFunc) *compiledFunc {
+	localCount := int(f.code.GetLocalCount())
+	c := &compiler{
+		v:       v,
+		fn:      &compiledFunc{localCount: localCount},
+		height:  len(f.type_.ParamTypes) + localCount,
+		regs:    v.regs,
+		lastDef: -1,
+	}
+	c.updateMaxHeight()
+
+	// 跳到函数体的结尾相当于 return，所以函数体的栈底为 bp，也就是
+	// 连同参数和局部变量一起丢弃
+	c.labels = []label{{opcode: binary.Call, results: len(f.type_.ResultTypes)}}
+	c.compileInstrs(f.code.Expr)
+
+	if c.regs {
+		// 寄存器引擎的 return 按操作数栈的高度读取返回值，所以函数体
+		// 正常结束时先将返回值移动到 bp 处，跟跳到函数结尾的分支一致
+		c.flush()
+		c.dropResidues()
+		c.height = c.labels[0].results
+		c.updateMaxHeight()
+		c.fn.frameSize = c.maxHeight
+	}
+	c.patchFixups(&c.labels[0])
+	c.emit(compiledInstr{op: opReturn, pseudo: true,
+		target: branchTarget{arity: len(f.type_.ResultTypes)}})
+	return c.fn
+}
+
+func (c *compiler) compileInstrs(instrs []binary.Instruction) {
+	for _, inst := range instrs {
+		c.compileInstr(inst)
+	}
+}
+
+func (c *compiler) compileInstr(inst binary.Instruction) {
+	opcode := inst.Opcode
+	c.instrIdx++
+	if c.v.fuelCosts != nil {
+		c.fuel += c.v.fuelCosts[opcode]
+	}
+
+	if c.regs {
+		defer c.updateMaxHeight()
+		if c.compileRegInstr(inst) {
+			return
+		}
+		c.flush()
+	}
+
+	// 注：
+	// 编译器先生成指令，然后再调整操作数栈的高度，以便每条指令都记录了
+	// 执行之前的栈高度（寄存器引擎需要用到）
+
+	switch opcode {
+	case binary.Block, binary.Loop:
+		args := inst.Args.(binary.BlockArgs)
+		c.emit(compiledInstr{op: opNop, opcode: opcode})
+		c.pushLabel(opcode, c.v.module.GetBlockType(args.BT))
+		c.compileInstrs(args.Instrs)
+		c.popLabel()
+	case binary.If:
+		args := inst.Args.(binary.IfArgs)
+		ft := c.v.module.GetBlockType(args.BT)
+		ifPC := c.emit(compiledInstr{op: opIf, opcode: opcode})
+		c.height-- // 条件
+		c.pushLabel(opcode, ft)
+		c.compileInstrs(args.Instrs1)
+		if len(args.Instrs2) > 0 {
+			c.jumpToEnd()
+			c.fn.code[ifPC].target.pc = len(c.fn.code)
+			c.resetLabel(len(ft.ParamTypes))
+			c.compileInstrs(args.Instrs2)
+		} else {
+			c.topLabel().fixups = append(c.topLabel().fixups, ifPC)
+		}
+		c.popLabel()
+	case binary.Try:
+		c.compileTry(inst.Args.(binary.TryArgs))
+	case binary.Unreachable, binary.Throw:
+		c.emitExec(inst, opExec)
+		c.setUnreachable()
+	case binary.Rethrow:
+		depth := len(c.labels) - 1 - int(inst.Args.(uint32))
+		c.emit(compiledInstr{op: opRethrow, opcode: opcode, imm: uint64(depth)})
+		c.setUnreachable()
+	case binary.Br:
+		c.emitBranch(opBr, opcode, inst.Args.(uint32))
+		c.setUnreachable()
+	case binary.BrIf:
+		c.emitBranch(opBrIf, opcode, inst.Args.(uint32))
+		c.height-- // 条件
+	case binary.BrTable:
+		c.compileBrTable(inst.Args.(binary.BrTableArgs))
+		c.setUnreachable()
+	case binary.Return:
+		c.emit(compiledInstr{op: opReturn, opcode: opcode,
+			target: branchTarget{arity: c.labels[0].results}})
+		c.setUnreachable()
+	case binary.Call:
+		idx := inst.Args.(uint32)
+		ft := c.v.funcs[idx].type_
+		c.emit(compiledInstr{op: opCall, opcode: opcode, imm: uint64(idx)})
+		c.height += len(ft.ResultTypes) - len(ft.ParamTypes)
+	case binary.CallIndirect:
+		ft := c.v.module.TypeSec[inst.Args.(binary.CallIndirectArgs).Type]
+		c.emitExec(inst, opExecCall)
+		c.height += len(ft.ResultTypes) - len(ft.ParamTypes) - 1
+	case binary.ReturnCall, binary.ReturnCallIndirect:
+		c.emitExec(inst, opExecCall)
+		c.setUnreachable()
+	case binary.LocalGet:
+		c.emit(compiledInstr{op: opLocalGet, opcode: opcode, imm: uint64(inst.Args.(uint32))})
+		c.height++
+	case binary.LocalSet:
+		c.emit(compiledInstr{op: opLocalSet, opcode: opcode, imm: uint64(inst.Args.(uint32))})
+		c.height--
+	case binary.LocalTee:
+		c.emit(compiledInstr{op: opLocalTee, opcode: opcode, imm: uint64(inst.Args.(uint32))})
+	case binary.GlobalGet, binary.GlobalSet:
+		idx := inst.Args.(uint32)
+		if c.isBoxedGlobal(idx) {
+			c.emitExec(inst, opExec)
+		} else if opcode == binary.GlobalGet {
+			c.emit(compiledInstr{op: opGlobalGet, opcode: opcode, imm: uint64(idx)})
+		} else {
+			c.emit(compiledInstr{op: opGlobalSet, opcode: opcode, imm: uint64(idx)})
+		}
+		if opcode == binary.GlobalGet {
+			c.height++
+		} else {
+			c.height--
+		}
+	case binary.I32Const:
+		c.emitConst(opcode, uint64(uint32(inst.Args.(int32))))
+	case binary.I64Const:
+		c.emitConst(opcode, uint64(inst.Args.(int64)))
+	case binary.F32Const:
+		c.emitConst(opcode, uint64(math.Float32bits(inst.Args.(float32))))
+	case binary.F64Const:
+		c.emitConst(opcode, math.Float64bits(inst.Args.(float64)))
+	case binary.Nop:
+		c.emit(compiledInstr{op: opNop, opcode: opcode})
+	default:
+		pops, pushes, _ := binary.GetOperandCount(inst)
+		c.compileTypedInstr(inst)
+		c.height += pushes - pops
+	}
+}
+
+// 预先解码立即数（或者直接执行）的指令，其余指令经由 instructionTable 执行
+func (c *compiler) compileTypedInstr(inst binary.Instruction) {
+	opcode := inst.Opcode
+	switch {
+	case memoryAccessTable[opcode] != nil:
+		memArg := inst.Args.(binary.MemArg)
+		c.emit(compiledInstr{op: opMemAccess, opcode: opcode, a: memArg.Mem, imm: memArg.Offset})
+	case indexedInstructionTable[opcode] != nil:
+		a, b := decodeIndices(inst.Args)
+		c.emit(compiledInstr{op: opIndexed, opcode: opcode, a: a, b: b})
+	case opcode == binary.MiscPrefix && c.isMiscIndexed(inst.Args.(binary.MiscArgs)):
+		miscArgs := inst.Args.(binary.MiscArgs)
+		a, b := decodeIndices(miscArgs.Args)
+		c.emit(compiledInstr{op: opMiscIndexed, opcode: opcode, a: a, b: b, c: miscArgs.SubOpcode})
+	case regBinaryOps[opcode] != nil:
+		c.compileBinary(opcode)
+	case regUnaryOps[opcode] != nil:
+		c.emit(compiledInstr{op: opUnary, opcode: opcode})
+	default:
+		c.emitExec(inst, opExec)
+	}
+}
+
+// 二元运算的右操作数如果是紧接着的常量或者 local.get，则合并为一条指令，
+// 合并之后的指令替换了原来的常量（或者 local.get）指令，所以跳到该位置的分支
+// 仍然会执行这两条原指令
+func (c *compiler) compileBinary(opcode byte) {
+	if n := len(c.fn.code); n > c.barrier {
+		// 合并之后的指令不会发生陷阱（燃料耗尽除外），所以序号只需要跟原来的某一条指令对应
+		switch last := &c.fn.code[n-1]; last.op {
+		case opConst, opLocalGet:
+			if last.op == opConst {
+				last.op = opBinaryImm
+			} else {
+				last.op = opBinaryLocal
+			}
+			last.opcode = opcode
+			last.fuel += c.fuel
+			c.fuel = 0
+			return
+		}
+	}
+	c.emit(compiledInstr{op: opBinary, opcode: opcode})
+}
+
+func (c *compiler) isMiscIndexed(args binary.MiscArgs) bool {
+	return int(args.SubOpcode) < len(miscIndexedInstructionTable) &&
+		miscIndexedInstructionTable[args.SubOpcode] != nil
+}
+
+// v128 和引用类型的全局变量无法使用 GetAsU64/SetAsU64 读写，仍然经由 instructionTable 执行，
+// 编译时全局变量尚未初始化，所以从模块里查找全局变量的类型
+func (c *compiler) isBoxedGlobal(idx uint32) bool {
+	isBoxed := func(vt binary.ValType) bool {
+		return vt == binary.ValTypeV128 || binary.IsRefType(vt)
+	}
+	for _, imp := range c.v.module.ImportSec {
+		if imp.Desc.Tag == binary.ImportTagGlobal {
+			if idx == 0 {
+				return isBoxed(imp.Desc.Global.ValType)
+			}
+			idx--
+		}
+	}
+	return int(idx) < len(c.v.module.GlobalSec) &&
+		isBoxed(c.v.module.GlobalSec[idx].Type.ValType)
+}
+
+// try 块的主体和各个 catch 子句依次排列，主体以及除了最后一个子句之外的
+// 各个子句的结尾都跳到结构块的结尾
+func (c *compiler) compileTry(args binary.TryArgs) {
+	c.emit(compiledInstr{op: opNop, opcode: binary.Try})
+	c.pushLabel(binary.Try, c.v.module.GetBlockType(args.BT))
+
+	depth := len(c.labels) - 1
+	idx := len(c.fn.tries)
+	c.fn.tries = append(c.fn.tries, tryBlock{
+		start:    len(c.fn.code),
+		depth:    depth,
+		height:   c.topLabel().height,
+		delegate: -1,
+	})
+
+	c.compileInstrs(args.Instrs)
+	c.fn.tries[idx].end = len(c.fn.code)
+
+	for _, clause := range args.Catches {
+		c.jumpToEnd()
+		handler := catchHandler{all: clause.All, tag: clause.Tag, pc: len(c.fn.code)}
+
+		// catch 子句开始时操作数栈上是异常的实参
+		params := 0
+		if !clause.All {
+			params = len(c.v.tags[clause.Tag].Type().ParamTypes)
+		}
+		c.resetLabel(params)
+		c.compileInstrs(clause.Instrs)
+		c.fn.tries[idx].catches = append(c.fn.tries[idx].catches, handler)
+	}
+
+	// delegate 的标签是相对于 try 块外层的
+	if args.Delegate != nil {
+		c.fn.tries[idx].delegate = depth - 1 - int(*args.Delegate)
+	}
+	c.popLabel()
+}
+
+func (c *compiler) compileBrTable(args binary.BrTableArgs) {
+	targets := make([]branchTarget, len(args.Labels)+1)
+	for i := range targets {
+		labelIdx := args.Default
+		if i < len(args.Labels) {
+			labelIdx = args.Labels[i]
+		}
+		l := c.getLabel(labelIdx)
+		targets[i] = l.getBranchTarget()
+		if l.opcode != binary.Loop {
+			l.tableFixups = append(l.tableFixups, &targets[i])
+		}
+	}
+
+	c.emit(compiledInstr{op: opBrTable, opcode: binary.BrTable, imm: uint64(len(c.fn.brTables))})
+	c.fn.brTables = append(c.fn.brTables, targets)
+}
+
+// -------- 辅助方法
+
+func (c *compiler) emit(in compiledInstr) int {
+	in.sp = int32(c.height)
+	in.fuel = c.fuel
+	c.fuel = 0
+
+	// 伪指令的序号是它之后的下一条原指令的序号（即已经编译的原指令的数量）
+	in.idx = int32(c.instrIdx)
+	if !in.pseudo {
+		in.idx--
+	}
+
+	c.fn.code = append(c.fn.code, in)
+	c.lastDef = -1
+	return len(c.fn.code) - 1
+}
+
+func (c *compiler) emitExec(inst binary.Instruction, op byte) {
+	c.emit(compiledInstr{op: op, opcode: inst.Opcode, args: inst.Args})
+}
+
+func (c *compiler) emitConst(opcode byte, val uint64) {
+	c.emit(compiledInstr{op: opConst, opcode: opcode, imm: val})
+	c.height++
+}
+
+func (c *compiler) emitBranch(op byte, opcode byte, labelIdx uint32) {
+	l := c.getLabel(labelIdx)
+	pc := c.emit(compiledInstr{op: op, opcode: opcode, target: l.getBranchTarget()})
+	if l.opcode != binary.Loop {
+		l.fixups = append(l.fixups, pc)
+	}
+}
+
+// 当前分支（then 分支、try 的主体以及 catch 子句）结束，跳到结构块的结尾
+func (c *compiler) jumpToEnd() {
+	c.flush()
+	c.dropResidues()
+	if l := c.topLabel(); !l.unreachable {
+		l.fixups = append(l.fixups, c.emit(compiledInstr{op: opJump, pseudo: true}))
+	}
+}
+
+// 结构块正常结束时，如果操作数栈上除了返回值之外还有残留的操作数（只会出现在
+// 未经验证的模块里），则丢弃残留的操作数
+func (c *compiler) dropResidues() {
+	l := c.topLabel()
+	if !l.unreachable && c.height != l.height+l.results {
+		c.emit(compiledInstr{op: opDropKeep, pseudo: true,
+			target: branchTarget{height: l.height, arity: l.results}})
+	}
+}
+
+func (c *compiler) pushLabel(opcode byte, ft binary.FuncType) {
+	c.lastDef = -1
+	params := len(ft.ParamTypes)
+	c.labels = append(c.labels, label{
+		opcode:  opcode,
+		height:  c.height - params,
+		params:  params,
+		results: len(ft.ResultTypes),
+		start:   len(c.fn.code),
+	})
+	c.barrier = len(c.fn.code)
+}
+
+func (c *compiler) popLabel() {
+	c.flush()
+	c.dropResidues()
+	l := c.topLabel()
+	c.patchFixups(l)
+	c.height = l.height + l.results
+	c.labels = c.labels[:len(c.labels)-1]
+	c.lastDef = -1
+}
+
+// 回填跳到结构块结尾的指令的目标
+func (c *compiler) patchFixups(l *label) {
+	c.chargeFuel()
+	end := len(c.fn.code)
+	c.barrier = end
+	for _, pc := range l.fixups {
+		c.fn.code[pc].target.pc = end
+	}
+	for _, target := range l.tableFixups {
+		target.pc = end
+	}
+}
+
+func (c *compiler) topLabel() *label {
+	return &c.labels[len(c.labels)-1]
+}
+
+func (c *compiler) getLabel(idx uint32) *label {
+	return &c.labels[len(c.labels)-1-int(idx)]
+}
+
+// 将当前结构块剩余的指令标记为不可到达
+func (c *compiler) setUnreachable() {
+	l := c.topLabel()
+	c.height = l.height
+	l.unreachable = true
+}
+
+// 开始结构块的另一个分支（else 分支或者 catch 子句），params 为分支开始时的操作数数量
+func (c *compiler) resetLabel(params int) {
+	l := c.topLabel()
+	c.height = l.height + params
+	l.unreachable = false
+	c.barrier = len(c.fn.code)
+	c.fuel = 0 // 上一个分支结尾不可到达的指令
+	c.vals = c.vals[:0]
+	c.lastDef = -1
+	c.updateMaxHeight()
+}
+
+// 分支的目标之前，尚未计入的燃料需要由一条伪指令消耗，不能计入目标位置的指令，
+// 否则分支到这个位置时也会消耗这些燃料
+func (c *compiler) chargeFuel() {
+	if c.fuel > 0 && !c.topLabel().unreachable {
+		c.emit(compiledInstr{op: opNop, pseudo: true})
+	}
+	c.fuel = 0
+}
+
+// 对于 loop，跳转目标是结构块的开头，需要保留参数，
+// 对于 block/if/try 以及函数体，跳转目标是结构块的结尾（在结构块结束时回填），需要保留返回值
+func (l *label) getBranchTarget() branchTarget {
+	if l.opcode == binary.Loop {
+		return branchTarget{pc: l.start, height: l.height, arity: l.params, loop: true}
+	}
+	return branchTarget{height: l.height, arity: l.results}
+}
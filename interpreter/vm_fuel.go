@@ -0,0 +1,31 @@
+package interpreter
+
+import "wasmvm/instance"
+
+// 燃料计量，见 Config.ConsumeFuel
+
+// 消耗执行一条指令所需的燃料，燃料不足时剩余的燃料清零并发生陷阱（该指令不会被执行）
+//
+// 编译之后的一条指令可能包含多条原指令（见 compiledInstr.fuel），它们的燃料一次性消耗，
+// 燃料不足时清零，所以不管按哪种方式计量，陷阱之后剩余的燃料都是一样的
+func (v *vm) consumeFuel(cost uint64) {
+	if v.fuel < cost {
+		v.fuel = 0
+		panic(instance.NewTrap(instance.TrapOutOfFuel))
+	}
+	v.fuel -= cost
+}
+
+// 实现接口 instance.FuelMeter 的方法
+
+func (v *vm) AddFuel(fuel uint64) {
+	v.fuel += fuel
+}
+
+func (v *vm) Fuel() uint64 {
+	return v.fuel
+}
+
+func (v *vm) FuelEnabled() bool {
+	return v.config.ConsumeFuel
+}
@@ -14,20 +14,24 @@ import (
 // type WasmVal = interface{}
 
 type vmFunc struct {
+	idx   uint32          // 函数的索引（包括导入函数在内）
 	type_ binary.FuncType // name: func_type
 
-	code binary.Code // code 和 goFunc 二选一
-	vm   *vm
+	code     binary.Code   // code 和 goFunc 二选一
+	compiled *compiledFunc // 编译之后的函数体，见 vm_compile.go
+	vm       *vm
 
 	// goFunc GoFunc          // 本地函数（native function）
 	func_ instance.Function // 外部函数，即从别的模块导入的函数
 }
 
 func newExternalFunc(
+	idx uint32,
 	funcType binary.FuncType,
 	//f GoFunc
 	f instance.Function) vmFunc {
 	return vmFunc{
+		idx:   idx,
 		type_: funcType,
 		// goFunc: f,
 		func_: f,
@@ -35,9 +39,11 @@ func newExternalFunc(
 }
 func newInternalFunc(
 	v *vm,
+	idx uint32,
 	funcType binary.FuncType,
 	code binary.Code) vmFunc {
 	return vmFunc{
+		idx:   idx,
 		type_: funcType,
 		code:  code,
 		vm:    v,
@@ -60,14 +66,62 @@ func (f vmFunc) Eval(args ...instance.WasmVal) []instance.WasmVal {
 	}
 }
 
+func (f vmFunc) TryEval(args ...instance.WasmVal) (results []instance.WasmVal, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			results = nil
+			err = instance.ToError(r)
+		}
+	}()
+	return f.Eval(args...), nil
+}
+
 // 从 vm 外部调用模块内部的函数（内部使用）
 func (f vmFunc) eval(args []interface{}) []interface{} {
+	// 如果执行过程中发生陷阱（panic），则将操作数栈和控制栈恢复到调用之前的状态，
+	// 以便模块实例仍然可以继续被调用
+	stackSize := f.vm.operandStack.stackSize()
+	controlDepth := f.vm.controlStack.controlDepth()
+	defer func() {
+		if r := recover(); r != nil {
+			// 在恢复控制栈之前记录本次调用的调用栈，
+			// 对于嵌套的调用（比如经由外部函数再次调用当前模块的函数），
+			// 内层的调用栈先被记录
+			if trap, ok := r.(*instance.Trap); ok {
+				trap.Backtrace = append(trap.Backtrace, f.vm.backtrace(controlDepth)...)
+			}
+			f.vm.operandStack.slots = f.vm.operandStack.slots[:stackSize]
+			f.vm.controlStack.frames = f.vm.controlStack.frames[:controlDepth]
+			if controlDepth == 0 {
+				f.vm.refs.reset()
+			}
+			panic(r)
+		}
+	}()
+
+	// 中断请求只对正在执行的调用有效，所以最外层的调用开始时丢弃之前的中断请求，
+	// 比如在模块实例空闲时，或者在上一次调用刚好结束时调用的 Interrupt()
+	if controlDepth == 0 {
+		f.vm.clearInterrupt()
+	}
+
+	// 从宿主进入模块（包括经由外部函数再次进入当前模块）视为一条 call 指令
+	if f.vm.fuelCosts != nil {
+		f.vm.consumeFuel(f.vm.fuelCosts[binary.Call])
+	}
+
 	pushArgs(f.vm, f.type_, args)
 	callFunc(f.vm, f)
 	if f.func_ == nil {
 		f.vm.loop()
 	}
-	return popResults(f.vm, f.type_)
+	results := popResults(f.vm, f.type_)
+
+	// 最外层的调用结束之后操作数栈上不再有引用的句柄，清空引用表，见 value_ref.go
+	if controlDepth == 0 {
+		f.vm.refs.reset()
+	}
+	return results
 }
 
 func pushArgs(v *vm, ft binary.FuncType, args []interface{}) {
@@ -96,13 +150,13 @@ func pushArgs(v *vm, ft binary.FuncType, args []interface{}) {
 		panic(errors.New("incorrect length of arguments"))
 	}
 	for i, vt := range ft.ParamTypes {
-		v.operandStack.pushU64(unwrapU64(vt, args[i]))
+		v.operandStack.pushSlot(v.unwrapSlot(vt, args[i]))
 	}
 }
 func popResults(v *vm, ft binary.FuncType) []interface{} {
 	results := make([]interface{}, len(ft.ResultTypes))
 	for n := len(ft.ResultTypes) - 1; n >= 0; n-- {
-		results[n] = wrapU64(ft.ResultTypes[n], v.operandStack.popU64())
+		results[n] = v.wrapSlot(ft.ResultTypes[n], v.operandStack.popSlot())
 	}
 	return results
 }
@@ -11,32 +11,59 @@ type globalVar struct {
 	type_ binary.GlobalType
 
 	// 数值
-	val uint64
+	val slot
+	ref instance.WasmVal // 引用类型的值直接存放 Go 的值，见 value_ref.go
 }
 
-func newGlobal(gt binary.GlobalType, val uint64) *globalVar {
-	return &globalVar{type_: gt, val: val}
+// 创建全局变量，用于宿主（host）模块提供全局变量
+func NewGlobal(valType binary.ValType, mutable bool, val instance.WasmVal) instance.Global {
+	gt := binary.GlobalType{ValType: valType}
+	if mutable {
+		gt.Mut = binary.MutVar
+	}
+	return newGlobal(gt, val)
+}
+
+func newGlobal(gt binary.GlobalType, val instance.WasmVal) *globalVar {
+	g := &globalVar{type_: gt}
+	g.Set(val)
+	return g
 }
 
 func (g *globalVar) Type() binary.GlobalType {
 	return g.type_
 }
 
+// 数值类型（不包括 v128）的全局变量才可以使用 GetAsU64/SetAsU64 读写
 func (g *globalVar) GetAsU64() uint64 { // 内部使用，name: GetRaw()
-	return g.val
+	return g.val.lo
 }
 
 func (g *globalVar) SetAsU64(val uint64) { // 内部使用，name: SetRaw(...)
 	if g.type_.Mut != 1 {
 		panic(errors.New("immutable global"))
 	}
-	g.val = val
+	g.val = slot{lo: val}
 }
 
 func (g *globalVar) Get() instance.WasmVal {
-	return wrapU64(g.type_.ValType, g.val)
+	switch vt := g.type_.ValType; {
+	case binary.IsRefType(vt):
+		return g.ref
+	case vt == binary.ValTypeV128:
+		return slotToV128(g.val)
+	default:
+		return wrapU64(vt, g.val.lo)
+	}
 }
 
 func (g *globalVar) Set(val instance.WasmVal) {
-	g.val = unwrapU64(g.type_.ValType, val)
+	switch vt := g.type_.ValType; {
+	case binary.IsRefType(vt):
+		g.ref = val
+	case vt == binary.ValTypeV128:
+		g.val = v128ToSlot(val.(binary.V128))
+	default:
+		g.val = slot{lo: unwrapU64(vt, val)}
+	}
 }
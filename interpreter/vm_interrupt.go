@@ -0,0 +1,70 @@
+package interpreter
+
+import (
+	"sync/atomic"
+	"wasmvm/instance"
+)
+
+// 中断执行
+//
+// 虚拟机在进入函数以及跳回循环开头（即每一次循环迭代）时检查：
+// - 是否调用了 Interrupt()；
+// - 当前调用（EvalFuncContext）的 context 是否已经被取消或者超时。
+// 满足任一条件则发生 instance.TrapInterrupted 陷阱。
+//
+// 因为不存在不经过上述两个位置的无限执行，所以执行总能在有限的
+// 指令数之内被中止。
+//
+// 唯一的例外是阻塞在 memory.atomic.wait32/64 的线程，它等待的同时也监听
+// 中断信号（见 interruptSignal）以及 context，被唤醒之后发生同样的陷阱。
+
+// 请求中断当前正在执行的调用，可以在其他 goroutine 里调用
+// 中断请求只对当前正在执行的调用有效，如果当前没有正在执行的调用，则中断请求被丢弃，
+// 不会影响之后的调用（见 clearInterrupt）
+func (v *vm) Interrupt() {
+	atomic.StoreInt32(&v.interrupted, 1)
+
+	v.interruptMu.Lock()
+	defer v.interruptMu.Unlock()
+	if v.interruptCh != nil {
+		close(v.interruptCh)
+		v.interruptCh = nil
+	}
+}
+
+// 返回在下一次 Interrupt() 时被关闭的通道
+//
+// 先获取通道再检查中断请求（checkInterrupt），即可保证不会错过中断：
+// 如果 Interrupt() 发生在获取通道之前，则检查时会发现中断请求，否则通道会被关闭。
+func (v *vm) interruptSignal() <-chan struct{} {
+	v.interruptMu.Lock()
+	defer v.interruptMu.Unlock()
+	if v.interruptCh == nil {
+		v.interruptCh = make(chan struct{})
+	}
+	return v.interruptCh
+}
+
+// 丢弃尚未生效的中断请求，在最外层的调用开始时调用
+func (v *vm) clearInterrupt() {
+	if atomic.LoadInt32(&v.interrupted) != 0 {
+		atomic.StoreInt32(&v.interrupted, 0)
+	}
+}
+
+func (v *vm) checkInterrupt() {
+	// 中断请求只生效一次
+	// 先读取再交换，以免每次检查（比如每一次循环迭代）都执行代价较大的交换操作
+	if atomic.LoadInt32(&v.interrupted) != 0 &&
+		atomic.CompareAndSwapInt32(&v.interrupted, 1, 0) {
+		panic(instance.NewTrap(instance.TrapInterrupted))
+	}
+
+	if v.done != nil {
+		select {
+		case <-v.done:
+			panic(instance.NewTrap(instance.TrapInterrupted))
+		default:
+		}
+	}
+}
@@ -1,8 +1,11 @@
 package interpreter
 
 import (
-	"errors"
+	"bytes"
+	"sync"
+	"time"
 	"wasmvm/binary"
+	"wasmvm/instance"
 )
 
 type memory struct {
@@ -10,6 +13,26 @@ type memory struct {
 	data  []byte         // 内存就是一个 byte 数组
 }
 
+// 创建内存块，用于宿主（host）模块提供内存
+// max 为 0 时表示不限制最大页面数
+func NewMemory(min uint32, max uint32) instance.Memory {
+	memType := binary.MemType{Min: uint64(min), Max: uint64(max)}
+	if max > 0 {
+		memType.Tag = 1
+	}
+	return newMemory(memType)
+}
+
+// 创建 64 位的内存块（memory64 提案），用于宿主模块提供内存
+// max 为 0 时表示不限制最大页面数
+func NewMemory64(min uint64, max uint64) instance.Memory64 {
+	memType := binary.MemType{Tag: binary.LimitsTag64, Min: min, Max: max}
+	if max > 0 {
+		memType.Tag |= binary.LimitsTagMax
+	}
+	return newMemory(memType)
+}
+
 func newMemory(memType binary.MemType) *memory {
 	return &memory{
 		type_: memType,
@@ -35,7 +58,12 @@ func (m *memory) Type() binary.MemType {
 // 获取内存块的页面数
 // 返回当前的页面数（uint32）
 func (m *memory) Size() uint32 {
-	return uint32(len(m.data) / binary.PageSize)
+	return uint32(m.Size64())
+}
+
+// 获取内存块的页面数，用于 64 位的内存
+func (m *memory) Size64() uint64 {
+	return uint64(len(m.data) / binary.PageSize)
 }
 
 // 扩充内存大小（在内存块的 max 允许的范围之内）
@@ -43,25 +71,39 @@ func (m *memory) Size() uint32 {
 // 返回旧的页面数（uint32）
 // 失败时会返回被转为 uint32 的 -1
 func (m *memory) Grow(increaseCount uint32) uint32 {
-	previousSize := m.Size()
+	return uint32(m.Grow64(uint64(increaseCount)))
+}
+
+// 扩充内存大小，用于 64 位的内存
+// 失败时会返回被转为 uint64 的 -1
+func (m *memory) Grow64(increaseCount uint64) uint64 {
+	previousSize := m.Size64()
 	if increaseCount == 0 {
 		return previousSize
 	}
 
-	// 如果不指定 max 值，则可以增长到最大允许的页面数 MaxPageCount
-	maxPages := uint32(binary.MaxPageCount)
+	// 如果不指定 max 值，则可以增长到最大允许的页面数 MaxPageCount（或者 MaxPageCount64）
+	maxPages := uint64(binary.MaxPageCount)
+	if m.type_.Is64() {
+		maxPages = binary.MaxPageCount64
+	}
 
-	if m.type_.Max > 0 {
+	if m.type_.HasMax() {
 		maxPages = m.type_.Max
 	}
 
-	if previousSize+increaseCount > maxPages {
+	// 注意 previousSize + increaseCount 可能会溢出
+	if increaseCount > maxPages || previousSize > maxPages-increaseCount {
 		// 失败则返回 -1
 		n1 := -1
-		return uint32(n1)
+		return uint64(n1)
 	}
 
-	newData := make([]byte, (previousSize+increaseCount)*binary.PageSize)
+	newData, ok := allocMemory(previousSize + increaseCount)
+	if !ok {
+		n1 := -1
+		return uint64(n1)
+	}
 	copy(newData, m.data)
 	m.data = newData
 
@@ -71,19 +113,181 @@ func (m *memory) Grow(increaseCount uint32) uint32 {
 	return previousSize
 }
 
-// 因为指令中的 offset 立即数是 uint32，而操作数栈弹出的值也是 uint32，
+// 分配指定页面数的内存，页面数过大（超出了 Go 能分配的范围）时返回 false
+func allocMemory(pages uint64) (data []byte, ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	return make([]byte, pages*binary.PageSize), true
+}
+
+// 对于 32 位的内存，因为指令中的 offset 立即数是 uint32，而操作数栈弹出的值也是 uint32，
 // 所以有效地址（uint32 + uint32）是一个 33 位的无符号整数，超出了 uint32 的范围，
 // 所以这里使用 uint64 存储有效地址。
+// 对于 64 位的内存，计算有效地址时已经检查了溢出。
 func (m *memory) Read(effective_address uint64, buf []byte) {
-	if int(effective_address)+len(buf) > len(m.data) {
-		panic(errors.New("out of memory boundary"))
-	}
+	m.checkRange(effective_address, len(buf))
 	copy(buf, m.data[effective_address:])
 }
 
 func (m *memory) Write(effective_address uint64, data []byte) {
-	if int(effective_address)+len(data) > len(m.data) {
-		panic(errors.New("out of memory boundary"))
-	}
+	m.checkRange(effective_address, len(data))
 	copy(m.data[effective_address:], data)
 }
+
+// 注意有效地址可能很大，不能直接转为 int 再相加
+func (m *memory) checkRange(effective_address uint64, n int) {
+	size := uint64(len(m.data))
+	if uint64(n) > size || effective_address > size-uint64(n) {
+		panic(instance.NewTrap(instance.TrapMemoryOutOfBounds))
+	}
+}
+
+// 共享内存（threads 提案）
+//
+// 共享内存可以同时被多个 goroutine 上的模块实例访问：
+//   - mu 保护 data，每次读写（包括扩充）都要获取这个锁，所以即使是普通的加载和
+//     存储指令也不会读写到扩充过程中的内存；
+//   - atomicMu 使各个原子操作（包括 wait 和 notify）互斥，原子操作在持有 atomicMu
+//     的情况下再通过 Read/Write 获取 mu，所以两个锁的获取顺序是固定的；
+//   - waiters 记录在各个地址上等待的 goroutine，按照等待的先后顺序排列，
+//     唤醒时关闭对应的 channel。
+type sharedMemory struct {
+	mem      *memory
+	mu       sync.Mutex
+	atomicMu sync.Mutex
+	waiters  map[uint64][]chan struct{}
+}
+
+// 创建共享内存，用于宿主模块向多个模块实例（线程）提供同一块内存
+// 共享内存必须指定最大页面数
+func NewSharedMemory(min uint32, max uint32) instance.SharedMemory {
+	return newSharedMemory(binary.MemType{
+		Tag: binary.LimitsTagMax | binary.LimitsTagShared,
+		Min: uint64(min),
+		Max: uint64(max),
+	})
+}
+
+func newSharedMemory(memType binary.MemType) *sharedMemory {
+	return &sharedMemory{
+		mem:     newMemory(memType),
+		waiters: map[uint64][]chan struct{}{},
+	}
+}
+
+func (m *sharedMemory) Type() binary.MemType {
+	return m.mem.Type()
+}
+
+func (m *sharedMemory) Size() uint32 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.mem.Size()
+}
+
+func (m *sharedMemory) Grow(increaseCount uint32) uint32 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.mem.Grow(increaseCount)
+}
+
+func (m *sharedMemory) Size64() uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.mem.Size64()
+}
+
+func (m *sharedMemory) Grow64(increaseCount uint64) uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.mem.Grow64(increaseCount)
+}
+
+func (m *sharedMemory) Read(effective_address uint64, buf []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.mem.Read(effective_address, buf)
+}
+
+func (m *sharedMemory) Write(effective_address uint64, data []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.mem.Write(effective_address, data)
+}
+
+func (m *sharedMemory) Atomic(f func()) {
+	m.atomicMu.Lock()
+	defer m.atomicMu.Unlock()
+	f()
+}
+
+func (m *sharedMemory) Wait(address uint64, expected []byte, timeout int64, cancel <-chan struct{}) uint32 {
+	var ch chan struct{}
+	m.Atomic(func() {
+		actual := make([]byte, len(expected))
+		m.Read(address, actual)
+		if bytes.Equal(actual, expected) {
+			ch = make(chan struct{})
+			m.waiters[address] = append(m.waiters[address], ch)
+		}
+	})
+
+	if ch == nil {
+		return 1 // 值不相等
+	}
+
+	// 不超时的时候 timer 为 nil，select 不会选中它
+	var timer <-chan time.Time
+	if timeout >= 0 {
+		t := time.NewTimer(time.Duration(timeout))
+		defer t.Stop()
+		timer = t.C
+	}
+
+	result := uint32(2)
+	select {
+	case <-ch:
+		return 0
+	case <-timer:
+	case <-cancel:
+		result = 3
+	}
+
+	// 超时或者被取消之后，如果在获取锁之前已经被唤醒了，仍然视为被唤醒
+	m.Atomic(func() {
+		waiters := m.waiters[address]
+		for i, c := range waiters {
+			if c == ch {
+				m.setWaiters(address, append(waiters[:i:i], waiters[i+1:]...))
+				return
+			}
+		}
+		result = 0
+	})
+	return result
+}
+
+func (m *sharedMemory) Notify(address uint64, count uint32) uint32 {
+	woken := uint32(0)
+	m.Atomic(func() {
+		waiters := m.waiters[address]
+		for woken < count && int(woken) < len(waiters) {
+			close(waiters[woken])
+			woken++
+		}
+		m.setWaiters(address, waiters[woken:])
+	})
+	return woken
+}
+
+// 设置在指定地址上等待的列表，需要在持有 atomicMu 的情况下调用
+func (m *sharedMemory) setWaiters(address uint64, remaining []chan struct{}) {
+	if len(remaining) == 0 {
+		delete(m.waiters, address)
+	} else {
+		m.waiters[address] = remaining
+	}
+}
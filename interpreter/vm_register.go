@@ -0,0 +1,400 @@
+package interpreter
+
+import (
+	"math"
+	"math/bits"
+	"wasmvm/binary"
+)
+
+// 寄存器引擎
+//
+// 栈式引擎（默认）的每条指令都需要从操作数栈弹出操作数，然后把结果压入操作数栈，
+// 即使是 local.get 和 i32.const 这样的指令也需要一次压入。寄存器引擎（见 Config.Engine）
+// 在预编译（见 vm_compile.go）时进一步将指令转换为三地址形式的寄存器指令：
+//
+// - 每个调用帧的寄存器就是该帧在操作数栈上的槽位，第 i 个寄存器即 bp + i，
+//   前面是参数和局部变量，后面是运算槽位（栈高度为 h 的操作数存放在第 h 个寄存器），
+//   所以寄存器的数量就是操作数栈的最大高度（compiledFunc.frameSize），进入函数时
+//   操作数栈一次性扩展到 bp + frameSize；
+// - local.get 和常量指令不生成指令，而是把局部变量（或者常量）记录为操作数栈上
+//   的操作数，由使用它的指令直接读取对应的寄存器（或者立即数）；
+// - 整数的算术、位运算以及比较指令直接读写寄存器，比如 i32.add 编译之后是
+//   `r[a] = r[b] + r[c]`，如果右操作数是常量则是 `r[a] = r[b] + imm`；
+// - 紧接在运算指令之后的 local.set 不生成指令，而是将运算指令的目标寄存器改为局部变量。
+//
+// 其余的指令（包括分支、函数调用以及各种不常用的指令）仍然使用原来的栈式指令，
+// 执行之前先将尚未写入寄存器的操作数（局部变量或者常量）写入各自的寄存器，
+// 然后将操作数栈的大小设置为 bp + 指令执行之前的栈高度（compiledInstr.sp），
+// 这样栈式指令从栈顶弹出和压入的操作数刚好就是对应的寄存器，例如：
+//
+// (local.set $i (i32.add (local.get $i) (i32.const 1)))
+//
+// 栈式引擎：              寄存器引擎：
+//   local.get 1            r1 = r1 + 1    ;; opRegBinaryImm
+//   i32.const 1
+//   i32.add
+//   local.set 1
+//
+// 启用燃料计量时，合并到寄存器指令（或者没有生成指令）的原指令的燃料计入紧接着的指令，
+// 见 vm_compile.go，所以消耗的燃料跟栈式引擎相同。
+
+const (
+	opRegMove      = opBinaryLocal + 1 + iota // r[a] = r[b]
+	opRegConst                                // r[a] = imm
+	opRegUnary                                // r[a] = op(r[b])
+	opRegBinary                               // r[a] = op(r[b], r[c])
+	opRegBinaryImm                            // r[a] = op(r[b], imm)
+)
+
+// 编译过程中操作数栈上的操作数
+type regVal struct {
+	kind byte   // 见 valXxx 常量
+	reg  uint32 // 局部变量的索引
+	imm  uint64 // 常量的值
+}
+
+const (
+	valInReg = iota // 已经存放在栈高度对应的寄存器里
+	valLocal        // 局部变量的值，尚未写入栈高度对应的寄存器
+	valConst        // 常量，尚未写入栈高度对应的寄存器
+)
+
+// 转换可以直接读写寄存器的指令，其他的指令返回 false
+func (c *compiler) compileRegInstr(inst binary.Instruction) bool {
+	switch opcode := inst.Opcode; opcode {
+	case binary.LocalGet:
+		c.pushVal(regVal{kind: valLocal, reg: inst.Args.(uint32)})
+	case binary.LocalSet:
+		c.compileLocalSet(inst.Args.(uint32))
+		c.height--
+	case binary.LocalTee:
+		idx := inst.Args.(uint32)
+		c.compileLocalSet(idx)
+		c.setVal(c.height-1, regVal{kind: valLocal, reg: idx})
+	case binary.I32Const:
+		c.pushVal(regVal{kind: valConst, imm: uint64(uint32(inst.Args.(int32)))})
+	case binary.I64Const:
+		c.pushVal(regVal{kind: valConst, imm: uint64(inst.Args.(int64))})
+	case binary.F32Const:
+		c.pushVal(regVal{kind: valConst, imm: uint64(math.Float32bits(inst.Args.(float32)))})
+	case binary.F64Const:
+		c.pushVal(regVal{kind: valConst, imm: math.Float64bits(inst.Args.(float64))})
+	case binary.Drop:
+		c.height--
+	default:
+		if regBinaryOps[opcode] != nil {
+			c.compileRegBinary(opcode)
+		} else if regUnaryOps[opcode] != nil {
+			c.compileRegUnary(opcode)
+		} else {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *compiler) compileRegBinary(opcode byte) {
+	h := c.height - 2
+	in := compiledInstr{op: opRegBinary, opcode: opcode, a: uint32(h)}
+	if rhs := c.getVal(h + 1); rhs.kind == valConst {
+		in.op = opRegBinaryImm
+		in.imm = rhs.imm
+	} else {
+		in.c = c.useVal(h + 1)
+	}
+	in.b = c.useVal(h)
+
+	c.emitDef(in)
+	c.height--
+	c.setVal(h, regVal{})
+}
+
+func (c *compiler) compileRegUnary(opcode byte) {
+	h := c.height - 1
+	in := compiledInstr{op: opRegUnary, opcode: opcode, a: uint32(h)}
+	in.b = c.useVal(h)
+
+	c.emitDef(in)
+	c.setVal(h, regVal{})
+}
+
+// 将栈顶的操作数写入局部变量
+func (c *compiler) compileLocalSet(idx uint32) {
+	h := c.height - 1
+
+	// 操作数栈上引用了该局部变量（原来的值）的操作数需要先写入各自的寄存器
+	for i := 0; i < h && i < len(c.vals); i++ {
+		if val := c.vals[i]; val.kind == valLocal && val.reg == idx {
+			c.emit(compiledInstr{op: opRegMove, pseudo: true, a: uint32(i), b: idx})
+			c.vals[i] = regVal{}
+		}
+	}
+
+	switch val := c.getVal(h); val.kind {
+	case valLocal:
+		if val.reg != idx {
+			c.emit(compiledInstr{op: opRegMove, opcode: binary.LocalSet, a: idx, b: val.reg})
+		}
+	case valConst:
+		c.emit(compiledInstr{op: opRegConst, opcode: binary.LocalSet, a: idx, imm: val.imm})
+	default:
+		if c.lastDef >= 0 && c.fn.code[c.lastDef].a == uint32(h) {
+			// 改写上一条运算指令的目标寄存器
+			c.fn.code[c.lastDef].a = idx
+			c.lastDef = -1
+		} else {
+			c.emit(compiledInstr{op: opRegMove, opcode: binary.LocalSet, a: idx, b: uint32(h)})
+		}
+	}
+}
+
+// -------- 辅助方法
+
+// 生成一条写入栈高度对应的寄存器的指令，紧接着的 local.set 可以改写它的目标寄存器
+func (c *compiler) emitDef(in compiledInstr) {
+	c.lastDef = c.emit(in)
+}
+
+func (c *compiler) pushVal(val regVal) {
+	c.setVal(c.height, val)
+	c.height++
+}
+
+func (c *compiler) getVal(h int) regVal {
+	if h < 0 || h >= len(c.vals) {
+		return regVal{}
+	}
+	return c.vals[h]
+}
+
+func (c *compiler) setVal(h int, val regVal) {
+	if h < 0 {
+		return // 不可到达的指令
+	}
+	for len(c.vals) <= h {
+		c.vals = append(c.vals, regVal{})
+	}
+	c.vals[h] = val
+}
+
+// 获取存放栈高度为 h 的操作数的寄存器，常量会先被写入栈高度对应的寄存器
+func (c *compiler) useVal(h int) uint32 {
+	switch val := c.getVal(h); val.kind {
+	case valLocal:
+		return val.reg
+	case valConst:
+		c.emit(compiledInstr{op: opRegConst, pseudo: true, a: uint32(h), imm: val.imm})
+		c.setVal(h, regVal{})
+	}
+	return uint32(h)
+}
+
+// 将尚未写入寄存器的操作数写入栈高度对应的寄存器
+// 执行栈式指令之前以及在结构块的边界（分支的目标）处，所有操作数都必须位于各自的寄存器
+func (c *compiler) flush() {
+	if !c.regs {
+		return
+	}
+
+	for h := 0; h < c.height && h < len(c.vals); h++ {
+		switch val := c.vals[h]; val.kind {
+		case valLocal:
+			c.emit(compiledInstr{op: opRegMove, pseudo: true, a: uint32(h), b: val.reg})
+		case valConst:
+			c.emit(compiledInstr{op: opRegConst, pseudo: true, a: uint32(h), imm: val.imm})
+		}
+	}
+	c.vals = c.vals[:0]
+}
+
+func (c *compiler) updateMaxHeight() {
+	if c.height > c.maxHeight {
+		c.maxHeight = c.height
+	}
+}
+
+// -------- 执行
+
+// 跟 vm.execFrame() 相同，但执行的是寄存器引擎编译的指令
+func (v *vm) execRegFrame(frame *controlFrame) {
+	fn := frame.fn
+	code := fn.code
+	s := &v.operandStack
+	bp := frame.bp
+	fuel := v.fuelCosts != nil
+
+	// 调用其他函数时操作数栈可能会被重新分配，所以每次进入（或者回到）
+	// 调用帧时都需要重新获取寄存器
+	s.resize(bp + fn.frameSize)
+	regs := s.slots[bp : bp+fn.frameSize]
+
+	for {
+		in := &code[frame.pc]
+		frame.pc++ // 向前移动一个指令
+		if fuel {
+			v.consumeFuel(in.fuel)
+		}
+
+		switch in.op {
+		case opRegMove:
+			regs[in.a] = regs[in.b]
+		case opRegConst:
+			regs[in.a] = slot{lo: in.imm}
+		case opRegUnary:
+			regs[in.a] = slot{lo: regUnaryOps[in.opcode](regs[in.b].lo)}
+		case opRegBinary:
+			regs[in.a] = slot{lo: regBinaryOps[in.opcode](regs[in.b].lo, regs[in.c].lo)}
+		case opRegBinaryImm:
+			regs[in.a] = slot{lo: regBinaryOps[in.opcode](regs[in.b].lo, in.imm)}
+		case opExec:
+			s.slots = s.slots[:bp+int(in.sp)]
+			instructionTable[in.opcode](v, in.args)
+		case opExecCall:
+			s.slots = s.slots[:bp+int(in.sp)]
+			instructionTable[in.opcode](v, in.args)
+			return
+		case opGlobalGet:
+			regs[in.sp] = slot{lo: v.globals[in.imm].GetAsU64()}
+		case opGlobalSet:
+			v.globals[in.imm].SetAsU64(regs[in.sp-1].lo)
+		case opMemAccess:
+			s.slots = s.slots[:bp+int(in.sp)]
+			memoryAccessTable[in.opcode](v, in.a, in.imm)
+		case opIndexed:
+			s.slots = s.slots[:bp+int(in.sp)]
+			indexedInstructionTable[in.opcode](v, in.a, in.b)
+		case opMiscIndexed:
+			s.slots = s.slots[:bp+int(in.sp)]
+			miscIndexedInstructionTable[in.c](v, in.a, in.b)
+		case opNop:
+			// 结构块在编译之后不需要执行任何操作
+		case opIf:
+			if uint32(regs[in.sp-1].lo) == 0 {
+				frame.pc = in.target.pc
+			}
+		case opJump:
+			frame.pc = in.target.pc
+		case opDropKeep:
+			moveResults(regs, &in.target, int(in.sp))
+		case opBr:
+			v.regBr(frame, regs, &in.target, int(in.sp))
+		case opBrIf:
+			if uint32(regs[in.sp-1].lo) != 0 {
+				v.regBr(frame, regs, &in.target, int(in.sp)-1)
+			}
+		case opBrTable:
+			targets := fn.brTables[in.imm]
+			idx := int(uint32(regs[in.sp-1].lo))
+			if idx >= len(targets)-1 {
+				idx = len(targets) - 1
+			}
+			v.regBr(frame, regs, &targets[idx], int(in.sp)-1)
+		case opReturn:
+			s.slots = s.slots[:bp+int(in.sp)]
+			v.exitFunc(in.target.arity)
+			return
+		case opCall:
+			s.slots = s.slots[:bp+int(in.sp)]
+			callFunc(v, v.funcs[in.imm])
+			return
+		case opRethrow:
+			rethrow(frame, int(in.imm))
+		}
+	}
+}
+
+// 跟 br() 相同，top 为（弹出条件或者索引之后的）栈高度
+func (v *vm) regBr(frame *controlFrame, regs []slot, target *branchTarget, top int) {
+	moveResults(regs, target, top)
+	frame.pc = target.pc
+
+	if target.loop {
+		// 跳回循环的开头，检查是否需要中断执行
+		v.checkInterrupt()
+	}
+}
+
+// 将栈顶的 target.arity 个操作数移动到目标结构块的栈底，跟 operandStack.dropKeep() 相同
+func moveResults(regs []slot, target *branchTarget, top int) {
+	if from := top - target.arity; from > target.height {
+		copy(regs[target.height:], regs[from:top])
+	}
+}
+
+// -------- 直接读写寄存器的指令
+//
+// 跟栈式指令一样，i32 的值存放在低 32 位，高位为 0，比较的结果为 1 或者 0
+
+func b2u(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+var regUnaryOps = [256]func(x uint64) uint64{
+	binary.I32Eqz:        func(x uint64) uint64 { return b2u(uint32(x) == 0) },
+	binary.I64Eqz:        func(x uint64) uint64 { return b2u(x == 0) },
+	binary.I32Clz:        func(x uint64) uint64 { return uint64(bits.LeadingZeros32(uint32(x))) },
+	binary.I32Ctz:        func(x uint64) uint64 { return uint64(bits.TrailingZeros32(uint32(x))) },
+	binary.I32PopCnt:     func(x uint64) uint64 { return uint64(bits.OnesCount32(uint32(x))) },
+	binary.I64Clz:        func(x uint64) uint64 { return uint64(bits.LeadingZeros64(x)) },
+	binary.I64Ctz:        func(x uint64) uint64 { return uint64(bits.TrailingZeros64(x)) },
+	binary.I64PopCnt:     func(x uint64) uint64 { return uint64(bits.OnesCount64(x)) },
+	binary.I32WrapI64:    func(x uint64) uint64 { return uint64(uint32(x)) },
+	binary.I64ExtendI32S: func(x uint64) uint64 { return uint64(int64(int32(x))) },
+	binary.I64ExtendI32U: func(x uint64) uint64 { return uint64(uint32(x)) },
+	binary.I32Extend8S:   func(x uint64) uint64 { return uint64(uint32(int32(int8(x)))) },
+	binary.I32Extend16S:  func(x uint64) uint64 { return uint64(uint32(int32(int16(x)))) },
+	binary.I64Extend8S:   func(x uint64) uint64 { return uint64(int64(int8(x))) },
+	binary.I64Extend16S:  func(x uint64) uint64 { return uint64(int64(int16(x))) },
+	binary.I64Extend32S:  func(x uint64) uint64 { return uint64(int64(int32(x))) },
+}
+
+var regBinaryOps = [256]func(lhs, rhs uint64) uint64{
+	// i32
+	binary.I32Eq:   func(lhs, rhs uint64) uint64 { return b2u(uint32(lhs) == uint32(rhs)) },
+	binary.I32Ne:   func(lhs, rhs uint64) uint64 { return b2u(uint32(lhs) != uint32(rhs)) },
+	binary.I32LtS:  func(lhs, rhs uint64) uint64 { return b2u(int32(lhs) < int32(rhs)) },
+	binary.I32LtU:  func(lhs, rhs uint64) uint64 { return b2u(uint32(lhs) < uint32(rhs)) },
+	binary.I32GtS:  func(lhs, rhs uint64) uint64 { return b2u(int32(lhs) > int32(rhs)) },
+	binary.I32GtU:  func(lhs, rhs uint64) uint64 { return b2u(uint32(lhs) > uint32(rhs)) },
+	binary.I32LeS:  func(lhs, rhs uint64) uint64 { return b2u(int32(lhs) <= int32(rhs)) },
+	binary.I32LeU:  func(lhs, rhs uint64) uint64 { return b2u(uint32(lhs) <= uint32(rhs)) },
+	binary.I32GeS:  func(lhs, rhs uint64) uint64 { return b2u(int32(lhs) >= int32(rhs)) },
+	binary.I32GeU:  func(lhs, rhs uint64) uint64 { return b2u(uint32(lhs) >= uint32(rhs)) },
+	binary.I32Add:  func(lhs, rhs uint64) uint64 { return uint64(uint32(lhs) + uint32(rhs)) },
+	binary.I32Sub:  func(lhs, rhs uint64) uint64 { return uint64(uint32(lhs) - uint32(rhs)) },
+	binary.I32Mul:  func(lhs, rhs uint64) uint64 { return uint64(uint32(lhs) * uint32(rhs)) },
+	binary.I32And:  func(lhs, rhs uint64) uint64 { return uint64(uint32(lhs) & uint32(rhs)) },
+	binary.I32Or:   func(lhs, rhs uint64) uint64 { return uint64(uint32(lhs) | uint32(rhs)) },
+	binary.I32Xor:  func(lhs, rhs uint64) uint64 { return uint64(uint32(lhs) ^ uint32(rhs)) },
+	binary.I32Shl:  func(lhs, rhs uint64) uint64 { return uint64(uint32(lhs) << (uint32(rhs) % 32)) },
+	binary.I32ShrS: func(lhs, rhs uint64) uint64 { return uint64(uint32(int32(lhs) >> (uint32(rhs) % 32))) },
+	binary.I32ShrU: func(lhs, rhs uint64) uint64 { return uint64(uint32(lhs) >> (uint32(rhs) % 32)) },
+	binary.I32Rotl: func(lhs, rhs uint64) uint64 { return uint64(bits.RotateLeft32(uint32(lhs), int(uint32(rhs)))) },
+	binary.I32Rotr: func(lhs, rhs uint64) uint64 { return uint64(bits.RotateLeft32(uint32(lhs), -int(uint32(rhs)))) },
+
+	// i64
+	binary.I64Eq:   func(lhs, rhs uint64) uint64 { return b2u(lhs == rhs) },
+	binary.I64Ne:   func(lhs, rhs uint64) uint64 { return b2u(lhs != rhs) },
+	binary.I64LtS:  func(lhs, rhs uint64) uint64 { return b2u(int64(lhs) < int64(rhs)) },
+	binary.I64LtU:  func(lhs, rhs uint64) uint64 { return b2u(lhs < rhs) },
+	binary.I64GtS:  func(lhs, rhs uint64) uint64 { return b2u(int64(lhs) > int64(rhs)) },
+	binary.I64GtU:  func(lhs, rhs uint64) uint64 { return b2u(lhs > rhs) },
+	binary.I64LeS:  func(lhs, rhs uint64) uint64 { return b2u(int64(lhs) <= int64(rhs)) },
+	binary.I64LeU:  func(lhs, rhs uint64) uint64 { return b2u(lhs <= rhs) },
+	binary.I64GeS:  func(lhs, rhs uint64) uint64 { return b2u(int64(lhs) >= int64(rhs)) },
+	binary.I64GeU:  func(lhs, rhs uint64) uint64 { return b2u(lhs >= rhs) },
+	binary.I64Add:  func(lhs, rhs uint64) uint64 { return lhs + rhs },
+	binary.I64Sub:  func(lhs, rhs uint64) uint64 { return lhs - rhs },
+	binary.I64Mul:  func(lhs, rhs uint64) uint64 { return lhs * rhs },
+	binary.I64And:  func(lhs, rhs uint64) uint64 { return lhs & rhs },
+	binary.I64Or:   func(lhs, rhs uint64) uint64 { return lhs | rhs },
+	binary.I64Xor:  func(lhs, rhs uint64) uint64 { return lhs ^ rhs },
+	binary.I64Shl:  func(lhs, rhs uint64) uint64 { return lhs << (rhs % 64) },
+	binary.I64ShrS: func(lhs, rhs uint64) uint64 { return uint64(int64(lhs) >> (rhs % 64)) },
+	binary.I64ShrU: func(lhs, rhs uint64) uint64 { return lhs >> (rhs % 64) },
+	binary.I64Rotl: func(lhs, rhs uint64) uint64 { return bits.RotateLeft64(lhs, int(rhs%64)) },
+	binary.I64Rotr: func(lhs, rhs uint64) uint64 { return bits.RotateLeft64(lhs, -int(rhs%64)) },
+}
@@ -2,11 +2,13 @@ package interpreter
 
 import (
 	"errors"
-	"wasmvm/binary"
+	"math"
+	"wasmvm/instance"
 )
 
-// 当前的 vm 实现共用调用帧以及流程控制的块帧，所以
-// 称为 `控制帧`（`controlFrame`）。
+// 函数体在实例化时已经被编译为扁平的指令数组（见 vm_compile.go），结构块
+// 不再需要创建控制帧，所以控制栈里只有调用帧，为了跟之前的实现保持一致，
+// 仍然称为 `控制帧`（`controlFrame`）。
 //
 // 当前的 vm 实现不为每个调用帧的创建新的操作数栈，而是
 // 将所有操作数栈都共享同一个操作数栈，然后使用控制帧记录
@@ -38,31 +40,32 @@ type controlStack struct {
 }
 
 type controlFrame struct {
-	// 创建当前帧的指令
-	// 对于函数调用，创建帧的指令是 call
-	// 对于流程控制所产生的帧，创建的指令有 block/loop 等
-	opcode byte
+	// 被调用函数编译之后的指令
+	fn *compiledFunc
 
-	// 函数签名、以及块类型
-	bt binary.FuncType
-
-	// 复制了一份当前过程的指令
-	instructions []binary.Instruction
-
-	// base pointer 一个栈帧的开始的开始地址，对于函数调用来说，它是第 0 个实参的地址
+	// base pointer 一个栈帧的开始的开始地址，即第 0 个实参的地址
 	bp int
 
-	// program counter 程序计数器，即当前指令的地址 **在当前帧** 里的位置，
+	// program counter 程序计数器，即下一条指令在 fn.code 里的位置，
 	// 初始值为 0
 	pc int
+
+	// 被调用函数的索引，用于生成陷阱的调用栈
+	funcIdx uint32
+
+	// 正在执行的 catch 以及 catch_all 子句所捕获的异常，用于 rethrow 指令，
+	// 按子句的嵌套深度从小到大排列
+	exceptions []caughtException
 }
 
-func newControlFrame(opcode byte,
-	bt binary.FuncType,
-	instructions []binary.Instruction,
-	bp int) *controlFrame {
+type caughtException struct {
+	depth int // catch 子句（即 try 块）的嵌套深度
+	ex    *instance.Exception
+}
+
+func newControlFrame(fn *compiledFunc, bp int, funcIdx uint32) *controlFrame {
 	// pc 初始值为 0
-	return &controlFrame{opcode, bt, instructions, bp, 0}
+	return &controlFrame{fn: fn, bp: bp, funcIdx: funcIdx}
 }
 
 func (s *controlStack) pushControlFrame(f *controlFrame) {
@@ -82,33 +85,106 @@ func (s *controlStack) controlDepth() int { // name: frameSize
 	return len(s.frames)
 }
 
-// 获取栈（包括控制栈和调用栈在内）顶的帧
+// 获取栈顶的帧
 func (s *controlStack) topControlFrame() *controlFrame { // name: topFrame
 	return s.frames[len(s.frames)-1]
 }
 
-// 获取最后的一个**调用帧**（非结构控制帧）
-func (s *controlStack) topCallFrame() *controlFrame {
-	for idx := len(s.frames) - 1; idx >= 0; idx-- {
-		if f := s.frames[idx]; f.opcode == binary.Call {
-			return f
+// 记录进入嵌套深度为 depth 的 catch 子句时捕获的异常
+// 同一深度以及更深的 catch 子句都已经结束，它们的记录随之失效
+func (f *controlFrame) setException(depth int, ex *instance.Exception) {
+	n := len(f.exceptions)
+	for n > 0 && f.exceptions[n-1].depth >= depth {
+		n--
+	}
+	f.exceptions = append(f.exceptions[:n], caughtException{depth: depth, ex: ex})
+}
+
+func (f *controlFrame) getException(depth int) *instance.Exception {
+	for i := len(f.exceptions) - 1; i >= 0; i-- {
+		if f.exceptions[i].depth == depth {
+			return f.exceptions[i].ex
 		}
 	}
+	panic(errors.New("caught exception not found"))
+}
 
-	panic(errors.New("call frame not found"))
+// -------- 异常的捕获
+//
+// throw 指令（或者宿主函数）抛出的异常以 panic(*instance.Exception) 的方式
+// 中止当前指令的执行，vm.loop() 从控制栈顶往下，在每个调用帧的函数的 try 表里
+// 查找能捕获该异常的 try 结构块（对于栈顶的帧，查找的位置是抛出异常的指令，
+// 对于其他帧，则是正在执行的 call 指令）：
+//
+// - 主体包含该位置的 try 块，如果有标签相同的 catch 子句，或者有 catch_all 子句，
+//   则捕获该异常，否则继续查找外层的 try 块；
+// - 以 delegate 结束的 try 块，则跳过 delegate 的目标之内的各层结构块，
+//   从目标层开始继续查找；
+// - 位于 catch 子句里的位置不在 try 块的主体范围之内，所以正在执行的 catch 子句
+//   不能捕获异常；
+// - 当前函数里找不到时，继续在下一个调用帧里查找。
+//
+// 捕获异常时，捕获异常的函数以上的调用帧全部被弹出，操作数栈恢复到进入 try 块时的高度，
+// 然后跳到 catch 子句的第一条指令（对于 catch 子句，异常的实参先被压入操作数栈）。
+//
+// - call    <-- 栈顶，弹出
+// - call    <-- 捕获异常的函数，跳到 catch 子句
+// - call
+//
+// 查找的范围只限于当前 vm.loop() 的帧（即入口函数的调用帧 frames[startDepth-1]
+// 以及之上的帧），
+// 查找不到时异常继续往外抛出，由外层的 vm.loop()（经由外部函数再次调用当前模块时）
+// 或者宿主处理。
+
+// 查找并转到捕获异常的 catch 子句，找不到时返回 false
+func (v *vm) catchException(ex *instance.Exception, startDepth int) bool {
+	frames := v.controlStack.frames
+	for idx := len(frames) - 1; idx >= startDepth-1; idx-- {
+		frame := frames[idx]
+		// 执行指令之前 pc 已经向前移动，所以抛出异常的指令是 pc - 1
+		if try, handler, ok := v.findCatch(frame.fn, frame.pc-1, ex); ok {
+			v.enterCatch(idx, try, handler, ex)
+			return true
+		}
+	}
+	return false
 }
 
-// 返回当前帧距离调用帧（即当前函数）的相对于函数的深度
-// 注：函数也是一个 block
-// 如果是在函数层获取，则返回 0，
-// 如果是在一层 block 里获取，则返回 1
-// 如果是在两层 block 里获取，则返回 2
-func (s *controlStack) getRelativeDepth() int {
-	for idx := len(s.frames) - 1; idx >= 0; idx-- {
-		if frame := s.frames[idx]; frame.opcode == binary.Call {
-			return len(s.frames) - 1 - idx
+// 在函数里查找能捕获位于 pc 的指令所抛出的异常的 catch 子句
+func (v *vm) findCatch(fn *compiledFunc, pc int, ex *instance.Exception) (*tryBlock, catchHandler, bool) {
+	maxDepth := math.MaxInt
+	for {
+		try := fn.getTryBlock(pc, maxDepth)
+		if try == nil {
+			return nil, catchHandler{}, false
 		}
+
+		if try.delegate >= 0 {
+			maxDepth = try.delegate
+			continue
+		}
+
+		for _, handler := range try.catches {
+			if handler.all || v.tags[handler.tag] == ex.Tag {
+				return try, handler, true
+			}
+		}
+		maxDepth = try.depth - 1
+	}
+}
+
+func (v *vm) enterCatch(idx int, try *tryBlock, handler catchHandler, ex *instance.Exception) {
+	for v.controlStack.controlDepth() > idx+1 {
+		v.controlStack.popControlFrame()
 	}
 
-	panic(errors.New("control stack error"))
+	frame := v.controlStack.topControlFrame()
+	v.operandStack.resize(frame.bp + try.height)
+	if !handler.all {
+		for i, vt := range ex.Tag.Type().ParamTypes {
+			v.operandStack.pushSlot(v.unwrapSlot(vt, ex.Args[i]))
+		}
+	}
+	frame.pc = handler.pc
+	frame.setException(try.depth, ex)
 }
@@ -1,10 +1,40 @@
 package interpreter
 
-import "math"
+import (
+	"math"
+	"wasmvm/binary"
+	"wasmvm/instance"
+)
 
 // 操作数栈（运算栈）
 type operandStack struct {
-	slots []uint64
+	slots    []slot
+	maxSlots int // 最大的槽位数量，为 0 时表示不限制
+}
+
+// 操作数栈的槽位
+//
+// 每个槽位有 128 位，以便 v128 类型的值也只占用一个槽位，这样局部变量的索引
+// 以及控制帧记录的栈高度都跟值的数量一一对应。
+// 标量（i32, i64, f32, f64 以及引用）只使用低 64 位，高 64 位为 0；
+// v128 按小端序存放，即第 0~7 个字节存放在低 64 位
+type slot struct {
+	lo uint64
+	hi uint64
+}
+
+func v128ToSlot(val binary.V128) slot {
+	return slot{
+		lo: byteOrder.Uint64(val[:8]),
+		hi: byteOrder.Uint64(val[8:]),
+	}
+}
+
+func slotToV128(s slot) binary.V128 {
+	var val binary.V128
+	byteOrder.PutUint64(val[:8], s.lo)
+	byteOrder.PutUint64(val[8:], s.hi)
+	return val
 }
 
 // 部分指令是明确注明是将整数解析为有符号数再进行运算，
@@ -12,8 +42,37 @@ type operandStack struct {
 
 // -------- 压入
 
+func (s *operandStack) pushSlot(val slot) {
+	n := len(s.slots)
+	if n == cap(s.slots) {
+		s.grow(n + 1)
+	}
+	s.slots = s.slots[:n+1]
+	s.slots[n] = val
+}
+
+// 扩大操作数栈的容量，使之至少能容纳 size 个槽位
+// 容量不会超过最大的槽位数量，所以压入操作数时只需检查容量，
+// size 超过最大的槽位数量时发生陷阱
+func (s *operandStack) grow(size int) {
+	if s.maxSlots > 0 && size > s.maxSlots {
+		panic(instance.NewTrap(instance.TrapStackExhausted))
+	}
+
+	newCap := 2*cap(s.slots) + 64
+	if newCap < size {
+		newCap = size
+	}
+	if s.maxSlots > 0 && newCap > s.maxSlots {
+		newCap = s.maxSlots
+	}
+	slots := make([]slot, len(s.slots), newCap)
+	copy(slots, s.slots)
+	s.slots = slots
+}
+
 func (s *operandStack) pushU64(val uint64) {
-	s.slots = append(s.slots, val)
+	s.pushSlot(slot{lo: val})
 }
 
 func (s *operandStack) pushU32(val uint32) {
@@ -36,6 +95,10 @@ func (s *operandStack) pushF64(val float64) {
 	s.pushU64(math.Float64bits(val))
 }
 
+func (s *operandStack) pushV128(val binary.V128) {
+	s.pushSlot(v128ToSlot(val))
+}
+
 func (s *operandStack) pushBool(val bool) {
 	// 使用 int32（有符号） 作为 boolean 型的数据类型
 	if val {
@@ -47,13 +110,17 @@ func (s *operandStack) pushBool(val bool) {
 
 // -------- 弹出
 
-func (s *operandStack) popU64() uint64 {
+func (s *operandStack) popSlot() slot {
 	lastIdx := len(s.slots) - 1
 	val := s.slots[lastIdx]
 	s.slots = s.slots[:lastIdx]
 	return val
 }
 
+func (s *operandStack) popU64() uint64 {
+	return s.popSlot().lo
+}
+
 func (s *operandStack) popS64() int64 {
 	return int64(s.popU64())
 }
@@ -74,6 +141,10 @@ func (s *operandStack) popF64() float64 {
 	return math.Float64frombits(s.popU64())
 }
 
+func (s *operandStack) popV128() binary.V128 {
+	return slotToV128(s.popSlot())
+}
+
 func (s *operandStack) popBool() bool {
 	// 使用 int32（有符号） 作为 boolean 型的数据类型
 	return s.popS32() != 0
@@ -89,29 +160,37 @@ func (s *operandStack) stackSize() int {
 
 // 按索引来获取栈的操作数
 // 用于函数调用的实参以及局部变量的读写
-func (s *operandStack) getOperand(idx uint32) uint64 {
+func (s *operandStack) getOperand(idx uint32) slot {
 	return s.slots[idx]
 }
 
 // 按索引来设置栈的操作数
 // 用于函数调用的实参以及局部变量的读写
-func (s *operandStack) setOperand(idx uint32, val uint64) {
+func (s *operandStack) setOperand(idx uint32, val slot) {
 	s.slots[idx] = val
 }
 
-func (s *operandStack) pushValues(vals []uint64) {
-	s.slots = append(s.slots, vals...)
-}
-
-func (s *operandStack) popValues(count int) []uint64 {
-	pos := len(s.slots) - count
-	vals := s.slots[pos:]
-	s.slots = s.slots[:pos]
-	return vals
+// 将栈的大小设置为 size，增加的槽位的值是未定义的，
+// 用于捕获异常时恢复栈的高度，以及寄存器引擎分配调用帧的寄存器
+func (s *operandStack) resize(size int) {
+	if size > cap(s.slots) {
+		s.grow(size)
+	}
+	s.slots = s.slots[:size]
 }
 
-func (s *operandStack) peekValue() uint64 {
+func (s *operandStack) peekValue() slot {
 	lastIdx := len(s.slots) - 1
 	val := s.slots[lastIdx]
 	return val
 }
+
+// 丢弃从 base 开始到栈顶的操作数，但保留栈顶的 keep 个操作数（移动到 base 处），
+// 用于分支以及函数返回
+func (s *operandStack) dropKeep(base int, keep int) {
+	top := len(s.slots) - keep
+	if top > base {
+		copy(s.slots[base:], s.slots[top:])
+		s.slots = s.slots[:base+keep]
+	}
+}
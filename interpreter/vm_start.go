@@ -0,0 +1,39 @@
+package interpreter
+
+import "wasmvm/instance"
+
+// start 段指定的函数（start 函数）
+//
+// 实例化模块时，在导入项链接完毕，并且表、内存、全局变量都初始化之后
+// 执行 start 函数。start 函数发生的陷阱会以 panic(*instance.Trap) 的方式
+// 从 NewModule() 抛出，跟链接错误一样视为实例化失败，
+// 使用 executor.TryNewModule 等函数实例化时，陷阱以 error 返回。
+//
+// 如果配置了 Config.DeferStart，则实例化时不执行 start 函数，
+// 由调用者通过 instance.Starter 接口在合适的时候（比如设置好燃料、
+// 断点之后）再执行。
+
+// 执行 start 函数，模块没有 start 段或者 start 函数已经执行过时什么都不做
+func (v *vm) execStartFunc() {
+	if v.module.StartSec == nil || v.started {
+		return
+	}
+
+	// 先标记为已执行，即使 start 函数发生陷阱，也不会再次执行
+	v.started = true
+	// start 函数也可以是导入的外部函数
+	v.funcs[*v.module.StartSec].Eval()
+}
+
+// 实现接口 instance.Starter 的方法
+
+func (v *vm) Start() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = instance.ToError(r)
+		}
+	}()
+
+	v.execStartFunc()
+	return nil
+}
@@ -1,7 +1,6 @@
 package interpreter
 
 import (
-	"errors"
 	"wasmvm/binary"
 	"wasmvm/instance"
 )
@@ -29,16 +28,28 @@ import (
 // 注
 // `表` 可以被导出导入，一张表的内容，即函数引用有可能来自多个不同的模块。
 
+// 表项的值是引用，表里直接存放引用的 Go 值（见 value_ref.go），nil 表示空引用
+
+// 表的最大长度（实现限制），table.grow 指令超出时返回 -1
+const maxTableSize = 10000000
+
 type table struct {
-	type_ binary.TableType // TableType 的信息包含表的类型（目前只有函数引用类型）以及限制值
-	// elems []vmFunc
-	elems []instance.Function
+	type_ binary.TableType // TableType 的信息包含表的类型（funcref 或者 externref）以及限制值
+	elems []instance.WasmVal
 }
 
+// 创建 funcref 类型的表，用于宿主（host）模块提供表
 func NewTable(min uint32, max uint32) instance.Table {
+	return NewTableWithType(binary.FuncRef, min, max)
+}
+
+func NewTableWithType(elemType binary.ValType, min uint32, max uint32) instance.Table {
 	tableType := binary.TableType{
-		ElemType: binary.FuncRef,
-		Limits:   binary.Limits{Min: min, Max: max},
+		ElemType: elemType,
+		Limits:   binary.Limits{Min: uint64(min), Max: uint64(max)},
+	}
+	if max > 0 {
+		tableType.Limits.Tag = 1
 	}
 	return newTable(tableType)
 }
@@ -46,7 +57,7 @@ func NewTable(min uint32, max uint32) instance.Table {
 func newTable(tableType binary.TableType) *table {
 	return &table{
 		type_: tableType,
-		elems: make([]instance.Function, tableType.Limits.Min),
+		elems: make([]instance.WasmVal, tableType.Limits.Min),
 	}
 }
 
@@ -58,30 +69,37 @@ func (t *table) Size() uint32 {
 	return uint32(len(t.elems))
 }
 
-func (t *table) Grow(increaseCount uint32) {
+// 扩充表的大小，新增的表项为空引用
+// 返回旧的大小，超出表的 max 值或者 maxTableSize 时会返回被转为 uint32 的 -1
+func (t *table) Grow(increaseCount uint32) uint32 {
+	previousSize := t.Size()
+	newSize := uint64(previousSize) + uint64(increaseCount)
+
 	// 检查是否超出指定的最大值
-	if t.type_.Limits.Max > 0 {
-		if t.Size()+increaseCount > t.type_.Limits.Max {
-			panic(errors.New("out of table range"))
-		}
+	if t.type_.Limits.HasMax() && newSize > t.type_.Limits.Max || newSize > maxTableSize {
+		n1 := -1
+		return uint32(n1)
 	}
 
-	t.elems = append(t.elems, make([]instance.Function, increaseCount)...)
+	t.elems = append(t.elems, make([]instance.WasmVal, increaseCount)...)
+	return previousSize
 }
 
-func (t *table) GetElem(idx uint32) instance.Function {
+func (t *table) GetElem(idx uint32) instance.WasmVal {
 	t.checkIdx(idx)
-	elem := t.elems[idx]
-	return elem
+	return t.elems[idx]
 }
 
-func (t *table) SetElem(idx uint32, elem instance.Function) {
+func (t *table) SetElem(idx uint32, elem instance.WasmVal) {
 	t.checkIdx(idx)
+	if elem != nil && t.type_.ElemType == binary.FuncRef {
+		_ = elem.(instance.Function) // funcref 的值必须是函数
+	}
 	t.elems[idx] = elem
 }
 
 func (t *table) checkIdx(idx uint32) {
 	if idx >= uint32(len(t.elems)) {
-		panic(errors.New("out of element range"))
+		panic(instance.NewTrap(instance.TrapTableOutOfBounds))
 	}
 }
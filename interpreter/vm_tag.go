@@ -0,0 +1,34 @@
+package interpreter
+
+import (
+	"wasmvm/binary"
+	"wasmvm/instance"
+)
+
+// 异常标签
+//
+// 标签实例只记录类型，throw 指令抛出的异常以及 catch 子句都引用标签实例，
+// 捕获异常时按标签实例是否相同（而不是类型是否相同）来匹配。
+type tag struct {
+	type_ binary.FuncType
+}
+
+// 创建异常标签，用于宿主（host）模块提供标签，
+// 宿主可以使用这个标签抛出模块能捕获的异常，或者识别模块抛出的异常
+func NewTag(ft binary.FuncType) instance.Tag {
+	return newTag(ft)
+}
+
+func newTag(ft binary.FuncType) *tag {
+	return &tag{type_: ft}
+}
+
+func (t *tag) Type() binary.FuncType {
+	return t.type_
+}
+
+func (v *vm) initTags() {
+	for _, tagType := range v.module.TagSec {
+		v.tags = append(v.tags, newTag(v.module.TypeSec[tagType.Type]))
+	}
+}
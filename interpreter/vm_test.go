@@ -1,11 +1,17 @@
 package interpreter
 
 import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"math"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 	"wasmvm/assert"
 	"wasmvm/binary"
+	"wasmvm/instance"
 )
 
 func wrapList[T comparable](items []T) []interface{} {
@@ -277,6 +283,214 @@ func TestInstCallIndirect(t *testing.T) {
 }
 
 // 仅测试实际数据当中部分的数据
+func TestTrap(t *testing.T) {
+	v := newVM(readModule("test-vm-trap.wasm"), nil)
+
+	tests := []struct {
+		funcIdx uint32
+		args    []interface{}
+		code    instance.TrapCode
+	}{
+		{0, nil, instance.TrapUnreachable},
+		{1, []interface{}{int32(65533)}, instance.TrapMemoryOutOfBounds},
+		{2, []interface{}{int32(1)}, instance.TrapIndirectCallTypeMismatch},
+		{2, []interface{}{int32(2)}, instance.TrapUninitializedElement},
+		{2, []interface{}{int32(3)}, instance.TrapTableOutOfBounds},
+		{3, []interface{}{int32(1), int32(0)}, instance.TrapIntegerDivideByZero},
+		{3, []interface{}{int32(math.MinInt32), int32(-1)}, instance.TrapIntegerOverflow},
+		{4, []interface{}{float32(math.NaN())}, instance.TrapInvalidConversion},
+		{4, []interface{}{float32(3e9)}, instance.TrapIntegerOverflow},
+	}
+
+	for _, test := range tests {
+		_, err := v.funcs[test.funcIdx].TryEval(test.args...)
+		trap, ok := err.(*instance.Trap)
+		if !ok {
+			t.Fatalf("func %d: expected a trap, got %v", test.funcIdx, err)
+		}
+		assert.AssertEqual(t, test.code, trap.Code)
+	}
+
+	// 调用栈
+	_, err := v.funcs[5].TryEval(int32(0))
+	trap := err.(*instance.Trap)
+	assert.AssertEqual(t, "trap: integer divide by zero", trap.Error())
+	assert.AssertEqual(t, 2, len(trap.Backtrace))
+	assert.AssertEqual(t, instance.Frame{FuncIdx: 3, FuncName: "div", PC: 2}, trap.Backtrace[0])
+	assert.AssertEqual(t, instance.Frame{FuncIdx: 5, FuncName: "main", PC: 6}, trap.Backtrace[1])
+
+	// 发生陷阱之后，模块实例仍然可以继续使用
+	results, err := v.funcs[5].TryEval(int32(1))
+	assert.AssertNil(t, err)
+	assert.AssertListEqual(t, []interface{}{int32(1)}, results)
+}
+
+func TestFuel(t *testing.T) {
+	m := readModule("test-vm-fuel.wasm")
+
+	for _, engine := range []Engine{EngineStack, EngineRegister} {
+		v := newVMWithConfig(m, nil, Config{ConsumeFuel: true, Fuel: 98, Engine: engine})
+		results, err := v.funcs[0].TryEval(int32(10))
+		assert.AssertNil(t, err)
+		assert.AssertListEqual(t, []interface{}{int32(10)}, results)
+		assert.AssertEqual(t, uint64(0), v.Fuel())
+
+		// 燃料耗尽
+		_, err = v.funcs[0].TryEval(int32(0))
+		trap, ok := err.(*instance.Trap)
+		assert.AssertTrue(t, ok)
+		assert.AssertEqual(t, instance.TrapOutOfFuel, trap.Code)
+
+		// 补充燃料之后可以继续调用
+		v.AddFuel(10)
+		results, err = v.funcs[0].TryEval(int32(0))
+		assert.AssertNil(t, err)
+		assert.AssertListEqual(t, []interface{}{int32(0)}, results)
+		assert.AssertEqual(t, uint64(2), v.Fuel())
+
+		// 指令的燃料表
+		v = newVMWithConfig(m, nil, Config{
+			ConsumeFuel: true,
+			Fuel:        1000,
+			FuelCosts:   map[byte]uint64{binary.Call: 10, binary.Br: 0},
+			Engine:      engine,
+		})
+		v.funcs[0].Eval(int32(10))
+		assert.AssertEqual(t, uint64(1000-(17+8*10)), v.Fuel())
+
+		// 没有启用燃料计量
+		v = newVMWithConfig(m, nil, Config{Engine: engine})
+		v.funcs[0].Eval(int32(10))
+		assert.AssertTrue(t, !v.FuelEnabled())
+	}
+}
+
+// 两种引擎合并指令的方式不同，但是消耗的燃料相同：
+// 以任意数量的燃料调用，要么都成功并且剩余的燃料相同，要么都在燃料耗尽时发生陷阱
+func TestFuelEngines(t *testing.T) {
+	m := readModule("test-vm-fuel.wasm")
+	for idx := range m.CodeSec {
+		v := newVMWithConfig(m, nil, Config{ConsumeFuel: true, Fuel: 1000})
+		v.funcs[idx].Eval(int32(10))
+		needed := 1000 - v.Fuel()
+
+		for fuel := uint64(0); fuel <= needed; fuel++ {
+			var outputs [2]string
+			for i, engine := range []Engine{EngineStack, EngineRegister} {
+				v := newVMWithConfig(m, nil, Config{ConsumeFuel: true, Fuel: fuel, Engine: engine})
+				results, err := v.funcs[idx].TryEval(int32(10))
+				outputs[i] = fmt.Sprintf("%v %v %d", results, err, v.Fuel())
+			}
+			assert.AssertEqual(t, outputs[0], outputs[1])
+		}
+	}
+}
+
+func TestInterrupt(t *testing.T) {
+	v := newVM(readModule("test-vm-interrupt.wasm"), nil)
+
+	// context 超时
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := v.EvalFuncContext(ctx, "spin")
+	assertTrapCode(t, instance.TrapInterrupted, err)
+
+	// context 已经被取消
+	_, err = v.EvalFuncContext(ctx, "one")
+	assertTrapCode(t, instance.TrapInterrupted, err)
+
+	// 在其他 goroutine 里中断
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		v.Interrupt()
+	}()
+	_, err = v.TryEvalFunc("spin")
+	assertTrapCode(t, instance.TrapInterrupted, err)
+
+	// 中断之后，模块实例仍然可以继续使用
+	results, err := v.EvalFuncContext(context.Background(), "one")
+	assert.AssertNil(t, err)
+	assert.AssertListEqual(t, []interface{}{int32(1)}, results)
+
+	// 模块实例空闲时的中断请求被丢弃，不影响之后的调用
+	v.Interrupt()
+	results, err = v.TryEvalFunc("one")
+	assert.AssertNil(t, err)
+	assert.AssertListEqual(t, []interface{}{int32(1)}, results)
+}
+
+func TestStackExhaustion(t *testing.T) {
+	m := readModule("test-vm-exhaustion.wasm")
+
+	// 调用栈的深度
+	v := newVMWithConfig(m, nil, Config{MaxCallDepth: 100})
+	results, err := v.TryEvalFunc("depth", int32(99))
+	assert.AssertNil(t, err)
+	assert.AssertListEqual(t, []interface{}{int32(99)}, results)
+
+	_, err = v.TryEvalFunc("depth", int32(100))
+	assertTrapCode(t, instance.TrapStackExhausted, err)
+
+	_, err = v.TryEvalFunc("runaway")
+	assertTrapCode(t, instance.TrapStackExhausted, err)
+	assert.AssertEqual(t, 100, len(err.(*instance.Trap).Backtrace))
+
+	// 发生陷阱之后，调用栈的深度恢复为 0
+	assert.AssertEqual(t, 0, v.controlStack.controlDepth())
+	results, err = v.TryEvalFunc("depth", int32(99))
+	assert.AssertNil(t, err)
+	assert.AssertListEqual(t, []interface{}{int32(99)}, results)
+
+	// 操作数栈的槽位数量
+	v = newVMWithConfig(m, nil, Config{MaxStackSlots: 50})
+	results, err = v.TryEvalFunc("depth", int32(10))
+	assert.AssertNil(t, err)
+	assert.AssertListEqual(t, []interface{}{int32(10)}, results)
+
+	_, err = v.TryEvalFunc("depth", int32(1000))
+	assertTrapCode(t, instance.TrapStackExhausted, err)
+	assert.AssertEqual(t, 0, v.operandStack.stackSize())
+
+	// 默认的限制
+	v = newVM(m, nil)
+	_, err = v.TryEvalFunc("runaway")
+	assertTrapCode(t, instance.TrapStackExhausted, err)
+	assert.AssertEqual(t, DefaultMaxCallDepth, len(err.(*instance.Trap).Backtrace))
+}
+
+// 尾调用在固定的控制栈和操作数栈空间里运行
+func TestTailCall(t *testing.T) {
+	m := readModule("test-vm-tail-call.wasm")
+	v := newVMWithConfig(m, nil, Config{MaxCallDepth: 10, MaxStackSlots: 50})
+
+	results, err := v.TryEvalFunc("count", int32(100000), int64(5))
+	assert.AssertNil(t, err)
+	assert.AssertListEqual(t, []interface{}{int64(100005)}, results)
+
+	results, err = v.TryEvalFunc("even", int32(10001))
+	assert.AssertNil(t, err)
+	assert.AssertListEqual(t, []interface{}{int32(0)}, results)
+
+	results, err = v.TryEvalFunc("odd", int32(10001))
+	assert.AssertNil(t, err)
+	assert.AssertListEqual(t, []interface{}{int32(1)}, results)
+
+	assert.AssertEqual(t, 0, v.controlStack.controlDepth())
+	assert.AssertEqual(t, 0, v.operandStack.stackSize())
+
+	// 非尾调用的递归则会耗尽调用栈
+	_, err = v.TryEvalFunc("depth", int32(100))
+	assertTrapCode(t, instance.TrapStackExhausted, err)
+}
+
+func assertTrapCode(t *testing.T, expected instance.TrapCode, err error) {
+	trap, ok := err.(*instance.Trap)
+	if !ok {
+		t.Fatalf("expected a trap, got %v", err)
+	}
+	assert.AssertEqual(t, expected, trap.Code)
+}
+
 func assertPartialMemoryData(t *testing.T, expected []byte, actual []byte) {
 	partial := make([]byte, len(expected))
 	copy(partial, actual)
@@ -311,5 +525,210 @@ func readModule(fileName string) binary.Module {
 	testResourcesDir := filepath.Join(currentDir, "..", "test", "resources", "interpreter")
 	wasmFilePath := filepath.Join(testResourcesDir, fileName)
 
-	return binary.DecodeFile(wasmFilePath)
+	m, err := binary.DecodeFile(wasmFilePath)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+func TestStartFunc(t *testing.T) {
+	m := readModule("test-vm-start.wasm")
+
+	// 实例化时执行 start 函数
+	v := newVM(m, nil)
+	assert.AssertListEqual(t, []interface{}{int32(11)}, v.EvalFunc("count"))
+
+	// start 函数只执行一次
+	assert.AssertNil(t, v.Start())
+	assert.AssertListEqual(t, []interface{}{int32(11)}, v.EvalFunc("count"))
+
+	// 推迟执行 start 函数
+	v = newVMWithConfig(m, nil, Config{DeferStart: true})
+	assert.AssertListEqual(t, []interface{}{int32(10)}, v.EvalFunc("count"))
+	assert.AssertNil(t, v.Start())
+	assert.AssertListEqual(t, []interface{}{int32(11)}, v.EvalFunc("count"))
+
+	// start 函数发生陷阱时实例化失败
+	failIdx := uint32(2)
+	m.StartSec = &failIdx
+
+	err := func() (err error) {
+		defer func() {
+			err = instance.ToError(recover())
+		}()
+		newVM(m, nil)
+		return nil
+	}()
+	assertTrapCode(t, instance.TrapUnreachable, err)
+	assert.AssertEqual(t, instance.Frame{FuncIdx: 2, FuncName: "fail", PC: 0},
+		err.(*instance.Trap).Backtrace[0])
+
+	v = newVMWithConfig(m, nil, Config{DeferStart: true})
+	assertTrapCode(t, instance.TrapUnreachable, v.Start())
+}
+
+func TestBenchGuests(t *testing.T) {
+	for _, config := range []Config{{}, {Engine: EngineRegister}} {
+		v := newVMWithConfig(readModule("test-vm-bench.wasm"), nil, config)
+		assert.AssertListEqual(t, []interface{}{int64(4950)}, v.EvalFunc("sum", int32(100)))
+		assert.AssertListEqual(t, []interface{}{int64(12586269025)}, v.EvalFunc("fib", int32(50)))
+		assert.AssertListEqual(t, []interface{}{int32(6765)}, v.EvalFunc("fib_rec", int32(20)))
+		assert.AssertListEqual(t, []interface{}{int32(4950)}, v.EvalFunc("sum_mem", int32(100)))
+		assert.AssertListEqual(t, []interface{}{int32(10)}, v.EvalFunc("count_global", int32(10)))
+		assert.AssertListEqual(t, []interface{}{int32(30)}, v.EvalFunc("count_global", int32(20)))
+	}
+}
+
+// 基准测试的客户程序在预编译之后不需要经由 instructionTable 执行任何指令
+// （即执行时不需要对 interface{} 进行类型断言），并且合并了常量、local.get 跟二元运算指令
+func TestCompileTypedInstrs(t *testing.T) {
+	v := newVM(readModule("test-vm-bench.wasm"), nil)
+	fused := 0
+	for _, f := range v.funcs {
+		for _, in := range f.compiled.code {
+			assert.AssertTrue(t, in.op != opExec && in.op != opExecCall)
+			if in.op == opBinaryImm || in.op == opBinaryLocal {
+				fused++
+			}
+		}
+	}
+	assert.AssertTrue(t, fused >= 10)
+
+	// 启用燃料计量时同样合并指令，合并之后的指令消耗两条原指令的燃料
+	v = newVMWithConfig(readModule("test-vm-bench.wasm"), nil, Config{ConsumeFuel: true})
+	fusedWithFuel := 0
+	for _, f := range v.funcs {
+		for _, in := range f.compiled.code {
+			if in.op == opBinaryImm || in.op == opBinaryLocal {
+				assert.AssertEqual(t, uint64(2), in.fuel)
+				fusedWithFuel++
+			}
+		}
+	}
+	assert.AssertEqual(t, fused, fusedWithFuel)
+}
+
+// 寄存器引擎跟栈式引擎的差分测试
+//
+// 使用两种引擎分别实例化 test/resources/interpreter 里的每个模块，然后以零值的实参
+// 调用每个内部函数，每次调用的返回值（或者陷阱以及调用栈）、调用之后内存的内容以及
+// 剩余的燃料都应该一致。有的函数是无限循环，所以每次调用都只给定固定数量的燃料。
+// 未通过验证的模块的行为是未定义的，所以跳过这些模块。
+func TestRegisterEngine(t *testing.T) {
+	currentDir, err := os.Getwd()
+	assert.AssertNil(t, err)
+
+	fileNames, err := filepath.Glob(filepath.Join(currentDir, "..", "test", "resources", "interpreter", "*.wasm"))
+	assert.AssertNil(t, err)
+	assert.AssertTrue(t, len(fileNames) > 0)
+
+	for _, fileName := range fileNames {
+		m := readModule(filepath.Base(fileName))
+		if binary.Validate(m) != nil {
+			continue
+		}
+		expected := evalAllFuncs(m, Config{ConsumeFuel: true})
+		actual := evalAllFuncs(m, Config{ConsumeFuel: true, Engine: EngineRegister})
+
+		assert.AssertEqual(t, len(expected), len(actual))
+		for i := range expected {
+			if expected[i] != actual[i] {
+				t.Errorf("%s: expected %s, got %s", filepath.Base(fileName), expected[i], actual[i])
+			}
+		}
+	}
+}
+
+// 依次调用模块的每个内部函数，每次调用之前将燃料设置为 evalFuel，返回每次调用的结果
+func evalAllFuncs(m binary.Module, config Config) (outputs []string) {
+	defer func() {
+		if r := recover(); r != nil {
+			outputs = append(outputs, "panic: "+instance.ToError(r).Error())
+		}
+	}()
+
+	v := newVMWithConfig(m, nil, config)
+	for _, f := range v.funcs[len(v.funcs)-len(m.CodeSec):] {
+		args := make([]interface{}, len(f.type_.ParamTypes))
+		for i, vt := range f.type_.ParamTypes {
+			args[i] = v.wrapSlot(vt, slot{})
+		}
+
+		v.fuel = evalFuel
+		results, err := f.TryEval(args...)
+
+		output := fmt.Sprintf("func %d:", f.idx)
+		for _, result := range results {
+			if _, ok := result.(instance.Function); ok {
+				result = "funcref"
+			}
+			output += fmt.Sprintf(" %v", result)
+		}
+		if trap, ok := err.(*instance.Trap); ok && trap.Code == instance.TrapOutOfFuel {
+			// 合并的指令一次性消耗燃料，所以燃料耗尽时正在执行的指令可能不同
+			output += " " + trap.Error()
+		} else if ok {
+			output += " " + trap.Trace()
+		} else if err != nil {
+			output += " " + err.Error()
+		}
+		for _, mem := range v.memories {
+			output += fmt.Sprintf(" memory %08x", crc32.ChecksumIEEE(dumpMemory(mem)))
+		}
+		output += fmt.Sprintf(" fuel %d", v.fuel)
+		outputs = append(outputs, output)
+	}
+	return outputs
+}
+
+const evalFuel = 100000
+
+func benchmarkGuest(b *testing.B, config Config, name string, arg int32) {
+	v := newVMWithConfig(readModule("test-vm-bench.wasm"), nil, config)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.EvalFunc(name, arg)
+	}
+}
+
+func BenchmarkSum(b *testing.B) {
+	benchmarkGuest(b, Config{}, "sum", 10000)
+}
+
+func BenchmarkFib(b *testing.B) {
+	benchmarkGuest(b, Config{}, "fib", 10000)
+}
+
+func BenchmarkFibRec(b *testing.B) {
+	benchmarkGuest(b, Config{}, "fib_rec", 20)
+}
+
+func BenchmarkSumMem(b *testing.B) {
+	benchmarkGuest(b, Config{}, "sum_mem", 10000)
+}
+
+func BenchmarkCountGlobal(b *testing.B) {
+	benchmarkGuest(b, Config{}, "count_global", 10000)
+}
+
+func BenchmarkSumRegister(b *testing.B) {
+	benchmarkGuest(b, Config{Engine: EngineRegister}, "sum", 10000)
+}
+
+func BenchmarkFibRegister(b *testing.B) {
+	benchmarkGuest(b, Config{Engine: EngineRegister}, "fib", 10000)
+}
+
+func BenchmarkFibRecRegister(b *testing.B) {
+	benchmarkGuest(b, Config{Engine: EngineRegister}, "fib_rec", 20)
+}
+
+func BenchmarkSumMemRegister(b *testing.B) {
+	benchmarkGuest(b, Config{Engine: EngineRegister}, "sum_mem", 10000)
+}
+
+func BenchmarkCountGlobalRegister(b *testing.B) {
+	benchmarkGuest(b, Config{Engine: EngineRegister}, "count_global", 10000)
 }
@@ -0,0 +1,38 @@
+package interpreter
+
+import "wasmvm/instance"
+
+// 陷阱的调用栈（wasm backtrace）
+//
+// 控制栈里的每个帧都是一个调用帧，每个函数正在执行的指令是该帧的 pc - 1，
+// 对于栈顶的帧，即发生陷阱的指令，对于其他帧，则是正在执行的 call 指令。
+// 指令的序号按原函数体（而不是编译之后的指令）计算，即不计算编译时插入的伪指令，
+// 计数方式跟 binary.ValidationError.InstrIdx 相同。
+
+// 生成控制栈里从 depth 开始（不包括 depth 之前的帧）到栈顶的调用栈，
+// 第一个元素是栈顶的函数
+func (v *vm) backtrace(depth int) []instance.Frame {
+	var trace []instance.Frame
+
+	frames := v.controlStack.frames
+	for idx := len(frames) - 1; idx >= depth; idx-- {
+		frame := frames[idx]
+		trace = append(trace, instance.Frame{
+			FuncIdx:  frame.funcIdx,
+			FuncName: v.funcNames.Get(frame.funcIdx),
+			PC:       getPC(frame),
+		})
+	}
+
+	return trace
+}
+
+// 计算一个函数正在执行的指令的序号
+func getPC(frame *controlFrame) int {
+	// 执行指令之前 pc 已经向前移动，所以正在执行的指令是 pc - 1，
+	// 如果函数还没有开始执行（比如进入函数时被中断），则为 0
+	if frame.pc == 0 {
+		return 0
+	}
+	return frame.fn.getInstrIdx(frame.pc - 1)
+}
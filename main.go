@@ -4,15 +4,32 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"unicode"
 	"wasmvm/binary"
 	"wasmvm/executor"
+	"wasmvm/instance"
+	"wasmvm/interpreter"
+	"wasmvm/wasm2go"
+	"wasmvm/wast"
+	"wasmvm/wat"
 )
 
 func main() {
 	args := os.Args
 	count := len(args)
 
-	if count == 3 {
+	if count == 3 && args[1] == "wasm2wat" {
+		wasm2wat(args[2])
+	} else if count == 3 && args[1] == "wat2wasm" {
+		wat2wasm(args[2])
+	} else if count == 3 && args[1] == "wasm-dump" {
+		wasmDump(args[2])
+	} else if count == 3 && args[1] == "wasm2go" {
+		wasmToGo(args[2])
+	} else if count == 3 && args[1] == "wast" {
+		runScript(args[2])
+	} else if count == 3 {
 		fmt.Println("Toy WebAssembly VM")
 		fmt.Printf("running %s, func: %s ...\n", args[1], args[2])
 		// exec args[1]
@@ -22,21 +39,144 @@ func main() {
 Usage:
 $ go run . path_to_bytecode_file start_function_name
 
+the file can be either a binary module (*.wasm) or a text module (*.wat).
+
 e.g.
-$ go run . examples/03-simple.wasm main`)
+$ go run . examples/03-simple.wasm main
+$ go run . examples/03-simple.wat main
+
+Tools:
+$ go run . wasm2wat path_to_source.wasm    print the text format
+$ go run . wat2wasm path_to_source.wat     convert to binary format (*.wasm)
+$ go run . wasm-dump path_to_source.wasm   print the annotated hex dump
+$ go run . wasm2go path_to_source.wasm     translate to a Go package (*.go)
+$ go run . wast path_to_script.wast        run the spec test script`)
 	}
 }
 
 func exec(fileName string, funcName string) {
+	wasmFilePath := getFilePath(fileName)
+
+	var m binary.Module
+	var err error
+	if strings.HasSuffix(wasmFilePath, ".wat") {
+		m, err = wat.ParseFile(wasmFilePath)
+	} else {
+		m, err = binary.DecodeFile(wasmFilePath)
+	}
+	exitOnError(err)
+	exitOnError(binary.Validate(m))
+
+	// 推迟执行 start 函数，以便跟调用函数一样输出陷阱的调用栈
+	mod, err := executor.TryNewModuleWithConfig(m, interpreter.Config{DeferStart: true})
+	exitOnError(err)
+	exitOnTrap(mod.(instance.Starter).Start())
+
+	r, err := mod.TryEvalFunc(funcName)
+	exitOnTrap(err)
+	fmt.Printf("%v\n", r)
+}
+
+func exitOnTrap(err error) {
+	if trap, ok := err.(*instance.Trap); ok {
+		// 输出陷阱的调用栈
+		fmt.Println(trap.Trace())
+		os.Exit(1)
+	}
+	exitOnError(err)
+}
+
+// 将二进制格式转换为文本格式并输出到标准输出，
+// 不直接写入同名的 `.wat` 文件，以免覆盖已有的源文件
+func wasm2wat(fileName string) {
+	m, err := binary.DecodeFile(getFilePath(fileName))
+	exitOnError(err)
+
+	fmt.Print(wat.Print(m))
+}
+
+// 将文本格式转换为二进制格式，输出文件跟源文件位于同一个目录
+func wat2wasm(fileName string) {
+	filePath := getFilePath(fileName)
+	m, err := wat.ParseFile(filePath)
+	exitOnError(err)
+
+	outputFilePath := strings.TrimSuffix(filePath, filepath.Ext(filePath)) + ".wasm"
+	exitOnError(os.WriteFile(outputFilePath, binary.Encode(m), 0644))
+	fmt.Printf("successful: %s\n", outputFilePath)
+}
+
+// 输出二进制格式的内容，数据不合法时，先输出出错之前的内容，再输出错误信息
+func wasmDump(fileName string) {
+	data, err := os.ReadFile(getFilePath(fileName))
+	exitOnError(err)
+
+	text, err := binary.Dump(data)
+	fmt.Print(text)
+	exitOnError(err)
+}
+
+// 将二进制格式翻译为 Go 源代码，输出文件跟源文件位于同一个目录，
+// Go 包的名称由文件名转换而来，比如 `fib-rec.wasm` 对应 `fibrec`
+func wasmToGo(fileName string) {
+	filePath := getFilePath(fileName)
+	m, err := binary.DecodeFile(filePath)
+	exitOnError(err)
+
+	baseName := strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath))
+	code, err := wasm2go.Generate(m, packageName(baseName))
+	if wasm2go.IsUnsupported(err) {
+		fmt.Println(err)
+		fmt.Println("note: wasm2go only supports numeric MVP modules, run the module with the interpreter instead")
+		os.Exit(1)
+	}
+	exitOnError(err)
+
+	outputFilePath := strings.TrimSuffix(filePath, filepath.Ext(filePath)) + ".go"
+	exitOnError(os.WriteFile(outputFilePath, code, 0644))
+	fmt.Printf("successful: %s\n", outputFilePath)
+}
+
+// 只保留文件名里的字母和数字，并转换为小写
+func packageName(name string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			sb.WriteRune(r)
+		}
+	}
+	if sb.Len() == 0 || unicode.IsDigit(rune(sb.String()[0])) {
+		return "m" + sb.String()
+	}
+	return sb.String()
+}
+
+// 执行规范测试脚本，输出每一条命令的执行结果，有命令失败时以状态码 1 退出
+func runScript(fileName string) {
+	report, err := wast.RunFile(getFilePath(fileName))
+	exitOnError(err)
+
+	fmt.Print(report)
+	if report.FailedCount() > 0 {
+		os.Exit(1)
+	}
+}
+
+// 相对路径相对于当前目录，绝对路径保持不变
+func getFilePath(fileName string) string {
+	if filepath.IsAbs(fileName) {
+		return fileName
+	}
 	currentDir, err := os.Getwd() // Getwd() 返回当前 package 的目录，比如 `/path/to/project`
 	if err != nil {
 		panic(err)
 	}
+	return filepath.Join(currentDir, fileName)
+}
 
-	wasmFilePath := filepath.Join(currentDir, fileName)
-
-	m := binary.DecodeFile(wasmFilePath)
-	mod := executor.NewModule(m)
-	r := mod.EvalFunc(funcName)
-	fmt.Printf("%v\n", r)
+func exitOnError(err error) {
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
 }
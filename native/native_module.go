@@ -1,6 +1,8 @@
 package native
 
 import (
+	"context"
+	"errors"
 	"wasmvm/binary"
 	"wasmvm/instance"
 )
@@ -13,9 +15,10 @@ func NewNativeModule() nativeModule {
 	return nativeModule{exported: map[string]interface{}{}}
 }
 
-// func (m nativeModule) Register(name string, value interface{}) {
-// 	m.exported[name] = value
-// }
+// 注册导出项，value 可以是 instance.Table, instance.Memory, instance.Global
+func (m nativeModule) Register(name string, value interface{}) {
+	m.exported[name] = value
+}
 
 func (m nativeModule) RegisterFunc(name string, paramTypes []binary.ValType, resultTypes []binary.ValType, func_ GoFunc) {
 	funcType := binary.FuncType{
@@ -35,6 +38,22 @@ func (m nativeModule) EvalFunc(name string, args ...instance.WasmVal) []instance
 	return m.exported[name].(instance.Function).Eval(args...)
 }
 
+func (m nativeModule) TryEvalFunc(name string, args ...instance.WasmVal) ([]instance.WasmVal, error) {
+	f, ok := m.exported[name].(instance.Function)
+	if !ok {
+		return nil, errors.New("function not found: " + name)
+	}
+	return f.TryEval(args...)
+}
+
+func (m nativeModule) EvalFuncContext(ctx context.Context, name string, args ...instance.WasmVal) ([]instance.WasmVal, error) {
+	// 本地函数无法在执行过程中被中断，所以只在调用之前检查
+	if ctx.Err() != nil {
+		return nil, instance.NewTrap(instance.TrapInterrupted)
+	}
+	return m.TryEvalFunc(name, args...)
+}
+
 func (m nativeModule) GetGlobalVal(name string) instance.WasmVal {
 	return m.exported[name].(instance.Global).Get()
 }
@@ -57,3 +76,13 @@ func (f nativeFunction) Type() binary.FuncType {
 func (f nativeFunction) Eval(args ...instance.WasmVal) []instance.WasmVal {
 	return f.func_(args)
 }
+
+func (f nativeFunction) TryEval(args ...instance.WasmVal) (results []instance.WasmVal, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			results = nil
+			err = instance.ToError(r)
+		}
+	}()
+	return f.func_(args), nil
+}
@@ -0,0 +1,54 @@
+package native
+
+import (
+	"fmt"
+	"wasmvm/binary"
+	"wasmvm/instance"
+	"wasmvm/interpreter"
+)
+
+// 规范测试（spec test）脚本使用的宿主模块 `spectest`
+//
+// 导出项跟 WebAssembly 官方的参考解释器一致：
+// - 函数 print, print_i32, print_i64, print_f32, print_f64, print_i32_f32, print_f64_f64
+// - 全局变量 global_i32, global_i64 (666), global_f32, global_f64 (666.6)
+// - 表 table (10 20 funcref)
+// - 内存 memory (1 2)
+func NewSpectestModule() instance.Module {
+	spectest := NewNativeModule()
+
+	spectest.RegisterFunc("print", []byte{}, []byte{}, printValues)
+	spectest.RegisterFunc("print_i32", []byte{binary.ValTypeI32}, []byte{}, printValues)
+	spectest.RegisterFunc("print_i64", []byte{binary.ValTypeI64}, []byte{}, printValues)
+	spectest.RegisterFunc("print_f32", []byte{binary.ValTypeF32}, []byte{}, printValues)
+	spectest.RegisterFunc("print_f64", []byte{binary.ValTypeF64}, []byte{}, printValues)
+	spectest.RegisterFunc("print_i32_f32", []byte{binary.ValTypeI32, binary.ValTypeF32}, []byte{}, printValues)
+	spectest.RegisterFunc("print_f64_f64", []byte{binary.ValTypeF64, binary.ValTypeF64}, []byte{}, printValues)
+
+	spectest.Register("global_i32", interpreter.NewGlobal(binary.ValTypeI32, false, int32(666)))
+	spectest.Register("global_i64", interpreter.NewGlobal(binary.ValTypeI64, false, int64(666)))
+	spectest.Register("global_f32", interpreter.NewGlobal(binary.ValTypeF32, false, float32(666.6)))
+	spectest.Register("global_f64", interpreter.NewGlobal(binary.ValTypeF64, false, float64(666.6)))
+
+	spectest.Register("table", interpreter.NewTable(10, 20))
+	spectest.Register("memory", interpreter.NewMemory(1, 2))
+
+	return spectest
+}
+
+// 输出参数的值及其类型，比如 `42 : i32`
+func printValues(args []instance.WasmVal) []instance.WasmVal {
+	for _, arg := range args {
+		switch arg.(type) {
+		case int32:
+			fmt.Printf("%v : i32\n", arg)
+		case int64:
+			fmt.Printf("%v : i64\n", arg)
+		case float32:
+			fmt.Printf("%v : f32\n", arg)
+		case float64:
+			fmt.Printf("%v : f64\n", arg)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+(module
+    (import "host" "error" (tag $error (param i32)))
+    (import "host" "check" (func $check (param i32)))
+    (tag $overflow (export "overflow") (param i64))
+
+    ;; 捕获宿主函数抛出的异常
+    (func (export "safe_check") (param i32) (result i32)
+        (try (result i32)
+            (do
+                (call $check (local.get 0))
+                (i32.const 0)
+            )
+            (catch $error)
+        )
+    )
+
+    ;; 抛出异常给宿主
+    (func (export "add") (param i64 i64) (result i64)
+        (local $sum i64)
+        (local.set $sum (i64.add (local.get 0) (local.get 1)))
+        (if (i64.lt_u (local.get $sum) (local.get 0))
+            (then (throw $overflow (local.get $sum)))
+        )
+        (local.get $sum)
+    )
+)
@@ -0,0 +1,36 @@
+(module
+    (import "host" "describe" (func $describe (param externref) (result i32)))
+
+    (type $i32 (func (result i32)))
+    (table $t (export "table") 2 externref)
+    (global $last (mut externref) (ref.null extern))
+
+    (func $answer (result i32) (i32.const 42))
+    (elem declare funcref (ref.func $answer))
+
+    ;; 宿主传入的值原样返回
+    (func (export "id") (param externref) (result externref)
+        (local.get 0)
+    )
+
+    ;; 将宿主传入的值保存在表和全局变量里，之后再读出来
+    (func (export "store") (param i32 externref)
+        (table.set $t (local.get 0) (local.get 1))
+        (global.set $last (local.get 1))
+    )
+    (func (export "load") (param i32) (result externref)
+        (table.get $t (local.get 0))
+    )
+    (func (export "last") (result externref)
+        (global.get $last)
+    )
+
+    ;; 将宿主传入的值转交给宿主函数
+    (func (export "describe") (param externref) (result i32)
+        (call $describe (local.get 0))
+    )
+
+    (func (export "answer") (result funcref)
+        (ref.func $answer)
+    )
+)
@@ -0,0 +1,12 @@
+(module
+    (import "host" "reenter" (func $reenter (param i32) (result i32)))
+
+    ;; 经由宿主函数再次调用当前模块的 inc 函数
+    (func (export "run") (param i32) (result i32)
+        (call $reenter (local.get 0))
+    )
+
+    (func (export "inc") (param i32) (result i32)
+        (i32.add (local.get 0) (i32.const 1))
+    )
+)
@@ -0,0 +1,4 @@
+(module
+    ;; 签名跟 env 模块的 add_i32 不一致
+    (import "env" "add_i32" (func $add (param i64 i64) (result i64)))
+)
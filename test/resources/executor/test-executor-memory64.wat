@@ -0,0 +1,13 @@
+(module
+    (import "env" "memory" (memory i64 1))
+
+    ;; 扩充内存，然后在新增的最后一个页面的末尾写入一个字节，返回旧的页面数
+    (func (export "grow_and_store") (param $pages i64) (param $val i32) (result i64)
+        (local $previous i64)
+        (local.set $previous (memory.grow (local.get $pages)))
+        (i32.store8
+            (i64.sub (i64.mul (memory.size) (i64.const 65536)) (i64.const 1))
+            (local.get $val))
+        (local.get $previous)
+    )
+)
@@ -0,0 +1,37 @@
+(module
+    (import "host" "div_rem" (func $div_rem (param i64 i64) (result i64 i64)))
+    (import "host" "mixed" (func $mixed (result i32 i64 f32 f64)))
+
+    (func (export "div_rem") (param i64 i64) (result i64 i64)
+        (call $div_rem (local.get 0) (local.get 1))
+    )
+
+    (func (export "mixed") (result i32 i64 f32 f64)
+        (call $mixed)
+    )
+
+    ;; 将多个返回值作为结构块的参数
+    (func (export "mixed_sum") (result f64)
+        (local f64)
+        (call $mixed)
+        (block (param i32 i64 f32 f64) (result f64)
+            (local.set 0)
+            (f64.promote_f32)
+            (local.get 0)
+            (f64.add)
+            (local.set 0)
+            (f64.convert_i64_s)
+            (local.get 0)
+            (f64.add)
+            (local.set 0)
+            (f64.convert_i32_s)
+            (local.get 0)
+            (f64.add)
+        )
+    )
+
+    (func (export "swap") (param i32 f32) (result f32 i32)
+        (local.get 1)
+        (local.get 0)
+    )
+)
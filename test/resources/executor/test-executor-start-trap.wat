@@ -0,0 +1,9 @@
+(module
+    (func $div (param i32) (result i32)
+        (i32.div_s (i32.const 1) (local.get 0))
+    )
+    (func $main
+        (drop (call $div (i32.const 0)))
+    )
+    (start $main)
+)
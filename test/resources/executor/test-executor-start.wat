@@ -0,0 +1,7 @@
+(module
+    (import "host" "log" (func $log (param i32)))
+    (func $main
+        (call $log (i32.const 42))
+    )
+    (start $main)
+)
@@ -0,0 +1,29 @@
+(module
+    (import "env" "memory" (memory 1 1 shared))
+
+    ;; 地址 0 是计数器，地址 4 是已经完成的线程的数量
+
+    ;; 将计数器增加 n 次（n 大于 0），然后唤醒等待的线程
+    (func (export "add") (param $n i32)
+        (loop $next
+            (drop (i32.atomic.rmw.add (i32.const 0) (i32.const 1)))
+            (br_if $next (local.tee $n (i32.sub (local.get $n) (i32.const 1))))
+        )
+        (drop (i32.atomic.rmw.add (i32.const 4) (i32.const 1)))
+        (drop (memory.atomic.notify (i32.const 4) (i32.const -1)))
+    )
+
+    ;; 等待直到 count 个线程完成，返回计数器的值
+    (func (export "join") (param $count i32) (result i32)
+        (local $done i32)
+        (block $exit
+            (loop $wait
+                (local.set $done (i32.atomic.load (i32.const 4)))
+                (br_if $exit (i32.ge_u (local.get $done) (local.get $count)))
+                (drop (memory.atomic.wait32 (i32.const 4) (local.get $done) (i64.const -1)))
+                (br $wait)
+            )
+        )
+        (i32.atomic.load (i32.const 0))
+    )
+)
@@ -0,0 +1,90 @@
+(module
+    ;; 基准测试的客户程序（紧凑的数值循环）
+
+    ;; 计算 0 + 1 + ... + (n - 1)
+    (func $sum (export "sum") (param $n i32) (result i64)
+        (local $i i32)
+        (local $acc i64)
+        (block $done
+            (loop $next
+                (br_if $done (i32.ge_u (local.get $i) (local.get $n)))
+                (local.set $acc
+                    (i64.add (local.get $acc) (i64.extend_i32_u (local.get $i))))
+                (local.set $i (i32.add (local.get $i) (i32.const 1)))
+                (br $next)
+            )
+        )
+        (local.get $acc)
+    )
+
+    ;; 使用循环计算斐波那契数（模 2^64）
+    (func $fib (export "fib") (param $n i32) (result i64)
+        (local $a i64)
+        (local $b i64)
+        (local $t i64)
+        (local.set $b (i64.const 1))
+        (block $done
+            (loop $next
+                (br_if $done (i32.eqz (local.get $n)))
+                (local.set $t (i64.add (local.get $a) (local.get $b)))
+                (local.set $a (local.get $b))
+                (local.set $b (local.get $t))
+                (local.set $n (i32.sub (local.get $n) (i32.const 1)))
+                (br $next)
+            )
+        )
+        (local.get $a)
+    )
+
+    ;; 使用递归计算斐波那契数
+    (func $fib_rec (export "fib_rec") (param $n i32) (result i32)
+        (if (result i32) (i32.lt_u (local.get $n) (i32.const 2))
+            (then (local.get $n))
+            (else
+                (i32.add
+                    (call $fib_rec (i32.sub (local.get $n) (i32.const 1)))
+                    (call $fib_rec (i32.sub (local.get $n) (i32.const 2)))))
+        )
+    )
+
+    (memory 1)
+    (global $counter (mut i32) (i32.const 0))
+
+    ;; 将 0, 1, ..., n - 1 依次写入内存（i32 数组），然后读出并求和（模 2^32）
+    (func $sum_mem (export "sum_mem") (param $n i32) (result i32)
+        (local $i i32)
+        (local $acc i32)
+        (block $filled
+            (loop $fill
+                (br_if $filled (i32.ge_u (local.get $i) (local.get $n)))
+                (i32.store (i32.shl (local.get $i) (i32.const 2)) (local.get $i))
+                (local.set $i (i32.add (local.get $i) (i32.const 1)))
+                (br $fill)
+            )
+        )
+        (local.set $i (i32.const 0))
+        (block $summed
+            (loop $add
+                (br_if $summed (i32.ge_u (local.get $i) (local.get $n)))
+                (local.set $acc
+                    (i32.add (local.get $acc) (i32.load (i32.shl (local.get $i) (i32.const 2)))))
+                (local.set $i (i32.add (local.get $i) (i32.const 1)))
+                (br $add)
+            )
+        )
+        (local.get $acc)
+    )
+
+    ;; 将全局变量累加 n 次，返回累加之后的值
+    (func $count_global (export "count_global") (param $n i32) (result i32)
+        (block $done
+            (loop $next
+                (br_if $done (i32.eqz (local.get $n)))
+                (global.set $counter (i32.add (global.get $counter) (i32.const 1)))
+                (local.set $n (i32.sub (local.get $n) (i32.const 1)))
+                (br $next)
+            )
+        )
+        (global.get $counter)
+    )
+)
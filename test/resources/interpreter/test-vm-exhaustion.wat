@@ -0,0 +1,18 @@
+(module
+    (func $runaway (export "runaway")
+        (call $runaway)
+    )
+
+    ;; 递归 n 次，调用栈的深度为 n + 1
+    (func $depth (export "depth") (param $n i32) (result i32)
+        (if (result i32) (i32.eqz (local.get $n))
+            (then (i32.const 0))
+            (else
+                (i32.add
+                    (i32.const 1)
+                    (call $depth (i32.sub (local.get $n) (i32.const 1)))
+                )
+            )
+        )
+    )
+)
@@ -0,0 +1,34 @@
+(module
+    ;; 执行的指令数量为 7 + 9 * n，加上函数入口共消耗 8 + 9 * n 个单位的燃料
+    (func $count (param $n i32) (result i32)
+        (local $i i32)
+        (block
+            (loop
+                (br_if 1 (i32.ge_u (local.get $i) (local.get $n)))
+                (local.set $i (i32.add (local.get $i) (i32.const 1)))
+                (br 0)
+            )
+        )
+        (local.get $i)
+    )
+
+    ;; 分支的目标之前是寄存器引擎不生成指令的 local.set 和 drop，
+    ;; 以及不可到达的指令
+    (func $skip (param $n i32) (result i32)
+        (local $i i32)
+        (loop $l
+            (block $b
+                (br_if $b (i32.and (local.get $i) (i32.const 1)))
+                (local.set $n (i32.add (local.get $n) (i32.const 1)))
+                (drop (local.get $n))
+            )
+            (if (i32.and (local.get $i) (i32.const 2))
+                (then (br 0) (drop (i32.const 7)) (drop (local.get $n)))
+                (else (local.set $n (i32.sub (local.get $n) (i32.const 3))))
+            )
+            (local.set $i (i32.add (local.get $i) (i32.const 1)))
+            (br_if $l (i32.lt_u (local.get $i) (i32.const 10)))
+        )
+        (local.get $n)
+    )
+)
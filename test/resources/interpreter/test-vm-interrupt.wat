@@ -0,0 +1,12 @@
+(module
+    ;; 无限循环
+    (func (export "spin")
+        (loop
+            (br 0)
+        )
+    )
+
+    (func (export "one") (result i32)
+        (i32.const 1)
+    )
+)
@@ -0,0 +1,21 @@
+(module
+    (memory 1)
+    (data (i32.const 0) "\01")
+    (global $count (mut i32) (i32.const 10))
+
+    ;; start 函数读取 data 段写入的内存，说明它在内存初始化之后执行
+    (func $init
+        (global.set $count
+            (i32.add (global.get $count) (i32.load8_u (i32.const 0))))
+        (i32.store8 (i32.const 0) (i32.const 0))
+    )
+    (start $init)
+
+    (func (export "count") (result i32)
+        (global.get $count)
+    )
+
+    (func $fail
+        (unreachable)
+    )
+)
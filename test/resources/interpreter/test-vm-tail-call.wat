@@ -0,0 +1,59 @@
+(module
+    (type $i32_to_i32 (func (param i32) (result i32)))
+    (table funcref (elem $even $odd))
+
+    ;; 尾递归 n 次，返回 acc + n
+    ;; return_call 位于 if 和 block 结构里面，而且在实参下面残留了一个操作数
+    (func $count (export "count") (param $n i32) (param $acc i64) (result i64)
+        (if (result i64) (i32.eqz (local.get $n))
+            (then (local.get $acc))
+            (else
+                (block (result i64)
+                    (i64.const 100)
+                    (return_call $count
+                        (i32.sub (local.get $n) (i32.const 1))
+                        (i64.add (local.get $acc) (i64.const 1))
+                    )
+                )
+            )
+        )
+    )
+
+    ;; 经由 return_call_indirect 相互递归
+    (func $even (export "even") (param $n i32) (result i32)
+        (if (result i32) (i32.eqz (local.get $n))
+            (then (i32.const 1))
+            (else
+                (return_call_indirect (type $i32_to_i32)
+                    (i32.sub (local.get $n) (i32.const 1))
+                    (i32.const 1)
+                )
+            )
+        )
+    )
+
+    (func $odd (export "odd") (param $n i32) (result i32)
+        (if (result i32) (i32.eqz (local.get $n))
+            (then (i32.const 0))
+            (else
+                (return_call_indirect (type $i32_to_i32)
+                    (i32.sub (local.get $n) (i32.const 1))
+                    (i32.const 0)
+                )
+            )
+        )
+    )
+
+    ;; 非尾调用的版本，作为对比
+    (func $depth (export "depth") (param $n i32) (result i32)
+        (if (result i32) (i32.eqz (local.get $n))
+            (then (i32.const 0))
+            (else
+                (i32.add
+                    (i32.const 1)
+                    (call $depth (i32.sub (local.get $n) (i32.const 1)))
+                )
+            )
+        )
+    )
+)
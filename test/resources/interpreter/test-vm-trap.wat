@@ -0,0 +1,38 @@
+(module
+    (memory 1)
+    (table 3 funcref)
+    (elem (i32.const 0) $div $trunc)
+
+    (func $unreachable
+        (unreachable)
+    )
+
+    (func $load (param i32) (result i32)
+        (i32.load (local.get 0))
+    )
+
+    (func $call_indirect (param i32) (result i32)
+        (i32.const 1)
+        (i32.const 1)
+        (local.get 0)
+        (call_indirect (param i32 i32) (result i32))
+    )
+
+    (func $div (param i32 i32) (result i32)
+        (i32.div_s (local.get 0) (local.get 1))
+    )
+
+    (func $trunc (param f32) (result i32)
+        (i32.trunc_f32_s (local.get 0))
+    )
+
+    ;; func 5
+    (func $main (param i32) (result i32)
+        (block (result i32)
+            (if (result i32) (local.get 0)
+                (then (i32.const 1))
+                (else (call $div (i32.const 1) (i32.const 0)))
+            )
+        )
+    )
+)
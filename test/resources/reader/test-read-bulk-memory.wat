@@ -0,0 +1,18 @@
+(module
+  (table 2 funcref)
+  (memory 1)
+  (func $f (param i32 i32 i32)
+    (memory.init 1 (local.get 0) (local.get 1) (local.get 2))
+    (data.drop 1)
+    (memory.copy (local.get 0) (local.get 1) (local.get 2))
+    (memory.fill (local.get 0) (local.get 1) (local.get 2))
+    (table.init 0 (local.get 0) (local.get 1) (local.get 2))
+    (elem.drop 0)
+    (table.copy (local.get 0) (local.get 1) (local.get 2))
+  )
+  (elem func $f)
+  (elem (i32.const 1) $f)
+  (elem declare func $f)
+  (data (i32.const 0) "foo")
+  (data "bar")
+)
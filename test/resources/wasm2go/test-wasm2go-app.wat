@@ -0,0 +1,21 @@
+(module
+    (import "sample" "fib_rec" (func $fib_rec (param i32) (result i32)))
+    (import "sample" "memory" (memory 1))
+    (import "sample" "counter" (global $counter (mut i32)))
+
+    ;; 调用预先翻译的模块的函数
+    (func (export "test_fib") (param i32) (result i32)
+        (call $fib_rec (local.get 0))
+    )
+
+    ;; 读取预先翻译的模块的内存，地址 16 处是数据项 "Hello" 的首个字符
+    (func (export "read_hello") (result i32)
+        (i32.load8_u (i32.const 16))
+    )
+
+    ;; 修改预先翻译的模块的全局变量
+    (func (export "bump") (result i32)
+        (global.set $counter (i32.add (global.get $counter) (i32.const 10)))
+        (global.get $counter)
+    )
+)
@@ -0,0 +1,35 @@
+(module
+    ;; 有操作数为 NaN 时，min 和 max 返回第一个 NaN 操作数，并且设置 quiet 位
+    (func (export "f32_min_max") (param f32 f32) (result f32 f32)
+        (f32.min (local.get 0) (local.get 1))
+        (f32.max (local.get 0) (local.get 1))
+    )
+
+    (func (export "f64_min_max") (param f64 f64) (result f64 f64)
+        (f64.min (local.get 0) (local.get 1))
+        (f64.max (local.get 0) (local.get 1))
+    )
+
+    (func (export "f32_round") (param f32) (result f32 f32 f32 f32 f32)
+        (f32.ceil (local.get 0))
+        (f32.floor (local.get 0))
+        (f32.trunc (local.get 0))
+        (f32.nearest (local.get 0))
+        (f32.neg (f32.abs (local.get 0)))
+    )
+
+    (func (export "f64_round") (param f64) (result f64 f64 f64 f64 f64)
+        (f64.ceil (local.get 0))
+        (f64.floor (local.get 0))
+        (f64.trunc (local.get 0))
+        (f64.nearest (local.get 0))
+        (f64.neg (f64.abs (local.get 0)))
+    )
+
+    (func (export "f32_trunc") (param f32) (result i32 i64 i32 i64)
+        (i32.trunc_sat_f32_u (local.get 0))
+        (i64.trunc_sat_f32_s (local.get 0))
+        (i32.trunc_f32_s (local.get 0))
+        (i64.trunc_f32_u (local.get 0))
+    )
+)
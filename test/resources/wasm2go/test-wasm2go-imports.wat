@@ -0,0 +1,42 @@
+(module
+    ;; 导入的内存、表和全局变量由嵌入方通过 Imports 接口提供，见 wasm2go_test.go
+    (import "env" "memory" (memory 1))
+    (import "env" "table" (table 2 funcref))
+    (import "env" "step" (global $step i32))
+    (import "env" "counter" (global $counter (mut i32)))
+
+    (type $unop (func (param i32) (result i32)))
+
+    (elem (i32.const 0) $double $square)
+
+    (func $double (type $unop)
+        (i32.mul (local.get 0) (i32.const 2))
+    )
+
+    (func $square (type $unop)
+        (i32.mul (local.get 0) (local.get 0))
+    )
+
+    ;; 通过导入的表调用函数
+    (func (export "apply") (param i32 i32) (result i32)
+        (call_indirect (type $unop) (local.get 1) (local.get 0))
+    )
+
+    (func (export "store") (param i32 i32)
+        (i32.store (local.get 0) (local.get 1))
+    )
+
+    (func (export "load") (param i32) (result i32)
+        (i32.load (local.get 0))
+    )
+
+    ;; 读写导入的全局变量
+    (func (export "bump") (result i32)
+        (global.set $counter (i32.add (global.get $counter) (global.get $step)))
+        (global.get $counter)
+    )
+
+    ;; 重新导出导入的内存和全局变量
+    (export "memory" (memory 0))
+    (export "counter" (global $counter))
+)
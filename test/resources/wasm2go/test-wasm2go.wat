@@ -0,0 +1,191 @@
+(module
+    (import "env" "add_i32" (func $add_i32 (param i32 i32) (result i32)))
+
+    (type $binop (func (param i32 i32) (result i32)))
+
+    (memory (export "memory") 1 4)
+    (data (i32.const 16) "Hello\00wasm2go")
+
+    (global $counter (export "counter") (mut i32) (i32.const 100))
+    (global $base i64 (i64.const -8))
+
+    (table 4 funcref)
+    (elem (i32.const 0) $add $sub $sub_i64)
+
+    (start $init)
+
+    ;; start 函数修改全局变量
+    (func $init
+        (global.set $counter (i32.add (global.get $counter) (i32.const 1)))
+    )
+
+    (func $add (type $binop)
+        (i32.add (local.get 0) (local.get 1))
+    )
+
+    (func $sub (type $binop)
+        (i32.sub (local.get 0) (local.get 1))
+    )
+
+    (func $sub_i64 (param i64 i64) (result i64)
+        (i64.sub (local.get 0) (local.get 1))
+    )
+
+    ;; 调用导入的函数
+    (func (export "import_add") (param i32 i32) (result i32)
+        (call $add_i32 (local.get 0) (local.get 1))
+    )
+
+    ;; 间接调用，下标 2 的函数类型不匹配，下标 3 未初始化，下标 4 超出范围
+    (func (export "call_indirect") (param i32 i32 i32) (result i32)
+        (call_indirect (type $binop) (local.get 1) (local.get 2) (local.get 0))
+    )
+
+    (func (export "inc_counter") (result i32)
+        (global.set $counter (i32.add (global.get $counter) (i32.const 1)))
+        (global.get $counter)
+    )
+
+    ;; 1 + 2 + ... + n
+    (func (export "sum") (param $n i32) (result i64)
+        (local $acc i64)
+        (block $exit
+            (loop $next
+                (br_if $exit (i32.eqz (local.get $n)))
+                (local.set $acc (i64.add (local.get $acc) (i64.extend_i32_u (local.get $n))))
+                (local.set $n (i32.sub (local.get $n) (i32.const 1)))
+                (br $next)
+            )
+        )
+        (local.get $acc)
+    )
+
+    ;; 迭代计算斐波那契数
+    (func (export "fib") (param $n i32) (result i64)
+        (local $a i64)
+        (local $b i64)
+        (local $t i64)
+        (local.set $b (i64.const 1))
+        (block $exit
+            (loop $next
+                (br_if $exit (i32.eqz (local.get $n)))
+                (local.set $t (i64.add (local.get $a) (local.get $b)))
+                (local.set $a (local.get $b))
+                (local.set $b (local.get $t))
+                (local.set $n (i32.sub (local.get $n) (i32.const 1)))
+                (br $next)
+            )
+        )
+        (local.get $a)
+    )
+
+    ;; 递归计算斐波那契数
+    (func $fib_rec (export "fib_rec") (param $n i32) (result i32)
+        (if (result i32) (i32.lt_u (local.get $n) (i32.const 2))
+            (then (local.get $n))
+            (else
+                (i32.add
+                    (call $fib_rec (i32.sub (local.get $n) (i32.const 1)))
+                    (call $fib_rec (i32.sub (local.get $n) (i32.const 2)))
+                )
+            )
+        )
+    )
+
+    ;; 0 -> 10, 1 -> 20, 2 -> 30, 其他 -> 40
+    (func (export "switch") (param i32) (result i32)
+        (block $default
+            (block $c2
+                (block $c1
+                    (block $c0
+                        (br_table $c0 $c1 $c2 $default (local.get 0))
+                    )
+                    (return (i32.const 10))
+                )
+                (return (i32.const 20))
+            )
+            (return (i32.const 30))
+        )
+        (i32.const 40)
+    )
+
+    ;; 多返回值以及带参数的块
+    (func $divmod (export "divmod") (param i32 i32) (result i32 i32)
+        (i32.div_u (local.get 0) (local.get 1))
+        (i32.rem_u (local.get 0) (local.get 1))
+    )
+
+    (func (export "swap_sum") (param i32 i32) (result i32 i32 i32)
+        (local.get 0)
+        (local.get 1)
+        (block (param i32 i32) (result i32 i32 i32)
+            (local.set 0)
+            (local.set 1)
+            (local.get 0)
+            (local.get 1)
+            (i32.add (local.get 0) (local.get 1))
+        )
+    )
+
+    (func (export "select") (param i32 i64 i64) (result i64)
+        (select (local.get 1) (local.get 2) (local.get 0))
+    )
+
+    (func (export "max_s") (param i32 i32) (result i32)
+        (if (i32.gt_s (local.get 0) (local.get 1))
+            (then (return (local.get 0)))
+        )
+        (local.get 1)
+    )
+
+    (func (export "div_s") (param i32 i32) (result i32)
+        (i32.div_s (local.get 0) (local.get 1))
+    )
+
+    (func (export "trap_unreachable") (result i32)
+        (unreachable)
+    )
+
+    (func (export "load_byte") (param i32) (result i32)
+        (i32.load8_u (local.get 0))
+    )
+
+    (func (export "store_load") (param i32 i64) (result i64)
+        (i64.store offset=8 (local.get 0) (local.get 1))
+        (i64.add
+            (i64.load32_s offset=8 (local.get 0))
+            (i64.load16_u offset=12 (local.get 0))
+        )
+    )
+
+    (func (export "grow") (param i32) (result i32 i32)
+        (memory.grow (local.get 0))
+        (memory.size)
+    )
+
+    (func (export "fill_copy") (param i32) (result i32)
+        (memory.fill (i32.const 256) (local.get 0) (i32.const 8))
+        (memory.copy (i32.const 300) (i32.const 252) (i32.const 8))
+        (i32.load (i32.const 302))
+    )
+
+    (func (export "bits") (param i32 i64) (result i32 i64 i32 i64)
+        (i32.rotl (i32.clz (local.get 0)) (i32.popcnt (local.get 0)))
+        (i64.shr_s (i64.add (local.get 1) (global.get $base)) (i64.const 65))
+        (i32.extend8_s (local.get 0))
+        (i64.extend32_s (local.get 1))
+    )
+
+    (func (export "float") (param f32 f64) (result f32 f64 i32)
+        (f32.sqrt (f32.mul (local.get 0) (f32.const 2.5)))
+        (f64.nearest (f64.min (local.get 1) (f64.promote_f32 (local.get 0))))
+        (f64.lt (f64.copysign (local.get 1) (f64.const -1)) (f64.const 0))
+    )
+
+    (func (export "convert") (param f64) (result i32 i64 i32 f32)
+        (i32.trunc_sat_f64_s (local.get 0))
+        (i64.trunc_f64_u (local.get 0))
+        (i32.reinterpret_f32 (f32.demote_f64 (local.get 0)))
+        (f32.convert_i64_s (i64.trunc_f64_s (local.get 0)))
+    )
+)
@@ -0,0 +1,229 @@
+;; 原子指令、共享内存以及 wait/notify（threads 提案）
+
+(module
+  (memory 1 1 shared)
+
+  (func (export "init") (param i64) (i64.store (i32.const 0) (local.get 0)))
+
+  (func (export "i32.atomic.load") (param i32) (result i32) (i32.atomic.load (local.get 0)))
+  (func (export "i64.atomic.load") (param i32) (result i64) (i64.atomic.load (local.get 0)))
+  (func (export "i32.atomic.load8_u") (param i32) (result i32) (i32.atomic.load8_u (local.get 0)))
+  (func (export "i32.atomic.load16_u") (param i32) (result i32) (i32.atomic.load16_u (local.get 0)))
+  (func (export "i64.atomic.load8_u") (param i32) (result i64) (i64.atomic.load8_u (local.get 0)))
+  (func (export "i64.atomic.load16_u") (param i32) (result i64) (i64.atomic.load16_u (local.get 0)))
+  (func (export "i64.atomic.load32_u") (param i32) (result i64) (i64.atomic.load32_u (local.get 0)))
+
+  (func (export "i32.atomic.store") (param i32 i32) (i32.atomic.store (local.get 0) (local.get 1)))
+  (func (export "i64.atomic.store") (param i32 i64) (i64.atomic.store (local.get 0) (local.get 1)))
+  (func (export "i32.atomic.store8") (param i32 i32) (i32.atomic.store8 (local.get 0) (local.get 1)))
+  (func (export "i64.atomic.store16") (param i32 i64) (i64.atomic.store16 (local.get 0) (local.get 1)))
+  (func (export "i64.atomic.store32") (param i32 i64) (i64.atomic.store32 (local.get 0) (local.get 1)))
+
+  (func (export "i32.atomic.rmw.add") (param i32 i32) (result i32) (i32.atomic.rmw.add (local.get 0) (local.get 1)))
+  (func (export "i64.atomic.rmw.sub") (param i32 i64) (result i64) (i64.atomic.rmw.sub (local.get 0) (local.get 1)))
+  (func (export "i32.atomic.rmw8.add_u") (param i32 i32) (result i32) (i32.atomic.rmw8.add_u (local.get 0) (local.get 1)))
+  (func (export "i32.atomic.rmw16.and_u") (param i32 i32) (result i32) (i32.atomic.rmw16.and_u (local.get 0) (local.get 1)))
+  (func (export "i64.atomic.rmw32.or_u") (param i32 i64) (result i64) (i64.atomic.rmw32.or_u (local.get 0) (local.get 1)))
+  (func (export "i64.atomic.rmw.xor") (param i32 i64) (result i64) (i64.atomic.rmw.xor (local.get 0) (local.get 1)))
+  (func (export "i32.atomic.rmw.xchg") (param i32 i32) (result i32) (i32.atomic.rmw.xchg (local.get 0) (local.get 1)))
+  (func (export "i64.atomic.rmw8.xchg_u") (param i32 i64) (result i64) (i64.atomic.rmw8.xchg_u (local.get 0) (local.get 1)))
+
+  (func (export "i32.atomic.rmw.cmpxchg") (param i32 i32 i32) (result i32)
+    (i32.atomic.rmw.cmpxchg (local.get 0) (local.get 1) (local.get 2)))
+  (func (export "i64.atomic.rmw.cmpxchg") (param i32 i64 i64) (result i64)
+    (i64.atomic.rmw.cmpxchg (local.get 0) (local.get 1) (local.get 2)))
+  (func (export "i32.atomic.rmw8.cmpxchg_u") (param i32 i32 i32) (result i32)
+    (i32.atomic.rmw8.cmpxchg_u (local.get 0) (local.get 1) (local.get 2)))
+
+  (func (export "wait32") (param i32 i32 i64) (result i32)
+    (memory.atomic.wait32 (local.get 0) (local.get 1) (local.get 2)))
+  (func (export "wait64") (param i32 i64 i64) (result i32)
+    (memory.atomic.wait64 (local.get 0) (local.get 1) (local.get 2)))
+  (func (export "notify") (param i32 i32) (result i32)
+    (memory.atomic.notify (local.get 0) (local.get 1)))
+
+  (func (export "fence") (atomic.fence))
+)
+
+;; 加载
+
+(invoke "init" (i64.const 0x0706050403020100))
+(assert_return (invoke "i32.atomic.load" (i32.const 0)) (i32.const 0x03020100))
+(assert_return (invoke "i32.atomic.load" (i32.const 4)) (i32.const 0x07060504))
+(assert_return (invoke "i64.atomic.load" (i32.const 0)) (i64.const 0x0706050403020100))
+(assert_return (invoke "i32.atomic.load8_u" (i32.const 3)) (i32.const 0x03))
+(assert_return (invoke "i32.atomic.load16_u" (i32.const 6)) (i32.const 0x0706))
+(assert_return (invoke "i64.atomic.load8_u" (i32.const 7)) (i64.const 0x07))
+(assert_return (invoke "i64.atomic.load16_u" (i32.const 2)) (i64.const 0x0302))
+(assert_return (invoke "i64.atomic.load32_u" (i32.const 4)) (i64.const 0x07060504))
+
+;; 存储
+
+(invoke "init" (i64.const 0))
+(assert_return (invoke "i32.atomic.store" (i32.const 0) (i32.const 0xffeeddcc)))
+(assert_return (invoke "i64.atomic.load" (i32.const 0)) (i64.const 0x00000000ffeeddcc))
+(assert_return (invoke "i64.atomic.store" (i32.const 0) (i64.const 0x0123456789abcdef)))
+(assert_return (invoke "i64.atomic.load" (i32.const 0)) (i64.const 0x0123456789abcdef))
+(assert_return (invoke "i32.atomic.store8" (i32.const 1) (i32.const 0x4242)))
+(assert_return (invoke "i64.atomic.load" (i32.const 0)) (i64.const 0x0123456789ab42ef))
+(assert_return (invoke "i64.atomic.store16" (i32.const 6) (i64.const 0xdeadbeef)))
+(assert_return (invoke "i64.atomic.load" (i32.const 0)) (i64.const 0xbeef456789ab42ef))
+(assert_return (invoke "i64.atomic.store32" (i32.const 0) (i64.const -1)))
+(assert_return (invoke "i64.atomic.load" (i32.const 0)) (i64.const 0xbeef4567ffffffff))
+
+;; 读-改-写，返回原来的值
+
+(invoke "init" (i64.const 0x1111111111111111))
+(assert_return (invoke "i32.atomic.rmw.add" (i32.const 0) (i32.const 0x12345678)) (i32.const 0x11111111))
+(assert_return (invoke "i32.atomic.load" (i32.const 0)) (i32.const 0x23456789))
+
+(invoke "init" (i64.const 0x1111111111111111))
+(assert_return (invoke "i64.atomic.rmw.sub" (i32.const 0) (i64.const 0x1111111111111112)) (i64.const 0x1111111111111111))
+(assert_return (invoke "i64.atomic.load" (i32.const 0)) (i64.const -1))
+
+;; 窄的指令只修改低端的字节，溢出的部分被丢弃
+(invoke "init" (i64.const 0x11111111111111ff))
+(assert_return (invoke "i32.atomic.rmw8.add_u" (i32.const 0) (i32.const 0x102)) (i32.const 0xff))
+(assert_return (invoke "i64.atomic.load" (i32.const 0)) (i64.const 0x1111111111111101))
+
+(invoke "init" (i64.const 0x1111111111111111))
+(assert_return (invoke "i32.atomic.rmw16.and_u" (i32.const 2) (i32.const 0xffff0101)) (i32.const 0x1111))
+(assert_return (invoke "i64.atomic.load" (i32.const 0)) (i64.const 0x1111111101011111))
+
+(invoke "init" (i64.const 0x1111111111111111))
+(assert_return (invoke "i64.atomic.rmw32.or_u" (i32.const 4) (i64.const 0xffffffff00000022)) (i64.const 0x11111111))
+(assert_return (invoke "i64.atomic.load" (i32.const 0)) (i64.const 0x1111113311111111))
+
+(invoke "init" (i64.const 0x1111111111111111))
+(assert_return (invoke "i64.atomic.rmw.xor" (i32.const 0) (i64.const 0x0101010101010101)) (i64.const 0x1111111111111111))
+(assert_return (invoke "i64.atomic.load" (i32.const 0)) (i64.const 0x1010101010101010))
+
+(invoke "init" (i64.const 0x1111111111111111))
+(assert_return (invoke "i32.atomic.rmw.xchg" (i32.const 4) (i32.const 0x22222222)) (i32.const 0x11111111))
+(assert_return (invoke "i64.atomic.load" (i32.const 0)) (i64.const 0x2222222211111111))
+(assert_return (invoke "i64.atomic.rmw8.xchg_u" (i32.const 0) (i64.const 0x333)) (i64.const 0x11))
+(assert_return (invoke "i64.atomic.load" (i32.const 0)) (i64.const 0x2222222211111133))
+
+;; 比较并交换，仅当原来的值等于期望值时才写入
+
+(invoke "init" (i64.const 0x1111111111111111))
+(assert_return (invoke "i32.atomic.rmw.cmpxchg" (i32.const 0) (i32.const 0) (i32.const 0x12345678)) (i32.const 0x11111111))
+(assert_return (invoke "i64.atomic.load" (i32.const 0)) (i64.const 0x1111111111111111))
+(assert_return (invoke "i32.atomic.rmw.cmpxchg" (i32.const 0) (i32.const 0x11111111) (i32.const 0x12345678)) (i32.const 0x11111111))
+(assert_return (invoke "i64.atomic.load" (i32.const 0)) (i64.const 0x1111111112345678))
+(assert_return (invoke "i64.atomic.rmw.cmpxchg" (i32.const 0) (i64.const 0x1111111112345678) (i64.const -2)) (i64.const 0x1111111112345678))
+(assert_return (invoke "i64.atomic.load" (i32.const 0)) (i64.const -2))
+
+;; 窄的比较并交换只比较期望值的低端字节
+(assert_return (invoke "i32.atomic.rmw8.cmpxchg_u" (i32.const 0) (i32.const 0x1fe) (i32.const 0x7f)) (i32.const 0xfe))
+(assert_return (invoke "i64.atomic.load" (i32.const 0)) (i64.const 0xffffffffffffff7f))
+
+;; 等待和唤醒
+
+(invoke "init" (i64.const 0))
+(assert_return (invoke "wait32" (i32.const 0) (i32.const 1) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "wait64" (i32.const 0) (i64.const 1) (i64.const -1)) (i32.const 1))
+(assert_return (invoke "wait32" (i32.const 0) (i32.const 0) (i64.const 0)) (i32.const 2))
+(assert_return (invoke "wait64" (i32.const 0) (i64.const 0) (i64.const 1000)) (i32.const 2))
+(assert_return (invoke "notify" (i32.const 0) (i32.const 1)) (i32.const 0))
+(assert_return (invoke "fence"))
+
+;; 地址没有对齐以及越界
+
+(assert_trap (invoke "i32.atomic.load" (i32.const 1)) "unaligned atomic")
+(assert_trap (invoke "i64.atomic.load" (i32.const 4)) "unaligned atomic")
+(assert_trap (invoke "i32.atomic.store" (i32.const 2) (i32.const 0)) "unaligned atomic")
+(assert_trap (invoke "i64.atomic.rmw32.or_u" (i32.const 2) (i64.const 0)) "unaligned atomic")
+(assert_trap (invoke "i32.atomic.rmw.cmpxchg" (i32.const 1) (i32.const 0) (i32.const 0)) "unaligned atomic")
+(assert_trap (invoke "wait64" (i32.const 4) (i64.const 0) (i64.const 0)) "unaligned atomic")
+(assert_trap (invoke "notify" (i32.const 1) (i32.const 1)) "unaligned atomic")
+(assert_return (invoke "i32.atomic.load8_u" (i32.const 0xffff)) (i32.const 0))
+(assert_trap (invoke "i32.atomic.load" (i32.const 0x10000)) "out of bounds memory access")
+(assert_trap (invoke "i64.atomic.load" (i32.const 0xfff9)) "out of bounds memory access")
+
+;; 原子指令也可以用于非共享的内存，但不能在非共享的内存上等待
+
+(module
+  (memory 1)
+  (func (export "rmw") (param i32) (result i32) (i32.atomic.rmw.add (i32.const 8) (local.get 0)))
+  (func (export "wait") (result i32) (memory.atomic.wait32 (i32.const 0) (i32.const 0) (i64.const 0)))
+  (func (export "notify") (result i32) (memory.atomic.notify (i32.const 0) (i32.const 1)))
+)
+
+(assert_return (invoke "rmw" (i32.const 5)) (i32.const 0))
+(assert_return (invoke "rmw" (i32.const 5)) (i32.const 5))
+(assert_trap (invoke "wait") "expected shared memory")
+(assert_return (invoke "notify") (i32.const 0))
+
+;; 导入和导出共享内存
+
+(module $shared
+  (memory (export "memory") 1 2 shared)
+  (func (export "store") (param i32 i32) (i32.atomic.store (local.get 0) (local.get 1)))
+)
+(register "shared" $shared)
+
+(module
+  (import "shared" "memory" (memory 1 2 shared))
+  (func (export "load") (param i32) (result i32) (i32.atomic.load (local.get 0)))
+)
+
+(assert_return (invoke $shared "store" (i32.const 16) (i32.const 42)))
+(assert_return (invoke "load" (i32.const 16)) (i32.const 42))
+
+(assert_unlinkable
+  (module (import "shared" "memory" (memory 1 2)))
+  "incompatible import type"
+)
+(assert_unlinkable
+  (module (import "spectest" "memory" (memory 1 2 shared)))
+  "incompatible import type"
+)
+
+;; 二进制格式：limits 的 tag 为 3 表示共享内存
+
+(module binary
+  "\00asm" "\01\00\00\00"
+  "\05\04\01\03\01\02"              ;; 内存段：(memory 1 2 shared)
+)
+(assert_malformed
+  (module binary
+    "\00asm" "\01\00\00\00"
+    "\05\04\01\08\01\02"            ;; 内存段：无效的 tag
+  )
+  "invalid limits flags"
+)
+
+;; 验证
+
+(assert_invalid
+  (module (memory 1 shared))
+  "shared memory must have maximum"
+)
+(assert_malformed
+  (module binary
+    "\00asm" "\01\00\00\00"
+    "\04\05\01\70\03\01\02"          ;; 表段：共享的表
+  )
+  "integer too large"
+)
+(assert_invalid
+  (module (memory 1 1 shared) (func (drop (i32.atomic.load align=2 (i32.const 0)))))
+  "alignment must be exactly natural"
+)
+(assert_invalid
+  (module (memory 1 1 shared) (func (drop (i64.atomic.rmw8.add_u align=2 (i32.const 0) (i64.const 0)))))
+  "alignment must be exactly natural"
+)
+(assert_invalid
+  (module (func (drop (i32.atomic.load (i32.const 0)))))
+  "unknown memory"
+)
+(assert_invalid
+  (module (memory 1 1 shared) (func (drop (i32.atomic.rmw.add (i32.const 0) (i64.const 0)))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (memory 1 1 shared) (func (result i32) (memory.atomic.wait64 (i32.const 0) (i32.const 0) (i64.const 0))))
+  "type mismatch"
+)
@@ -0,0 +1,65 @@
+;; 二进制格式
+
+(module binary "\00asm" "\01\00\00\00")
+(module binary "\00asm\01\00\00\00")
+(module $M1 binary "\00asm" "\01\00\00\00")
+(module $M2 binary "\00asm" "\01\00\00\00")
+
+(assert_malformed (module binary "") "unexpected end")
+(assert_malformed (module binary "\01") "unexpected end")
+(assert_malformed (module binary "\00as") "unexpected end")
+(assert_malformed (module binary "asm\00") "magic header not detected")
+(assert_malformed (module binary "msa\00") "magic header not detected")
+(assert_malformed (module binary "msa\00\01\00\00\00") "magic header not detected")
+(assert_malformed (module binary "\00ASM\01\00\00\00") "magic header not detected")
+
+(assert_malformed (module binary "\00asm") "unexpected end")
+(assert_malformed (module binary "\00asm\01") "unexpected end")
+(assert_malformed (module binary "\00asm\01\00\00") "unexpected end")
+(assert_malformed (module binary "\00asm\00\00\00\00") "unknown binary version")
+(assert_malformed (module binary "\00asm\0d\00\00\00") "unknown binary version")
+(assert_malformed (module binary "\00asm\00\00\00\01") "unknown binary version")
+
+;; 函数：类型段 + 函数段 + 导出段 + 代码段
+(module binary
+  "\00asm" "\01\00\00\00"
+  "\01\06\01\60\01\7f\01\7f"        ;; 类型段：(func (param i32) (result i32))
+  "\03\02\01\00"                    ;; 函数段
+  "\07\07\01\03inc\00\00"           ;; 导出段："inc"
+  "\0a\09\01\07\00"                 ;; 代码段
+  "\20\00\41\01\6a\0b"              ;; local.get 0, i32.const 1, i32.add, end
+)
+(assert_return (invoke "inc" (i32.const 41)) (i32.const 42))
+
+;; 段的长度跟内容不一致
+(assert_malformed
+  (module binary
+    "\00asm" "\01\00\00\00"
+    "\01\07\01\60\01\7f\01\7f"      ;; 类型段的长度多了 1
+  )
+  "unexpected end"
+)
+
+;; 函数段跟代码段的项目数量不一致
+(assert_malformed
+  (module binary
+    "\00asm" "\01\00\00\00"
+    "\01\04\01\60\00\00"            ;; 类型段：(func)
+    "\03\02\01\00"                  ;; 函数段：1 个函数
+  )
+  "function and code section have inconsistent lengths"
+)
+
+;; 未知的段
+(assert_malformed
+  (module binary "\00asm" "\01\00\00\00" "\0e\01\00")
+  "malformed section id"
+)
+
+;; 自定义段可以出现在任意位置
+(module binary
+  "\00asm" "\01\00\00\00"
+  "\00\05\04name"                   ;; 空的自定义段
+  "\01\04\01\60\00\00"
+  "\00\06\04test\ff"
+)
@@ -0,0 +1,163 @@
+;; bulk memory：memory.fill, memory.copy, memory.init, data.drop
+
+(module
+  (memory 1)
+  (data $d "\aa\bb\cc\dd")
+  (data $e (i32.const 200) "\01\02\03")
+
+  (func (export "load8_u") (param i32) (result i32)
+    (i32.load8_u (local.get 0))
+  )
+  (func (export "fill") (param i32 i32 i32)
+    (memory.fill (local.get 0) (local.get 1) (local.get 2))
+  )
+  (func (export "copy") (param i32 i32 i32)
+    (memory.copy (local.get 0) (local.get 1) (local.get 2))
+  )
+  (func (export "init") (param i32 i32 i32)
+    (memory.init $d (local.get 0) (local.get 1) (local.get 2))
+  )
+  (func (export "drop")
+    (data.drop $d)
+  )
+  (func (export "init-active") (param i32 i32 i32)
+    (memory.init $e (local.get 0) (local.get 1) (local.get 2))
+  )
+)
+
+(assert_return (invoke "fill" (i32.const 1) (i32.const 0xff) (i32.const 3)))
+(assert_return (invoke "load8_u" (i32.const 0)) (i32.const 0))
+(assert_return (invoke "load8_u" (i32.const 1)) (i32.const 0xff))
+(assert_return (invoke "load8_u" (i32.const 3)) (i32.const 0xff))
+(assert_return (invoke "load8_u" (i32.const 4)) (i32.const 0))
+
+;; 只取低 8 位
+(assert_return (invoke "fill" (i32.const 0) (i32.const 0x1234) (i32.const 1)))
+(assert_return (invoke "load8_u" (i32.const 0)) (i32.const 0x34))
+
+;; 长度为 0 时，地址等于内存大小不算越界，大于内存大小则越界
+(assert_return (invoke "fill" (i32.const 0x10000) (i32.const 0) (i32.const 0)))
+(assert_trap (invoke "fill" (i32.const 0x10001) (i32.const 0) (i32.const 0)) "out of bounds memory access")
+(assert_trap (invoke "fill" (i32.const 0xffff) (i32.const 0) (i32.const 2)) "out of bounds memory access")
+
+(assert_return (invoke "init" (i32.const 100) (i32.const 1) (i32.const 3)))
+(assert_return (invoke "load8_u" (i32.const 99)) (i32.const 0))
+(assert_return (invoke "load8_u" (i32.const 100)) (i32.const 0xbb))
+(assert_return (invoke "load8_u" (i32.const 102)) (i32.const 0xdd))
+(assert_return (invoke "load8_u" (i32.const 103)) (i32.const 0))
+(assert_trap (invoke "init" (i32.const 100) (i32.const 2) (i32.const 3)) "out of bounds memory access")
+(assert_trap (invoke "init" (i32.const 0xfffe) (i32.const 0) (i32.const 3)) "out of bounds memory access")
+(assert_return (invoke "init" (i32.const 0) (i32.const 4) (i32.const 0)))
+
+;; 源区域与目标区域重叠
+(assert_return (invoke "copy" (i32.const 101) (i32.const 100) (i32.const 3)))
+(assert_return (invoke "load8_u" (i32.const 101)) (i32.const 0xbb))
+(assert_return (invoke "load8_u" (i32.const 102)) (i32.const 0xcc))
+(assert_return (invoke "load8_u" (i32.const 103)) (i32.const 0xdd))
+(assert_return (invoke "copy" (i32.const 100) (i32.const 101) (i32.const 3)))
+(assert_return (invoke "load8_u" (i32.const 100)) (i32.const 0xbb))
+(assert_return (invoke "load8_u" (i32.const 102)) (i32.const 0xdd))
+(assert_trap (invoke "copy" (i32.const 0) (i32.const 0xffff) (i32.const 2)) "out of bounds memory access")
+(assert_trap (invoke "copy" (i32.const 0xffff) (i32.const 0) (i32.const 2)) "out of bounds memory access")
+
+;; 丢弃之后的数据段相当于长度为 0 的数据段
+(assert_return (invoke "drop"))
+(assert_return (invoke "drop"))
+(assert_return (invoke "init" (i32.const 0) (i32.const 0) (i32.const 0)))
+(assert_trap (invoke "init" (i32.const 0) (i32.const 0) (i32.const 1)) "out of bounds memory access")
+
+;; 活动数据段在实例化之后即被丢弃
+(assert_return (invoke "init-active" (i32.const 0) (i32.const 0) (i32.const 0)))
+(assert_trap (invoke "init-active" (i32.const 0) (i32.const 0) (i32.const 1)) "out of bounds memory access")
+
+(assert_invalid
+  (module (memory 1) (func (data.drop 0)))
+  "unknown data segment"
+)
+(assert_invalid
+  (module (memory 1) (data "") (func (memory.init 1 (i32.const 0) (i32.const 0) (i32.const 0))))
+  "unknown data segment"
+)
+(assert_invalid
+  (module (func (memory.fill (i32.const 0) (i32.const 0) (i32.const 0))))
+  "unknown memory"
+)
+(assert_invalid
+  (module (memory 1) (func (memory.copy (i32.const 0) (i32.const 0) (i64.const 0))))
+  "type mismatch"
+)
+
+;; bulk table：table.init, elem.drop, table.copy
+
+(module
+  (type $t (func (result i32)))
+  (table 4 funcref)
+  (elem $p func $zero $one $two)
+  (elem (i32.const 0) $two)
+  (elem declare func $three)
+
+  (func $zero (result i32) (i32.const 0))
+  (func $one (result i32) (i32.const 1))
+  (func $two (result i32) (i32.const 2))
+  (func $three (result i32) (i32.const 3))
+
+  (func (export "call") (param i32) (result i32)
+    (call_indirect (type $t) (local.get 0))
+  )
+  (func (export "init") (param i32 i32 i32)
+    (table.init $p (local.get 0) (local.get 1) (local.get 2))
+  )
+  (func (export "drop")
+    (elem.drop $p)
+  )
+  (func (export "copy") (param i32 i32 i32)
+    (table.copy (local.get 0) (local.get 1) (local.get 2))
+  )
+)
+
+(assert_return (invoke "call" (i32.const 0)) (i32.const 2))
+(assert_trap (invoke "call" (i32.const 1)) "uninitialized element")
+
+(assert_return (invoke "init" (i32.const 1) (i32.const 0) (i32.const 3)))
+(assert_return (invoke "call" (i32.const 1)) (i32.const 0))
+(assert_return (invoke "call" (i32.const 3)) (i32.const 2))
+(assert_trap (invoke "init" (i32.const 2) (i32.const 0) (i32.const 3)) "out of bounds table access")
+(assert_trap (invoke "init" (i32.const 0) (i32.const 1) (i32.const 3)) "out of bounds table access")
+(assert_return (invoke "init" (i32.const 4) (i32.const 3) (i32.const 0)))
+(assert_trap (invoke "init" (i32.const 5) (i32.const 0) (i32.const 0)) "out of bounds table access")
+
+;; 源区域与目标区域重叠
+(assert_return (invoke "copy" (i32.const 0) (i32.const 1) (i32.const 3)))
+(assert_return (invoke "call" (i32.const 0)) (i32.const 0))
+(assert_return (invoke "call" (i32.const 1)) (i32.const 1))
+(assert_return (invoke "call" (i32.const 2)) (i32.const 2))
+(assert_return (invoke "call" (i32.const 3)) (i32.const 2))
+(assert_trap (invoke "copy" (i32.const 2) (i32.const 0) (i32.const 3)) "out of bounds table access")
+
+(assert_return (invoke "drop"))
+(assert_return (invoke "init" (i32.const 0) (i32.const 0) (i32.const 0)))
+(assert_trap (invoke "init" (i32.const 0) (i32.const 0) (i32.const 1)) "out of bounds table access")
+
+(assert_invalid
+  (module (func (elem.drop 0)))
+  "unknown elem segment"
+)
+(assert_invalid
+  (module (elem func) (func (table.init 0 (i32.const 0) (i32.const 0) (i32.const 0))))
+  "unknown table"
+)
+(assert_invalid
+  (module (func (table.copy (i32.const 0) (i32.const 0) (i32.const 0))))
+  "unknown table"
+)
+
+;; 实例化时越界的活动段
+
+(assert_trap
+  (module (memory 1) (data (i32.const 0xffff) "ab"))
+  "out of bounds memory access"
+)
+(assert_trap
+  (module (table 1 funcref) (func) (elem (i32.const 1) 0))
+  "out of bounds table access"
+)
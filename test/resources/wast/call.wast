@@ -0,0 +1,111 @@
+;; call and call_indirect
+
+(module
+  (type $proc (func))
+  (type $i32-i32 (func (param i32) (result i32)))
+  (type $i64-i64 (func (param i64) (result i64)))
+
+  (func $const-i32 (result i32) (i32.const 0x132))
+  (func $id-i32 (type $i32-i32) (local.get 0))
+  (func $add-i64 (param i64 i64) (result i64) (i64.add (local.get 0) (local.get 1)))
+
+  (func (export "call-const") (result i32) (call $const-i32))
+  (func (export "call-id") (param i32) (result i32) (call $id-i32 (local.get 0)))
+  (func (export "call-add") (param i64 i64) (result i64) (call $add-i64 (local.get 0) (local.get 1)))
+
+  (func $fac (export "fac") (type $i64-i64)
+    (if (result i64) (i64.eqz (local.get 0))
+      (then (i64.const 1))
+      (else (i64.mul (local.get 0) (call $fac (i64.sub (local.get 0) (i64.const 1)))))
+    )
+  )
+  (func $fib (export "fib") (type $i64-i64)
+    (if (result i64) (i64.le_u (local.get 0) (i64.const 1))
+      (then (i64.const 1))
+      (else
+        (i64.add
+          (call $fib (i64.sub (local.get 0) (i64.const 2)))
+          (call $fib (i64.sub (local.get 0) (i64.const 1)))
+        )
+      )
+    )
+  )
+  (func $even (export "even") (param i64) (result i32)
+    (if (result i32) (i64.eqz (local.get 0))
+      (then (i32.const 44))
+      (else (call $odd (i64.sub (local.get 0) (i64.const 1))))
+    )
+  )
+  (func $odd (export "odd") (param i64) (result i32)
+    (if (result i32) (i64.eqz (local.get 0))
+      (then (i32.const 99))
+      (else (call $even (i64.sub (local.get 0) (i64.const 1))))
+    )
+  )
+
+  (table funcref (elem $const-i32 $id-i32 $fac $fib))
+  (func $nop)
+  (func (export "call-indirect-id") (param i32) (result i32)
+    (call_indirect (type $i32-i32) (local.get 0) (i32.const 1))
+  )
+  (func (export "call-indirect-fac") (param i64) (result i64)
+    (call_indirect (type $i64-i64) (local.get 0) (i32.const 2))
+  )
+  (func (export "dispatch") (param i32 i64) (result i64)
+    (call_indirect (type $i64-i64) (local.get 1) (local.get 0))
+  )
+  (func (export "call-indirect-oob") (call_indirect (type $proc) (i32.const 4)))
+)
+
+(assert_return (invoke "call-const") (i32.const 0x132))
+(assert_return (invoke "call-id" (i32.const -7)) (i32.const -7))
+(assert_return (invoke "call-add" (i64.const 1) (i64.const 2)) (i64.const 3))
+(assert_return (invoke "fac" (i64.const 0)) (i64.const 1))
+(assert_return (invoke "fac" (i64.const 5)) (i64.const 120))
+(assert_return (invoke "fac" (i64.const 25)) (i64.const 7034535277573963776))
+(assert_return (invoke "fib" (i64.const 5)) (i64.const 8))
+(assert_return (invoke "fib" (i64.const 20)) (i64.const 10946))
+(assert_return (invoke "even" (i64.const 0)) (i32.const 44))
+(assert_return (invoke "even" (i64.const 77)) (i32.const 99))
+(assert_return (invoke "odd" (i64.const 200)) (i32.const 99))
+(assert_return (invoke "call-indirect-id" (i32.const 5)) (i32.const 5))
+(assert_return (invoke "call-indirect-fac" (i64.const 6)) (i64.const 720))
+(assert_return (invoke "dispatch" (i32.const 2) (i64.const 5)) (i64.const 120))
+(assert_return (invoke "dispatch" (i32.const 3) (i64.const 5)) (i64.const 8))
+(assert_trap (invoke "dispatch" (i32.const 0) (i64.const 2)) "indirect call type mismatch")
+(assert_trap (invoke "dispatch" (i32.const 1) (i64.const 2)) "indirect call type mismatch")
+(assert_trap (invoke "dispatch" (i32.const 4) (i64.const 2)) "undefined element")
+(assert_trap (invoke "dispatch" (i32.const -1) (i64.const 2)) "undefined element")
+(assert_trap (invoke "call-indirect-oob") "undefined element")
+
+(assert_invalid
+  (module (func $type-void-vs-num (i32.eqz (call 1))) (func))
+  "type mismatch"
+)
+(assert_invalid
+  (module (func $arity-1-vs-0 (call 1)) (func (param i32)))
+  "type mismatch"
+)
+(assert_invalid
+  (module (func $unbound-func (call 1)))
+  "unknown function"
+)
+(assert_invalid
+  (module (type (func)) (func $no-table (call_indirect (type 0) (i32.const 0))))
+  "unknown table"
+)
+(assert_invalid
+  (module (table 0 funcref) (func $unbound-type (call_indirect (type 1) (i32.const 0))))
+  "unknown type"
+)
+
+;; 无限递归
+
+(module
+  (func $runaway (export "runaway") (call $runaway))
+  (func $mutual-runaway1 (export "mutual-runaway") (call $mutual-runaway2))
+  (func $mutual-runaway2 (call $mutual-runaway1))
+)
+
+(assert_exhaustion (invoke "runaway") "call stack exhausted")
+(assert_exhaustion (invoke "mutual-runaway") "call stack exhausted")
@@ -0,0 +1,166 @@
+;; block, loop, if, br, br_if, br_table, return, select, unreachable
+
+(module
+  (func (export "empty-block") (block))
+  (func (export "block-value") (result i32) (block (result i32) (i32.const 7)))
+  (func (export "nested-block") (result i32)
+    (block (result i32)
+      (drop (i32.const 1))
+      (block (result i32) (i32.const 9))
+    )
+  )
+  (func (export "br-block") (result i32)
+    (block (result i32) (br 0 (i32.const 2)) (i32.const 3))
+  )
+  (func (export "br-nested") (result i32)
+    (block (result i32)
+      (block (result i32)
+        (block (br 2 (i32.const 8)))
+        (i32.const 1)
+      )
+    )
+  )
+  (func (export "br-if") (param i32) (result i32)
+    (block (result i32)
+      (drop (br_if 0 (i32.const 10) (local.get 0)))
+      (i32.const 20)
+    )
+  )
+  (func (export "br-table") (param i32) (result i32)
+    (block
+      (block
+        (block
+          (br_table 0 1 2 (local.get 0))
+        )
+        (return (i32.const 100))
+      )
+      (return (i32.const 101))
+    )
+    (i32.const 102)
+  )
+  (func (export "br-table-value") (param i32) (result i32)
+    (block (result i32)
+      (block (result i32)
+        (br_table 1 0 (i32.const 5) (local.get 0))
+      )
+      (i32.const 1)
+      (i32.add)
+    )
+  )
+  (func (export "if") (param i32) (result i32)
+    (if (result i32) (local.get 0) (then (i32.const 1)) (else (i32.const 2)))
+  )
+  (func (export "if-no-else") (param i32) (result i32)
+    (local $r i32)
+    (local.set $r (i32.const 5))
+    (if (local.get 0) (then (local.set $r (i32.const 6))))
+    (local.get $r)
+  )
+  (func (export "if-br") (param i32) (result i32)
+    (if (result i32) (local.get 0)
+      (then (br 0 (i32.const 3)) (i32.const 4))
+      (else (i32.const 5))
+    )
+  )
+  (func (export "loop-sum") (param $n i32) (result i32)
+    (local $sum i32)
+    (block $done
+      (loop $next
+        (br_if $done (i32.eqz (local.get $n)))
+        (local.set $sum (i32.add (local.get $sum) (local.get $n)))
+        (local.set $n (i32.sub (local.get $n) (i32.const 1)))
+        (br $next)
+      )
+    )
+    (local.get $sum)
+  )
+  (func (export "loop-value") (result i32)
+    (loop (result i32) (i32.const 11))
+  )
+  (func (export "return-early") (param i32) (result i32)
+    (if (local.get 0) (then (return (i32.const 1))))
+    (i32.const 0)
+  )
+  (func (export "return-nested") (result i32)
+    (block (loop (block (return (i32.const 42)))))
+    (i32.const 0)
+  )
+  (func (export "select") (param i32) (result i64)
+    (select (i64.const 1) (i64.const 2) (local.get 0))
+  )
+  (func (export "select-f64") (param f64 f64 i32) (result f64)
+    (select (local.get 0) (local.get 1) (local.get 2))
+  )
+  (func (export "unreachable") (result i32) (unreachable))
+  (func (export "unreachable-in-block") (result i32)
+    (block (result i32) (i32.const 1) (br_if 0 (i32.const 0)) (drop) (unreachable))
+  )
+  (func (export "nop") (result i32) (nop) (i32.const 1) (nop))
+  (func (export "tee") (param i32) (result i32)
+    (local i32)
+    (i32.add (local.tee 1 (local.get 0)) (local.get 1))
+  )
+)
+
+(assert_return (invoke "empty-block"))
+(assert_return (invoke "block-value") (i32.const 7))
+(assert_return (invoke "nested-block") (i32.const 9))
+(assert_return (invoke "br-block") (i32.const 2))
+(assert_return (invoke "br-nested") (i32.const 8))
+(assert_return (invoke "br-if" (i32.const 0)) (i32.const 20))
+(assert_return (invoke "br-if" (i32.const 1)) (i32.const 10))
+(assert_return (invoke "br-table" (i32.const 0)) (i32.const 100))
+(assert_return (invoke "br-table" (i32.const 1)) (i32.const 101))
+(assert_return (invoke "br-table" (i32.const 2)) (i32.const 102))
+(assert_return (invoke "br-table" (i32.const 3)) (i32.const 102))
+(assert_return (invoke "br-table" (i32.const -1)) (i32.const 102))
+(assert_return (invoke "br-table-value" (i32.const 0)) (i32.const 5))
+(assert_return (invoke "br-table-value" (i32.const 1)) (i32.const 6))
+(assert_return (invoke "br-table-value" (i32.const 100)) (i32.const 6))
+(assert_return (invoke "if" (i32.const 0)) (i32.const 2))
+(assert_return (invoke "if" (i32.const 10)) (i32.const 1))
+(assert_return (invoke "if-no-else" (i32.const 0)) (i32.const 5))
+(assert_return (invoke "if-no-else" (i32.const 1)) (i32.const 6))
+(assert_return (invoke "if-br" (i32.const 1)) (i32.const 3))
+(assert_return (invoke "if-br" (i32.const 0)) (i32.const 5))
+(assert_return (invoke "loop-sum" (i32.const 0)) (i32.const 0))
+(assert_return (invoke "loop-sum" (i32.const 100)) (i32.const 5050))
+(assert_return (invoke "loop-value") (i32.const 11))
+(assert_return (invoke "return-early" (i32.const 1)) (i32.const 1))
+(assert_return (invoke "return-early" (i32.const 0)) (i32.const 0))
+(assert_return (invoke "return-nested") (i32.const 42))
+(assert_return (invoke "select" (i32.const 1)) (i64.const 1))
+(assert_return (invoke "select" (i32.const 0)) (i64.const 2))
+(assert_return (invoke "select-f64" (f64.const 1) (f64.const nan) (i32.const 0)) (f64.const nan))
+(assert_trap (invoke "unreachable") "unreachable")
+(assert_trap (invoke "unreachable-in-block") "unreachable")
+(assert_return (invoke "nop") (i32.const 1))
+(assert_return (invoke "tee" (i32.const 21)) (i32.const 42))
+
+;; 发生陷阱之后，模块实例仍然可以继续使用
+(assert_return (invoke "loop-sum" (i32.const 3)) (i32.const 6))
+
+(assert_invalid
+  (module (func $type-value-empty-vs-num (result i32) (block (result i32))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (func $type-break-void-vs-num (result i32) (block (result i32) (br 0))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (func $unbound-label (br 1)))
+  "unknown label"
+)
+(assert_invalid
+  (module (func $if-missing-else (result i32) (if (result i32) (i32.const 1) (then (i32.const 1)))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (func $select-type-mismatch (select (i32.const 1) (i64.const 1) (i32.const 1)) (drop)))
+  "type mismatch"
+)
+(assert_malformed
+  (module quote "(func (br_table))")
+  "unexpected token"
+)
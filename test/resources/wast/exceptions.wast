@@ -0,0 +1,430 @@
+;; 异常处理指令：try、catch、catch_all、delegate、throw 和 rethrow
+
+(module
+  (tag $e0)
+  (tag $e1 (param i32))
+  (tag $e2 (param i32 i64))
+  (tag $e-unused (param f32))
+
+  (func $throw-if (param i32) (result i32)
+    (if (local.get 0) (then (throw $e1 (local.get 0))))
+    (i32.const 0)
+  )
+
+  (func (export "throw-e0") (throw $e0))
+  (func (export "throw-e1") (param i32) (throw $e1 (local.get 0)))
+
+  (func (export "empty-catch") (try (do) (catch $e0)))
+
+  (func (export "simple-throw-catch") (param i32) (result i32)
+    (try (result i32)
+      (do (if (i32.eqz (local.get 0)) (then (throw $e0))) (i32.const 42))
+      (catch $e0 (i32.const 23))
+    )
+  )
+
+  (func (export "catch-param") (param i32) (result i32)
+    (try (result i32)
+      (do (throw $e1 (local.get 0)))
+      (catch $e1 (i32.add (i32.const 1)))
+    )
+  )
+
+  (func (export "catch-multi-param") (result i64)
+    (local i64)
+    (try (result i64)
+      (do (throw $e2 (i32.const 3) (i64.const 4)))
+      (catch $e2
+        (local.set 0)
+        (i64.extend_i32_u)
+        (i64.add (local.get 0))
+        (i64.add (i64.const 10))
+      )
+    )
+  )
+
+  (func (export "catch-first-match") (param i32) (result i32)
+    (try (result i32)
+      (do (throw $e1 (local.get 0)))
+      (catch $e0 (i32.const 0))
+      (catch $e1)
+      (catch_all (i32.const -1))
+    )
+  )
+
+  (func (export "catch-all") (param i32) (result i32)
+    (try (result i32)
+      (do
+        (if (i32.eqz (local.get 0)) (then (throw $e0)))
+        (throw $e2 (local.get 0) (i64.const 0))
+      )
+      (catch $e1)
+      (catch_all (i32.const 99))
+    )
+  )
+
+  ;; 异常来自被调用的函数，调用帧和操作数都被丢弃
+  (func (export "catch-from-call") (param i32) (result i32)
+    (i32.const 1000)
+    (try (result i32)
+      (do
+        (i64.const 7)
+        (block (result i32)
+          (loop (result i32) (call $throw-if (local.get 0)))
+        )
+        (drop)
+        (drop)
+        (i32.const 5)
+      )
+      (catch $e1)
+    )
+    (i32.add)
+  )
+
+  ;; 异常跟陷阱不同，catch_all 不会捕获陷阱
+  (func (export "catch-trap") (result i32)
+    (try (result i32)
+      (do (unreachable))
+      (catch_all (i32.const 1))
+    )
+  )
+
+  (func (export "uncaught") (param i32) (result i32)
+    (try (result i32)
+      (do (throw $e1 (local.get 0)))
+      (catch $e0 (i32.const 0))
+    )
+  )
+
+  ;; 局部变量在 catch 子句里仍然可用
+  (func (export "catch-local") (param i32) (result i32)
+    (local i32)
+    (local.set 1 (i32.const 10))
+    (try (result i32)
+      (do (local.set 1 (i32.const 20)) (call $throw-if (local.get 0)))
+      (catch $e1 (i32.add (local.get 1)))
+    )
+  )
+
+  ;; 从 catch 子句跳出到外层
+  (func (export "catch-br") (param i32) (result i32)
+    (block $outer (result i32)
+      (try (result i32)
+        (do (throw $e1 (local.get 0)))
+        (catch $e1 (br $outer))
+      )
+      (drop)
+      (i32.const 0)
+    )
+  )
+
+  ;; 嵌套的 try，内层不能捕获时交给外层
+  (func (export "nested") (param i32) (result i32)
+    (try (result i32)
+      (do
+        (try (result i32)
+          (do
+            (if (i32.eqz (local.get 0)) (then (throw $e0)))
+            (throw $e1 (local.get 0))
+          )
+          (catch $e0 (i32.const 100))
+        )
+      )
+      (catch $e1 (i32.const 200) (i32.add))
+    )
+  )
+
+  ;; catch 子句里抛出的异常不会被同一个 try 捕获
+  (func (export "throw-in-catch") (result i32)
+    (try (result i32)
+      (do
+        (try (result i32)
+          (do (throw $e0))
+          (catch $e0 (throw $e1 (i32.const 5)))
+          (catch $e1 (drop) (i32.const 0))
+        )
+      )
+      (catch $e1)
+    )
+  )
+
+  (func (export "loop-throw") (param i32) (result i32)
+    (local i32)
+    (loop $l
+      (try
+        (do (throw $e1 (local.get 1)))
+        (catch $e1 (local.set 1 (i32.add (i32.const 1))))
+      )
+      (br_if $l (i32.lt_u (local.get 1) (local.get 0)))
+    )
+    (local.get 1)
+  )
+)
+
+(assert_exception (invoke "throw-e0"))
+(assert_exception (invoke "throw-e1" (i32.const 1)))
+(assert_return (invoke "empty-catch"))
+(assert_return (invoke "simple-throw-catch" (i32.const 0)) (i32.const 23))
+(assert_return (invoke "simple-throw-catch" (i32.const 1)) (i32.const 42))
+(assert_return (invoke "catch-param" (i32.const 41)) (i32.const 42))
+(assert_return (invoke "catch-multi-param") (i64.const 17))
+(assert_return (invoke "catch-first-match" (i32.const 7)) (i32.const 7))
+(assert_return (invoke "catch-all" (i32.const 0)) (i32.const 99))
+(assert_return (invoke "catch-all" (i32.const 1)) (i32.const 99))
+(assert_return (invoke "catch-from-call" (i32.const 0)) (i32.const 1005))
+(assert_return (invoke "catch-from-call" (i32.const 3)) (i32.const 1003))
+(assert_trap (invoke "catch-trap") "unreachable")
+(assert_exception (invoke "uncaught" (i32.const 1)))
+(assert_return (invoke "catch-local" (i32.const 0)) (i32.const 0))
+(assert_return (invoke "catch-local" (i32.const 2)) (i32.const 22))
+(assert_return (invoke "catch-br" (i32.const 9)) (i32.const 9))
+(assert_return (invoke "nested" (i32.const 0)) (i32.const 100))
+(assert_return (invoke "nested" (i32.const 3)) (i32.const 203))
+(assert_return (invoke "throw-in-catch") (i32.const 5))
+(assert_return (invoke "loop-throw" (i32.const 10)) (i32.const 10))
+
+;; rethrow
+
+(module
+  (tag $e0)
+  (tag $e1 (param i32))
+
+  (func (export "rethrow-uncaught")
+    (try (do (throw $e0)) (catch $e0 (rethrow 0)))
+  )
+
+  (func (export "rethrow-catch-all") (result i32)
+    (try (result i32)
+      (do (try (do (throw $e1 (i32.const 3))) (catch_all (rethrow 0))) (i32.const 0))
+      (catch $e1)
+    )
+  )
+
+  ;; rethrow 的标签指向外层的 catch 子句
+  (func (export "rethrow-outer") (param i32) (result i32)
+    (try (result i32)
+      (do
+        (try (result i32)
+          (do (throw $e1 (local.get 0)))
+          (catch $e1
+            (drop)
+            (try (result i32)
+              (do (throw $e0))
+              (catch $e0 (rethrow 1))
+            )
+          )
+        )
+      )
+      (catch $e1 (i32.const 1000) (i32.add))
+    )
+  )
+
+  (func (export "rethrow-label") (param i32) (result i32)
+    (try $l (result i32)
+      (do (throw $e1 (local.get 0)))
+      (catch $e1
+        (if (i32.eqz (local.get 0)) (then (rethrow $l)))
+      )
+    )
+  )
+)
+
+(assert_exception (invoke "rethrow-uncaught"))
+(assert_return (invoke "rethrow-catch-all") (i32.const 3))
+(assert_return (invoke "rethrow-outer" (i32.const 7)) (i32.const 1007))
+(assert_return (invoke "rethrow-label" (i32.const 1)) (i32.const 1))
+(assert_exception (invoke "rethrow-label" (i32.const 0)))
+
+;; delegate
+
+(module
+  (tag $e0)
+  (tag $e1 (param i32))
+
+  (func (export "delegate-to-outer") (result i32)
+    (try $t (result i32)
+      (do
+        (try (result i32)
+          (do (throw $e1 (i32.const 9)))
+          (delegate $t)
+        )
+      )
+      (catch $e1)
+    )
+  )
+
+  ;; delegate 跳过中间的 try 块
+  (func (export "delegate-skip") (result i32)
+    (try $t0 (result i32)
+      (do
+        (try $t1 (result i32)
+          (do
+            (try (result i32)
+              (do (throw $e1 (i32.const 3)))
+              (delegate $t0)
+            )
+          )
+          (catch $e1 (i32.const 100) (i32.add))
+        )
+      )
+      (catch $e1 (i32.const 200) (i32.add))
+    )
+  )
+
+  (func (export "delegate-block") (result i32)
+    (try $t (result i32)
+      (do
+        (block
+          (try (do (throw $e0)) (delegate 0))
+        )
+        (i32.const 0)
+      )
+      (catch $e0 (i32.const 1))
+    )
+  )
+
+  ;; delegate 到函数的标签，异常交给调用者处理
+  (func $delegate-caller
+    (try (do (throw $e0)) (delegate 0))
+  )
+  (func (export "delegate-to-caller") (result i32)
+    (try (result i32)
+      (do (call $delegate-caller) (i32.const 0))
+      (catch $e0 (i32.const 2))
+    )
+  )
+  (func (export "delegate-uncaught")
+    (try (do (throw $e0)) (delegate 0))
+  )
+)
+
+(assert_return (invoke "delegate-to-outer") (i32.const 9))
+(assert_return (invoke "delegate-skip") (i32.const 203))
+(assert_return (invoke "delegate-block") (i32.const 1))
+(assert_return (invoke "delegate-to-caller") (i32.const 2))
+(assert_exception (invoke "delegate-uncaught"))
+
+;; 导入和导出标签，不同模块之间按标签实例匹配
+
+(module
+  (tag $e (export "e") (param i32))
+  (func (export "throw") (param i32) (throw $e (local.get 0)))
+)
+(register "M")
+
+(module
+  (tag $e (import "M" "e") (param i32))
+  (tag $local (param i32))
+  (func $throw (import "M" "throw") (param i32))
+
+  (func (export "catch-imported") (param i32) (result i32)
+    (try (result i32)
+      (do (call $throw (local.get 0)) (i32.const 0))
+      (catch $local (i32.const -1) (i32.add))
+      (catch $e)
+    )
+  )
+  (func (export "throw-imported") (param i32) (throw $e (local.get 0)))
+  (func (export "uncaught-local") (result i32)
+    (try (result i32)
+      (do (throw $local (i32.const 1)))
+      (catch $e)
+    )
+  )
+)
+
+(assert_return (invoke "catch-imported" (i32.const 5)) (i32.const 5))
+(assert_exception (invoke "throw-imported" (i32.const 1)))
+(assert_exception (invoke "uncaught-local"))
+
+(assert_unlinkable
+  (module (tag (import "M" "e") (param i64)))
+  "incompatible import type"
+)
+
+;; 平铺形式
+
+(module
+  (tag $e (param i32))
+  (func (export "flat") (param i32) (result i32)
+    try $t (result i32)
+      local.get 0
+      throw $e
+    catch $e
+      i32.const 1
+      i32.add
+    catch_all
+      i32.const 0
+    end $t
+  )
+  (func (export "flat-delegate") (result i32)
+    try (result i32)
+      try (result i32)
+        i32.const 4
+        throw $e
+      delegate 0
+    catch $e
+    end
+  )
+)
+
+(assert_return (invoke "flat" (i32.const 1)) (i32.const 2))
+(assert_return (invoke "flat-delegate") (i32.const 4))
+
+;; 验证
+
+(assert_invalid
+  (module (func (throw 0)))
+  "unknown tag"
+)
+(assert_invalid
+  (module (tag (param i32)) (func (throw 0)))
+  "type mismatch"
+)
+(assert_invalid
+  (module (tag) (func (result i32) (try (result i32) (do (i32.const 1)) (catch 0))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (tag (param i64)) (func (result i32) (try (result i32) (do (i32.const 1)) (catch 0))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (func (try (do) (catch 0))))
+  "unknown tag"
+)
+(assert_invalid
+  (module (func (rethrow 0)))
+  "invalid rethrow label"
+)
+(assert_invalid
+  (module (func (try (do (rethrow 0)) (catch_all))))
+  "invalid rethrow label"
+)
+(assert_invalid
+  (module (func (try (do) (delegate 1))))
+  "unknown label"
+)
+(assert_invalid
+  (module (type (func (result i32))) (tag (type 0)))
+  "non-empty tag result type"
+)
+(assert_invalid
+  (module (tag $e) (func (result i32) (throw $e) (f32.const 0)))
+  "type mismatch"
+)
+(assert_malformed
+  (module binary
+    "\00asm" "\01\00\00\00"
+    "\01\04\01\60\00\00"       ;; 类型段：1 个类型 [] -> []
+    "\03\02\01\00"             ;; 函数段
+    "\0d\03\01\00\00"          ;; 标签段：1 个标签
+    "\0a\0a\01"                ;; 代码段
+    "\08\00"
+    "\06\40"                   ;; try
+    "\19"                      ;; catch_all
+    "\07\00"                   ;; catch 0（位于 catch_all 之后）
+    "\0b\0b"
+  )
+  "catch after catch_all"
+)
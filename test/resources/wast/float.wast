@@ -0,0 +1,111 @@
+;; f32 and f64 operations
+
+(module
+  (func (export "f32.add") (param f32 f32) (result f32) (f32.add (local.get 0) (local.get 1)))
+  (func (export "f32.sub") (param f32 f32) (result f32) (f32.sub (local.get 0) (local.get 1)))
+  (func (export "f32.mul") (param f32 f32) (result f32) (f32.mul (local.get 0) (local.get 1)))
+  (func (export "f32.div") (param f32 f32) (result f32) (f32.div (local.get 0) (local.get 1)))
+  (func (export "f32.min") (param f32 f32) (result f32) (f32.min (local.get 0) (local.get 1)))
+  (func (export "f32.max") (param f32 f32) (result f32) (f32.max (local.get 0) (local.get 1)))
+  (func (export "f32.sqrt") (param f32) (result f32) (f32.sqrt (local.get 0)))
+  (func (export "f32.neg") (param f32) (result f32) (f32.neg (local.get 0)))
+  (func (export "f32.abs") (param f32) (result f32) (f32.abs (local.get 0)))
+  (func (export "f32.copysign") (param f32 f32) (result f32) (f32.copysign (local.get 0) (local.get 1)))
+  (func (export "f32.ceil") (param f32) (result f32) (f32.ceil (local.get 0)))
+  (func (export "f32.floor") (param f32) (result f32) (f32.floor (local.get 0)))
+  (func (export "f32.trunc") (param f32) (result f32) (f32.trunc (local.get 0)))
+  (func (export "f32.nearest") (param f32) (result f32) (f32.nearest (local.get 0)))
+  (func (export "f32.eq") (param f32 f32) (result i32) (f32.eq (local.get 0) (local.get 1)))
+  (func (export "f32.ne") (param f32 f32) (result i32) (f32.ne (local.get 0) (local.get 1)))
+  (func (export "f32.lt") (param f32 f32) (result i32) (f32.lt (local.get 0) (local.get 1)))
+
+  (func (export "f64.add") (param f64 f64) (result f64) (f64.add (local.get 0) (local.get 1)))
+  (func (export "f64.sub") (param f64 f64) (result f64) (f64.sub (local.get 0) (local.get 1)))
+  (func (export "f64.mul") (param f64 f64) (result f64) (f64.mul (local.get 0) (local.get 1)))
+  (func (export "f64.div") (param f64 f64) (result f64) (f64.div (local.get 0) (local.get 1)))
+  (func (export "f64.min") (param f64 f64) (result f64) (f64.min (local.get 0) (local.get 1)))
+  (func (export "f64.max") (param f64 f64) (result f64) (f64.max (local.get 0) (local.get 1)))
+  (func (export "f64.sqrt") (param f64) (result f64) (f64.sqrt (local.get 0)))
+  (func (export "f64.neg") (param f64) (result f64) (f64.neg (local.get 0)))
+  (func (export "f64.nearest") (param f64) (result f64) (f64.nearest (local.get 0)))
+  (func (export "f64.ge") (param f64 f64) (result i32) (f64.ge (local.get 0) (local.get 1)))
+)
+
+(assert_return (invoke "f32.add" (f32.const 1.5) (f32.const 2.25)) (f32.const 3.75))
+(assert_return (invoke "f32.add" (f32.const -0x0p+0) (f32.const 0x0p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.add" (f32.const 0x1p-149) (f32.const 0x1p-149)) (f32.const 0x1p-148))
+(assert_return (invoke "f32.add" (f32.const 0x1.fffffep+127) (f32.const 0x1.fffffep+127)) (f32.const inf))
+(assert_return (invoke "f32.add" (f32.const inf) (f32.const -inf)) (f32.const nan:canonical))
+(assert_return (invoke "f32.add" (f32.const nan) (f32.const 1)) (f32.const nan:canonical))
+(assert_return (invoke "f32.add" (f32.const nan:0x200000) (f32.const 1)) (f32.const nan:arithmetic))
+(assert_return (invoke "f32.sub" (f32.const 1) (f32.const 0.5)) (f32.const 0.5))
+(assert_return (invoke "f32.sub" (f32.const inf) (f32.const inf)) (f32.const nan:canonical))
+(assert_return (invoke "f32.mul" (f32.const -2) (f32.const 0x1.8p+1)) (f32.const -6))
+(assert_return (invoke "f32.mul" (f32.const 0) (f32.const inf)) (f32.const nan:canonical))
+(assert_return (invoke "f32.div" (f32.const 1) (f32.const 0)) (f32.const inf))
+(assert_return (invoke "f32.div" (f32.const -1) (f32.const 0)) (f32.const -inf))
+(assert_return (invoke "f32.div" (f32.const 0) (f32.const 0)) (f32.const nan:canonical))
+(assert_return (invoke "f32.div" (f32.const 1) (f32.const 3)) (f32.const 0x1.555556p-2))
+(assert_return (invoke "f32.min" (f32.const -0x0p+0) (f32.const 0x0p+0)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.min" (f32.const 0x0p+0) (f32.const -0x0p+0)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.min" (f32.const 1) (f32.const nan)) (f32.const nan:canonical))
+(assert_return (invoke "f32.max" (f32.const -0x0p+0) (f32.const 0x0p+0)) (f32.const 0x0p+0))
+(assert_return (invoke "f32.max" (f32.const -inf) (f32.const 1)) (f32.const 1))
+(assert_return (invoke "f32.max" (f32.const nan) (f32.const 1)) (f32.const nan:canonical))
+(assert_return (invoke "f32.sqrt" (f32.const 4)) (f32.const 2))
+(assert_return (invoke "f32.sqrt" (f32.const -0x0p+0)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.sqrt" (f32.const -1)) (f32.const nan:canonical))
+(assert_return (invoke "f32.neg" (f32.const 0x0p+0)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.neg" (f32.const nan:0x200000)) (f32.const -nan:0x200000))
+(assert_return (invoke "f32.abs" (f32.const -nan:0x200000)) (f32.const nan:0x200000))
+(assert_return (invoke "f32.abs" (f32.const -inf)) (f32.const inf))
+(assert_return (invoke "f32.copysign" (f32.const 1) (f32.const -0x0p+0)) (f32.const -1))
+(assert_return (invoke "f32.copysign" (f32.const -nan) (f32.const 1)) (f32.const nan))
+(assert_return (invoke "f32.ceil" (f32.const -0.5)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.ceil" (f32.const 1.5)) (f32.const 2))
+(assert_return (invoke "f32.floor" (f32.const -0.5)) (f32.const -1))
+(assert_return (invoke "f32.trunc" (f32.const -1.5)) (f32.const -1))
+(assert_return (invoke "f32.nearest" (f32.const 0.5)) (f32.const 0))
+(assert_return (invoke "f32.nearest" (f32.const 1.5)) (f32.const 2))
+(assert_return (invoke "f32.nearest" (f32.const 2.5)) (f32.const 2))
+(assert_return (invoke "f32.nearest" (f32.const -0.5)) (f32.const -0x0p+0))
+(assert_return (invoke "f32.nearest" (f32.const -3.5)) (f32.const -4))
+(assert_return (invoke "f32.eq" (f32.const nan) (f32.const nan)) (i32.const 0))
+(assert_return (invoke "f32.eq" (f32.const -0x0p+0) (f32.const 0x0p+0)) (i32.const 1))
+(assert_return (invoke "f32.ne" (f32.const nan) (f32.const nan)) (i32.const 1))
+(assert_return (invoke "f32.lt" (f32.const -inf) (f32.const inf)) (i32.const 1))
+(assert_return (invoke "f32.lt" (f32.const nan) (f32.const inf)) (i32.const 0))
+
+(assert_return (invoke "f64.add" (f64.const 0.1) (f64.const 0.2)) (f64.const 0x1.3333333333334p-2))
+(assert_return (invoke "f64.add" (f64.const 0x1p-1074) (f64.const 0x1p-1074)) (f64.const 0x1p-1073))
+(assert_return (invoke "f64.add" (f64.const inf) (f64.const -inf)) (f64.const nan:canonical))
+(assert_return (invoke "f64.add" (f64.const nan:0x4000000000000) (f64.const 1)) (f64.const nan:arithmetic))
+(assert_return (invoke "f64.sub" (f64.const 1) (f64.const 1)) (f64.const 0))
+(assert_return (invoke "f64.mul" (f64.const 0x1p+1023) (f64.const 2)) (f64.const inf))
+(assert_return (invoke "f64.mul" (f64.const -0x0p+0) (f64.const inf)) (f64.const nan:canonical))
+(assert_return (invoke "f64.div" (f64.const 1) (f64.const -0x0p+0)) (f64.const -inf))
+(assert_return (invoke "f64.div" (f64.const 1) (f64.const 3)) (f64.const 0x1.5555555555555p-2))
+(assert_return (invoke "f64.min" (f64.const -0x0p+0) (f64.const 0x0p+0)) (f64.const -0x0p+0))
+(assert_return (invoke "f64.min" (f64.const nan) (f64.const 0)) (f64.const nan:canonical))
+(assert_return (invoke "f64.max" (f64.const 0x0p+0) (f64.const -0x0p+0)) (f64.const 0x0p+0))
+(assert_return (invoke "f64.sqrt" (f64.const 2)) (f64.const 0x1.6a09e667f3bcdp+0))
+(assert_return (invoke "f64.sqrt" (f64.const -inf)) (f64.const nan:canonical))
+(assert_return (invoke "f64.neg" (f64.const -nan:0x4000000000000)) (f64.const nan:0x4000000000000))
+(assert_return (invoke "f64.nearest" (f64.const 4.5)) (f64.const 4))
+(assert_return (invoke "f64.nearest" (f64.const -4.5)) (f64.const -4))
+(assert_return (invoke "f64.nearest" (f64.const 0x1.fffffffffffffp+51)) (f64.const 0x1p+52))
+(assert_return (invoke "f64.ge" (f64.const nan) (f64.const nan)) (i32.const 0))
+(assert_return (invoke "f64.ge" (f64.const -0x0p+0) (f64.const 0x0p+0)) (i32.const 1))
+
+(assert_invalid
+  (module (func (result f32) (f32.add (f64.const 0) (f32.const 0))))
+  "type mismatch"
+)
+(assert_malformed
+  (module quote "(func (result f32) (f32.const nan:0x800000))")
+  "constant out of range"
+)
+(assert_malformed
+  (module quote "(func (result f64) (f64.const 1e309))")
+  "constant out of range"
+)
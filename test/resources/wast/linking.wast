@@ -0,0 +1,333 @@
+;; 模块之间的链接（导入和导出）
+
+;; 函数
+
+(module $Mf
+  (func (export "call") (result i32) (call $g))
+  (func $g (result i32) (i32.const 2))
+)
+(register "Mf" $Mf)
+
+(module $Nf
+  (func $f (import "Mf" "call") (result i32))
+  (export "Mf.call" (func $f))
+  (func (export "call Mf.call") (result i32) (call $f))
+  (func (export "call") (result i32) (call $g))
+  (func $g (result i32) (i32.const 3))
+)
+
+(assert_return (invoke $Mf "call") (i32.const 2))
+(assert_return (invoke $Nf "Mf.call") (i32.const 2))
+(assert_return (invoke $Nf "call") (i32.const 3))
+(assert_return (invoke $Nf "call Mf.call") (i32.const 2))
+
+(module
+  (import "spectest" "print_i32" (func $f (param i32)))
+  (export "print" (func $f))
+)
+(register "reexport_f")
+
+(module
+  (import "reexport_f" "print" (func $f (param i32)))
+  (func (export "print") (param i32) (call $f (local.get 0)))
+)
+(assert_return (invoke "print" (i32.const 42)))
+
+;; 全局变量
+
+(module $Mg
+  (global $glob (export "glob") i32 (i32.const 42))
+  (func (export "get") (result i32) (global.get $glob))
+
+  (global $mut_glob (export "mut_glob") (mut i32) (i32.const 142))
+  (func (export "get_mut") (result i32) (global.get $mut_glob))
+  (func (export "set_mut") (param i32) (global.set $mut_glob (local.get 0)))
+)
+(register "Mg" $Mg)
+
+(module $Ng
+  (global $x (import "Mg" "glob") i32)
+  (global $mut_glob (import "Mg" "mut_glob") (mut i32))
+  (func $f (import "Mg" "get") (result i32))
+  (func $get_mut (import "Mg" "get_mut") (result i32))
+  (func $set_mut (import "Mg" "set_mut") (param i32))
+
+  (export "Mg.glob" (global $x))
+  (export "Mg.get" (func $f))
+  (global $glob (export "glob") i32 (i32.const 43))
+  (func (export "get") (result i32) (global.get $glob))
+
+  (export "Mg.mut_glob" (global $mut_glob))
+  (export "Mg.get_mut" (func $get_mut))
+  (export "Mg.set_mut" (func $set_mut))
+  (func (export "get_mut_imported") (result i32) (global.get $mut_glob))
+  (func (export "set_mut_imported") (param i32) (global.set $mut_glob (local.get 0)))
+)
+
+(assert_return (get $Mg "glob") (i32.const 42))
+(assert_return (get $Ng "Mg.glob") (i32.const 42))
+(assert_return (get $Ng "glob") (i32.const 43))
+(assert_return (invoke $Mg "get") (i32.const 42))
+(assert_return (invoke $Ng "Mg.get") (i32.const 42))
+(assert_return (invoke $Ng "get") (i32.const 43))
+
+(assert_return (get $Mg "mut_glob") (i32.const 142))
+(assert_return (get $Ng "Mg.mut_glob") (i32.const 142))
+(assert_return (invoke $Mg "get_mut") (i32.const 142))
+(assert_return (invoke $Ng "Mg.get_mut") (i32.const 142))
+
+(assert_return (invoke $Mg "set_mut" (i32.const 241)))
+(assert_return (get $Mg "mut_glob") (i32.const 241))
+(assert_return (get $Ng "Mg.mut_glob") (i32.const 241))
+(assert_return (invoke $Ng "get_mut_imported") (i32.const 241))
+
+(assert_return (invoke $Ng "set_mut_imported" (i32.const 341)))
+(assert_return (invoke $Mg "get_mut") (i32.const 341))
+
+;; 内存
+
+(module $Mm
+  (memory (export "mem") 1 5)
+  (data (i32.const 10) "\00\01\02\03\04\05\06\07\08\09")
+
+  (func (export "load") (param $a i32) (result i32)
+    (i32.load8_u (local.get 0))
+  )
+)
+(register "Mm" $Mm)
+
+(module $Nm
+  (func $loadM (import "Mm" "load") (param i32) (result i32))
+
+  (memory 1)
+  (data (i32.const 10) "\f0\f1\f2\f3\f4\f5")
+
+  (export "Mm.load" (func $loadM))
+  (func (export "load") (param $a i32) (result i32)
+    (i32.load8_u (local.get 0))
+  )
+)
+
+(assert_return (invoke $Mm "load" (i32.const 12)) (i32.const 2))
+(assert_return (invoke $Nm "Mm.load" (i32.const 12)) (i32.const 2))
+(assert_return (invoke $Nm "load" (i32.const 12)) (i32.const 0xf2))
+
+(module $Om
+  (memory (import "Mm" "mem") 1)
+  (data (i32.const 5) "\a0\a1\a2\a3\a4\a5\a6\a7")
+
+  (func (export "load") (param $a i32) (result i32)
+    (i32.load8_u (local.get 0))
+  )
+)
+
+(assert_return (invoke $Mm "load" (i32.const 12)) (i32.const 0xa7))
+(assert_return (invoke $Om "load" (i32.const 12)) (i32.const 0xa7))
+
+(module $Pm
+  (memory (import "Mm" "mem") 1)
+  (func (export "grow") (param $a i32) (result i32)
+    (memory.grow (local.get 0))
+  )
+)
+
+(assert_return (invoke $Pm "grow" (i32.const 0)) (i32.const 1))
+(assert_return (invoke $Pm "grow" (i32.const 2)) (i32.const 1))
+(assert_return (invoke $Pm "grow" (i32.const 0)) (i32.const 3))
+(assert_return (invoke $Mm "load" (i32.const 0x20000)) (i32.const 0))
+
+;; 表
+
+(module $Mt
+  (type (func (result i32)))
+  (table (export "tab") 10 funcref)
+  (elem (i32.const 2) $g $g $g $g)
+  (func $g (result i32) (i32.const 4))
+  (func (export "call") (param i32) (result i32)
+    (call_indirect (type 0) (local.get 0))
+  )
+)
+(register "Mt" $Mt)
+
+(module $Nt
+  (type (func (result i32)))
+  (table (import "Mt" "tab") 10 funcref)
+  (elem (i32.const 1) $h)
+  (func $h (result i32) (i32.const -4))
+  (func (export "call") (param i32) (result i32)
+    (call_indirect (type 0) (local.get 0))
+  )
+)
+
+(assert_return (invoke $Mt "call" (i32.const 2)) (i32.const 4))
+(assert_return (invoke $Mt "call" (i32.const 1)) (i32.const -4))
+(assert_return (invoke $Nt "call" (i32.const 1)) (i32.const -4))
+(assert_return (invoke $Nt "call" (i32.const 3)) (i32.const 4))
+(assert_trap (invoke $Mt "call" (i32.const 7)) "uninitialized element")
+(assert_trap (invoke $Mt "call" (i32.const 20)) "undefined element")
+
+;; 别的模块放进表里的函数，按结构比较函数类型
+(module $Ot
+  (type (func (param i32) (result i32)))
+  (table (import "Mt" "tab") 10 funcref)
+  (elem (i32.const 8) $i)
+  (func $i (param i32) (result i32) (local.get 0))
+  (func (export "call") (param i32 i32) (result i32)
+    (call_indirect (type 0) (local.get 0) (local.get 1))
+  )
+)
+
+(assert_return (invoke $Ot "call" (i32.const 5) (i32.const 8)) (i32.const 5))
+(assert_trap (invoke $Ot "call" (i32.const 5) (i32.const 2)) "indirect call type mismatch")
+(assert_trap (invoke $Ot "call" (i32.const 5) (i32.const 7)) "uninitialized element")
+(assert_trap (invoke $Mt "call" (i32.const 8)) "indirect call type mismatch")
+
+;; spectest 模块
+
+(module
+  (import "spectest" "print" (func))
+  (import "spectest" "print_i64" (func (param i64)))
+  (import "spectest" "print_f32" (func (param f32)))
+  (import "spectest" "print_f64" (func (param f64)))
+  (import "spectest" "print_i32_f32" (func (param i32 f32)))
+  (import "spectest" "print_f64_f64" (func (param f64 f64)))
+  (import "spectest" "global_f32" (global f32))
+  (import "spectest" "global_f64" (global f64))
+  (import "spectest" "table" (table 10 funcref))
+  (import "spectest" "memory" (memory 1))
+
+  (func (export "global_f32") (result f32) (global.get 0))
+  (func (export "global_f64") (result f64) (global.get 1))
+  (func (export "size") (result i32) (memory.size))
+)
+
+(assert_return (invoke "global_f32") (f32.const 666.6))
+(assert_return (invoke "global_f64") (f64.const 666.6))
+(assert_return (invoke "size") (i32.const 1))
+
+;; 无法链接的模块
+
+(assert_unlinkable
+  (module (import "spectest" "unknown" (func)))
+  "unknown import"
+)
+(assert_unlinkable
+  (module (import "unknown" "unknown" (func)))
+  "unknown import"
+)
+(assert_unlinkable
+  (module (import "spectest" "unknown" (global i32)))
+  "unknown import"
+)
+(assert_unlinkable
+  (module (import "Mm" "unknown" (memory 1)))
+  "unknown import"
+)
+
+;; 导入项的类型不匹配
+
+(module $Mi
+  (func (export "func-i32") (param i32))
+  (global (export "global-i32") i32 (i32.const 0))
+  (global (export "global-mut-i32") (mut i32) (i32.const 0))
+  (table (export "table-10-20") 10 20 funcref)
+  (memory (export "memory-2-3") 2 3)
+)
+(register "Mi" $Mi)
+
+(module $Mi2
+  (table (export "table-10") 10 funcref)
+)
+(register "Mi2" $Mi2)
+
+(module (import "Mi" "func-i32" (func (param i32))))
+(assert_unlinkable
+  (module (import "Mi" "func-i32" (func)))
+  "incompatible import type"
+)
+(assert_unlinkable
+  (module (import "Mi" "func-i32" (func (param i32) (result i32))))
+  "incompatible import type"
+)
+(assert_unlinkable
+  (module (import "Mi" "func-i32" (func (param i64))))
+  "incompatible import type"
+)
+(assert_unlinkable
+  (module (import "Mi" "func-i32" (global i32)))
+  "incompatible import type"
+)
+(assert_unlinkable
+  (module (import "Mi" "global-i32" (func)))
+  "incompatible import type"
+)
+
+(module (import "Mi" "global-i32" (global i32)))
+(module (import "Mi" "global-mut-i32" (global (mut i32))))
+(assert_unlinkable
+  (module (import "Mi" "global-i32" (global i64)))
+  "incompatible import type"
+)
+(assert_unlinkable
+  (module (import "Mi" "global-i32" (global (mut i32))))
+  "incompatible import type"
+)
+(assert_unlinkable
+  (module (import "Mi" "global-mut-i32" (global i32)))
+  "incompatible import type"
+)
+
+(module (import "Mi" "table-10-20" (table 10 funcref)))
+(module (import "Mi" "table-10-20" (table 5 20 funcref)))
+(module (import "Mi" "table-10-20" (table 10 25 funcref)))
+(module (import "Mi2" "table-10" (table 0 funcref)))
+(assert_unlinkable
+  (module (import "Mi" "table-10-20" (table 12 funcref)))
+  "incompatible import type"
+)
+(assert_unlinkable
+  (module (import "Mi" "table-10-20" (table 10 18 funcref)))
+  "incompatible import type"
+)
+(assert_unlinkable
+  (module (import "Mi2" "table-10" (table 10 20 funcref)))
+  "incompatible import type"
+)
+(assert_unlinkable
+  (module (import "Mi2" "table-10" (memory 1)))
+  "incompatible import type"
+)
+
+(module (import "Mi" "memory-2-3" (memory 2)))
+(module (import "Mi" "memory-2-3" (memory 1 3)))
+(module (import "Mi" "memory-2-3" (memory 2 4)))
+(assert_unlinkable
+  (module (import "Mi" "memory-2-3" (memory 3)))
+  "incompatible import type"
+)
+(assert_unlinkable
+  (module (import "Mi" "memory-2-3" (memory 2 2)))
+  "incompatible import type"
+)
+(assert_unlinkable
+  (module (import "Mi" "memory-2-3" (table 1 funcref)))
+  "incompatible import type"
+)
+
+;; 内存增长之后，下限以当前的大小为准
+(module $Mg2
+  (memory (export "memory") 1)
+  (func (export "grow") (param i32) (result i32) (memory.grow (local.get 0)))
+)
+(register "Mg2" $Mg2)
+(assert_unlinkable
+  (module (import "Mg2" "memory" (memory 2)))
+  "incompatible import type"
+)
+(assert_return (invoke $Mg2 "grow" (i32.const 1)) (i32.const 1))
+(module (import "Mg2" "memory" (memory 2)))
+
+(assert_unlinkable
+  (module (import "spectest" "print_i32" (func (param i64))))
+  "incompatible import type"
+)
@@ -0,0 +1,124 @@
+;; memory, load, store, memory.size, memory.grow
+
+(module (memory 0))
+(module (memory 1))
+(module (memory 0 0))
+(module (memory 1 256))
+(module (memory 0 65536))
+(module (memory 0) (memory 0))
+
+(assert_invalid (module (data (i32.const 0))) "unknown memory")
+(assert_invalid
+  (module (func $f (drop (i32.load (i32.const 0)))))
+  "unknown memory"
+)
+(assert_invalid (module (memory 1 0)) "size minimum must not be greater than maximum")
+(assert_invalid (module (memory 65537)) "memory size must be at most 65536 pages (4GiB)")
+(assert_invalid
+  (module (memory 1) (func (drop (i64.load align=16 (i32.const 0)))))
+  "alignment must not be larger than natural"
+)
+(assert_malformed
+  (module quote "(memory 1) (func (drop (i32.load align=3 (i32.const 0))))")
+  "alignment"
+)
+
+(module
+  (memory 1)
+  (data (i32.const 0) "ABC\a7D") (data (i32.const 20) "WASM")
+
+  (func (export "data") (result i32)
+    (i32.and
+      (i32.and
+        (i32.and
+          (i32.eq (i32.load8_u (i32.const 0)) (i32.const 65))
+          (i32.eq (i32.load8_u (i32.const 3)) (i32.const 167))
+        )
+        (i32.eq (i32.load8_u (i32.const 6)) (i32.const 0))
+      )
+      (i32.eq (i32.load8_u (i32.const 23)) (i32.const 77))
+    )
+  )
+
+  (func (export "i32_load8_s") (param $i i32) (result i32)
+    (i32.store8 (i32.const 8) (local.get $i))
+    (i32.load8_s (i32.const 8))
+  )
+  (func (export "i32_load16_u") (param $i i32) (result i32)
+    (i32.store16 (i32.const 8) (local.get $i))
+    (i32.load16_u (i32.const 8))
+  )
+  (func (export "i64_load32_s") (param $i i64) (result i64)
+    (i64.store32 (i32.const 8) (local.get $i))
+    (i64.load32_s (i32.const 8))
+  )
+  (func (export "i64_load") (param $i i64) (result i64)
+    (i64.store offset=3 align=1 (i32.const 5) (local.get $i))
+    (i64.load offset=1 (i32.const 7))
+  )
+  (func (export "f64_load") (param $f f64) (result f64)
+    (f64.store (i32.const 16) (local.get $f))
+    (f64.load (i32.const 16))
+  )
+  (func (export "little_endian") (result i32)
+    (i32.store (i32.const 0) (i32.const 0x01020304))
+    (i32.load8_u (i32.const 0))
+  )
+  (func (export "load_at") (param $a i32) (result i32) (i32.load (local.get $a)))
+  (func (export "load_offset") (param $a i32) (result i32) (i32.load offset=0xfffffff0 (local.get $a)))
+  (func (export "store_at") (param $a i32) (i64.store (local.get $a) (i64.const -1)))
+
+  (func (export "size") (result i32) (memory.size))
+  (func (export "grow") (param i32) (result i32) (memory.grow (local.get 0)))
+)
+
+(assert_return (invoke "data") (i32.const 1))
+(assert_return (invoke "i32_load8_s" (i32.const -1)) (i32.const -1))
+(assert_return (invoke "i32_load8_s" (i32.const 0xfedc6543)) (i32.const 0x43))
+(assert_return (invoke "i32_load8_s" (i32.const 0x3456436f)) (i32.const 0x6f))
+(assert_return (invoke "i32_load8_s" (i32.const 0x12345680)) (i32.const -128))
+(assert_return (invoke "i32_load16_u" (i32.const -1)) (i32.const 65535))
+(assert_return (invoke "i32_load16_u" (i32.const 0xfedc6543)) (i32.const 0x6543))
+(assert_return (invoke "i64_load32_s" (i64.const 0x0000ffff80000000)) (i64.const -2147483648))
+(assert_return (invoke "i64_load" (i64.const 0x0123456789abcdef)) (i64.const 0x0123456789abcdef))
+(assert_return (invoke "f64_load" (f64.const -0x1.5p+10)) (f64.const -0x1.5p+10))
+(assert_return (invoke "f64_load" (f64.const nan:0x4000000000001)) (f64.const nan:0x4000000000001))
+(assert_return (invoke "little_endian") (i32.const 4))
+
+(assert_return (invoke "load_at" (i32.const 65532)) (i32.const 0))
+(assert_trap (invoke "load_at" (i32.const 65533)) "out of bounds memory access")
+(assert_trap (invoke "load_at" (i32.const -1)) "out of bounds memory access")
+(assert_trap (invoke "load_offset" (i32.const 0x20)) "out of bounds memory access")
+(assert_trap (invoke "store_at" (i32.const 65529)) "out of bounds memory access")
+(assert_trap (invoke "store_at" (i32.const -8)) "out of bounds memory access")
+(invoke "store_at" (i32.const 65528))
+(assert_return (invoke "load_at" (i32.const 65532)) (i32.const -1))
+
+(assert_return (invoke "size") (i32.const 1))
+(assert_return (invoke "grow" (i32.const 0)) (i32.const 1))
+(assert_return (invoke "grow" (i32.const 2)) (i32.const 1))
+(assert_return (invoke "size") (i32.const 3))
+(assert_return (invoke "load_at" (i32.const 0x2fffc)) (i32.const 0))
+(assert_trap (invoke "load_at" (i32.const 0x2fffd)) "out of bounds memory access")
+(assert_return (invoke "grow" (i32.const 0x10000)) (i32.const -1))
+(assert_return (invoke "size") (i32.const 3))
+
+(module
+  (memory 0 2)
+  (func (export "grow") (param i32) (result i32) (memory.grow (local.get 0)))
+)
+(assert_return (invoke "grow" (i32.const 1)) (i32.const 0))
+(assert_return (invoke "grow" (i32.const 2)) (i32.const -1))
+(assert_return (invoke "grow" (i32.const 1)) (i32.const 1))
+(assert_return (invoke "grow" (i32.const 1)) (i32.const -1))
+
+;; 数据段超出内存的范围时，实例化失败
+(assert_trap
+  (module (memory 1) (data (i32.const 65535) "ab"))
+  "out of bounds memory access"
+)
+(assert_trap
+  (module (memory 0) (data (i32.const 1) ""))
+  "out of bounds memory access"
+)
+(module (memory 0) (data (i32.const 0) ""))
@@ -0,0 +1,126 @@
+;; 64 位的内存（memory64 提案）
+
+(module
+  (memory i64 1 4)
+  (data (i64.const 8) "\01\02\03\04\05\06\07\08")
+
+  (func (export "size") (result i64) (memory.size))
+  (func (export "grow") (param i64) (result i64) (memory.grow (local.get 0)))
+
+  (func (export "load8") (param i64) (result i32) (i32.load8_u (local.get 0)))
+  (func (export "load64") (param i64) (result i64) (i64.load (local.get 0)))
+  (func (export "load-offset") (param i64) (result i32) (i32.load offset=0x10000 (local.get 0)))
+  (func (export "load-big-offset") (param i64) (result i32) (i32.load8_u offset=0xffffffffffffff00 (local.get 0)))
+  (func (export "store") (param i64 i32) (i32.store (local.get 0) (local.get 1)))
+
+  (func (export "fill") (param i64 i32 i64) (memory.fill (local.get 0) (local.get 1) (local.get 2)))
+  (func (export "copy") (param i64 i64 i64) (memory.copy (local.get 0) (local.get 1) (local.get 2)))
+
+  (func (export "v128.load") (param i64) (result i64)
+    (i64x2.extract_lane 0 (v128.load (local.get 0))))
+  (func (export "atomic.add") (param i64 i32) (result i32)
+    (i32.atomic.rmw.add (local.get 0) (local.get 1)))
+)
+
+(assert_return (invoke "size") (i64.const 1))
+(assert_return (invoke "load8" (i64.const 8)) (i32.const 1))
+(assert_return (invoke "load64" (i64.const 8)) (i64.const 0x0807060504030201))
+(assert_return (invoke "v128.load" (i64.const 8)) (i64.const 0x0807060504030201))
+
+;; 地址和偏移值都是 u64，相加溢出时视为越界
+(assert_trap (invoke "load8" (i64.const 0x10000)) "out of bounds memory access")
+(assert_trap (invoke "load8" (i64.const -1)) "out of bounds memory access")
+(assert_trap (invoke "load64" (i64.const 0xfff9)) "out of bounds memory access")
+(assert_trap (invoke "load-offset" (i64.const 0)) "out of bounds memory access")
+(assert_trap (invoke "load-big-offset" (i64.const 0x100)) "out of bounds memory access")
+(assert_trap (invoke "load-big-offset" (i64.const 0x200)) "out of bounds memory access")
+
+;; 扩充内存
+(assert_return (invoke "grow" (i64.const 1)) (i64.const 1))
+(assert_return (invoke "size") (i64.const 2))
+(assert_return (invoke "store" (i64.const 0x10000) (i32.const 42)))
+(assert_return (invoke "load-offset" (i64.const 0)) (i32.const 42))
+(assert_return (invoke "grow" (i64.const 3)) (i64.const -1))
+(assert_return (invoke "grow" (i64.const 0x1000000000000)) (i64.const -1))
+(assert_return (invoke "grow" (i64.const -1)) (i64.const -1))
+(assert_return (invoke "grow" (i64.const 2)) (i64.const 2))
+(assert_return (invoke "size") (i64.const 4))
+
+;; 批量内存指令
+(assert_return (invoke "fill" (i64.const 0x100) (i32.const 0xaa) (i64.const 4)))
+(assert_return (invoke "load8" (i64.const 0x103)) (i32.const 0xaa))
+(assert_return (invoke "copy" (i64.const 0x200) (i64.const 8) (i64.const 8)))
+(assert_return (invoke "load64" (i64.const 0x200)) (i64.const 0x0807060504030201))
+(assert_trap (invoke "fill" (i64.const 0x40000) (i32.const 0) (i64.const 1)) "out of bounds memory access")
+(assert_trap (invoke "copy" (i64.const 0) (i64.const 1) (i64.const -1)) "out of bounds memory access")
+
+;; 原子指令
+(assert_return (invoke "atomic.add" (i64.const 0x300) (i32.const 5)) (i32.const 0))
+(assert_return (invoke "atomic.add" (i64.const 0x300) (i32.const 5)) (i32.const 5))
+
+;; 内联的数据，偏移值为 i64
+(module
+  (memory i64 (data "abc"))
+  (func (export "size") (result i64) (memory.size))
+  (func (export "load") (param i64) (result i32) (i32.load8_u (local.get 0)))
+)
+(assert_return (invoke "size") (i64.const 1))
+(assert_return (invoke "load" (i64.const 2)) (i32.const 0x63))
+
+;; 在 32 位和 64 位的内存之间复制
+(module
+  (memory $m32 1)
+  (memory $m64 i64 1)
+  (func (export "copy-64-to-32") (param i32 i64 i32) (memory.copy $m32 $m64 (local.get 0) (local.get 1) (local.get 2)))
+  (func (export "store64") (param i64 i32) (i32.store8 $m64 (local.get 0) (local.get 1)))
+  (func (export "load32") (param i32) (result i32) (i32.load8_u $m32 (local.get 0)))
+)
+(assert_return (invoke "store64" (i64.const 5) (i32.const 7)))
+(assert_return (invoke "copy-64-to-32" (i32.const 100) (i64.const 5) (i32.const 1)))
+(assert_return (invoke "load32" (i32.const 100)) (i32.const 7))
+
+;; 导入和导出 64 位的内存
+(module $mem64
+  (memory (export "memory") i64 1)
+  (func (export "store") (param i64 i32) (i32.store8 (local.get 0) (local.get 1)))
+)
+(register "mem64" $mem64)
+
+(module
+  (import "mem64" "memory" (memory i64 1))
+  (func (export "load") (param i64) (result i32) (i32.load8_u (local.get 0)))
+)
+(assert_return (invoke $mem64 "store" (i64.const 9) (i32.const 99)))
+(assert_return (invoke "load" (i64.const 9)) (i32.const 99))
+
+;; 二进制格式：limits 的 tag 的第 2 位表示 64 位，限制值是 u64
+(module binary
+  "\00asm" "\01\00\00\00"
+  "\05\04\01\05\01\02"              ;; 内存段：(memory i64 1 2)
+)
+
+;; 验证
+(assert_invalid
+  (module (memory i64 1) (func (drop (i32.load (i32.const 0)))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (memory i64 1) (func (result i32) (memory.size)))
+  "type mismatch"
+)
+(assert_invalid
+  (module (memory 1) (func (drop (i32.load (i64.const 0)))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (memory i64 1) (data (i32.const 0) ""))
+  "type mismatch"
+)
+(assert_malformed
+  (module quote "(memory 1) (func (drop (i32.load offset=0x100000000 (i32.const 0))))")
+  "i32 constant"
+)
+(assert_invalid
+  (module (memory i64 0x1000000000001))
+  "memory size must be at most 281474976710656 pages (16EiB)"
+)
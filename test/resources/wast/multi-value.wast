@@ -0,0 +1,168 @@
+;; 多返回值以及带参数的结构块
+
+(module
+  (type $pair (func (param i32 i32) (result i32 i32)))
+  (type $to-pair (func (param i32) (result i32 i32)))
+  (type $mixed (func (result i32 i64 f32 f64)))
+
+  ;; 函数
+
+  (func $swap (export "swap") (param i32 i32) (result i32 i32)
+    (local.get 1) (local.get 0)
+  )
+  (func (export "mixed") (result i32 i64 f32 f64)
+    (i32.const 1) (i64.const -2) (f32.const 3.5) (f64.const -4.25)
+  )
+  (func (export "call-swap") (param i32 i32) (result i32)
+    (i32.sub (call $swap (local.get 0) (local.get 1)))
+  )
+  (func (export "return-pair") (param i32) (result i32 i32)
+    (block
+      (br_if 0 (local.get 0))
+      (return (i32.const 1) (i32.const 2))
+    )
+    (i32.const 3) (i32.const 4)
+  )
+  (func (export "call-indirect-swap") (param i32 i32) (result i32 i32)
+    (call_indirect (type $pair) (local.get 0) (local.get 1) (i32.const 0))
+  )
+  (table funcref (elem $swap))
+
+  ;; block
+
+  (func (export "block-params") (param i32 i32) (result i32)
+    (local.get 0) (local.get 1)
+    (block (param i32 i32) (result i32)
+      (i32.sub)
+    )
+  )
+  (func (export "block-type-idx") (param i32 i32) (result i32 i32)
+    (local.get 0) (local.get 1)
+    (block (type $pair)
+      (i32.add (local.get 0) (local.get 1)) (drop)
+    )
+  )
+  (func (export "block-results") (result i32 i32 i32)
+    (block (result i32 i32 i32)
+      (i32.const 1) (i32.const 2) (i32.const 3)
+    )
+  )
+  (func (export "block-br") (param i32) (result i32 i64)
+    (block (result i32 i64)
+      (i32.const 10) (i64.const 20)
+      (br_if 0 (local.get 0))
+      (drop) (drop)
+      (i32.const 30) (i64.const 40)
+    )
+  )
+  (func (export "block-br-residue") (result i32 i32)
+    (i32.const 99)
+    (block (param i32) (result i32 i32)
+      (drop)
+      (i32.const 7) (i32.const 8) (i32.const 9)
+      (br 0)
+    )
+  )
+  (func (export "br-table") (param i32) (result i32 i32)
+    (block (result i32 i32)
+      (block (result i32 i32)
+        (i32.const 1) (i32.const 2)
+        (br_table 0 1 (local.get 0))
+      )
+      (i32.add) (i32.const 0)
+    )
+  )
+
+  ;; loop
+
+  ;; 计算 1 + 2 + ... + n，循环的参数为 (counter, sum)
+  (func (export "loop-sum") (param i32) (result i32)
+    (local $c i32) (local $s i32)
+    (local.get 0) (i32.const 0)
+    (loop $l (param i32 i32) (result i32)
+      (local.set $s) (local.set $c)
+      (i32.sub (local.get $c) (i32.const 1))
+      (i32.add (local.get $s) (local.get $c))
+      (br_if $l (i32.gt_u (local.get $c) (i32.const 1)))
+      (local.set $s) (drop) (local.get $s)
+    )
+  )
+  (func (export "loop-type-idx") (param i32) (result i32 i32)
+    (local.get 0)
+    (loop (type $to-pair)
+      (i32.const 1)
+    )
+  )
+
+  ;; if
+
+  (func (export "if-params") (param i32 i32 i32) (result i32)
+    (local.get 0) (local.get 1)
+    (if (param i32 i32) (result i32) (local.get 2)
+      (then (i32.add))
+      (else (i32.sub))
+    )
+  )
+  (func (export "if-results") (param i32) (result i32 f64)
+    (if (result i32 f64) (local.get 0)
+      (then (i32.const 1) (f64.const 1.5))
+      (else (i32.const 2) (f64.const 2.5))
+    )
+  )
+  (func (export "if-type-idx") (param i32) (result i32 i32)
+    (local.get 0) (local.get 0)
+    (if (type $pair) (local.get 0)
+      (then (i32.mul) (i32.const 1))
+    )
+  )
+)
+
+(assert_return (invoke "swap" (i32.const 1) (i32.const 2)) (i32.const 2) (i32.const 1))
+(assert_return (invoke "mixed")
+  (i32.const 1) (i64.const -2) (f32.const 3.5) (f64.const -4.25)
+)
+(assert_return (invoke "call-swap" (i32.const 10) (i32.const 3)) (i32.const -7))
+(assert_return (invoke "return-pair" (i32.const 0)) (i32.const 1) (i32.const 2))
+(assert_return (invoke "return-pair" (i32.const 1)) (i32.const 3) (i32.const 4))
+(assert_return (invoke "call-indirect-swap" (i32.const 5) (i32.const 6)) (i32.const 6) (i32.const 5))
+
+(assert_return (invoke "block-params" (i32.const 10) (i32.const 3)) (i32.const 7))
+(assert_return (invoke "block-type-idx" (i32.const 10) (i32.const 3)) (i32.const 10) (i32.const 3))
+(assert_return (invoke "block-results") (i32.const 1) (i32.const 2) (i32.const 3))
+(assert_return (invoke "block-br" (i32.const 1)) (i32.const 10) (i64.const 20))
+(assert_return (invoke "block-br" (i32.const 0)) (i32.const 30) (i64.const 40))
+(assert_return (invoke "block-br-residue") (i32.const 8) (i32.const 9))
+(assert_return (invoke "br-table" (i32.const 0)) (i32.const 3) (i32.const 0))
+(assert_return (invoke "br-table" (i32.const 1)) (i32.const 1) (i32.const 2))
+(assert_return (invoke "br-table" (i32.const 5)) (i32.const 1) (i32.const 2))
+
+(assert_return (invoke "loop-sum" (i32.const 10)) (i32.const 55))
+(assert_return (invoke "loop-sum" (i32.const 1)) (i32.const 1))
+(assert_return (invoke "loop-type-idx" (i32.const 5)) (i32.const 5) (i32.const 1))
+
+(assert_return (invoke "if-params" (i32.const 10) (i32.const 3) (i32.const 1)) (i32.const 13))
+(assert_return (invoke "if-params" (i32.const 10) (i32.const 3) (i32.const 0)) (i32.const 7))
+(assert_return (invoke "if-results" (i32.const 1)) (i32.const 1) (f64.const 1.5))
+(assert_return (invoke "if-results" (i32.const 0)) (i32.const 2) (f64.const 2.5))
+(assert_return (invoke "if-type-idx" (i32.const 3)) (i32.const 9) (i32.const 1))
+(assert_return (invoke "if-type-idx" (i32.const 0)) (i32.const 0) (i32.const 0))
+
+(assert_invalid
+  (module (func (result i32 i32) (i32.const 1)))
+  "type mismatch"
+)
+(assert_invalid
+  (module (func (result i32) (block (param i32) (result i32) (i32.const 1))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (func (result i32 i32) (i32.const 0) (block (param i32) (result i32 i32) (br 0))))
+  "type mismatch"
+)
+(assert_invalid
+  (module
+    (type (func (param i32) (result i32)))
+    (func (result i32) (i32.const 0) (if (type 0) (i32.const 1) (then (drop))))
+  )
+  "type mismatch"
+)
@@ -0,0 +1,151 @@
+;; multi memory：多个内存块，以及内存指令里的内存索引
+
+(module
+  (memory $m0 1)
+  (memory $m1 1 2)
+  (data (memory $m1) (i32.const 0) "\01\02\03\04")
+  (data $d "\aa\bb")
+
+  (func (export "load0") (param i32) (result i32)
+    (i32.load8_u $m0 (local.get 0))
+  )
+  (func (export "load1") (param i32) (result i32)
+    (i32.load8_u $m1 (local.get 0))
+  )
+  (func (export "load1-offset") (param i32) (result i32)
+    (i32.load16_u $m1 offset=1 (local.get 0))
+  )
+  (func (export "store1") (param i32 i32)
+    (i32.store8 1 (local.get 0) (local.get 1))
+  )
+  (func (export "size0") (result i32)
+    (memory.size $m0)
+  )
+  (func (export "size1") (result i32)
+    (memory.size $m1)
+  )
+  (func (export "grow1") (param i32) (result i32)
+    (memory.grow $m1 (local.get 0))
+  )
+  (func (export "copy-1-to-0") (param i32 i32 i32)
+    (memory.copy $m0 $m1 (local.get 0) (local.get 1) (local.get 2))
+  )
+  (func (export "fill1") (param i32 i32 i32)
+    (memory.fill $m1 (local.get 0) (local.get 1) (local.get 2))
+  )
+  (func (export "init1") (param i32 i32 i32)
+    (memory.init $m1 $d (local.get 0) (local.get 1) (local.get 2))
+  )
+)
+
+(assert_return (invoke "load0" (i32.const 0)) (i32.const 0))
+(assert_return (invoke "load1" (i32.const 0)) (i32.const 1))
+(assert_return (invoke "load1" (i32.const 3)) (i32.const 4))
+(assert_return (invoke "load1-offset" (i32.const 0)) (i32.const 0x0302))
+(assert_return (invoke "store1" (i32.const 4) (i32.const 5)))
+(assert_return (invoke "load1" (i32.const 4)) (i32.const 5))
+(assert_return (invoke "load0" (i32.const 4)) (i32.const 0))
+
+(assert_return (invoke "copy-1-to-0" (i32.const 10) (i32.const 1) (i32.const 3)))
+(assert_return (invoke "load0" (i32.const 10)) (i32.const 2))
+(assert_return (invoke "load0" (i32.const 12)) (i32.const 4))
+(assert_return (invoke "load1" (i32.const 10)) (i32.const 0))
+
+(assert_return (invoke "fill1" (i32.const 20) (i32.const 7) (i32.const 2)))
+(assert_return (invoke "load1" (i32.const 21)) (i32.const 7))
+(assert_return (invoke "load0" (i32.const 21)) (i32.const 0))
+
+(assert_return (invoke "init1" (i32.const 30) (i32.const 0) (i32.const 2)))
+(assert_return (invoke "load1" (i32.const 31)) (i32.const 0xbb))
+(assert_return (invoke "load0" (i32.const 31)) (i32.const 0))
+
+(assert_return (invoke "grow1" (i32.const 1)) (i32.const 1))
+(assert_return (invoke "grow1" (i32.const 1)) (i32.const -1))
+(assert_return (invoke "size0") (i32.const 1))
+(assert_return (invoke "size1") (i32.const 2))
+(assert_return (invoke "load1" (i32.const 0x1ffff)) (i32.const 0))
+(assert_trap (invoke "load0" (i32.const 0x10000)) "out of bounds memory access")
+(assert_trap (invoke "copy-1-to-0" (i32.const 0) (i32.const 0x10000) (i32.const 0x10001)) "out of bounds memory access")
+
+;; 导出和导入多个内存块
+
+(module $mems
+  (memory (export "a") 1)
+  (memory (export "b") 1)
+  (func (export "store-b") (param i32 i32)
+    (i32.store8 1 (local.get 0) (local.get 1))
+  )
+)
+(register "mems" $mems)
+
+(module
+  (import "mems" "b" (memory $b 1))
+  (import "mems" "a" (memory $a 1))
+  (func (export "load-a") (param i32) (result i32)
+    (i32.load8_u $a (local.get 0))
+  )
+  (func (export "load-b") (param i32) (result i32)
+    (i32.load8_u $b (local.get 0))
+  )
+)
+
+(assert_return (invoke $mems "store-b" (i32.const 8) (i32.const 9)))
+(assert_return (invoke "load-b" (i32.const 8)) (i32.const 9))
+(assert_return (invoke "load-a" (i32.const 8)) (i32.const 0))
+
+(assert_invalid
+  (module (memory 1) (func (drop (i32.load 1 (i32.const 0)))))
+  "unknown memory"
+)
+(assert_invalid
+  (module (memory 1) (func (memory.copy 0 1 (i32.const 0) (i32.const 0) (i32.const 0))))
+  "unknown memory"
+)
+(assert_invalid
+  (module (memory 1) (data (memory 1) (i32.const 0) ""))
+  "unknown memory"
+)
+
+;; 多张表
+
+(module
+  (type $t (func (result i32)))
+  (table $t0 1 funcref)
+  (table $t1 2 funcref)
+  (func $one (result i32) (i32.const 1))
+  (func $two (result i32) (i32.const 2))
+  (elem (table $t0) (i32.const 0) func $one)
+  (elem (table $t1) (i32.const 1) func $two)
+  (elem $p func $one $two)
+
+  (func (export "call0") (param i32) (result i32)
+    (call_indirect $t0 (type $t) (local.get 0))
+  )
+  (func (export "call1") (param i32) (result i32)
+    (call_indirect $t1 (type $t) (local.get 0))
+  )
+  (func (export "copy-0-to-1") (param i32 i32 i32)
+    (table.copy $t1 $t0 (local.get 0) (local.get 1) (local.get 2))
+  )
+  (func (export "init1") (param i32 i32 i32)
+    (table.init $t1 $p (local.get 0) (local.get 1) (local.get 2))
+  )
+  (func (export "size1") (result i32)
+    (table.size $t1)
+  )
+)
+
+(assert_return (invoke "call0" (i32.const 0)) (i32.const 1))
+(assert_return (invoke "call1" (i32.const 1)) (i32.const 2))
+(assert_trap (invoke "call1" (i32.const 0)) "uninitialized element")
+(assert_trap (invoke "call0" (i32.const 1)) "out of bounds table access")
+(assert_return (invoke "copy-0-to-1" (i32.const 0) (i32.const 0) (i32.const 1)))
+(assert_return (invoke "call1" (i32.const 0)) (i32.const 1))
+(assert_return (invoke "init1" (i32.const 0) (i32.const 1) (i32.const 1)))
+(assert_return (invoke "call1" (i32.const 0)) (i32.const 2))
+(assert_return (invoke "size1") (i32.const 2))
+
+(assert_invalid
+  (module (table 1 funcref) (type (func)) (func (call_indirect 1 (type 0) (i32.const 0))))
+  "unknown table"
+)
@@ -0,0 +1,168 @@
+;; reference types：ref.null, ref.is_null, ref.func, typed select
+
+(module
+  (type $t (func (result i32)))
+  (func $one (result i32) (i32.const 1))
+  (func $two (result i32) (i32.const 2))
+  (elem declare func $one)
+
+  (global $gf (mut funcref) (ref.func $two))
+  (global $ge (mut externref) (ref.null extern))
+
+  (func (export "is_null-func") (param funcref) (result i32)
+    (ref.is_null (local.get 0))
+  )
+  (func (export "is_null-extern") (param externref) (result i32)
+    (ref.is_null (local.get 0))
+  )
+  (func (export "id-extern") (param externref) (result externref)
+    (local.get 0)
+  )
+  (func (export "null-func") (result funcref)
+    (ref.null func)
+  )
+  (func (export "ref-func") (result funcref)
+    (ref.func $one)
+  )
+  (func (export "get-global-func") (result funcref)
+    (global.get $gf)
+  )
+  (func (export "set-global-extern") (param externref)
+    (global.set $ge (local.get 0))
+  )
+  (func (export "get-global-extern") (result externref)
+    (global.get $ge)
+  )
+  (func (export "select-extern") (param externref externref i32) (result externref)
+    (select (result externref) (local.get 0) (local.get 1) (local.get 2))
+  )
+  (func (export "block-extern") (param externref) (result externref)
+    (block (result externref) (local.get 0))
+  )
+)
+
+(assert_return (invoke "is_null-func" (ref.null func)) (i32.const 1))
+(assert_return (invoke "is_null-extern" (ref.null extern)) (i32.const 1))
+(assert_return (invoke "is_null-extern" (ref.extern 1)) (i32.const 0))
+(assert_return (invoke "id-extern" (ref.extern 7)) (ref.extern 7))
+(assert_return (invoke "id-extern" (ref.null extern)) (ref.null extern))
+(assert_return (invoke "null-func") (ref.null func))
+(assert_return (invoke "ref-func") (ref.func))
+(assert_return (invoke "get-global-func") (ref.func))
+(assert_return (invoke "get-global-extern") (ref.null extern))
+(assert_return (invoke "set-global-extern" (ref.extern 3)))
+(assert_return (invoke "get-global-extern") (ref.extern 3))
+(assert_return (invoke "select-extern" (ref.extern 1) (ref.extern 2) (i32.const 1)) (ref.extern 1))
+(assert_return (invoke "select-extern" (ref.extern 1) (ref.extern 2) (i32.const 0)) (ref.extern 2))
+(assert_return (invoke "block-extern" (ref.extern 5)) (ref.extern 5))
+
+(assert_invalid
+  (module (func $f) (func (drop (ref.func $f))))
+  "undeclared function reference"
+)
+(assert_invalid
+  (module (func (param i32) (result i32) (ref.is_null (local.get 0))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (func (param externref) (result externref)
+    (select (local.get 0) (local.get 0) (i32.const 1))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (func (result i32)
+    (select (result i32 i32) (i32.const 0) (i32.const 0) (i32.const 1))))
+  "invalid result arity"
+)
+(assert_invalid
+  (module (global funcref (ref.null extern)))
+  "type mismatch"
+)
+
+;; table.get, table.set, table.size, table.grow, table.fill
+
+(module
+  (type $t (func (result i32)))
+  (table 2 funcref)
+  (func $one (result i32) (i32.const 1))
+  (func $two (result i32) (i32.const 2))
+  (elem (i32.const 0) funcref (ref.func $one) (ref.null func))
+  (elem declare funcref (ref.func $two))
+
+  (func (export "get") (param i32) (result funcref)
+    (table.get (local.get 0))
+  )
+  (func (export "set") (param i32)
+    (table.set (local.get 0) (ref.func $two))
+  )
+  (func (export "clear") (param i32)
+    (table.set (local.get 0) (ref.null func))
+  )
+  (func (export "call") (param i32) (result i32)
+    (call_indirect (type $t) (local.get 0))
+  )
+)
+
+(assert_return (invoke "get" (i32.const 0)) (ref.func))
+(assert_return (invoke "get" (i32.const 1)) (ref.null func))
+(assert_trap (invoke "get" (i32.const 2)) "out of bounds table access")
+(assert_return (invoke "call" (i32.const 0)) (i32.const 1))
+(assert_trap (invoke "call" (i32.const 1)) "uninitialized element")
+(assert_return (invoke "set" (i32.const 1)))
+(assert_return (invoke "call" (i32.const 1)) (i32.const 2))
+(assert_return (invoke "clear" (i32.const 0)))
+(assert_trap (invoke "call" (i32.const 0)) "uninitialized element")
+(assert_trap (invoke "set" (i32.const 2)) "out of bounds table access")
+
+(module
+  (table $t 1 4 externref)
+  (func (export "get") (param i32) (result externref)
+    (table.get $t (local.get 0))
+  )
+  (func (export "set") (param i32 externref)
+    (table.set $t (local.get 0) (local.get 1))
+  )
+  (func (export "size") (result i32)
+    (table.size $t)
+  )
+  (func (export "grow") (param externref i32) (result i32)
+    (table.grow $t (local.get 0) (local.get 1))
+  )
+  (func (export "fill") (param i32 externref i32)
+    (table.fill $t (local.get 0) (local.get 1) (local.get 2))
+  )
+)
+
+(assert_return (invoke "size") (i32.const 1))
+(assert_return (invoke "get" (i32.const 0)) (ref.null extern))
+(assert_return (invoke "set" (i32.const 0) (ref.extern 1)))
+(assert_return (invoke "get" (i32.const 0)) (ref.extern 1))
+(assert_return (invoke "grow" (ref.extern 2) (i32.const 2)) (i32.const 1))
+(assert_return (invoke "size") (i32.const 3))
+(assert_return (invoke "get" (i32.const 2)) (ref.extern 2))
+(assert_return (invoke "grow" (ref.null extern) (i32.const 2)) (i32.const -1))
+(assert_return (invoke "grow" (ref.null extern) (i32.const 0)) (i32.const 3))
+(assert_return (invoke "fill" (i32.const 1) (ref.extern 3) (i32.const 2)))
+(assert_return (invoke "get" (i32.const 0)) (ref.extern 1))
+(assert_return (invoke "get" (i32.const 1)) (ref.extern 3))
+(assert_return (invoke "get" (i32.const 2)) (ref.extern 3))
+(assert_return (invoke "fill" (i32.const 3) (ref.null extern) (i32.const 0)))
+(assert_trap (invoke "fill" (i32.const 2) (ref.null extern) (i32.const 2)) "out of bounds table access")
+(assert_return (invoke "get" (i32.const 2)) (ref.extern 3))
+
+(assert_invalid
+  (module (table 1 externref) (type (func)) (func (call_indirect (type 0) (i32.const 0))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (table 1 externref) (func $f) (elem (i32.const 0) $f))
+  "type mismatch"
+)
+(assert_invalid
+  (module (table 1 funcref) (func (param externref) (table.set (i32.const 0) (local.get 0))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (table 1 funcref) (elem externref) (func (table.init 0 (i32.const 0) (i32.const 0) (i32.const 0))))
+  "type mismatch"
+)
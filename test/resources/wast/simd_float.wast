@@ -0,0 +1,208 @@
+;; SIMD 浮点数运算：算术、min/max、取整、比较以及类型转换
+
+(module
+  (func (export "f32x4.add") (param v128 v128) (result v128) (f32x4.add (local.get 0) (local.get 1)))
+  (func (export "f32x4.sub") (param v128 v128) (result v128) (f32x4.sub (local.get 0) (local.get 1)))
+  (func (export "f32x4.mul") (param v128 v128) (result v128) (f32x4.mul (local.get 0) (local.get 1)))
+  (func (export "f32x4.div") (param v128 v128) (result v128) (f32x4.div (local.get 0) (local.get 1)))
+  (func (export "f32x4.min") (param v128 v128) (result v128) (f32x4.min (local.get 0) (local.get 1)))
+  (func (export "f32x4.max") (param v128 v128) (result v128) (f32x4.max (local.get 0) (local.get 1)))
+  (func (export "f32x4.pmin") (param v128 v128) (result v128) (f32x4.pmin (local.get 0) (local.get 1)))
+  (func (export "f32x4.pmax") (param v128 v128) (result v128) (f32x4.pmax (local.get 0) (local.get 1)))
+  (func (export "f32x4.abs") (param v128) (result v128) (f32x4.abs (local.get 0)))
+  (func (export "f32x4.neg") (param v128) (result v128) (f32x4.neg (local.get 0)))
+  (func (export "f32x4.sqrt") (param v128) (result v128) (f32x4.sqrt (local.get 0)))
+  (func (export "f32x4.ceil") (param v128) (result v128) (f32x4.ceil (local.get 0)))
+  (func (export "f32x4.floor") (param v128) (result v128) (f32x4.floor (local.get 0)))
+  (func (export "f32x4.trunc") (param v128) (result v128) (f32x4.trunc (local.get 0)))
+  (func (export "f32x4.nearest") (param v128) (result v128) (f32x4.nearest (local.get 0)))
+
+  (func (export "f64x2.add") (param v128 v128) (result v128) (f64x2.add (local.get 0) (local.get 1)))
+  (func (export "f64x2.mul") (param v128 v128) (result v128) (f64x2.mul (local.get 0) (local.get 1)))
+  (func (export "f64x2.div") (param v128 v128) (result v128) (f64x2.div (local.get 0) (local.get 1)))
+  (func (export "f64x2.min") (param v128 v128) (result v128) (f64x2.min (local.get 0) (local.get 1)))
+  (func (export "f64x2.max") (param v128 v128) (result v128) (f64x2.max (local.get 0) (local.get 1)))
+  (func (export "f64x2.pmin") (param v128 v128) (result v128) (f64x2.pmin (local.get 0) (local.get 1)))
+  (func (export "f64x2.neg") (param v128) (result v128) (f64x2.neg (local.get 0)))
+  (func (export "f64x2.sqrt") (param v128) (result v128) (f64x2.sqrt (local.get 0)))
+  (func (export "f64x2.nearest") (param v128) (result v128) (f64x2.nearest (local.get 0)))
+)
+
+(assert_return (invoke "f32x4.add"
+  (v128.const f32x4 1.5 -2.25 0x1p127 0.1)
+  (v128.const f32x4 2.5 2.25 0x1p127 0.2))
+  (v128.const f32x4 4.0 0.0 inf 0x1.3333342p-2))
+(assert_return (invoke "f32x4.sub"
+  (v128.const f32x4 1.5 -0.0 inf inf)
+  (v128.const f32x4 2.5 0.0 1.0 inf))
+  (v128.const f32x4 -1.0 -0.0 inf nan:canonical))
+(assert_return (invoke "f32x4.mul"
+  (v128.const f32x4 3.0 -0.0 0x1p100 nan)
+  (v128.const f32x4 -0.5 1.0 0x1p100 1.0))
+  (v128.const f32x4 -1.5 -0.0 inf nan:arithmetic))
+(assert_return (invoke "f32x4.div"
+  (v128.const f32x4 1.0 -1.0 0.0 1.0)
+  (v128.const f32x4 3.0 0.0 0.0 -inf))
+  (v128.const f32x4 0x1.555556p-2 -inf nan:canonical -0.0))
+(assert_return (invoke "f32x4.min"
+  (v128.const f32x4 0.0 -0.0 nan 1.0)
+  (v128.const f32x4 -0.0 0.0 1.0 2.0))
+  (v128.const f32x4 -0.0 -0.0 nan:canonical 1.0))
+(assert_return (invoke "f32x4.max"
+  (v128.const f32x4 0.0 -0.0 1.0 -inf)
+  (v128.const f32x4 -0.0 0.0 nan -1.0))
+  (v128.const f32x4 0.0 0.0 nan:canonical -1.0))
+(assert_return (invoke "f32x4.pmin"
+  (v128.const f32x4 0.0 -0.0 nan 1.0)
+  (v128.const f32x4 -0.0 0.0 1.0 nan))
+  (v128.const f32x4 0.0 -0.0 nan 1.0))
+(assert_return (invoke "f32x4.pmax"
+  (v128.const f32x4 0.0 -0.0 nan 1.0)
+  (v128.const f32x4 -0.0 0.0 1.0 2.0))
+  (v128.const f32x4 0.0 -0.0 nan 2.0))
+(assert_return (invoke "f32x4.abs"
+  (v128.const f32x4 -1.0 -0.0 -nan:0x200000 inf))
+  (v128.const f32x4 1.0 0.0 nan:0x200000 inf))
+(assert_return (invoke "f32x4.neg"
+  (v128.const f32x4 -1.0 0.0 nan:0x200000 inf))
+  (v128.const f32x4 1.0 -0.0 -nan:0x200000 -inf))
+(assert_return (invoke "f32x4.sqrt"
+  (v128.const f32x4 4.0 2.0 -0.0 -1.0))
+  (v128.const f32x4 2.0 0x1.6a09e6p+0 -0.0 nan:canonical))
+(assert_return (invoke "f32x4.ceil"
+  (v128.const f32x4 1.1 -1.9 -0.5 0x1p30))
+  (v128.const f32x4 2.0 -1.0 -0.0 0x1p30))
+(assert_return (invoke "f32x4.floor"
+  (v128.const f32x4 1.9 -1.1 0.5 -0.0))
+  (v128.const f32x4 1.0 -2.0 0.0 -0.0))
+(assert_return (invoke "f32x4.trunc"
+  (v128.const f32x4 1.9 -1.9 -0.5 inf))
+  (v128.const f32x4 1.0 -1.0 -0.0 inf))
+(assert_return (invoke "f32x4.nearest"
+  (v128.const f32x4 0.5 1.5 2.5 -0.5))
+  (v128.const f32x4 0.0 2.0 2.0 -0.0))
+
+(assert_return (invoke "f64x2.add"
+  (v128.const f64x2 0.1 0x1.fffffffffffffp1023)
+  (v128.const f64x2 0.2 0x1.fffffffffffffp1023))
+  (v128.const f64x2 0x1.3333333333334p-2 inf))
+(assert_return (invoke "f64x2.mul"
+  (v128.const f64x2 -0.0 inf)
+  (v128.const f64x2 5.0 0.0))
+  (v128.const f64x2 -0.0 nan:canonical))
+(assert_return (invoke "f64x2.div"
+  (v128.const f64x2 1.0 -2.0)
+  (v128.const f64x2 3.0 -0.0))
+  (v128.const f64x2 0x1.5555555555555p-2 inf))
+(assert_return (invoke "f64x2.min"
+  (v128.const f64x2 0.0 nan)
+  (v128.const f64x2 -0.0 1.0))
+  (v128.const f64x2 -0.0 nan:canonical))
+(assert_return (invoke "f64x2.max"
+  (v128.const f64x2 -0.0 -inf)
+  (v128.const f64x2 0.0 -1e300))
+  (v128.const f64x2 0.0 -1e300))
+(assert_return (invoke "f64x2.pmin"
+  (v128.const f64x2 0.0 nan)
+  (v128.const f64x2 -0.0 1.0))
+  (v128.const f64x2 0.0 nan))
+(assert_return (invoke "f64x2.neg"
+  (v128.const f64x2 nan:0x4000000000000 -0.0))
+  (v128.const f64x2 -nan:0x4000000000000 0.0))
+(assert_return (invoke "f64x2.sqrt"
+  (v128.const f64x2 2.0 -inf))
+  (v128.const f64x2 0x1.6a09e667f3bcdp+0 nan:canonical))
+(assert_return (invoke "f64x2.nearest"
+  (v128.const f64x2 -1.5 4.5))
+  (v128.const f64x2 -2.0 4.0))
+
+;; 比较
+
+(module
+  (func (export "f32x4.eq") (param v128 v128) (result v128) (f32x4.eq (local.get 0) (local.get 1)))
+  (func (export "f32x4.ne") (param v128 v128) (result v128) (f32x4.ne (local.get 0) (local.get 1)))
+  (func (export "f32x4.lt") (param v128 v128) (result v128) (f32x4.lt (local.get 0) (local.get 1)))
+  (func (export "f32x4.ge") (param v128 v128) (result v128) (f32x4.ge (local.get 0) (local.get 1)))
+  (func (export "f64x2.gt") (param v128 v128) (result v128) (f64x2.gt (local.get 0) (local.get 1)))
+  (func (export "f64x2.le") (param v128 v128) (result v128) (f64x2.le (local.get 0) (local.get 1)))
+)
+
+(assert_return (invoke "f32x4.eq"
+  (v128.const f32x4 0.0 nan 1.0 inf)
+  (v128.const f32x4 -0.0 nan 2.0 inf))
+  (v128.const i32x4 -1 0 0 -1))
+(assert_return (invoke "f32x4.ne"
+  (v128.const f32x4 0.0 nan 1.0 inf)
+  (v128.const f32x4 -0.0 nan 2.0 inf))
+  (v128.const i32x4 0 -1 -1 0))
+(assert_return (invoke "f32x4.lt"
+  (v128.const f32x4 -0.0 nan 1.0 -inf)
+  (v128.const f32x4 0.0 1.0 2.0 -inf))
+  (v128.const i32x4 0 0 -1 0))
+(assert_return (invoke "f32x4.ge"
+  (v128.const f32x4 -0.0 nan 1.0 -inf)
+  (v128.const f32x4 0.0 1.0 2.0 -inf))
+  (v128.const i32x4 -1 0 0 -1))
+(assert_return (invoke "f64x2.gt"
+  (v128.const f64x2 2.0 nan)
+  (v128.const f64x2 1.0 nan))
+  (v128.const i64x2 -1 0))
+(assert_return (invoke "f64x2.le"
+  (v128.const f64x2 -0.0 nan)
+  (v128.const f64x2 0.0 0.0))
+  (v128.const i64x2 -1 0))
+
+;; 类型转换
+
+(module
+  (func (export "i32x4.trunc_sat_f32x4_s") (param v128) (result v128) (i32x4.trunc_sat_f32x4_s (local.get 0)))
+  (func (export "i32x4.trunc_sat_f32x4_u") (param v128) (result v128) (i32x4.trunc_sat_f32x4_u (local.get 0)))
+  (func (export "f32x4.convert_i32x4_s") (param v128) (result v128) (f32x4.convert_i32x4_s (local.get 0)))
+  (func (export "f32x4.convert_i32x4_u") (param v128) (result v128) (f32x4.convert_i32x4_u (local.get 0)))
+  (func (export "i32x4.trunc_sat_f64x2_s_zero") (param v128) (result v128) (i32x4.trunc_sat_f64x2_s_zero (local.get 0)))
+  (func (export "i32x4.trunc_sat_f64x2_u_zero") (param v128) (result v128) (i32x4.trunc_sat_f64x2_u_zero (local.get 0)))
+  (func (export "f64x2.convert_low_i32x4_s") (param v128) (result v128) (f64x2.convert_low_i32x4_s (local.get 0)))
+  (func (export "f64x2.convert_low_i32x4_u") (param v128) (result v128) (f64x2.convert_low_i32x4_u (local.get 0)))
+  (func (export "f32x4.demote_f64x2_zero") (param v128) (result v128) (f32x4.demote_f64x2_zero (local.get 0)))
+  (func (export "f64x2.promote_low_f32x4") (param v128) (result v128) (f64x2.promote_low_f32x4 (local.get 0)))
+)
+
+(assert_return (invoke "i32x4.trunc_sat_f32x4_s"
+  (v128.const f32x4 -1.9 nan 0x1p31 -0x1p32))
+  (v128.const i32x4 -1 0 0x7fffffff 0x80000000))
+(assert_return (invoke "i32x4.trunc_sat_f32x4_u"
+  (v128.const f32x4 -1.9 nan 0x1p32 4294967040.0))
+  (v128.const i32x4 0 0 0xffffffff 4294967040))
+(assert_return (invoke "f32x4.convert_i32x4_s"
+  (v128.const i32x4 -1 0x7fffffff 16777217 0x80000000))
+  (v128.const f32x4 -1.0 0x1p31 16777216.0 -0x1p31))
+(assert_return (invoke "f32x4.convert_i32x4_u"
+  (v128.const i32x4 -1 0x7fffffff 16777219 0))
+  (v128.const f32x4 0x1p32 0x1p31 16777220.0 0.0))
+(assert_return (invoke "i32x4.trunc_sat_f64x2_s_zero"
+  (v128.const f64x2 -2147483648.9 1e10))
+  (v128.const i32x4 0x80000000 0x7fffffff 0 0))
+(assert_return (invoke "i32x4.trunc_sat_f64x2_u_zero"
+  (v128.const f64x2 -0.9 4294967295.9))
+  (v128.const i32x4 0 0xffffffff 0 0))
+(assert_return (invoke "f64x2.convert_low_i32x4_s"
+  (v128.const i32x4 -1 0x80000000 5 6))
+  (v128.const f64x2 -1.0 -2147483648.0))
+(assert_return (invoke "f64x2.convert_low_i32x4_u"
+  (v128.const i32x4 -1 0x80000000 5 6))
+  (v128.const f64x2 4294967295.0 2147483648.0))
+(assert_return (invoke "f32x4.demote_f64x2_zero"
+  (v128.const f64x2 0x1.fffffffffffffp-1 1e300))
+  (v128.const f32x4 1.0 inf 0.0 0.0))
+(assert_return (invoke "f64x2.promote_low_f32x4"
+  (v128.const f32x4 0x1.fffffep127 -0.0 9.0 9.0))
+  (v128.const f64x2 0x1.fffffep127 -0.0))
+
+(assert_invalid
+  (module (func (result v128) (f32x4.add (v128.const f32x4 0 0 0 0) (f32.const 1))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (func (result v128) (f64x2.sqrt (f64.const 1))))
+  "type mismatch"
+)
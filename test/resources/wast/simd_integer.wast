@@ -0,0 +1,396 @@
+;; SIMD 整数运算：算术、饱和运算、比较、移位、归约以及位宽转换
+
+(module
+  (func (export "i8x16.add") (param v128 v128) (result v128) (i8x16.add (local.get 0) (local.get 1)))
+  (func (export "i8x16.sub") (param v128 v128) (result v128) (i8x16.sub (local.get 0) (local.get 1)))
+  (func (export "i8x16.add_sat_s") (param v128 v128) (result v128) (i8x16.add_sat_s (local.get 0) (local.get 1)))
+  (func (export "i8x16.add_sat_u") (param v128 v128) (result v128) (i8x16.add_sat_u (local.get 0) (local.get 1)))
+  (func (export "i8x16.sub_sat_s") (param v128 v128) (result v128) (i8x16.sub_sat_s (local.get 0) (local.get 1)))
+  (func (export "i8x16.sub_sat_u") (param v128 v128) (result v128) (i8x16.sub_sat_u (local.get 0) (local.get 1)))
+  (func (export "i8x16.min_s") (param v128 v128) (result v128) (i8x16.min_s (local.get 0) (local.get 1)))
+  (func (export "i8x16.min_u") (param v128 v128) (result v128) (i8x16.min_u (local.get 0) (local.get 1)))
+  (func (export "i8x16.max_s") (param v128 v128) (result v128) (i8x16.max_s (local.get 0) (local.get 1)))
+  (func (export "i8x16.max_u") (param v128 v128) (result v128) (i8x16.max_u (local.get 0) (local.get 1)))
+  (func (export "i8x16.avgr_u") (param v128 v128) (result v128) (i8x16.avgr_u (local.get 0) (local.get 1)))
+  (func (export "i8x16.abs") (param v128) (result v128) (i8x16.abs (local.get 0)))
+  (func (export "i8x16.neg") (param v128) (result v128) (i8x16.neg (local.get 0)))
+  (func (export "i8x16.popcnt") (param v128) (result v128) (i8x16.popcnt (local.get 0)))
+
+  (func (export "i16x8.add") (param v128 v128) (result v128) (i16x8.add (local.get 0) (local.get 1)))
+  (func (export "i16x8.mul") (param v128 v128) (result v128) (i16x8.mul (local.get 0) (local.get 1)))
+  (func (export "i16x8.add_sat_s") (param v128 v128) (result v128) (i16x8.add_sat_s (local.get 0) (local.get 1)))
+  (func (export "i16x8.sub_sat_u") (param v128 v128) (result v128) (i16x8.sub_sat_u (local.get 0) (local.get 1)))
+  (func (export "i16x8.avgr_u") (param v128 v128) (result v128) (i16x8.avgr_u (local.get 0) (local.get 1)))
+  (func (export "i16x8.q15mulr_sat_s") (param v128 v128) (result v128) (i16x8.q15mulr_sat_s (local.get 0) (local.get 1)))
+  (func (export "i16x8.abs") (param v128) (result v128) (i16x8.abs (local.get 0)))
+
+  (func (export "i32x4.add") (param v128 v128) (result v128) (i32x4.add (local.get 0) (local.get 1)))
+  (func (export "i32x4.sub") (param v128 v128) (result v128) (i32x4.sub (local.get 0) (local.get 1)))
+  (func (export "i32x4.mul") (param v128 v128) (result v128) (i32x4.mul (local.get 0) (local.get 1)))
+  (func (export "i32x4.min_s") (param v128 v128) (result v128) (i32x4.min_s (local.get 0) (local.get 1)))
+  (func (export "i32x4.max_u") (param v128 v128) (result v128) (i32x4.max_u (local.get 0) (local.get 1)))
+  (func (export "i32x4.neg") (param v128) (result v128) (i32x4.neg (local.get 0)))
+  (func (export "i32x4.dot_i16x8_s") (param v128 v128) (result v128) (i32x4.dot_i16x8_s (local.get 0) (local.get 1)))
+
+  (func (export "i64x2.add") (param v128 v128) (result v128) (i64x2.add (local.get 0) (local.get 1)))
+  (func (export "i64x2.sub") (param v128 v128) (result v128) (i64x2.sub (local.get 0) (local.get 1)))
+  (func (export "i64x2.mul") (param v128 v128) (result v128) (i64x2.mul (local.get 0) (local.get 1)))
+  (func (export "i64x2.abs") (param v128) (result v128) (i64x2.abs (local.get 0)))
+)
+
+(assert_return (invoke "i8x16.add"
+  (v128.const i8x16 0 1 2 3 4 5 6 7 8 9 10 11 12 13 127 255)
+  (v128.const i8x16 1 1 1 1 1 1 1 1 1 1 1 1 1 1 1 1))
+  (v128.const i8x16 1 2 3 4 5 6 7 8 9 10 11 12 13 14 -128 0))
+(assert_return (invoke "i8x16.sub"
+  (v128.const i8x16 0 1 2 3 4 5 6 7 8 9 10 11 12 13 -128 255)
+  (v128.const i8x16 1 1 1 1 1 1 1 1 1 1 1 1 1 1 1 1))
+  (v128.const i8x16 -1 0 1 2 3 4 5 6 7 8 9 10 11 12 127 254))
+(assert_return (invoke "i8x16.add_sat_s"
+  (v128.const i8x16 127 -128 100 -100 0 1 -1 0 127 -128 100 -100 0 1 -1 0)
+  (v128.const i8x16 1 -1 100 -100 0 -1 1 0 127 -128 27 -28 5 6 7 8))
+  (v128.const i8x16 127 -128 127 -128 0 0 0 0 127 -128 127 -128 5 7 6 8))
+(assert_return (invoke "i8x16.add_sat_u"
+  (v128.const i8x16 255 254 128 0 1 2 3 4 255 254 128 0 1 2 3 4)
+  (v128.const i8x16 1 1 128 0 254 253 252 251 255 2 127 255 0 0 0 0))
+  (v128.const i8x16 255 255 255 0 255 255 255 255 255 255 255 255 1 2 3 4))
+(assert_return (invoke "i8x16.sub_sat_s"
+  (v128.const i8x16 -128 127 0 -1 0 0 0 0 -128 127 0 -1 0 0 0 0)
+  (v128.const i8x16 1 -1 -128 127 0 0 0 0 1 -1 -128 127 0 0 0 0))
+  (v128.const i8x16 -128 127 127 -128 0 0 0 0 -128 127 127 -128 0 0 0 0))
+(assert_return (invoke "i8x16.sub_sat_u"
+  (v128.const i8x16 0 1 255 128 0 1 255 128 0 1 255 128 0 1 255 128)
+  (v128.const i8x16 1 1 1 129 1 1 1 129 1 1 1 129 1 1 1 129))
+  (v128.const i8x16 0 0 254 0 0 0 254 0 0 0 254 0 0 0 254 0))
+(assert_return (invoke "i8x16.min_s"
+  (v128.const i8x16 -128 127 0 -1 5 5 5 5 -128 127 0 -1 5 5 5 5)
+  (v128.const i8x16 127 -128 -1 0 6 4 5 -5 127 -128 -1 0 6 4 5 -5))
+  (v128.const i8x16 -128 -128 -1 -1 5 4 5 -5 -128 -128 -1 -1 5 4 5 -5))
+(assert_return (invoke "i8x16.min_u"
+  (v128.const i8x16 -128 127 0 -1 5 5 5 5 -128 127 0 -1 5 5 5 5)
+  (v128.const i8x16 127 -128 -1 0 6 4 5 -5 127 -128 -1 0 6 4 5 -5))
+  (v128.const i8x16 127 127 0 0 5 4 5 5 127 127 0 0 5 4 5 5))
+(assert_return (invoke "i8x16.max_s"
+  (v128.const i8x16 -128 127 0 -1 5 5 5 5 -128 127 0 -1 5 5 5 5)
+  (v128.const i8x16 127 -128 -1 0 6 4 5 -5 127 -128 -1 0 6 4 5 -5))
+  (v128.const i8x16 127 127 0 0 6 5 5 5 127 127 0 0 6 5 5 5))
+(assert_return (invoke "i8x16.max_u"
+  (v128.const i8x16 -128 127 0 -1 5 5 5 5 -128 127 0 -1 5 5 5 5)
+  (v128.const i8x16 127 -128 -1 0 6 4 5 -5 127 -128 -1 0 6 4 5 -5))
+  (v128.const i8x16 -128 -128 -1 -1 6 5 5 -5 -128 -128 -1 -1 6 5 5 -5))
+(assert_return (invoke "i8x16.avgr_u"
+  (v128.const i8x16 0 1 255 255 0 3 200 100 0 1 255 255 0 3 200 100)
+  (v128.const i8x16 0 0 255 0 1 4 100 201 0 0 255 0 1 4 100 201))
+  (v128.const i8x16 0 1 255 128 1 4 150 151 0 1 255 128 1 4 150 151))
+(assert_return (invoke "i8x16.abs"
+  (v128.const i8x16 0 1 -1 127 -127 -128 2 -2 0 1 -1 127 -127 -128 2 -2))
+  (v128.const i8x16 0 1 1 127 127 -128 2 2 0 1 1 127 127 -128 2 2))
+(assert_return (invoke "i8x16.neg"
+  (v128.const i8x16 0 1 -1 127 -127 -128 2 -2 0 1 -1 127 -127 -128 2 -2))
+  (v128.const i8x16 0 -1 1 -127 127 -128 -2 2 0 -1 1 -127 127 -128 -2 2))
+(assert_return (invoke "i8x16.popcnt"
+  (v128.const i8x16 0 1 2 3 0xff 0x80 0x55 0x7f 0 1 2 3 0xff 0x80 0x55 0x7f))
+  (v128.const i8x16 0 1 1 2 8 1 4 7 0 1 1 2 8 1 4 7))
+
+(assert_return (invoke "i16x8.add"
+  (v128.const i16x8 0x7fff 0xffff 1 2 3 4 5 6)
+  (v128.const i16x8 1 1 -1 -2 -3 -4 -5 0x8000))
+  (v128.const i16x8 -0x8000 0 0 0 0 0 0 0x8006))
+(assert_return (invoke "i16x8.mul"
+  (v128.const i16x8 0x100 -1 3 0x7fff 0x8000 2 0 12345)
+  (v128.const i16x8 0x100 -1 -3 2 2 -0x4000 100 2))
+  (v128.const i16x8 0 1 -9 -2 0 -0x8000 0 24690))
+(assert_return (invoke "i16x8.add_sat_s"
+  (v128.const i16x8 0x7fff -0x8000 0x4000 -0x4000 1 -1 100 0)
+  (v128.const i16x8 1 -1 0x4000 -0x4001 -1 1 -200 0))
+  (v128.const i16x8 0x7fff -0x8000 0x7fff -0x8000 0 0 -100 0))
+(assert_return (invoke "i16x8.sub_sat_u"
+  (v128.const i16x8 0 0xffff 100 0x8000 1 2 3 4)
+  (v128.const i16x8 1 1 101 0x7fff 1 1 1 1))
+  (v128.const i16x8 0 0xfffe 0 1 0 1 2 3))
+(assert_return (invoke "i16x8.avgr_u"
+  (v128.const i16x8 0xffff 0 1 0xfffe 3 4 5 6)
+  (v128.const i16x8 0xffff 1 2 0xffff 3 5 6 8))
+  (v128.const i16x8 0xffff 1 2 0xffff 3 5 6 7))
+(assert_return (invoke "i16x8.q15mulr_sat_s"
+  (v128.const i16x8 -0x8000 0x4000 0x4000 -0x8000 0x7fff 1 -1 0x2000)
+  (v128.const i16x8 -0x8000 0x4000 -0x4000 0x7fff 0x7fff 0x4000 0x4000 3))
+  (v128.const i16x8 0x7fff 0x2000 -0x2000 -0x7fff 0x7ffe 1 0 1))
+(assert_return (invoke "i16x8.abs"
+  (v128.const i16x8 -0x8000 -0x7fff -1 0 1 0x7fff -2 2))
+  (v128.const i16x8 -0x8000 0x7fff 1 0 1 0x7fff 2 2))
+
+(assert_return (invoke "i32x4.add"
+  (v128.const i32x4 0x7fffffff 0xffffffff 1 -1)
+  (v128.const i32x4 1 1 -2 -2))
+  (v128.const i32x4 0x80000000 0 -1 -3))
+(assert_return (invoke "i32x4.sub"
+  (v128.const i32x4 0x80000000 0 1 -1)
+  (v128.const i32x4 1 1 -2 -2))
+  (v128.const i32x4 0x7fffffff -1 3 1))
+(assert_return (invoke "i32x4.mul"
+  (v128.const i32x4 0x10000 -1 123456789 0x80000000)
+  (v128.const i32x4 0x10000 -1 -3 -1))
+  (v128.const i32x4 0 1 -370370367 0x80000000))
+(assert_return (invoke "i32x4.min_s"
+  (v128.const i32x4 0x80000000 0x7fffffff -1 5)
+  (v128.const i32x4 0x7fffffff 0x80000000 0 4))
+  (v128.const i32x4 0x80000000 0x80000000 -1 4))
+(assert_return (invoke "i32x4.max_u"
+  (v128.const i32x4 0x80000000 0x7fffffff -1 5)
+  (v128.const i32x4 0x7fffffff 0x80000000 0 4))
+  (v128.const i32x4 0x80000000 0x80000000 -1 5))
+(assert_return (invoke "i32x4.neg"
+  (v128.const i32x4 0x80000000 0x7fffffff -1 0))
+  (v128.const i32x4 0x80000000 -0x7fffffff 1 0))
+(assert_return (invoke "i32x4.dot_i16x8_s"
+  (v128.const i16x8 -0x8000 -0x8000 1 2 -3 4 0x7fff 0x7fff)
+  (v128.const i16x8 -0x8000 -0x8000 3 4 5 -6 0x7fff 0x7fff))
+  (v128.const i32x4 0x80000000 11 -39 0x7ffe0002))
+
+(assert_return (invoke "i64x2.add"
+  (v128.const i64x2 0x7fffffffffffffff -1)
+  (v128.const i64x2 1 1))
+  (v128.const i64x2 0x8000000000000000 0))
+(assert_return (invoke "i64x2.sub"
+  (v128.const i64x2 0x8000000000000000 0)
+  (v128.const i64x2 1 1))
+  (v128.const i64x2 0x7fffffffffffffff -1))
+(assert_return (invoke "i64x2.mul"
+  (v128.const i64x2 0x100000000 -3)
+  (v128.const i64x2 0x100000000 0x5555555555555555))
+  (v128.const i64x2 0 0x0000000000000001))
+(assert_return (invoke "i64x2.abs"
+  (v128.const i64x2 0x8000000000000000 -5))
+  (v128.const i64x2 0x8000000000000000 5))
+
+;; 比较
+
+(module
+  (func (export "i8x16.eq") (param v128 v128) (result v128) (i8x16.eq (local.get 0) (local.get 1)))
+  (func (export "i8x16.lt_s") (param v128 v128) (result v128) (i8x16.lt_s (local.get 0) (local.get 1)))
+  (func (export "i8x16.lt_u") (param v128 v128) (result v128) (i8x16.lt_u (local.get 0) (local.get 1)))
+  (func (export "i16x8.ne") (param v128 v128) (result v128) (i16x8.ne (local.get 0) (local.get 1)))
+  (func (export "i16x8.gt_s") (param v128 v128) (result v128) (i16x8.gt_s (local.get 0) (local.get 1)))
+  (func (export "i16x8.ge_u") (param v128 v128) (result v128) (i16x8.ge_u (local.get 0) (local.get 1)))
+  (func (export "i32x4.le_s") (param v128 v128) (result v128) (i32x4.le_s (local.get 0) (local.get 1)))
+  (func (export "i32x4.gt_u") (param v128 v128) (result v128) (i32x4.gt_u (local.get 0) (local.get 1)))
+  (func (export "i64x2.eq") (param v128 v128) (result v128) (i64x2.eq (local.get 0) (local.get 1)))
+  (func (export "i64x2.lt_s") (param v128 v128) (result v128) (i64x2.lt_s (local.get 0) (local.get 1)))
+  (func (export "i64x2.ge_s") (param v128 v128) (result v128) (i64x2.ge_s (local.get 0) (local.get 1)))
+)
+
+(assert_return (invoke "i8x16.eq"
+  (v128.const i8x16 0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15)
+  (v128.const i8x16 0 0 2 2 4 4 6 6 8 8 10 10 12 12 14 14))
+  (v128.const i8x16 -1 0 -1 0 -1 0 -1 0 -1 0 -1 0 -1 0 -1 0))
+(assert_return (invoke "i8x16.lt_s"
+  (v128.const i8x16 -1 0 127 -128 1 2 3 4 -1 0 127 -128 1 2 3 4)
+  (v128.const i8x16 0 -1 -128 127 1 3 2 4 0 -1 -128 127 1 3 2 4))
+  (v128.const i8x16 -1 0 0 -1 0 -1 0 0 -1 0 0 -1 0 -1 0 0))
+(assert_return (invoke "i8x16.lt_u"
+  (v128.const i8x16 -1 0 127 -128 1 2 3 4 -1 0 127 -128 1 2 3 4)
+  (v128.const i8x16 0 -1 -128 127 1 3 2 4 0 -1 -128 127 1 3 2 4))
+  (v128.const i8x16 0 -1 -1 0 0 -1 0 0 0 -1 -1 0 0 -1 0 0))
+(assert_return (invoke "i16x8.ne"
+  (v128.const i16x8 0 1 2 3 4 5 6 7)
+  (v128.const i16x8 0 0 2 2 4 4 6 6))
+  (v128.const i16x8 0 -1 0 -1 0 -1 0 -1))
+(assert_return (invoke "i16x8.gt_s"
+  (v128.const i16x8 -1 0 0x7fff -0x8000 1 2 3 4)
+  (v128.const i16x8 0 -1 -0x8000 0x7fff 1 3 2 4))
+  (v128.const i16x8 0 -1 -1 0 0 0 -1 0))
+(assert_return (invoke "i16x8.ge_u"
+  (v128.const i16x8 -1 0 0x7fff -0x8000 1 2 3 4)
+  (v128.const i16x8 0 -1 -0x8000 0x7fff 1 3 2 4))
+  (v128.const i16x8 -1 0 0 -1 -1 0 -1 -1))
+(assert_return (invoke "i32x4.le_s"
+  (v128.const i32x4 -1 0 0x80000000 5)
+  (v128.const i32x4 0 -1 0x7fffffff 5))
+  (v128.const i32x4 -1 0 -1 -1))
+(assert_return (invoke "i32x4.gt_u"
+  (v128.const i32x4 -1 0 0x80000000 5)
+  (v128.const i32x4 0 -1 0x7fffffff 5))
+  (v128.const i32x4 -1 0 -1 0))
+(assert_return (invoke "i64x2.eq"
+  (v128.const i64x2 0x100000000 -1)
+  (v128.const i64x2 0 -1))
+  (v128.const i64x2 0 -1))
+(assert_return (invoke "i64x2.lt_s"
+  (v128.const i64x2 -1 0x7fffffffffffffff)
+  (v128.const i64x2 0 0x8000000000000000))
+  (v128.const i64x2 -1 0))
+(assert_return (invoke "i64x2.ge_s"
+  (v128.const i64x2 -1 5)
+  (v128.const i64x2 -1 6))
+  (v128.const i64x2 -1 0))
+
+;; 移位、all_true 和 bitmask
+
+(module
+  (func (export "i8x16.shl") (param v128 i32) (result v128) (i8x16.shl (local.get 0) (local.get 1)))
+  (func (export "i8x16.shr_s") (param v128 i32) (result v128) (i8x16.shr_s (local.get 0) (local.get 1)))
+  (func (export "i8x16.shr_u") (param v128 i32) (result v128) (i8x16.shr_u (local.get 0) (local.get 1)))
+  (func (export "i16x8.shr_s") (param v128 i32) (result v128) (i16x8.shr_s (local.get 0) (local.get 1)))
+  (func (export "i32x4.shl") (param v128 i32) (result v128) (i32x4.shl (local.get 0) (local.get 1)))
+  (func (export "i64x2.shr_u") (param v128 i32) (result v128) (i64x2.shr_u (local.get 0) (local.get 1)))
+  (func (export "i64x2.shr_s") (param v128 i32) (result v128) (i64x2.shr_s (local.get 0) (local.get 1)))
+
+  (func (export "i8x16.all_true") (param v128) (result i32) (i8x16.all_true (local.get 0)))
+  (func (export "i16x8.all_true") (param v128) (result i32) (i16x8.all_true (local.get 0)))
+  (func (export "i32x4.all_true") (param v128) (result i32) (i32x4.all_true (local.get 0)))
+  (func (export "i64x2.all_true") (param v128) (result i32) (i64x2.all_true (local.get 0)))
+  (func (export "i8x16.bitmask") (param v128) (result i32) (i8x16.bitmask (local.get 0)))
+  (func (export "i16x8.bitmask") (param v128) (result i32) (i16x8.bitmask (local.get 0)))
+  (func (export "i32x4.bitmask") (param v128) (result i32) (i32x4.bitmask (local.get 0)))
+  (func (export "i64x2.bitmask") (param v128) (result i32) (i64x2.bitmask (local.get 0)))
+)
+
+(assert_return (invoke "i8x16.shl" (v128.const i8x16 0x81 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15) (i32.const 1))
+  (v128.const i8x16 2 2 4 6 8 10 12 14 16 18 20 22 24 26 28 30))
+(assert_return (invoke "i8x16.shl" (v128.const i8x16 0x81 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15) (i32.const 9))
+  (v128.const i8x16 2 2 4 6 8 10 12 14 16 18 20 22 24 26 28 30))
+(assert_return (invoke "i8x16.shr_s" (v128.const i8x16 0x80 0x7f -1 2 0 0 0 0 0 0 0 0 0 0 0 0) (i32.const 7))
+  (v128.const i8x16 -1 0 -1 0 0 0 0 0 0 0 0 0 0 0 0 0))
+(assert_return (invoke "i8x16.shr_u" (v128.const i8x16 0x80 0x7f -1 2 0 0 0 0 0 0 0 0 0 0 0 0) (i32.const -1))
+  (v128.const i8x16 1 0 1 0 0 0 0 0 0 0 0 0 0 0 0 0))
+(assert_return (invoke "i16x8.shr_s" (v128.const i16x8 -0x8000 0x7fff -1 0x100 0 0 0 0) (i32.const 8))
+  (v128.const i16x8 -0x80 0x7f -1 1 0 0 0 0))
+(assert_return (invoke "i32x4.shl" (v128.const i32x4 1 -1 0x40000000 3) (i32.const 33))
+  (v128.const i32x4 2 -2 0x80000000 6))
+(assert_return (invoke "i64x2.shr_u" (v128.const i64x2 -1 0x8000000000000000) (i32.const 63))
+  (v128.const i64x2 1 1))
+(assert_return (invoke "i64x2.shr_s" (v128.const i64x2 -1 0x8000000000000000) (i32.const 127))
+  (v128.const i64x2 -1 -1))
+
+(assert_return (invoke "i8x16.all_true" (v128.const i8x16 1 1 1 1 1 1 1 1 1 1 1 1 1 1 1 1)) (i32.const 1))
+(assert_return (invoke "i8x16.all_true" (v128.const i8x16 1 1 1 1 1 1 1 1 1 1 1 1 1 1 1 0)) (i32.const 0))
+(assert_return (invoke "i16x8.all_true" (v128.const i8x16 1 0 1 0 1 0 1 0 1 0 1 0 1 0 1 0)) (i32.const 1))
+(assert_return (invoke "i16x8.all_true" (v128.const i16x8 1 1 1 0 1 1 1 1)) (i32.const 0))
+(assert_return (invoke "i32x4.all_true" (v128.const i32x4 0x100 1 -1 0x80000000)) (i32.const 1))
+(assert_return (invoke "i32x4.all_true" (v128.const i32x4 1 0 1 1)) (i32.const 0))
+(assert_return (invoke "i64x2.all_true" (v128.const i64x2 0x100000000 1)) (i32.const 1))
+(assert_return (invoke "i64x2.all_true" (v128.const i64x2 0 1)) (i32.const 0))
+(assert_return (invoke "i8x16.bitmask" (v128.const i8x16 -1 0 -1 0 0x80 0x7f 0 0 0 0 0 0 0 0 0 -1)) (i32.const 0x8015))
+(assert_return (invoke "i16x8.bitmask" (v128.const i16x8 -1 0 0x8000 0x7fff 0 0 0 -1)) (i32.const 0x85))
+(assert_return (invoke "i32x4.bitmask" (v128.const i32x4 -1 0 0x80000000 0x7fffffff)) (i32.const 5))
+(assert_return (invoke "i64x2.bitmask" (v128.const i64x2 0 -1)) (i32.const 2))
+
+;; 位宽转换：narrow, extend, extadd_pairwise, extmul
+
+(module
+  (func (export "i8x16.narrow_i16x8_s") (param v128 v128) (result v128) (i8x16.narrow_i16x8_s (local.get 0) (local.get 1)))
+  (func (export "i8x16.narrow_i16x8_u") (param v128 v128) (result v128) (i8x16.narrow_i16x8_u (local.get 0) (local.get 1)))
+  (func (export "i16x8.narrow_i32x4_s") (param v128 v128) (result v128) (i16x8.narrow_i32x4_s (local.get 0) (local.get 1)))
+  (func (export "i16x8.narrow_i32x4_u") (param v128 v128) (result v128) (i16x8.narrow_i32x4_u (local.get 0) (local.get 1)))
+
+  (func (export "i16x8.extend_low_i8x16_s") (param v128) (result v128) (i16x8.extend_low_i8x16_s (local.get 0)))
+  (func (export "i16x8.extend_high_i8x16_s") (param v128) (result v128) (i16x8.extend_high_i8x16_s (local.get 0)))
+  (func (export "i16x8.extend_low_i8x16_u") (param v128) (result v128) (i16x8.extend_low_i8x16_u (local.get 0)))
+  (func (export "i32x4.extend_high_i16x8_u") (param v128) (result v128) (i32x4.extend_high_i16x8_u (local.get 0)))
+  (func (export "i64x2.extend_low_i32x4_s") (param v128) (result v128) (i64x2.extend_low_i32x4_s (local.get 0)))
+  (func (export "i64x2.extend_high_i32x4_u") (param v128) (result v128) (i64x2.extend_high_i32x4_u (local.get 0)))
+
+  (func (export "i16x8.extadd_pairwise_i8x16_s") (param v128) (result v128) (i16x8.extadd_pairwise_i8x16_s (local.get 0)))
+  (func (export "i16x8.extadd_pairwise_i8x16_u") (param v128) (result v128) (i16x8.extadd_pairwise_i8x16_u (local.get 0)))
+  (func (export "i32x4.extadd_pairwise_i16x8_s") (param v128) (result v128) (i32x4.extadd_pairwise_i16x8_s (local.get 0)))
+  (func (export "i32x4.extadd_pairwise_i16x8_u") (param v128) (result v128) (i32x4.extadd_pairwise_i16x8_u (local.get 0)))
+
+  (func (export "i16x8.extmul_low_i8x16_s") (param v128 v128) (result v128) (i16x8.extmul_low_i8x16_s (local.get 0) (local.get 1)))
+  (func (export "i16x8.extmul_high_i8x16_u") (param v128 v128) (result v128) (i16x8.extmul_high_i8x16_u (local.get 0) (local.get 1)))
+  (func (export "i32x4.extmul_low_i16x8_u") (param v128 v128) (result v128) (i32x4.extmul_low_i16x8_u (local.get 0) (local.get 1)))
+  (func (export "i32x4.extmul_high_i16x8_s") (param v128 v128) (result v128) (i32x4.extmul_high_i16x8_s (local.get 0) (local.get 1)))
+  (func (export "i64x2.extmul_low_i32x4_s") (param v128 v128) (result v128) (i64x2.extmul_low_i32x4_s (local.get 0) (local.get 1)))
+  (func (export "i64x2.extmul_high_i32x4_u") (param v128 v128) (result v128) (i64x2.extmul_high_i32x4_u (local.get 0) (local.get 1)))
+)
+
+(assert_return (invoke "i8x16.narrow_i16x8_s"
+  (v128.const i16x8 0 1 -1 127 128 -128 -129 0x7fff)
+  (v128.const i16x8 -0x8000 2 3 4 5 6 7 8))
+  (v128.const i8x16 0 1 -1 127 127 -128 -128 127 -128 2 3 4 5 6 7 8))
+(assert_return (invoke "i8x16.narrow_i16x8_u"
+  (v128.const i16x8 0 1 -1 127 128 255 256 0x7fff)
+  (v128.const i16x8 -0x8000 2 3 4 5 6 7 8))
+  (v128.const i8x16 0 1 0 127 128 255 255 255 0 2 3 4 5 6 7 8))
+(assert_return (invoke "i16x8.narrow_i32x4_s"
+  (v128.const i32x4 0x8000 -0x8001 -1 0x7fff)
+  (v128.const i32x4 0x80000000 0x7fffffff 1 -0x8000))
+  (v128.const i16x8 0x7fff -0x8000 -1 0x7fff -0x8000 0x7fff 1 -0x8000))
+(assert_return (invoke "i16x8.narrow_i32x4_u"
+  (v128.const i32x4 0x10000 -1 0xffff 0x8000)
+  (v128.const i32x4 0x80000000 0x7fffffff 1 0))
+  (v128.const i16x8 0xffff 0 0xffff 0x8000 0 0xffff 1 0))
+
+(assert_return (invoke "i16x8.extend_low_i8x16_s"
+  (v128.const i8x16 0 1 -1 127 -128 2 3 -4 9 9 9 9 9 9 9 9))
+  (v128.const i16x8 0 1 -1 127 -128 2 3 -4))
+(assert_return (invoke "i16x8.extend_high_i8x16_s"
+  (v128.const i8x16 9 9 9 9 9 9 9 9 0 1 -1 127 -128 2 3 -4))
+  (v128.const i16x8 0 1 -1 127 -128 2 3 -4))
+(assert_return (invoke "i16x8.extend_low_i8x16_u"
+  (v128.const i8x16 0 1 -1 127 -128 2 3 -4 9 9 9 9 9 9 9 9))
+  (v128.const i16x8 0 1 255 127 128 2 3 252))
+(assert_return (invoke "i32x4.extend_high_i16x8_u"
+  (v128.const i16x8 1 2 3 4 -1 0x8000 0x7fff 0))
+  (v128.const i32x4 0xffff 0x8000 0x7fff 0))
+(assert_return (invoke "i64x2.extend_low_i32x4_s"
+  (v128.const i32x4 -1 0x80000000 3 4))
+  (v128.const i64x2 -1 -0x80000000))
+(assert_return (invoke "i64x2.extend_high_i32x4_u"
+  (v128.const i32x4 1 2 -1 0x80000000))
+  (v128.const i64x2 0xffffffff 0x80000000))
+
+(assert_return (invoke "i16x8.extadd_pairwise_i8x16_s"
+  (v128.const i8x16 -128 -128 127 127 -1 1 0 0 1 2 3 4 5 6 7 8))
+  (v128.const i16x8 -256 254 0 0 3 7 11 15))
+(assert_return (invoke "i16x8.extadd_pairwise_i8x16_u"
+  (v128.const i8x16 -128 -128 127 127 -1 1 0 0 1 2 3 4 5 6 7 8))
+  (v128.const i16x8 256 254 256 0 3 7 11 15))
+(assert_return (invoke "i32x4.extadd_pairwise_i16x8_s"
+  (v128.const i16x8 -0x8000 -0x8000 0x7fff 0x7fff -1 1 2 3))
+  (v128.const i32x4 -0x10000 0xfffe 0 5))
+(assert_return (invoke "i32x4.extadd_pairwise_i16x8_u"
+  (v128.const i16x8 -0x8000 -0x8000 0x7fff 0x7fff -1 1 2 3))
+  (v128.const i32x4 0x10000 0xfffe 0x10000 5))
+
+(assert_return (invoke "i16x8.extmul_low_i8x16_s"
+  (v128.const i8x16 -128 -128 127 -1 2 3 4 5 9 9 9 9 9 9 9 9)
+  (v128.const i8x16 -128 127 127 -1 -2 3 4 5 9 9 9 9 9 9 9 9))
+  (v128.const i16x8 0x4000 -0x3f80 0x3f01 1 -4 9 16 25))
+(assert_return (invoke "i16x8.extmul_high_i8x16_u"
+  (v128.const i8x16 9 9 9 9 9 9 9 9 -128 -128 127 -1 2 3 4 5)
+  (v128.const i8x16 9 9 9 9 9 9 9 9 -128 127 127 -1 -2 3 4 5))
+  (v128.const i16x8 0x4000 0x3f80 0x3f01 0xfe01 0x1fc 9 16 25))
+(assert_return (invoke "i32x4.extmul_low_i16x8_u"
+  (v128.const i16x8 -1 0x8000 3 4 9 9 9 9)
+  (v128.const i16x8 -1 2 -3 4 9 9 9 9))
+  (v128.const i32x4 0xfffe0001 0x10000 0x2fff7 16))
+(assert_return (invoke "i32x4.extmul_high_i16x8_s"
+  (v128.const i16x8 9 9 9 9 -1 -0x8000 3 4)
+  (v128.const i16x8 9 9 9 9 -1 -0x8000 -3 4))
+  (v128.const i32x4 1 0x40000000 -9 16))
+(assert_return (invoke "i64x2.extmul_low_i32x4_s"
+  (v128.const i32x4 -0x80000000 -1 9 9)
+  (v128.const i32x4 -0x80000000 2 9 9))
+  (v128.const i64x2 0x4000000000000000 -2))
+(assert_return (invoke "i64x2.extmul_high_i32x4_u"
+  (v128.const i32x4 9 9 -1 0x80000000)
+  (v128.const i32x4 9 9 -1 2))
+  (v128.const i64x2 0xfffffffe00000001 0x100000000))
+
+(assert_invalid
+  (module (func (result v128) (i8x16.shl (v128.const i64x2 0 0) (i64.const 1))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (func (result v128) (i32x4.add (v128.const i64x2 0 0) (i32.const 1))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (func (result v128) (i16x8.all_true (v128.const i64x2 0 0))))
+  "type mismatch"
+)
+(assert_malformed
+  (module quote "(func (result v128) (i64x2.min_s (v128.const i64x2 0 0) (v128.const i64x2 0 0)))")
+  "unknown operator"
+)
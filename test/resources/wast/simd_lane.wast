@@ -0,0 +1,179 @@
+;; SIMD：v128.const, splat, extract_lane, replace_lane, shuffle, swizzle
+
+(module
+  (func (export "const-i8x16") (result v128)
+    (v128.const i8x16 0 1 2 3 4 5 6 7 8 9 10 11 12 13 0x7f -1)
+  )
+  (func (export "const-f32x4") (result v128)
+    (v128.const f32x4 1.0 -0x1p-1 inf nan:0x200000)
+  )
+
+  (func (export "i8x16.splat") (param i32) (result v128) (i8x16.splat (local.get 0)))
+  (func (export "i16x8.splat") (param i32) (result v128) (i16x8.splat (local.get 0)))
+  (func (export "i32x4.splat") (param i32) (result v128) (i32x4.splat (local.get 0)))
+  (func (export "i64x2.splat") (param i64) (result v128) (i64x2.splat (local.get 0)))
+  (func (export "f32x4.splat") (param f32) (result v128) (f32x4.splat (local.get 0)))
+  (func (export "f64x2.splat") (param f64) (result v128) (f64x2.splat (local.get 0)))
+
+  (func (export "i8x16.extract_lane_s") (param v128) (result i32) (i8x16.extract_lane_s 15 (local.get 0)))
+  (func (export "i8x16.extract_lane_u") (param v128) (result i32) (i8x16.extract_lane_u 15 (local.get 0)))
+  (func (export "i16x8.extract_lane_s") (param v128) (result i32) (i16x8.extract_lane_s 7 (local.get 0)))
+  (func (export "i16x8.extract_lane_u") (param v128) (result i32) (i16x8.extract_lane_u 7 (local.get 0)))
+  (func (export "i32x4.extract_lane") (param v128) (result i32) (i32x4.extract_lane 3 (local.get 0)))
+  (func (export "i64x2.extract_lane") (param v128) (result i64) (i64x2.extract_lane 1 (local.get 0)))
+  (func (export "f32x4.extract_lane") (param v128) (result f32) (f32x4.extract_lane 2 (local.get 0)))
+  (func (export "f64x2.extract_lane") (param v128) (result f64) (f64x2.extract_lane 0 (local.get 0)))
+
+  (func (export "i8x16.replace_lane") (param v128 i32) (result v128) (i8x16.replace_lane 0 (local.get 0) (local.get 1)))
+  (func (export "i16x8.replace_lane") (param v128 i32) (result v128) (i16x8.replace_lane 7 (local.get 0) (local.get 1)))
+  (func (export "i32x4.replace_lane") (param v128 i32) (result v128) (i32x4.replace_lane 1 (local.get 0) (local.get 1)))
+  (func (export "i64x2.replace_lane") (param v128 i64) (result v128) (i64x2.replace_lane 1 (local.get 0) (local.get 1)))
+  (func (export "f32x4.replace_lane") (param v128 f32) (result v128) (f32x4.replace_lane 3 (local.get 0) (local.get 1)))
+  (func (export "f64x2.replace_lane") (param v128 f64) (result v128) (f64x2.replace_lane 1 (local.get 0) (local.get 1)))
+
+  (func (export "shuffle-interleave") (param v128 v128) (result v128)
+    (i8x16.shuffle 0 16 1 17 2 18 3 19 4 20 5 21 6 22 7 23 (local.get 0) (local.get 1))
+  )
+  (func (export "shuffle-reverse") (param v128) (result v128)
+    (i8x16.shuffle 15 14 13 12 11 10 9 8 7 6 5 4 3 2 1 0 (local.get 0) (local.get 0))
+  )
+  (func (export "i8x16.swizzle") (param v128 v128) (result v128)
+    (i8x16.swizzle (local.get 0) (local.get 1))
+  )
+)
+
+(assert_return (invoke "const-i8x16") (v128.const i8x16 0 1 2 3 4 5 6 7 8 9 10 11 12 13 127 255))
+(assert_return (invoke "const-i8x16") (v128.const i32x4 0x03020100 0x07060504 0x0b0a0908 0xff7f0d0c))
+(assert_return (invoke "const-f32x4") (v128.const i32x4 0x3f800000 0xbf000000 0x7f800000 0x7fa00000))
+
+(assert_return (invoke "i8x16.splat" (i32.const 0x1ff)) (v128.const i8x16 -1 -1 -1 -1 -1 -1 -1 -1 -1 -1 -1 -1 -1 -1 -1 -1))
+(assert_return (invoke "i16x8.splat" (i32.const 0x12345)) (v128.const i16x8 0x2345 0x2345 0x2345 0x2345 0x2345 0x2345 0x2345 0x2345))
+(assert_return (invoke "i32x4.splat" (i32.const -2)) (v128.const i32x4 -2 -2 -2 -2))
+(assert_return (invoke "i64x2.splat" (i64.const 0x0102030405060708)) (v128.const i64x2 0x0102030405060708 0x0102030405060708))
+(assert_return (invoke "f32x4.splat" (f32.const -1.5)) (v128.const f32x4 -1.5 -1.5 -1.5 -1.5))
+(assert_return (invoke "f64x2.splat" (f64.const 0x1p-1074)) (v128.const f64x2 0x1p-1074 0x1p-1074))
+(assert_return (invoke "f32x4.splat" (f32.const nan:0x1)) (v128.const f32x4 nan:0x1 nan:0x1 nan:0x1 nan:0x1))
+
+(assert_return (invoke "i8x16.extract_lane_s" (v128.const i8x16 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0x80)) (i32.const -128))
+(assert_return (invoke "i8x16.extract_lane_u" (v128.const i8x16 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0x80)) (i32.const 128))
+(assert_return (invoke "i16x8.extract_lane_s" (v128.const i16x8 0 0 0 0 0 0 0 -2)) (i32.const -2))
+(assert_return (invoke "i16x8.extract_lane_u" (v128.const i16x8 0 0 0 0 0 0 0 -2)) (i32.const 0xfffe))
+(assert_return (invoke "i32x4.extract_lane" (v128.const i32x4 1 2 3 -4)) (i32.const -4))
+(assert_return (invoke "i64x2.extract_lane" (v128.const i64x2 1 0x8000000000000000)) (i64.const 0x8000000000000000))
+(assert_return (invoke "f32x4.extract_lane" (v128.const f32x4 0 0 -0x1.fffffep127 0)) (f32.const -0x1.fffffep127))
+(assert_return (invoke "f32x4.extract_lane" (v128.const f32x4 0 0 nan:0x200000 0)) (f32.const nan:0x200000))
+(assert_return (invoke "f64x2.extract_lane" (v128.const f64x2 -0.0 1)) (f64.const -0.0))
+
+(assert_return (invoke "i8x16.replace_lane" (v128.const i8x16 0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15) (i32.const 0x1ff))
+  (v128.const i8x16 -1 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15))
+(assert_return (invoke "i16x8.replace_lane" (v128.const i16x8 0 1 2 3 4 5 6 7) (i32.const -1))
+  (v128.const i16x8 0 1 2 3 4 5 6 -1))
+(assert_return (invoke "i32x4.replace_lane" (v128.const i32x4 0 1 2 3) (i32.const 0x7fffffff))
+  (v128.const i32x4 0 0x7fffffff 2 3))
+(assert_return (invoke "i64x2.replace_lane" (v128.const i64x2 0 1) (i64.const -1))
+  (v128.const i64x2 0 -1))
+(assert_return (invoke "f32x4.replace_lane" (v128.const f32x4 0 1 2 3) (f32.const inf))
+  (v128.const f32x4 0 1 2 inf))
+(assert_return (invoke "f64x2.replace_lane" (v128.const f64x2 0 1) (f64.const -nan))
+  (v128.const f64x2 0 -nan))
+
+(assert_return
+  (invoke "shuffle-interleave"
+    (v128.const i8x16 0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15)
+    (v128.const i8x16 16 17 18 19 20 21 22 23 24 25 26 27 28 29 30 31))
+  (v128.const i8x16 0 16 1 17 2 18 3 19 4 20 5 21 6 22 7 23))
+(assert_return
+  (invoke "shuffle-reverse" (v128.const i8x16 0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15))
+  (v128.const i8x16 15 14 13 12 11 10 9 8 7 6 5 4 3 2 1 0))
+(assert_return
+  (invoke "i8x16.swizzle"
+    (v128.const i8x16 0xf0 0xf1 0xf2 0xf3 0xf4 0xf5 0xf6 0xf7 0xf8 0xf9 0xfa 0xfb 0xfc 0xfd 0xfe 0xff)
+    (v128.const i8x16 15 0 14 1 16 17 255 128 3 3 3 3 -1 -16 7 8))
+  (v128.const i8x16 0xff 0xf0 0xfe 0xf1 0 0 0 0 0xf3 0xf3 0xf3 0xf3 0 0 0xf7 0xf8))
+
+;; v128 作为局部变量、全局变量、块的结果以及 select 的操作数
+
+(module
+  (global $g (mut v128) (v128.const f32x4 1 2 3 4))
+  (global $c v128 (v128.const i64x2 -1 1))
+
+  (func (export "local") (param v128) (result v128)
+    (local v128)
+    (local.set 1 (local.get 0))
+    (local.get 1)
+  )
+  (func (export "local-default") (result v128)
+    (local v128)
+    (local.get 0)
+  )
+  (func (export "get-global") (result v128) (global.get $g))
+  (func (export "set-global") (param v128) (global.set $g (local.get 0)))
+  (func (export "get-const-global") (result v128) (global.get $c))
+  (func (export "block") (param v128) (result v128)
+    (block (result v128) (local.get 0))
+  )
+  (func (export "loop-param") (param v128) (result v128)
+    (local.get 0)
+    (loop (param v128) (result v128))
+  )
+  (func (export "select") (param v128 v128 i32) (result v128)
+    (select (local.get 0) (local.get 1) (local.get 2))
+  )
+  (func $swap (param v128 v128) (result v128 v128)
+    (local.get 1) (local.get 0)
+  )
+  (func (export "call") (param v128 v128) (result v128)
+    (call $swap (local.get 0) (local.get 1))
+    (drop)
+  )
+)
+
+(assert_return (invoke "local" (v128.const i32x4 1 2 3 4)) (v128.const i32x4 1 2 3 4))
+(assert_return (invoke "local-default") (v128.const i32x4 0 0 0 0))
+(assert_return (invoke "get-global") (v128.const f32x4 1 2 3 4))
+(assert_return (invoke "set-global" (v128.const i8x16 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16)))
+(assert_return (invoke "get-global") (v128.const i8x16 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16))
+(assert_return (invoke "get-const-global") (v128.const i64x2 -1 1))
+(assert_return (invoke "block" (v128.const i64x2 7 8)) (v128.const i64x2 7 8))
+(assert_return (invoke "loop-param" (v128.const i64x2 7 8)) (v128.const i64x2 7 8))
+(assert_return (invoke "select" (v128.const i32x4 1 1 1 1) (v128.const i32x4 2 2 2 2) (i32.const 1)) (v128.const i32x4 1 1 1 1))
+(assert_return (invoke "select" (v128.const i32x4 1 1 1 1) (v128.const i32x4 2 2 2 2) (i32.const 0)) (v128.const i32x4 2 2 2 2))
+(assert_return (invoke "call" (v128.const i32x4 1 1 1 1) (v128.const i32x4 2 2 2 2)) (v128.const i32x4 2 2 2 2))
+
+(assert_invalid
+  (module (func (result i32) (i8x16.extract_lane_s 16 (v128.const i64x2 0 0))))
+  "invalid lane index"
+)
+(assert_invalid
+  (module (func (result i64) (i64x2.extract_lane 2 (v128.const i64x2 0 0))))
+  "invalid lane index"
+)
+(assert_invalid
+  (module (func (result v128) (f32x4.replace_lane 4 (v128.const i64x2 0 0) (f32.const 0))))
+  "invalid lane index"
+)
+(assert_invalid
+  (module (func (result v128)
+    (i8x16.shuffle 0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 32 (v128.const i64x2 0 0) (v128.const i64x2 0 0))))
+  "invalid lane index"
+)
+(assert_invalid
+  (module (func (result v128) (i32x4.splat (i64.const 0))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (func (result v128) (f32x4.replace_lane 0 (v128.const i64x2 0 0) (f64.const 0))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (func (result i32) (i32x4.extract_lane 0 (i32.const 0))))
+  "type mismatch"
+)
+(assert_malformed
+  (module quote "(func (result v128) (v128.const i32x4 0 0 0))")
+  "unexpected token"
+)
+(assert_malformed
+  (module quote "(func (result v128) (v128.const i8x16 256 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0))")
+  "constant out of range"
+)
@@ -0,0 +1,166 @@
+;; SIMD 内存指令以及位运算指令
+
+(module
+  (memory 1)
+  (data (i32.const 0) "\00\01\02\03\04\05\06\07\08\09\0a\0b\0c\0d\0e\0f")
+  (data (i32.const 16) "\80\81\82\83\84\85\86\87\ff\fe\fd\fc\fb\fa\f9\f8")
+
+  (func (export "v128.load") (param i32) (result v128) (v128.load (local.get 0)))
+  (func (export "v128.load_offset") (param i32) (result v128) (v128.load offset=16 align=1 (local.get 0)))
+  (func (export "v128.load8x8_s") (param i32) (result v128) (v128.load8x8_s (local.get 0)))
+  (func (export "v128.load8x8_u") (param i32) (result v128) (v128.load8x8_u (local.get 0)))
+  (func (export "v128.load16x4_s") (param i32) (result v128) (v128.load16x4_s (local.get 0)))
+  (func (export "v128.load32x2_u") (param i32) (result v128) (v128.load32x2_u (local.get 0)))
+  (func (export "v128.load8_splat") (param i32) (result v128) (v128.load8_splat (local.get 0)))
+  (func (export "v128.load16_splat") (param i32) (result v128) (v128.load16_splat (local.get 0)))
+  (func (export "v128.load32_splat") (param i32) (result v128) (v128.load32_splat (local.get 0)))
+  (func (export "v128.load64_splat") (param i32) (result v128) (v128.load64_splat (local.get 0)))
+  (func (export "v128.load32_zero") (param i32) (result v128) (v128.load32_zero (local.get 0)))
+  (func (export "v128.load64_zero") (param i32) (result v128) (v128.load64_zero (local.get 0)))
+  (func (export "v128.load8_lane") (param i32 v128) (result v128) (v128.load8_lane 15 (local.get 0) (local.get 1)))
+  (func (export "v128.load16_lane") (param i32 v128) (result v128) (v128.load16_lane offset=2 0 (local.get 0) (local.get 1)))
+  (func (export "v128.load32_lane") (param i32 v128) (result v128) (v128.load32_lane align=1 2 (local.get 0) (local.get 1)))
+  (func (export "v128.load64_lane") (param i32 v128) (result v128) (v128.load64_lane 1 (local.get 0) (local.get 1)))
+
+  (func (export "v128.store") (param i32 v128) (result v128)
+    (v128.store offset=32 (local.get 0) (local.get 1))
+    (v128.load offset=32 (local.get 0))
+  )
+  (func (export "v128.store8_lane") (param i32 v128) (result i64)
+    (i64.store (i32.const 48) (i64.const 0))
+    (v128.store8_lane 3 (i32.const 48) (local.get 1))
+    (i64.load (i32.const 48))
+  )
+  (func (export "v128.store16_lane") (param v128) (result i64)
+    (i64.store (i32.const 48) (i64.const 0))
+    (v128.store16_lane offset=48 7 (i32.const 0) (local.get 0))
+    (i64.load (i32.const 48))
+  )
+  (func (export "v128.store32_lane") (param v128) (result i64)
+    (i64.store (i32.const 48) (i64.const 0))
+    (v128.store32_lane 1 (i32.const 48) (local.get 0))
+    (i64.load (i32.const 48))
+  )
+  (func (export "v128.store64_lane") (param v128) (result i64)
+    (v128.store64_lane 0 (i32.const 48) (local.get 0))
+    (i64.load (i32.const 48))
+  )
+)
+
+(assert_return (invoke "v128.load" (i32.const 0))
+  (v128.const i8x16 0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15))
+(assert_return (invoke "v128.load" (i32.const 1))
+  (v128.const i8x16 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 0x80))
+(assert_return (invoke "v128.load_offset" (i32.const 0))
+  (v128.const i8x16 0x80 0x81 0x82 0x83 0x84 0x85 0x86 0x87 0xff 0xfe 0xfd 0xfc 0xfb 0xfa 0xf9 0xf8))
+(assert_return (invoke "v128.load8x8_s" (i32.const 20))
+  (v128.const i16x8 -124 -123 -122 -121 -1 -2 -3 -4))
+(assert_return (invoke "v128.load8x8_u" (i32.const 20))
+  (v128.const i16x8 0x84 0x85 0x86 0x87 0xff 0xfe 0xfd 0xfc))
+(assert_return (invoke "v128.load16x4_s" (i32.const 22))
+  (v128.const i32x4 -30842 -257 -771 -1285))
+(assert_return (invoke "v128.load32x2_u" (i32.const 20))
+  (v128.const i64x2 0x87868584 0xfcfdfeff))
+(assert_return (invoke "v128.load8_splat" (i32.const 16))
+  (v128.const i8x16 0x80 0x80 0x80 0x80 0x80 0x80 0x80 0x80 0x80 0x80 0x80 0x80 0x80 0x80 0x80 0x80))
+(assert_return (invoke "v128.load16_splat" (i32.const 1))
+  (v128.const i16x8 0x0201 0x0201 0x0201 0x0201 0x0201 0x0201 0x0201 0x0201))
+(assert_return (invoke "v128.load32_splat" (i32.const 4))
+  (v128.const i32x4 0x07060504 0x07060504 0x07060504 0x07060504))
+(assert_return (invoke "v128.load64_splat" (i32.const 8))
+  (v128.const i64x2 0x0f0e0d0c0b0a0908 0x0f0e0d0c0b0a0908))
+(assert_return (invoke "v128.load32_zero" (i32.const 12))
+  (v128.const i32x4 0x0f0e0d0c 0 0 0))
+(assert_return (invoke "v128.load64_zero" (i32.const 0))
+  (v128.const i64x2 0x0706050403020100 0))
+(assert_return (invoke "v128.load8_lane" (i32.const 5) (v128.const i64x2 -1 -1))
+  (v128.const i8x16 -1 -1 -1 -1 -1 -1 -1 -1 -1 -1 -1 -1 -1 -1 -1 5))
+(assert_return (invoke "v128.load16_lane" (i32.const 0) (v128.const i64x2 0 0))
+  (v128.const i16x8 0x0302 0 0 0 0 0 0 0))
+(assert_return (invoke "v128.load32_lane" (i32.const 1) (v128.const i32x4 1 2 3 4))
+  (v128.const i32x4 1 2 0x04030201 4))
+(assert_return (invoke "v128.load64_lane" (i32.const 16) (v128.const i64x2 7 8))
+  (v128.const i64x2 7 0x8786858483828180))
+
+(assert_return (invoke "v128.store" (i32.const 1) (v128.const i32x4 0x11223344 0x55667788 0x99aabbcc 0xddeeff00))
+  (v128.const i32x4 0x11223344 0x55667788 0x99aabbcc 0xddeeff00))
+(assert_return (invoke "v128.store8_lane" (i32.const 0) (v128.const i8x16 0 1 2 0xab 4 5 6 7 8 9 10 11 12 13 14 15))
+  (i64.const 0xab))
+(assert_return (invoke "v128.store16_lane" (v128.const i16x8 0 1 2 3 4 5 6 0xabcd))
+  (i64.const 0xabcd))
+(assert_return (invoke "v128.store32_lane" (v128.const i32x4 0 0x89abcdef 2 3))
+  (i64.const 0x89abcdef))
+(assert_return (invoke "v128.store64_lane" (v128.const i64x2 0x0123456789abcdef 3))
+  (i64.const 0x0123456789abcdef))
+
+(assert_trap (invoke "v128.load" (i32.const 65521)) "out of bounds memory access")
+(assert_trap (invoke "v128.load" (i32.const -1)) "out of bounds memory access")
+(assert_trap (invoke "v128.load64_splat" (i32.const 65529)) "out of bounds memory access")
+(assert_trap (invoke "v128.load8_lane" (i32.const 65536) (v128.const i64x2 0 0)) "out of bounds memory access")
+(assert_trap (invoke "v128.store" (i32.const 65505) (v128.const i64x2 0 0)) "out of bounds memory access")
+(assert_return (invoke "v128.load" (i32.const 65520))
+  (v128.const i64x2 0 0))
+
+;; 位运算
+
+(module
+  (func (export "v128.not") (param v128) (result v128) (v128.not (local.get 0)))
+  (func (export "v128.and") (param v128 v128) (result v128) (v128.and (local.get 0) (local.get 1)))
+  (func (export "v128.andnot") (param v128 v128) (result v128) (v128.andnot (local.get 0) (local.get 1)))
+  (func (export "v128.or") (param v128 v128) (result v128) (v128.or (local.get 0) (local.get 1)))
+  (func (export "v128.xor") (param v128 v128) (result v128) (v128.xor (local.get 0) (local.get 1)))
+  (func (export "v128.bitselect") (param v128 v128 v128) (result v128) (v128.bitselect (local.get 0) (local.get 1) (local.get 2)))
+  (func (export "v128.any_true") (param v128) (result i32) (v128.any_true (local.get 0)))
+)
+
+(assert_return (invoke "v128.not" (v128.const i64x2 0 0x00ff00ff00ff00ff))
+  (v128.const i64x2 -1 0xff00ff00ff00ff00))
+(assert_return (invoke "v128.and"
+  (v128.const i64x2 0x0f0f0f0f0f0f0f0f 0xffff0000ffff0000)
+  (v128.const i64x2 0x00ff00ff00ff00ff 0x12345678abcdef01))
+  (v128.const i64x2 0x000f000f000f000f 0x12340000abcd0000))
+(assert_return (invoke "v128.andnot"
+  (v128.const i64x2 0x0f0f0f0f0f0f0f0f 0xffff0000ffff0000)
+  (v128.const i64x2 0x00ff00ff00ff00ff 0x12345678abcdef01))
+  (v128.const i64x2 0x0f000f000f000f00 0xedcb000054320000))
+(assert_return (invoke "v128.or"
+  (v128.const i64x2 0x0f0f0f0f0f0f0f0f 0xffff0000ffff0000)
+  (v128.const i64x2 0x00ff00ff00ff00ff 0x12345678abcdef01))
+  (v128.const i64x2 0x0fff0fff0fff0fff 0xffff5678ffffef01))
+(assert_return (invoke "v128.xor"
+  (v128.const i64x2 0x0f0f0f0f0f0f0f0f 0xffff0000ffff0000)
+  (v128.const i64x2 0x00ff00ff00ff00ff 0x12345678abcdef01))
+  (v128.const i64x2 0x0ff00ff00ff00ff0 0xedcb56785432ef01))
+(assert_return (invoke "v128.bitselect"
+  (v128.const i64x2 0xaaaaaaaaaaaaaaaa 0x1111111111111111)
+  (v128.const i64x2 0x5555555555555555 0x2222222222222222)
+  (v128.const i64x2 0xffffffff00000000 0x0f0f0f0f0f0f0f0f))
+  (v128.const i64x2 0xaaaaaaaa55555555 0x2121212121212121))
+(assert_return (invoke "v128.any_true" (v128.const i64x2 0 0)) (i32.const 0))
+(assert_return (invoke "v128.any_true" (v128.const i64x2 0 0x8000000000000000)) (i32.const 1))
+(assert_return (invoke "v128.any_true" (v128.const i8x16 0 0 0 0 0 0 0 1 0 0 0 0 0 0 0 0)) (i32.const 1))
+
+(assert_invalid
+  (module (memory 1) (func (result v128) (v128.load align=32 (i32.const 0))))
+  "alignment must not be larger than natural"
+)
+(assert_invalid
+  (module (memory 1) (func (result v128) (v128.load64_splat align=16 (i32.const 0))))
+  "alignment must not be larger than natural"
+)
+(assert_invalid
+  (module (func (result v128) (v128.load (i32.const 0))))
+  "unknown memory"
+)
+(assert_invalid
+  (module (memory 1) (func (result v128) (v128.load8_lane 16 (i32.const 0) (v128.const i64x2 0 0))))
+  "invalid lane index"
+)
+(assert_invalid
+  (module (memory 1) (func (v128.store (i32.const 0) (i64.const 0))))
+  "type mismatch"
+)
+(assert_invalid
+  (module (func (result i32) (v128.any_true (i32.const 0))))
+  "type mismatch"
+)
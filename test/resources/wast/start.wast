@@ -0,0 +1,99 @@
+;; start 函数
+
+(assert_invalid
+  (module (func) (start 1))
+  "unknown function"
+)
+(assert_invalid
+  (module (func $main (result i32) (return (i32.const 0))) (start $main))
+  "start function"
+)
+(assert_invalid
+  (module (func $main (param $a i32)) (start $main))
+  "start function"
+)
+
+(module
+  (memory (data "A"))
+  (func $inc
+    (i32.store8
+      (i32.const 0)
+      (i32.add (i32.load8_u (i32.const 0)) (i32.const 1))
+    )
+  )
+  (func $get (result i32)
+    (return (i32.load8_u (i32.const 0)))
+  )
+  (func $main
+    (call $inc)
+    (call $inc)
+    (call $inc)
+  )
+  (start $main)
+  (export "inc" (func $inc))
+  (export "get" (func $get))
+)
+(assert_return (invoke "get") (i32.const 68))
+(invoke "inc")
+(assert_return (invoke "get") (i32.const 69))
+(invoke "inc")
+(assert_return (invoke "get") (i32.const 70))
+
+(module
+  (memory (data "A"))
+  (func $inc
+    (i32.store8
+      (i32.const 0)
+      (i32.add (i32.load8_u (i32.const 0)) (i32.const 1))
+    )
+  )
+  (func $get (result i32)
+    (return (i32.load8_u (i32.const 0)))
+  )
+  (func $main
+    (call $inc)
+    (call $inc)
+    (call $inc)
+  )
+  (start 2)
+  (export "inc" (func $inc))
+  (export "get" (func $get))
+)
+(assert_return (invoke "get") (i32.const 68))
+
+;; start 函数调用导入的函数
+(module
+  (func $print_i32 (import "spectest" "print_i32") (param i32))
+  (func $main (call $print_i32 (i32.const 1)))
+  (start 1)
+)
+
+;; start 函数发生陷阱时实例化失败
+(assert_trap
+  (module (func $main (unreachable)) (start $main))
+  "unreachable"
+)
+
+;; start 函数发生陷阱之前对共享的内存和表所做的修改仍然有效
+(module $Ms
+  (memory (export "memory") 1)
+  (table (export "table") 1 funcref)
+  (func (export "get-byte") (result i32) (i32.load8_u (i32.const 0)))
+  (func (export "call") (result i32) (call_indirect (result i32) (i32.const 0)))
+)
+(register "Ms" $Ms)
+
+(assert_trap
+  (module
+    (import "Ms" "memory" (memory 1))
+    (import "Ms" "table" (table 1 funcref))
+    (data (i32.const 0) "\2a")
+    (elem (i32.const 0) $f)
+    (func $f (result i32) (i32.const 7))
+    (func $main (unreachable))
+    (start $main)
+  )
+  "unreachable"
+)
+(assert_return (invoke $Ms "get-byte") (i32.const 42))
+(assert_return (invoke $Ms "call") (i32.const 7))
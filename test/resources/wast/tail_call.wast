@@ -0,0 +1,296 @@
+;; 尾调用指令：return_call 和 return_call_indirect
+
+(module
+  (func $const-i32 (result i32) (i32.const 0x132))
+  (func $const-i64 (result i64) (i64.const 0x164))
+  (func $id-f64 (param f64) (result f64) (local.get 0))
+  (func $second (param i32 i64) (result i64) (local.get 1))
+  (func $pair (param i32 i32) (result i32 i32) (local.get 1) (local.get 0))
+
+  (func (export "type-i32") (result i32) (return_call $const-i32))
+  (func (export "type-i64") (result i64) (return_call $const-i64))
+  (func (export "type-f64") (param f64) (result f64) (return_call $id-f64 (local.get 0)))
+  (func (export "type-second") (result i64) (return_call $second (i32.const 32) (i64.const 64)))
+  (func (export "type-multi") (result i32 i32) (return_call $pair (i32.const 1) (i32.const 2)))
+
+  ;; 实参下面的操作数以及外层的结构块都会被丢弃
+  (func (export "residue") (result i64)
+    (i32.const 1)
+    (block (result i32)
+      (loop (result i32)
+        (f32.const 0)
+        (return_call $const-i64)
+      )
+    )
+    (drop)
+    (drop)
+    (i64.const -1)
+  )
+
+  (func $fac-acc (export "fac-acc") (param i64 i64) (result i64)
+    (if (result i64) (i64.eqz (local.get 0))
+      (then (local.get 1))
+      (else
+        (return_call $fac-acc
+          (i64.sub (local.get 0) (i64.const 1))
+          (i64.mul (local.get 0) (local.get 1))
+        )
+      )
+    )
+  )
+
+  (func $count (export "count") (param i64) (result i64)
+    (if (result i64) (i64.eqz (local.get 0))
+      (then (local.get 0))
+      (else (return_call $count (i64.sub (local.get 0) (i64.const 1))))
+    )
+  )
+
+  (func $even (export "even") (param i64) (result i32)
+    (if (result i32) (i64.eqz (local.get 0))
+      (then (i32.const 44))
+      (else (return_call $odd (i64.sub (local.get 0) (i64.const 1))))
+    )
+  )
+  (func $odd (export "odd") (param i64) (result i32)
+    (if (result i32) (i64.eqz (local.get 0))
+      (then (i32.const 99))
+      (else (return_call $even (i64.sub (local.get 0) (i64.const 1))))
+    )
+  )
+
+  ;; 普通的 call 指令之后的尾调用，返回值应该回到调用者
+  (func (export "nested") (param i64) (result i64)
+    (i64.add (call $count (local.get 0)) (call $fac-acc (i64.const 5) (i64.const 1)))
+  )
+)
+
+(assert_return (invoke "type-i32") (i32.const 0x132))
+(assert_return (invoke "type-i64") (i64.const 0x164))
+(assert_return (invoke "type-f64" (f64.const 3.5)) (f64.const 3.5))
+(assert_return (invoke "type-second") (i64.const 64))
+(assert_return (invoke "type-multi") (i32.const 2) (i32.const 1))
+(assert_return (invoke "residue") (i64.const 0x164))
+
+(assert_return (invoke "fac-acc" (i64.const 0) (i64.const 1)) (i64.const 1))
+(assert_return (invoke "fac-acc" (i64.const 5) (i64.const 1)) (i64.const 120))
+(assert_return (invoke "fac-acc" (i64.const 25) (i64.const 1)) (i64.const 7034535277573963776))
+
+(assert_return (invoke "count" (i64.const 0)) (i64.const 0))
+(assert_return (invoke "count" (i64.const 1000)) (i64.const 0))
+(assert_return (invoke "count" (i64.const 100000)) (i64.const 0))
+
+(assert_return (invoke "even" (i64.const 0)) (i32.const 44))
+(assert_return (invoke "even" (i64.const 1)) (i32.const 99))
+(assert_return (invoke "even" (i64.const 100000)) (i32.const 44))
+(assert_return (invoke "odd" (i64.const 77777)) (i32.const 44))
+
+(assert_return (invoke "nested" (i64.const 100000)) (i64.const 120))
+
+;; return_call_indirect
+
+(module
+  (type $out-i32 (func (result i32)))
+  (type $over-i64 (func (param i64) (result i64)))
+  (type $f32-i32 (func (param f32 i32) (result i32)))
+
+  (table funcref
+    (elem $const-i32 $id-i64 $f32-i32 $even $odd)
+  )
+
+  (func $const-i32 (type $out-i32) (i32.const 0x132))
+  (func $id-i64 (type $over-i64) (local.get 0))
+  (func $f32-i32 (type $f32-i32) (local.get 1))
+  (func $even (param i32) (result i32)
+    (if (result i32) (i32.eqz (local.get 0))
+      (then (i32.const 44))
+      (else
+        (return_call_indirect (param i32) (result i32)
+          (i32.sub (local.get 0) (i32.const 1))
+          (i32.const 4)
+        )
+      )
+    )
+  )
+  (func $odd (param i32) (result i32)
+    (if (result i32) (i32.eqz (local.get 0))
+      (then (i32.const 99))
+      (else
+        (return_call_indirect (param i32) (result i32)
+          (i32.sub (local.get 0) (i32.const 1))
+          (i32.const 3)
+        )
+      )
+    )
+  )
+
+  (func (export "type-i32") (result i32) (return_call_indirect (type $out-i32) (i32.const 0)))
+  (func (export "type-i64") (param i64) (result i64)
+    (return_call_indirect (type $over-i64) (local.get 0) (i32.const 1))
+  )
+  (func (export "type-f32-i32") (result i32)
+    (return_call_indirect (type $f32-i32) (f32.const 1) (i32.const 32) (i32.const 2))
+  )
+  (func (export "dispatch") (param i32 i64) (result i64)
+    (return_call_indirect (type $over-i64) (local.get 1) (local.get 0))
+  )
+  (func (export "even") (param i32) (result i32)
+    (return_call_indirect (param i32) (result i32) (local.get 0) (i32.const 3))
+  )
+  (func (export "even-as-i64") (param i64) (result i64)
+    (return_call_indirect (type $over-i64) (local.get 0) (i32.const 3))
+  )
+)
+
+(assert_return (invoke "type-i32") (i32.const 0x132))
+(assert_return (invoke "type-i64" (i64.const 0x164)) (i64.const 0x164))
+(assert_return (invoke "type-f32-i32") (i32.const 32))
+
+(assert_return (invoke "dispatch" (i32.const 1) (i64.const 2)) (i64.const 2))
+(assert_trap (invoke "dispatch" (i32.const 0) (i64.const 2)) "indirect call type mismatch")
+(assert_trap (invoke "dispatch" (i32.const 2) (i64.const 2)) "indirect call type mismatch")
+(assert_trap (invoke "dispatch" (i32.const 5) (i64.const 2)) "undefined element")
+(assert_trap (invoke "dispatch" (i32.const -1) (i64.const 2)) "undefined element")
+
+(assert_return (invoke "even" (i32.const 0)) (i32.const 44))
+(assert_return (invoke "even" (i32.const 1)) (i32.const 99))
+(assert_return (invoke "even" (i32.const 100001)) (i32.const 99))
+(assert_return (invoke "even" (i32.const 100000)) (i32.const 44))
+(assert_trap (invoke "even-as-i64" (i64.const 1)) "indirect call type mismatch")
+
+;; 尾调用导入的函数
+
+(module
+  (func (export "add") (param i32 i32) (result i32) (i32.add (local.get 0) (local.get 1)))
+  (func (export "pair") (param i32) (result i32 i64)
+    (local.get 0) (i64.extend_i32_u (local.get 0))
+  )
+)
+(register "M")
+
+(module
+  (func $add (import "M" "add") (param i32 i32) (result i32))
+  (func $pair (import "M" "pair") (param i32) (result i32 i64))
+  (table funcref (elem $add))
+
+  (func (export "add") (param i32) (result i32)
+    (block (result i32)
+      (i32.const 100)
+      (return_call $add (local.get 0) (i32.const 1))
+    )
+  )
+  (func (export "pair") (result i32 i64)
+    (return_call $pair (i32.const 7))
+  )
+  (func (export "add-indirect") (param i32) (result i32)
+    (return_call_indirect (param i32 i32) (result i32) (local.get 0) (i32.const 2) (i32.const 0))
+  )
+  ;; 经由普通的 call 调用，尾调用外部函数之后返回到调用者
+  (func $inner (param i32) (result i32)
+    (return_call $add (local.get 0) (local.get 0))
+  )
+  (func (export "call-inner") (param i32) (result i32)
+    (i32.mul (call $inner (local.get 0)) (i32.const 10))
+  )
+)
+
+(assert_return (invoke "add" (i32.const 41)) (i32.const 42))
+(assert_return (invoke "pair") (i32.const 7) (i64.const 7))
+(assert_return (invoke "add-indirect" (i32.const 40)) (i32.const 42))
+(assert_return (invoke "call-inner" (i32.const 3)) (i32.const 60))
+
+;; 验证
+
+(assert_invalid
+  (module
+    (func $type-void-vs-num (result i32) (return_call 1) (i32.const 0))
+    (func)
+  )
+  "type mismatch"
+)
+(assert_invalid
+  (module
+    (func $type-num-vs-num (result i32) (return_call 1))
+    (func (result i64) (i64.const 1))
+  )
+  "type mismatch"
+)
+(assert_invalid
+  (module
+    (func $arity-mismatch (result i32) (return_call 1))
+    (func (result i32 i32) (i32.const 1) (i32.const 2))
+  )
+  "type mismatch"
+)
+(assert_invalid
+  (module
+    (func $type-first-void-vs-num (return_call 1 (nop) (i32.const 1)))
+    (func (param i32 i32))
+  )
+  "type mismatch"
+)
+(assert_invalid
+  (module
+    (func $type-arg-num-vs-num (return_call 1 (f32.const 1) (i32.const 1)))
+    (func (param i32 i32))
+  )
+  "type mismatch"
+)
+(assert_invalid
+  (module (func $unbound-func (return_call 1)))
+  "unknown function"
+)
+(assert_invalid
+  (module
+    (type (func (result i64)))
+    (table 1 funcref)
+    (func $type-num-vs-num (result i32)
+      (return_call_indirect (type 0) (i32.const 0))
+    )
+  )
+  "type mismatch"
+)
+(assert_invalid
+  (module
+    (type (func))
+    (table 1 funcref)
+    (func $type-index-void-vs-i32 (return_call_indirect (type 0) (nop)))
+  )
+  "type mismatch"
+)
+(assert_invalid
+  (module
+    (type (func))
+    (func $no-table (return_call_indirect (type 0) (i32.const 0)))
+  )
+  "unknown table"
+)
+(assert_invalid
+  (module
+    (table 1 funcref)
+    (func $unbound-type (return_call_indirect (type 1) (i32.const 0)))
+  )
+  "unknown type"
+)
+
+;; 尾调用之后的指令不可达，栈是多态的
+(module
+  (func $f (result i32) (i32.const 1))
+  (func (export "polymorphic") (result i32)
+    (return_call $f)
+    (i64.add)
+    (drop)
+    (i32.const 0)
+  )
+)
+(assert_return (invoke "polymorphic") (i32.const 1))
+(assert_invalid
+  (module
+    (func $f (result i32) (i32.const 1))
+    (func (result i32)
+      (return_call $f)
+      (f32.const 0)
+    )
+  )
+  "type mismatch"
+)
@@ -0,0 +1,454 @@
+package wasm2go
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"wasmvm/binary"
+)
+
+// 翻译函数体
+//
+// 翻译时记录操作数栈的高度 h，栈高度为 h 的操作数存放在 Go 局部变量 s<h> 里，
+// 所以每条指令都翻译为对几个固定变量的读写，比如栈高度为 2 时的 i32.add 翻译为：
+//
+//	s0 = uint64(uint32(s0) + uint32(s1))
+//
+// 结构化控制指令翻译为 goto 语句：block 和 if 的标签位于块的末尾，loop 的标签位于
+// 块的开头，跳转之前先将块的结果（或者 loop 的参数）移动到块开始时的栈高度处，
+// 跳转到函数这一层（包括 return 指令）则直接返回。
+//
+// br、return 以及 unreachable 之后直到块末尾的指令是不可到达的，这些指令不生成代码，
+// 如果块的末尾也不可到达（既没有顺序执行到达，也没有跳转到达），则块之后的指令
+// 同样不生成代码，这样生成的代码不会有 Go 编译器（以及 go vet）报告的不可到达的代码，
+// 也不会有未使用的标签。
+
+type funcGen struct {
+	g          *generator
+	results    int // 函数的结果的数量
+	lines      []string
+	height     int      // 操作数栈的高度
+	labels     []*label // 当前所在的块，最后一个元素是最内层的块
+	labelCount int
+
+	stackVars []varUsage // 每个栈槽位对应的变量的使用情况
+	localVars []varUsage // 每个局部变量（包括参数）的使用情况
+}
+
+type label struct {
+	name   string
+	height int  // 块开始时的栈高度（不包括块的参数）
+	arity  int  // 跳转到标签时传递的操作数的数量，loop 为参数的数量，block 和 if 为结果的数量
+	used   bool // 是否有跳转到这个标签的指令
+}
+
+type varUsage struct {
+	written bool
+	read    bool
+}
+
+func (g *generator) genFunc(idx uint32) {
+	ft := g.module.TypeSec[g.funcTypes[idx]]
+	code := g.module.CodeSec[int(idx)-g.importCount]
+
+	f := &funcGen{g: g, results: len(ft.ResultTypes)}
+	f.localVars = make([]varUsage, len(ft.ParamTypes)+int(code.GetLocalCount()))
+
+	if f.genInstrs(code.Expr) {
+		if f.results == 0 {
+			f.emit("m.Leave()") // 函数的末尾不需要 return 语句
+		} else {
+			f.genReturn(f.results)
+		}
+	}
+
+	params := make([]string, len(ft.ParamTypes))
+	for i := range params {
+		params[i] = fmt.Sprintf("l%d uint64", i)
+	}
+
+	// 只声明用到的局部变量，只写入而没有读取的变量需要使用 `_ = x` 避免编译错误
+	var decls, unread []string
+	for i, usage := range f.localVars[len(params):] {
+		name := fmt.Sprintf("l%d", len(params)+i)
+		if usage.written || usage.read {
+			decls = append(decls, name)
+		}
+		if usage.written && !usage.read {
+			unread = append(unread, name)
+		}
+	}
+	for i, usage := range f.stackVars {
+		name := fmt.Sprintf("s%d", i)
+		if usage.written || usage.read {
+			decls = append(decls, name)
+		}
+		if usage.written && !usage.read {
+			unread = append(unread, name)
+		}
+	}
+
+	g.printf("\n%s", g.funcComment(idx))
+	g.printf("func (m *Module) f%d(%s)%s {\n", idx, strings.Join(params, ", "), u64Results(ft))
+	if len(decls) > 0 {
+		g.printf("var %s uint64\n", strings.Join(decls, ", "))
+	}
+	if len(unread) > 0 {
+		g.printf("%s = %s\n", strings.Repeat("_, ", len(unread)-1)+"_", strings.Join(unread, ", "))
+	}
+	g.printf("m.Enter()\n")
+	for _, line := range f.lines {
+		if line != "" {
+			g.printf("%s\n", line)
+		}
+	}
+	g.printf("}\n")
+}
+
+func (f *funcGen) emit(format string, a ...interface{}) {
+	line := fmt.Sprintf(format, a...)
+	if strings.Contains(line, "math.") {
+		f.g.packages["math"] = true
+	}
+	if strings.Contains(line, "bits.") {
+		f.g.packages["math/bits"] = true
+	}
+	f.lines = append(f.lines, line)
+}
+
+// 读取栈槽位 h 对应的变量
+func (f *funcGen) get(h int) string {
+	f.stackVar(h).read = true
+	return fmt.Sprintf("s%d", h)
+}
+
+// 写入栈槽位 h 对应的变量
+func (f *funcGen) set(h int) string {
+	f.stackVar(h).written = true
+	return fmt.Sprintf("s%d", h)
+}
+
+func (f *funcGen) stackVar(h int) *varUsage {
+	for len(f.stackVars) <= h {
+		f.stackVars = append(f.stackVars, varUsage{})
+	}
+	return &f.stackVars[h]
+}
+
+func (f *funcGen) getLocal(idx uint32) string {
+	f.localVars[idx].read = true
+	return fmt.Sprintf("l%d", idx)
+}
+
+func (f *funcGen) setLocal(idx uint32) string {
+	f.localVars[idx].written = true
+	return fmt.Sprintf("l%d", idx)
+}
+
+func (f *funcGen) newLabelName() string {
+	f.labelCount++
+	return fmt.Sprintf("L%d", f.labelCount)
+}
+
+// 翻译指令序列，返回指令序列的末尾是否可到达
+func (f *funcGen) genInstrs(instrs []binary.Instruction) bool {
+	for _, instr := range instrs {
+		if !f.genInstr(instr) {
+			return false
+		}
+	}
+	return true
+}
+
+// 翻译一条指令，返回指令之后的位置是否可到达
+func (f *funcGen) genInstr(instr binary.Instruction) bool {
+	opcode := instr.Opcode
+
+	if expr, ok := unaryOps[opcode]; ok {
+		if expr != "" {
+			f.unary(expr)
+		}
+		return true
+	}
+	if expr, ok := binaryOps[opcode]; ok {
+		f.binary(expr)
+		return true
+	}
+	if opcode >= binary.I32Load && opcode <= binary.I64Store32 {
+		f.genMemoryAccess(opcode, instr.Args.(binary.MemArg))
+		return true
+	}
+
+	switch opcode {
+	case binary.Unreachable:
+		f.emit("panic(instance.NewTrap(instance.TrapUnreachable))")
+		return false
+	case binary.Nop:
+	case binary.Block, binary.Loop, binary.If:
+		return f.genBlock(instr)
+	case binary.Br:
+		f.genBr(instr.Args.(uint32))
+		return false
+	case binary.BrIf:
+		f.height--
+		f.emit("if uint32(%s) != 0 {", f.get(f.height))
+		f.genBr(instr.Args.(uint32))
+		f.emit("}")
+	case binary.BrTable:
+		args := instr.Args.(binary.BrTableArgs)
+		f.height--
+		f.emit("switch uint32(%s) {", f.get(f.height))
+		for i, depth := range args.Labels {
+			f.emit("case %d:", i)
+			f.genBr(depth)
+		}
+		f.emit("default:")
+		f.genBr(args.Default)
+		f.emit("}")
+		return false
+	case binary.Return:
+		f.genReturn(f.results)
+		return false
+	case binary.Call:
+		funcIdx := instr.Args.(uint32)
+		f.genCall(fmt.Sprintf("m.f%d", funcIdx), f.g.module.TypeSec[f.g.funcTypes[funcIdx]])
+	case binary.CallIndirect:
+		args := instr.Args.(binary.CallIndirectArgs)
+		ft := f.g.module.TypeSec[args.Type]
+		checkValTypes(ft.ParamTypes)
+		checkValTypes(ft.ResultTypes)
+		f.height--
+		callee := fmt.Sprintf("m.table%d.Get(uint32(%s), sigs[%d]).(%s)",
+			args.Table, f.get(f.height), args.Type, u64FuncType(ft))
+		f.genCall(callee, ft)
+	case binary.Drop:
+		f.height--
+	case binary.Select, binary.SelectT:
+		cond, rhs := f.get(f.height-1), f.get(f.height-2)
+		f.emit("if uint32(%s) == 0 {", cond)
+		f.emit("%s = %s", f.set(f.height-3), rhs)
+		f.emit("}")
+		f.height -= 2
+	case binary.LocalGet:
+		f.push(f.getLocal(instr.Args.(uint32)))
+	case binary.LocalSet:
+		f.height--
+		f.emit("%s = %s", f.setLocal(instr.Args.(uint32)), f.get(f.height))
+	case binary.LocalTee:
+		f.emit("%s = %s", f.setLocal(instr.Args.(uint32)), f.get(f.height-1))
+	case binary.GlobalGet:
+		if idx := instr.Args.(uint32); int(idx) < len(f.g.importedGlobals) {
+			f.push(fmt.Sprintf("m.g%d.GetAsU64()", idx))
+		} else {
+			f.push(fmt.Sprintf("m.g%d", idx))
+		}
+	case binary.GlobalSet:
+		f.height--
+		if idx := instr.Args.(uint32); int(idx) < len(f.g.importedGlobals) {
+			f.emit("m.g%d.SetAsU64(%s)", idx, f.get(f.height))
+		} else {
+			f.emit("m.g%d = %s", idx, f.get(f.height))
+		}
+	case binary.I32Const:
+		f.push(fmt.Sprintf("%d", uint32(instr.Args.(int32))))
+	case binary.I64Const:
+		f.push(fmt.Sprintf("%d", uint64(instr.Args.(int64))))
+	case binary.F32Const:
+		f.push(fmt.Sprintf("%#x", math.Float32bits(instr.Args.(float32))))
+	case binary.F64Const:
+		f.push(fmt.Sprintf("%#x", math.Float64bits(instr.Args.(float64))))
+	case binary.MemorySize:
+		f.push(fmt.Sprintf("uint64(m.mem%d.Size())", instr.Args.(uint32)))
+	case binary.MemoryGrow:
+		f.unary(fmt.Sprintf("uint64(m.mem%d.Grow(uint32(%%s)))", instr.Args.(uint32)))
+	case binary.MiscPrefix:
+		f.genMisc(instr)
+	default:
+		unsupported("instruction %s", instr.GetOpname())
+	}
+	return true
+}
+
+// 将表达式的值压入操作数栈
+func (f *funcGen) push(expr string) {
+	f.emit("%s = %s", f.set(f.height), expr)
+	f.height++
+}
+
+func (f *funcGen) unary(expr string) {
+	operand := f.get(f.height - 1)
+	f.emit("%s = "+expr, f.set(f.height-1), operand)
+}
+
+func (f *funcGen) binary(expr string) {
+	lhs, rhs := f.get(f.height-2), f.get(f.height-1)
+	f.height--
+	f.emit("%s = "+expr, f.set(f.height-1), lhs, rhs)
+}
+
+// 跳转到相对深度为 depth 的标签
+func (f *funcGen) genBr(depth uint32) {
+	if int(depth) == len(f.labels) {
+		f.genReturn(f.results)
+		return
+	}
+
+	l := f.labels[len(f.labels)-1-int(depth)]
+	src := f.height - l.arity
+	for i := 0; i < l.arity; i++ {
+		if src+i != l.height+i {
+			f.emit("%s = %s", f.set(l.height+i), f.get(src+i))
+		}
+	}
+	l.used = true
+	f.emit("goto %s", l.name)
+}
+
+// 返回栈顶的 n 个操作数
+func (f *funcGen) genReturn(n int) {
+	results := make([]string, n)
+	for i := range results {
+		results[i] = f.get(f.height - n + i)
+	}
+	f.emit("m.Leave()")
+	f.emit("return %s", strings.Join(results, ", "))
+}
+
+// 调用函数，参数位于栈顶，返回值替换参数
+func (f *funcGen) genCall(callee string, ft binary.FuncType) {
+	base := f.height - len(ft.ParamTypes)
+	args := make([]string, len(ft.ParamTypes))
+	for i := range args {
+		args[i] = f.get(base + i)
+	}
+	results := make([]string, len(ft.ResultTypes))
+	for i := range results {
+		results[i] = f.set(base + i)
+	}
+
+	call := fmt.Sprintf("%s(%s)", callee, strings.Join(args, ", "))
+	if len(results) == 0 {
+		f.emit("%s", call)
+	} else {
+		f.emit("%s = %s", strings.Join(results, ", "), call)
+	}
+	f.height = base + len(results)
+}
+
+func (f *funcGen) genBlock(instr binary.Instruction) bool {
+	var bt binary.BlockType
+	switch args := instr.Args.(type) {
+	case binary.BlockArgs:
+		bt = args.BT
+	case binary.IfArgs:
+		bt = args.BT
+	}
+	ft := f.g.module.GetBlockType(bt)
+	checkValTypes(ft.ParamTypes)
+	checkValTypes(ft.ResultTypes)
+	params, results := len(ft.ParamTypes), len(ft.ResultTypes)
+
+	switch instr.Opcode {
+	case binary.Block:
+		l := f.pushLabel(f.height-params, results)
+		live := f.genInstrs(instr.Args.(binary.BlockArgs).Instrs)
+		f.popLabel()
+		if l.used {
+			f.emit("%s:", l.name)
+		}
+		f.height = l.height + results
+		return live || l.used
+
+	case binary.Loop:
+		l := f.pushLabel(f.height-params, params)
+		pos := len(f.lines)
+		f.lines = append(f.lines, "") // 标签的位置，如果有跳转到这个标签的指令则在翻译完循环体之后填入
+		live := f.genInstrs(instr.Args.(binary.BlockArgs).Instrs)
+		f.popLabel()
+		if l.used {
+			f.lines[pos] = l.name + ":"
+		}
+		f.height = l.height + results
+		return live
+
+	default: // if
+		args := instr.Args.(binary.IfArgs)
+		f.height--
+		cond := f.get(f.height)
+		l := f.pushLabel(f.height-params, results)
+
+		if len(args.Instrs2) == 0 {
+			// 没有 else 分支时，条件不成立则直接跳到末尾（参数即结果）
+			l.used = true
+			f.emit("if uint32(%s) == 0 {", cond)
+			f.emit("goto %s", l.name)
+			f.emit("}")
+			f.genInstrs(args.Instrs1)
+			f.popLabel()
+			f.emit("%s:", l.name)
+			f.height = l.height + results
+			return true
+		}
+
+		elseName := f.newLabelName()
+		f.emit("if uint32(%s) == 0 {", cond)
+		f.emit("goto %s", elseName)
+		f.emit("}")
+		if f.genInstrs(args.Instrs1) {
+			l.used = true
+			f.emit("goto %s", l.name)
+		}
+		f.emit("%s:", elseName)
+		f.height = l.height + params
+		live := f.genInstrs(args.Instrs2)
+		f.popLabel()
+		if l.used {
+			f.emit("%s:", l.name)
+		}
+		f.height = l.height + results
+		return live || l.used
+	}
+}
+
+func (f *funcGen) pushLabel(height int, arity int) *label {
+	l := &label{name: f.newLabelName(), height: height, arity: arity}
+	f.labels = append(f.labels, l)
+	return l
+}
+
+func (f *funcGen) popLabel() {
+	f.labels = f.labels[:len(f.labels)-1]
+}
+
+func (f *funcGen) genMemoryAccess(opcode byte, memArg binary.MemArg) {
+	mem := fmt.Sprintf("m.mem%d", memArg.Mem)
+	offset := uint32(memArg.Offset)
+
+	if opcode <= binary.I64Load32U {
+		f.unary(fmt.Sprintf(loadOps[opcode], mem, offset))
+		return
+	}
+
+	f.height -= 2
+	addr, val := f.get(f.height), f.get(f.height+1)
+	f.emit(storeOps[opcode], mem, addr, offset, val)
+}
+
+func (f *funcGen) genMisc(instr binary.Instruction) {
+	args := instr.Args.(binary.MiscArgs)
+	switch sub := args.SubOpcode; {
+	case sub <= binary.I64TruncSatF64U:
+		f.unary(truncSatOps[sub])
+	case sub == binary.MemoryCopy:
+		memArgs := args.Args.(binary.MemoryCopyArgs)
+		f.height -= 3
+		f.emit("m.mem%d.Copy(uint32(%s), m.mem%d, uint32(%s), uint32(%s))",
+			memArgs.Dst, f.get(f.height), memArgs.Src, f.get(f.height+1), f.get(f.height+2))
+	case sub == binary.MemoryFill:
+		f.height -= 3
+		f.emit("m.mem%d.Fill(uint32(%s), uint8(%s), uint32(%s))",
+			args.Args.(uint32), f.get(f.height), f.get(f.height+1), f.get(f.height+2))
+	default:
+		unsupported("instruction %s", instr.GetOpname())
+	}
+}
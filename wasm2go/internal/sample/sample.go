@@ -0,0 +1,699 @@
+// Code generated by wasm2go. DO NOT EDIT.
+
+package sample
+
+import (
+	"math"
+	"math/bits"
+	"wasmvm/binary"
+	"wasmvm/instance"
+	"wasmvm/wasm2go/rt"
+)
+
+var types = []binary.FuncType{
+	{Tag: binary.FtTag, ParamTypes: []binary.ValType{binary.ValTypeI32, binary.ValTypeI32}, ResultTypes: []binary.ValType{binary.ValTypeI32}},
+	{Tag: binary.FtTag},
+	{Tag: binary.FtTag, ParamTypes: []binary.ValType{binary.ValTypeI64, binary.ValTypeI64}, ResultTypes: []binary.ValType{binary.ValTypeI64}},
+	{Tag: binary.FtTag, ParamTypes: []binary.ValType{binary.ValTypeI32, binary.ValTypeI32, binary.ValTypeI32}, ResultTypes: []binary.ValType{binary.ValTypeI32}},
+	{Tag: binary.FtTag, ResultTypes: []binary.ValType{binary.ValTypeI32}},
+	{Tag: binary.FtTag, ParamTypes: []binary.ValType{binary.ValTypeI32}, ResultTypes: []binary.ValType{binary.ValTypeI64}},
+	{Tag: binary.FtTag, ParamTypes: []binary.ValType{binary.ValTypeI32}, ResultTypes: []binary.ValType{binary.ValTypeI32}},
+	{Tag: binary.FtTag, ParamTypes: []binary.ValType{binary.ValTypeI32, binary.ValTypeI32}, ResultTypes: []binary.ValType{binary.ValTypeI32, binary.ValTypeI32}},
+	{Tag: binary.FtTag, ParamTypes: []binary.ValType{binary.ValTypeI32, binary.ValTypeI32}, ResultTypes: []binary.ValType{binary.ValTypeI32, binary.ValTypeI32, binary.ValTypeI32}},
+	{Tag: binary.FtTag, ParamTypes: []binary.ValType{binary.ValTypeI32, binary.ValTypeI64, binary.ValTypeI64}, ResultTypes: []binary.ValType{binary.ValTypeI64}},
+	{Tag: binary.FtTag, ParamTypes: []binary.ValType{binary.ValTypeI32, binary.ValTypeI64}, ResultTypes: []binary.ValType{binary.ValTypeI64}},
+	{Tag: binary.FtTag, ParamTypes: []binary.ValType{binary.ValTypeI32}, ResultTypes: []binary.ValType{binary.ValTypeI32, binary.ValTypeI32}},
+	{Tag: binary.FtTag, ParamTypes: []binary.ValType{binary.ValTypeI32, binary.ValTypeI64}, ResultTypes: []binary.ValType{binary.ValTypeI32, binary.ValTypeI64, binary.ValTypeI32, binary.ValTypeI64}},
+	{Tag: binary.FtTag, ParamTypes: []binary.ValType{binary.ValTypeF32, binary.ValTypeF64}, ResultTypes: []binary.ValType{binary.ValTypeF32, binary.ValTypeF64, binary.ValTypeI32}},
+	{Tag: binary.FtTag, ParamTypes: []binary.ValType{binary.ValTypeF64}, ResultTypes: []binary.ValType{binary.ValTypeI32, binary.ValTypeI64, binary.ValTypeI32, binary.ValTypeF32}},
+}
+
+// 函数类型的编号，用于 call_indirect（见 rt.Table）
+var sigs = rt.Sigs(types)
+
+// 导入项，由嵌入方实现（导入的内存、表和全局变量在实例化时获取一次）
+type Imports interface {
+	EnvAddI32(p0 int32, p1 int32) int32 // "env" "add_i32"
+}
+
+// 从其他模块实例获取导入项，见 NewModule
+type linker struct {
+	f0 instance.Function
+}
+
+// 跟 interpreter.NewModule 相同，导入项从 mm 里的模块实例获取，
+// 找不到导入项或者类型不匹配时抛出 *instance.LinkError
+func NewModule(mm map[string]instance.Module) instance.Module {
+	return New(&linker{
+		f0: rt.LinkFunc(mm, "env", "add_i32", types[0]),
+	})
+}
+
+func (l *linker) EnvAddI32(p0 int32, p1 int32) int32 {
+	r := l.f0.Eval(p0, p1)
+	return r[0].(int32)
+}
+
+type Module struct {
+	rt.Instance
+	imports Imports
+	mem0    *rt.Memory
+	table0  *rt.Table
+	g0      uint64 // (mut i32)
+	g1      uint64 // i64
+}
+
+// 实例化模块，imports 提供导入项
+func New(imports Imports) *Module {
+	m := &Module{imports: imports}
+	m.mem0 = rt.NewMemory(binary.MemType{Tag: binary.LimitsTagMax, Min: 1, Max: 4})
+	m.table0 = rt.NewTable(binary.Limits{Min: 4})
+	m.g0 = 0x64
+	m.g1 = 0xfffffffffffffff8
+	m.table0.Init(0, []rt.Elem{{Sig: sigs[0], Func: m.f2}, {Sig: sigs[0], Func: m.f3}, {Sig: sigs[2], Func: m.f4}}...)
+	m.mem0.Write(16, []byte("Hello\x00wasm2go"))
+	m.Export("memory", m.mem0)
+	m.Export("counter", rt.NewGlobal(binary.GlobalType{ValType: binary.ValTypeI32, Mut: 1}, &m.g0))
+	m.Export("import_add", rt.NewFunc(&m.Instance, types[0], func(args []uint64) []uint64 {
+		r0 := m.f5(args[0], args[1])
+		return []uint64{r0}
+	}))
+	m.Export("call_indirect", rt.NewFunc(&m.Instance, types[3], func(args []uint64) []uint64 {
+		r0 := m.f6(args[0], args[1], args[2])
+		return []uint64{r0}
+	}))
+	m.Export("inc_counter", rt.NewFunc(&m.Instance, types[4], func(args []uint64) []uint64 {
+		r0 := m.f7()
+		return []uint64{r0}
+	}))
+	m.Export("sum", rt.NewFunc(&m.Instance, types[5], func(args []uint64) []uint64 {
+		r0 := m.f8(args[0])
+		return []uint64{r0}
+	}))
+	m.Export("fib", rt.NewFunc(&m.Instance, types[5], func(args []uint64) []uint64 {
+		r0 := m.f9(args[0])
+		return []uint64{r0}
+	}))
+	m.Export("fib_rec", rt.NewFunc(&m.Instance, types[6], func(args []uint64) []uint64 {
+		r0 := m.f10(args[0])
+		return []uint64{r0}
+	}))
+	m.Export("switch", rt.NewFunc(&m.Instance, types[6], func(args []uint64) []uint64 {
+		r0 := m.f11(args[0])
+		return []uint64{r0}
+	}))
+	m.Export("divmod", rt.NewFunc(&m.Instance, types[7], func(args []uint64) []uint64 {
+		r0, r1 := m.f12(args[0], args[1])
+		return []uint64{r0, r1}
+	}))
+	m.Export("swap_sum", rt.NewFunc(&m.Instance, types[8], func(args []uint64) []uint64 {
+		r0, r1, r2 := m.f13(args[0], args[1])
+		return []uint64{r0, r1, r2}
+	}))
+	m.Export("select", rt.NewFunc(&m.Instance, types[9], func(args []uint64) []uint64 {
+		r0 := m.f14(args[0], args[1], args[2])
+		return []uint64{r0}
+	}))
+	m.Export("max_s", rt.NewFunc(&m.Instance, types[0], func(args []uint64) []uint64 {
+		r0 := m.f15(args[0], args[1])
+		return []uint64{r0}
+	}))
+	m.Export("div_s", rt.NewFunc(&m.Instance, types[0], func(args []uint64) []uint64 {
+		r0 := m.f16(args[0], args[1])
+		return []uint64{r0}
+	}))
+	m.Export("trap_unreachable", rt.NewFunc(&m.Instance, types[4], func(args []uint64) []uint64 {
+		r0 := m.f17()
+		return []uint64{r0}
+	}))
+	m.Export("load_byte", rt.NewFunc(&m.Instance, types[6], func(args []uint64) []uint64 {
+		r0 := m.f18(args[0])
+		return []uint64{r0}
+	}))
+	m.Export("store_load", rt.NewFunc(&m.Instance, types[10], func(args []uint64) []uint64 {
+		r0 := m.f19(args[0], args[1])
+		return []uint64{r0}
+	}))
+	m.Export("grow", rt.NewFunc(&m.Instance, types[11], func(args []uint64) []uint64 {
+		r0, r1 := m.f20(args[0])
+		return []uint64{r0, r1}
+	}))
+	m.Export("fill_copy", rt.NewFunc(&m.Instance, types[6], func(args []uint64) []uint64 {
+		r0 := m.f21(args[0])
+		return []uint64{r0}
+	}))
+	m.Export("bits", rt.NewFunc(&m.Instance, types[12], func(args []uint64) []uint64 {
+		r0, r1, r2, r3 := m.f22(args[0], args[1])
+		return []uint64{r0, r1, r2, r3}
+	}))
+	m.Export("float", rt.NewFunc(&m.Instance, types[13], func(args []uint64) []uint64 {
+		r0, r1, r2 := m.f23(args[0], args[1])
+		return []uint64{r0, r1, r2}
+	}))
+	m.Export("convert", rt.NewFunc(&m.Instance, types[14], func(args []uint64) []uint64 {
+		r0, r1, r2, r3 := m.f24(args[0])
+		return []uint64{r0, r1, r2, r3}
+	}))
+	m.f1() // start
+	return m
+}
+
+// 导出的函数 "import_add"
+func (m *Module) ImportAdd(p0 int32, p1 int32) int32 {
+	defer m.Restore(m.Depth())
+	r0 := m.f5(uint64(uint32(p0)), uint64(uint32(p1)))
+	return int32(r0)
+}
+
+// 导出的函数 "call_indirect"
+func (m *Module) CallIndirect(p0 int32, p1 int32, p2 int32) int32 {
+	defer m.Restore(m.Depth())
+	r0 := m.f6(uint64(uint32(p0)), uint64(uint32(p1)), uint64(uint32(p2)))
+	return int32(r0)
+}
+
+// 导出的函数 "inc_counter"
+func (m *Module) IncCounter() int32 {
+	defer m.Restore(m.Depth())
+	r0 := m.f7()
+	return int32(r0)
+}
+
+// 导出的函数 "sum"
+func (m *Module) Sum(p0 int32) int64 {
+	defer m.Restore(m.Depth())
+	r0 := m.f8(uint64(uint32(p0)))
+	return int64(r0)
+}
+
+// 导出的函数 "fib"
+func (m *Module) Fib(p0 int32) int64 {
+	defer m.Restore(m.Depth())
+	r0 := m.f9(uint64(uint32(p0)))
+	return int64(r0)
+}
+
+// 导出的函数 "fib_rec"
+func (m *Module) FibRec(p0 int32) int32 {
+	defer m.Restore(m.Depth())
+	r0 := m.f10(uint64(uint32(p0)))
+	return int32(r0)
+}
+
+// 导出的函数 "switch"
+func (m *Module) Switch(p0 int32) int32 {
+	defer m.Restore(m.Depth())
+	r0 := m.f11(uint64(uint32(p0)))
+	return int32(r0)
+}
+
+// 导出的函数 "divmod"
+func (m *Module) Divmod(p0 int32, p1 int32) (int32, int32) {
+	defer m.Restore(m.Depth())
+	r0, r1 := m.f12(uint64(uint32(p0)), uint64(uint32(p1)))
+	return int32(r0), int32(r1)
+}
+
+// 导出的函数 "swap_sum"
+func (m *Module) SwapSum(p0 int32, p1 int32) (int32, int32, int32) {
+	defer m.Restore(m.Depth())
+	r0, r1, r2 := m.f13(uint64(uint32(p0)), uint64(uint32(p1)))
+	return int32(r0), int32(r1), int32(r2)
+}
+
+// 导出的函数 "select"
+func (m *Module) Select(p0 int32, p1 int64, p2 int64) int64 {
+	defer m.Restore(m.Depth())
+	r0 := m.f14(uint64(uint32(p0)), uint64(p1), uint64(p2))
+	return int64(r0)
+}
+
+// 导出的函数 "max_s"
+func (m *Module) MaxS(p0 int32, p1 int32) int32 {
+	defer m.Restore(m.Depth())
+	r0 := m.f15(uint64(uint32(p0)), uint64(uint32(p1)))
+	return int32(r0)
+}
+
+// 导出的函数 "div_s"
+func (m *Module) DivS(p0 int32, p1 int32) int32 {
+	defer m.Restore(m.Depth())
+	r0 := m.f16(uint64(uint32(p0)), uint64(uint32(p1)))
+	return int32(r0)
+}
+
+// 导出的函数 "trap_unreachable"
+func (m *Module) TrapUnreachable() int32 {
+	defer m.Restore(m.Depth())
+	r0 := m.f17()
+	return int32(r0)
+}
+
+// 导出的函数 "load_byte"
+func (m *Module) LoadByte(p0 int32) int32 {
+	defer m.Restore(m.Depth())
+	r0 := m.f18(uint64(uint32(p0)))
+	return int32(r0)
+}
+
+// 导出的函数 "store_load"
+func (m *Module) StoreLoad(p0 int32, p1 int64) int64 {
+	defer m.Restore(m.Depth())
+	r0 := m.f19(uint64(uint32(p0)), uint64(p1))
+	return int64(r0)
+}
+
+// 导出的函数 "grow"
+func (m *Module) Grow(p0 int32) (int32, int32) {
+	defer m.Restore(m.Depth())
+	r0, r1 := m.f20(uint64(uint32(p0)))
+	return int32(r0), int32(r1)
+}
+
+// 导出的函数 "fill_copy"
+func (m *Module) FillCopy(p0 int32) int32 {
+	defer m.Restore(m.Depth())
+	r0 := m.f21(uint64(uint32(p0)))
+	return int32(r0)
+}
+
+// 导出的函数 "bits"
+func (m *Module) Bits(p0 int32, p1 int64) (int32, int64, int32, int64) {
+	defer m.Restore(m.Depth())
+	r0, r1, r2, r3 := m.f22(uint64(uint32(p0)), uint64(p1))
+	return int32(r0), int64(r1), int32(r2), int64(r3)
+}
+
+// 导出的函数 "float"
+func (m *Module) Float(p0 float32, p1 float64) (float32, float64, int32) {
+	defer m.Restore(m.Depth())
+	r0, r1, r2 := m.f23(rt.F32Bits(p0), rt.F64Bits(p1))
+	return rt.F32(r0), rt.F64(r1), int32(r2)
+}
+
+// 导出的函数 "convert"
+func (m *Module) Convert(p0 float64) (int32, int64, int32, float32) {
+	defer m.Restore(m.Depth())
+	r0, r1, r2, r3 := m.f24(rt.F64Bits(p0))
+	return int32(r0), int64(r1), int32(r2), rt.F32(r3)
+}
+
+// func 0 <add_i32>
+func (m *Module) f0(l0 uint64, l1 uint64) uint64 {
+	r0 := m.imports.EnvAddI32(int32(l0), int32(l1))
+	return uint64(uint32(r0))
+}
+
+// func 1 <init>
+func (m *Module) f1() {
+	var s0, s1 uint64
+	m.Enter()
+	s0 = m.g0
+	s1 = 1
+	s0 = uint64(uint32(s0) + uint32(s1))
+	m.g0 = s0
+	m.Leave()
+}
+
+// func 2 <add>
+func (m *Module) f2(l0 uint64, l1 uint64) uint64 {
+	var s0, s1 uint64
+	m.Enter()
+	s0 = l0
+	s1 = l1
+	s0 = uint64(uint32(s0) + uint32(s1))
+	m.Leave()
+	return s0
+}
+
+// func 3 <sub>
+func (m *Module) f3(l0 uint64, l1 uint64) uint64 {
+	var s0, s1 uint64
+	m.Enter()
+	s0 = l0
+	s1 = l1
+	s0 = uint64(uint32(s0) - uint32(s1))
+	m.Leave()
+	return s0
+}
+
+// func 4 <sub_i64>
+func (m *Module) f4(l0 uint64, l1 uint64) uint64 {
+	var s0, s1 uint64
+	m.Enter()
+	s0 = l0
+	s1 = l1
+	s0 = s0 - s1
+	m.Leave()
+	return s0
+}
+
+// func 5
+func (m *Module) f5(l0 uint64, l1 uint64) uint64 {
+	var s0, s1 uint64
+	m.Enter()
+	s0 = l0
+	s1 = l1
+	s0 = m.f0(s0, s1)
+	m.Leave()
+	return s0
+}
+
+// func 6
+func (m *Module) f6(l0 uint64, l1 uint64, l2 uint64) uint64 {
+	var s0, s1, s2 uint64
+	m.Enter()
+	s0 = l1
+	s1 = l2
+	s2 = l0
+	s0 = m.table0.Get(uint32(s2), sigs[0]).(func(uint64, uint64) uint64)(s0, s1)
+	m.Leave()
+	return s0
+}
+
+// func 7
+func (m *Module) f7() uint64 {
+	var s0, s1 uint64
+	m.Enter()
+	s0 = m.g0
+	s1 = 1
+	s0 = uint64(uint32(s0) + uint32(s1))
+	m.g0 = s0
+	s0 = m.g0
+	m.Leave()
+	return s0
+}
+
+// func 8
+func (m *Module) f8(l0 uint64) uint64 {
+	var l1, s0, s1 uint64
+	m.Enter()
+L2:
+	s0 = l0
+	s0 = rt.Bool(uint32(s0) == 0)
+	if uint32(s0) != 0 {
+		goto L1
+	}
+	s0 = l1
+	s1 = l0
+	s1 = uint64(uint32(s1))
+	s0 = s0 + s1
+	l1 = s0
+	s0 = l0
+	s1 = 1
+	s0 = uint64(uint32(s0) - uint32(s1))
+	l0 = s0
+	goto L2
+L1:
+	s0 = l1
+	m.Leave()
+	return s0
+}
+
+// func 9
+func (m *Module) f9(l0 uint64) uint64 {
+	var l1, l2, l3, s0, s1 uint64
+	m.Enter()
+	s0 = 1
+	l2 = s0
+L2:
+	s0 = l0
+	s0 = rt.Bool(uint32(s0) == 0)
+	if uint32(s0) != 0 {
+		goto L1
+	}
+	s0 = l1
+	s1 = l2
+	s0 = s0 + s1
+	l3 = s0
+	s0 = l2
+	l1 = s0
+	s0 = l3
+	l2 = s0
+	s0 = l0
+	s1 = 1
+	s0 = uint64(uint32(s0) - uint32(s1))
+	l0 = s0
+	goto L2
+L1:
+	s0 = l1
+	m.Leave()
+	return s0
+}
+
+// func 10 <fib_rec>
+func (m *Module) f10(l0 uint64) uint64 {
+	var s0, s1, s2 uint64
+	m.Enter()
+	s0 = l0
+	s1 = 2
+	s0 = rt.Bool(uint32(s0) < uint32(s1))
+	if uint32(s0) == 0 {
+		goto L2
+	}
+	s0 = l0
+	goto L1
+L2:
+	s0 = l0
+	s1 = 1
+	s0 = uint64(uint32(s0) - uint32(s1))
+	s0 = m.f10(s0)
+	s1 = l0
+	s2 = 2
+	s1 = uint64(uint32(s1) - uint32(s2))
+	s1 = m.f10(s1)
+	s0 = uint64(uint32(s0) + uint32(s1))
+L1:
+	m.Leave()
+	return s0
+}
+
+// func 11
+func (m *Module) f11(l0 uint64) uint64 {
+	var s0 uint64
+	m.Enter()
+	s0 = l0
+	switch uint32(s0) {
+	case 0:
+		goto L4
+	case 1:
+		goto L3
+	case 2:
+		goto L2
+	default:
+		goto L1
+	}
+L4:
+	s0 = 10
+	m.Leave()
+	return s0
+L3:
+	s0 = 20
+	m.Leave()
+	return s0
+L2:
+	s0 = 30
+	m.Leave()
+	return s0
+L1:
+	s0 = 40
+	m.Leave()
+	return s0
+}
+
+// func 12 <divmod>
+func (m *Module) f12(l0 uint64, l1 uint64) (uint64, uint64) {
+	var s0, s1, s2 uint64
+	m.Enter()
+	s0 = l0
+	s1 = l1
+	s0 = uint64(rt.I32DivU(uint32(s0), uint32(s1)))
+	s1 = l0
+	s2 = l1
+	s1 = uint64(rt.I32RemU(uint32(s1), uint32(s2)))
+	m.Leave()
+	return s0, s1
+}
+
+// func 13
+func (m *Module) f13(l0 uint64, l1 uint64) (uint64, uint64, uint64) {
+	var s0, s1, s2, s3 uint64
+	m.Enter()
+	s0 = l0
+	s1 = l1
+	l0 = s1
+	l1 = s0
+	s0 = l0
+	s1 = l1
+	s2 = l0
+	s3 = l1
+	s2 = uint64(uint32(s2) + uint32(s3))
+	m.Leave()
+	return s0, s1, s2
+}
+
+// func 14
+func (m *Module) f14(l0 uint64, l1 uint64, l2 uint64) uint64 {
+	var s0, s1, s2 uint64
+	m.Enter()
+	s0 = l1
+	s1 = l2
+	s2 = l0
+	if uint32(s2) == 0 {
+		s0 = s1
+	}
+	m.Leave()
+	return s0
+}
+
+// func 15
+func (m *Module) f15(l0 uint64, l1 uint64) uint64 {
+	var s0, s1 uint64
+	m.Enter()
+	s0 = l0
+	s1 = l1
+	s0 = rt.Bool(int32(s0) > int32(s1))
+	if uint32(s0) == 0 {
+		goto L1
+	}
+	s0 = l0
+	m.Leave()
+	return s0
+L1:
+	s0 = l1
+	m.Leave()
+	return s0
+}
+
+// func 16
+func (m *Module) f16(l0 uint64, l1 uint64) uint64 {
+	var s0, s1 uint64
+	m.Enter()
+	s0 = l0
+	s1 = l1
+	s0 = uint64(uint32(rt.I32DivS(int32(s0), int32(s1))))
+	m.Leave()
+	return s0
+}
+
+// func 17
+func (m *Module) f17() uint64 {
+	m.Enter()
+	panic(instance.NewTrap(instance.TrapUnreachable))
+}
+
+// func 18
+func (m *Module) f18(l0 uint64) uint64 {
+	var s0 uint64
+	m.Enter()
+	s0 = l0
+	s0 = uint64(m.mem0.Load8(uint32(s0), 0))
+	m.Leave()
+	return s0
+}
+
+// func 19
+func (m *Module) f19(l0 uint64, l1 uint64) uint64 {
+	var s0, s1 uint64
+	m.Enter()
+	s0 = l0
+	s1 = l1
+	m.mem0.Store64(uint32(s0), 8, s1)
+	s0 = l0
+	s0 = uint64(int64(int32(m.mem0.Load32(uint32(s0), 8))))
+	s1 = l0
+	s1 = uint64(m.mem0.Load16(uint32(s1), 12))
+	s0 = s0 + s1
+	m.Leave()
+	return s0
+}
+
+// func 20
+func (m *Module) f20(l0 uint64) (uint64, uint64) {
+	var s0, s1 uint64
+	m.Enter()
+	s0 = l0
+	s0 = uint64(m.mem0.Grow(uint32(s0)))
+	s1 = uint64(m.mem0.Size())
+	m.Leave()
+	return s0, s1
+}
+
+// func 21
+func (m *Module) f21(l0 uint64) uint64 {
+	var s0, s1, s2 uint64
+	m.Enter()
+	s0 = 256
+	s1 = l0
+	s2 = 8
+	m.mem0.Fill(uint32(s0), uint8(s1), uint32(s2))
+	s0 = 300
+	s1 = 252
+	s2 = 8
+	m.mem0.Copy(uint32(s0), m.mem0, uint32(s1), uint32(s2))
+	s0 = 302
+	s0 = uint64(m.mem0.Load32(uint32(s0), 0))
+	m.Leave()
+	return s0
+}
+
+// func 22
+func (m *Module) f22(l0 uint64, l1 uint64) (uint64, uint64, uint64, uint64) {
+	var s0, s1, s2, s3 uint64
+	m.Enter()
+	s0 = l0
+	s0 = uint64(bits.LeadingZeros32(uint32(s0)))
+	s1 = l0
+	s1 = uint64(bits.OnesCount32(uint32(s1)))
+	s0 = uint64(bits.RotateLeft32(uint32(s0), int(uint32(s1))))
+	s1 = l1
+	s2 = m.g1
+	s1 = s1 + s2
+	s2 = 65
+	s1 = uint64(int64(s1) >> (s2 % 64))
+	s2 = l0
+	s2 = uint64(uint32(int32(int8(s2))))
+	s3 = l1
+	s3 = uint64(int64(int32(s3)))
+	m.Leave()
+	return s0, s1, s2, s3
+}
+
+// func 23
+func (m *Module) f23(l0 uint64, l1 uint64) (uint64, uint64, uint64) {
+	var s0, s1, s2, s3 uint64
+	m.Enter()
+	s0 = l0
+	s1 = 0x40200000
+	s0 = rt.F32Bits(rt.F32(s0) * rt.F32(s1))
+	s0 = rt.F32Bits(float32(math.Sqrt(float64(rt.F32(s0)))))
+	s1 = l1
+	s2 = l0
+	s2 = rt.F64Bits(float64(rt.F32(s2)))
+	s1 = rt.F64Bits(rt.F64Min(rt.F64(s1), rt.F64(s2)))
+	s1 = rt.F64Bits(math.RoundToEven(rt.F64(s1)))
+	s2 = l1
+	s3 = 0xbff0000000000000
+	s2 = rt.F64Bits(math.Copysign(rt.F64(s2), rt.F64(s3)))
+	s3 = 0x0
+	s2 = rt.Bool(rt.F64(s2) < rt.F64(s3))
+	m.Leave()
+	return s0, s1, s2
+}
+
+// func 24
+func (m *Module) f24(l0 uint64) (uint64, uint64, uint64, uint64) {
+	var s0, s1, s2, s3 uint64
+	m.Enter()
+	s0 = l0
+	s0 = uint64(uint32(int32(rt.TruncSatS(rt.F64(s0), 32))))
+	s1 = l0
+	s1 = rt.TruncU64(rt.F64(s1))
+	s2 = l0
+	s2 = rt.F32Bits(float32(rt.F64(s2)))
+	s3 = l0
+	s3 = uint64(rt.TruncS64(rt.F64(s3)))
+	s3 = rt.F32Bits(float32(int64(s3)))
+	m.Leave()
+	return s0, s1, s2, s3
+}
@@ -0,0 +1,195 @@
+package wasm2go
+
+import "wasmvm/binary"
+
+// 数值指令以及内存指令对应的 Go 表达式
+//
+// 表达式里的 %s 依次是操作数对应的变量，i32 的值存放在 uint64 的低 32 位（高 32 位为 0），
+// 所以运算之前先转换为 uint32（或者 int32），运算之后再转换回 uint64。
+// 表达式的语义跟解释器的对应指令相同（见 interpreter/inst_numeric_*.go）。
+
+// 一元运算（包括 eqz、类型转换），值为空字符串的指令不需要生成代码（比如 reinterpret）
+var unaryOps = map[byte]string{
+	binary.I32Eqz: "rt.Bool(uint32(%s) == 0)",
+	binary.I64Eqz: "rt.Bool(%s == 0)",
+
+	binary.I32Clz:    "uint64(bits.LeadingZeros32(uint32(%s)))",
+	binary.I32Ctz:    "uint64(bits.TrailingZeros32(uint32(%s)))",
+	binary.I32PopCnt: "uint64(bits.OnesCount32(uint32(%s)))",
+	binary.I64Clz:    "uint64(bits.LeadingZeros64(%s))",
+	binary.I64Ctz:    "uint64(bits.TrailingZeros64(%s))",
+	binary.I64PopCnt: "uint64(bits.OnesCount64(%s))",
+
+	// 直接清除符号位，原因见 interpreter 的 f32Abs
+	binary.F32Abs:     "uint64(uint32(%s) &^ (1 << 31))",
+	binary.F32Neg:     "rt.F32Bits(-rt.F32(%s))",
+	binary.F32Ceil:    "rt.F32Bits(float32(math.Ceil(float64(rt.F32(%s)))))",
+	binary.F32Floor:   "rt.F32Bits(float32(math.Floor(float64(rt.F32(%s)))))",
+	binary.F32Trunc:   "rt.F32Bits(float32(math.Trunc(float64(rt.F32(%s)))))",
+	binary.F32Nearest: "rt.F32Bits(float32(math.RoundToEven(float64(rt.F32(%s)))))",
+	binary.F32Sqrt:    "rt.F32Bits(float32(math.Sqrt(float64(rt.F32(%s)))))",
+	binary.F64Abs:     "rt.F64Bits(math.Abs(rt.F64(%s)))",
+	binary.F64Neg:     "rt.F64Bits(-rt.F64(%s))",
+	binary.F64Ceil:    "rt.F64Bits(math.Ceil(rt.F64(%s)))",
+	binary.F64Floor:   "rt.F64Bits(math.Floor(rt.F64(%s)))",
+	binary.F64Trunc:   "rt.F64Bits(math.Trunc(rt.F64(%s)))",
+	binary.F64Nearest: "rt.F64Bits(math.RoundToEven(rt.F64(%s)))",
+	binary.F64Sqrt:    "rt.F64Bits(math.Sqrt(rt.F64(%s)))",
+
+	binary.I32WrapI64:        "uint64(uint32(%s))",
+	binary.I32TruncF32S:      "uint64(uint32(rt.TruncS32(float64(rt.F32(%s)))))",
+	binary.I32TruncF32U:      "uint64(rt.TruncU32(float64(rt.F32(%s))))",
+	binary.I32TruncF64S:      "uint64(uint32(rt.TruncS32(rt.F64(%s))))",
+	binary.I32TruncF64U:      "uint64(rt.TruncU32(rt.F64(%s)))",
+	binary.I64ExtendI32S:     "uint64(int64(int32(%s)))",
+	binary.I64ExtendI32U:     "uint64(uint32(%s))",
+	binary.I64TruncF32S:      "uint64(rt.TruncS64(float64(rt.F32(%s))))",
+	binary.I64TruncF32U:      "rt.TruncU64(float64(rt.F32(%s)))",
+	binary.I64TruncF64S:      "uint64(rt.TruncS64(rt.F64(%s)))",
+	binary.I64TruncF64U:      "rt.TruncU64(rt.F64(%s))",
+	binary.F32ConvertI32S:    "rt.F32Bits(float32(int32(%s)))",
+	binary.F32ConvertI32U:    "rt.F32Bits(float32(uint32(%s)))",
+	binary.F32ConvertI64S:    "rt.F32Bits(float32(int64(%s)))",
+	binary.F32ConvertI64U:    "rt.F32Bits(float32(%s))",
+	binary.F32DemoteF64:      "rt.F32Bits(float32(rt.F64(%s)))",
+	binary.F64ConvertI32S:    "rt.F64Bits(float64(int32(%s)))",
+	binary.F64ConvertI32U:    "rt.F64Bits(float64(uint32(%s)))",
+	binary.F64ConvertI64S:    "rt.F64Bits(float64(int64(%s)))",
+	binary.F64ConvertI64U:    "rt.F64Bits(float64(%s))",
+	binary.F64PromoteF32:     "rt.F64Bits(float64(rt.F32(%s)))",
+	binary.I32ReinterpretF32: "",
+	binary.I64ReinterpretF64: "",
+	binary.F32ReinterpretI32: "",
+	binary.F64ReinterpretI64: "",
+	binary.I32Extend8S:       "uint64(uint32(int32(int8(%s))))",
+	binary.I32Extend16S:      "uint64(uint32(int32(int16(%s))))",
+	binary.I64Extend8S:       "uint64(int64(int8(%s)))",
+	binary.I64Extend16S:      "uint64(int64(int16(%s)))",
+	binary.I64Extend32S:      "uint64(int64(int32(%s)))",
+}
+
+// 饱和截断指令，按子操作码排列
+var truncSatOps = []string{
+	binary.I32TruncSatF32S: "uint64(uint32(int32(rt.TruncSatS(float64(rt.F32(%s)), 32))))",
+	binary.I32TruncSatF32U: "uint64(uint32(rt.TruncSatU(float64(rt.F32(%s)), 32)))",
+	binary.I32TruncSatF64S: "uint64(uint32(int32(rt.TruncSatS(rt.F64(%s), 32))))",
+	binary.I32TruncSatF64U: "uint64(uint32(rt.TruncSatU(rt.F64(%s), 32)))",
+	binary.I64TruncSatF32S: "uint64(rt.TruncSatS(float64(rt.F32(%s)), 64))",
+	binary.I64TruncSatF32U: "rt.TruncSatU(float64(rt.F32(%s)), 64)",
+	binary.I64TruncSatF64S: "uint64(rt.TruncSatS(rt.F64(%s), 64))",
+	binary.I64TruncSatF64U: "rt.TruncSatU(rt.F64(%s), 64)",
+}
+
+// 二元运算（包括比较），两个 %s 依次是左操作数和右操作数
+var binaryOps = map[byte]string{
+	binary.I32Eq:  "rt.Bool(uint32(%s) == uint32(%s))",
+	binary.I32Ne:  "rt.Bool(uint32(%s) != uint32(%s))",
+	binary.I32LtS: "rt.Bool(int32(%s) < int32(%s))",
+	binary.I32LtU: "rt.Bool(uint32(%s) < uint32(%s))",
+	binary.I32GtS: "rt.Bool(int32(%s) > int32(%s))",
+	binary.I32GtU: "rt.Bool(uint32(%s) > uint32(%s))",
+	binary.I32LeS: "rt.Bool(int32(%s) <= int32(%s))",
+	binary.I32LeU: "rt.Bool(uint32(%s) <= uint32(%s))",
+	binary.I32GeS: "rt.Bool(int32(%s) >= int32(%s))",
+	binary.I32GeU: "rt.Bool(uint32(%s) >= uint32(%s))",
+	binary.I64Eq:  "rt.Bool(%s == %s)",
+	binary.I64Ne:  "rt.Bool(%s != %s)",
+	binary.I64LtS: "rt.Bool(int64(%s) < int64(%s))",
+	binary.I64LtU: "rt.Bool(%s < %s)",
+	binary.I64GtS: "rt.Bool(int64(%s) > int64(%s))",
+	binary.I64GtU: "rt.Bool(%s > %s)",
+	binary.I64LeS: "rt.Bool(int64(%s) <= int64(%s))",
+	binary.I64LeU: "rt.Bool(%s <= %s)",
+	binary.I64GeS: "rt.Bool(int64(%s) >= int64(%s))",
+	binary.I64GeU: "rt.Bool(%s >= %s)",
+	binary.F32Eq:  "rt.Bool(rt.F32(%s) == rt.F32(%s))",
+	binary.F32Ne:  "rt.Bool(rt.F32(%s) != rt.F32(%s))",
+	binary.F32Lt:  "rt.Bool(rt.F32(%s) < rt.F32(%s))",
+	binary.F32Gt:  "rt.Bool(rt.F32(%s) > rt.F32(%s))",
+	binary.F32Le:  "rt.Bool(rt.F32(%s) <= rt.F32(%s))",
+	binary.F32Ge:  "rt.Bool(rt.F32(%s) >= rt.F32(%s))",
+	binary.F64Eq:  "rt.Bool(rt.F64(%s) == rt.F64(%s))",
+	binary.F64Ne:  "rt.Bool(rt.F64(%s) != rt.F64(%s))",
+	binary.F64Lt:  "rt.Bool(rt.F64(%s) < rt.F64(%s))",
+	binary.F64Gt:  "rt.Bool(rt.F64(%s) > rt.F64(%s))",
+	binary.F64Le:  "rt.Bool(rt.F64(%s) <= rt.F64(%s))",
+	binary.F64Ge:  "rt.Bool(rt.F64(%s) >= rt.F64(%s))",
+
+	binary.I32Add:  "uint64(uint32(%s) + uint32(%s))",
+	binary.I32Sub:  "uint64(uint32(%s) - uint32(%s))",
+	binary.I32Mul:  "uint64(uint32(%s) * uint32(%s))",
+	binary.I32DivS: "uint64(uint32(rt.I32DivS(int32(%s), int32(%s))))",
+	binary.I32DivU: "uint64(rt.I32DivU(uint32(%s), uint32(%s)))",
+	binary.I32RemS: "uint64(uint32(rt.I32RemS(int32(%s), int32(%s))))",
+	binary.I32RemU: "uint64(rt.I32RemU(uint32(%s), uint32(%s)))",
+	binary.I32And:  "%s & %s",
+	binary.I32Or:   "%s | %s",
+	binary.I32Xor:  "%s ^ %s",
+	binary.I32Shl:  "uint64(uint32(%s) << (uint32(%s) %% 32))",
+	binary.I32ShrS: "uint64(uint32(int32(%s) >> (uint32(%s) %% 32)))",
+	binary.I32ShrU: "uint64(uint32(%s) >> (uint32(%s) %% 32))",
+	binary.I32Rotl: "uint64(bits.RotateLeft32(uint32(%s), int(uint32(%s))))",
+	binary.I32Rotr: "uint64(bits.RotateLeft32(uint32(%s), -int(uint32(%s))))",
+
+	binary.I64Add:  "%s + %s",
+	binary.I64Sub:  "%s - %s",
+	binary.I64Mul:  "%s * %s",
+	binary.I64DivS: "uint64(rt.I64DivS(int64(%s), int64(%s)))",
+	binary.I64DivU: "rt.I64DivU(%s, %s)",
+	binary.I64RemS: "uint64(rt.I64RemS(int64(%s), int64(%s)))",
+	binary.I64RemU: "rt.I64RemU(%s, %s)",
+	binary.I64And:  "%s & %s",
+	binary.I64Or:   "%s | %s",
+	binary.I64Xor:  "%s ^ %s",
+	binary.I64Shl:  "%s << (%s %% 64)",
+	binary.I64ShrS: "uint64(int64(%s) >> (%s %% 64))",
+	binary.I64ShrU: "%s >> (%s %% 64)",
+	binary.I64Rotl: "bits.RotateLeft64(%s, int(%s))",
+	binary.I64Rotr: "bits.RotateLeft64(%s, -int(%s))",
+
+	binary.F32Add:      "rt.F32Bits(rt.F32(%s) + rt.F32(%s))",
+	binary.F32Sub:      "rt.F32Bits(rt.F32(%s) - rt.F32(%s))",
+	binary.F32Mul:      "rt.F32Bits(rt.F32(%s) * rt.F32(%s))",
+	binary.F32Div:      "rt.F32Bits(rt.F32(%s) / rt.F32(%s))",
+	binary.F32Min:      "rt.F32Bits(rt.F32Min(rt.F32(%s), rt.F32(%s)))",
+	binary.F32Max:      "rt.F32Bits(rt.F32Max(rt.F32(%s), rt.F32(%s)))",
+	binary.F32CopySign: "uint64(uint32(%s)&^(1<<31) | uint32(%s)&(1<<31))",
+	binary.F64Add:      "rt.F64Bits(rt.F64(%s) + rt.F64(%s))",
+	binary.F64Sub:      "rt.F64Bits(rt.F64(%s) - rt.F64(%s))",
+	binary.F64Mul:      "rt.F64Bits(rt.F64(%s) * rt.F64(%s))",
+	binary.F64Div:      "rt.F64Bits(rt.F64(%s) / rt.F64(%s))",
+	binary.F64Min:      "rt.F64Bits(rt.F64Min(rt.F64(%s), rt.F64(%s)))",
+	binary.F64Max:      "rt.F64Bits(rt.F64Max(rt.F64(%s), rt.F64(%s)))",
+	binary.F64CopySign: "rt.F64Bits(math.Copysign(rt.F64(%s), rt.F64(%s)))",
+}
+
+// 加载指令，参数依次是内存、地址和偏移值
+var loadOps = map[byte]string{
+	binary.I32Load:    "uint64(%s.Load32(uint32(%%s), %d))",
+	binary.I64Load:    "%s.Load64(uint32(%%s), %d)",
+	binary.F32Load:    "uint64(%s.Load32(uint32(%%s), %d))",
+	binary.F64Load:    "%s.Load64(uint32(%%s), %d)",
+	binary.I32Load8S:  "uint64(uint32(int32(int8(%s.Load8(uint32(%%s), %d)))))",
+	binary.I32Load8U:  "uint64(%s.Load8(uint32(%%s), %d))",
+	binary.I32Load16S: "uint64(uint32(int32(int16(%s.Load16(uint32(%%s), %d)))))",
+	binary.I32Load16U: "uint64(%s.Load16(uint32(%%s), %d))",
+	binary.I64Load8S:  "uint64(int64(int8(%s.Load8(uint32(%%s), %d))))",
+	binary.I64Load8U:  "uint64(%s.Load8(uint32(%%s), %d))",
+	binary.I64Load16S: "uint64(int64(int16(%s.Load16(uint32(%%s), %d))))",
+	binary.I64Load16U: "uint64(%s.Load16(uint32(%%s), %d))",
+	binary.I64Load32S: "uint64(int64(int32(%s.Load32(uint32(%%s), %d))))",
+	binary.I64Load32U: "uint64(%s.Load32(uint32(%%s), %d))",
+}
+
+// 存储指令，参数依次是内存、地址、偏移值和被存储的值
+var storeOps = map[byte]string{
+	binary.I32Store:   "%s.Store32(uint32(%s), %d, uint32(%s))",
+	binary.I64Store:   "%s.Store64(uint32(%s), %d, %s)",
+	binary.F32Store:   "%s.Store32(uint32(%s), %d, uint32(%s))",
+	binary.F64Store:   "%s.Store64(uint32(%s), %d, %s)",
+	binary.I32Store8:  "%s.Store8(uint32(%s), %d, uint8(%s))",
+	binary.I32Store16: "%s.Store16(uint32(%s), %d, uint16(%s))",
+	binary.I64Store8:  "%s.Store8(uint32(%s), %d, uint8(%s))",
+	binary.I64Store16: "%s.Store16(uint32(%s), %d, uint16(%s))",
+	binary.I64Store32: "%s.Store32(uint32(%s), %d, uint32(%s))",
+}
@@ -0,0 +1,171 @@
+package rt
+
+import (
+	"context"
+	"errors"
+	"wasmvm/binary"
+	"wasmvm/instance"
+)
+
+// wasm2go 生成的 Go 包所使用的运行时
+//
+// 生成的模块（即 Go 包里的 Module 结构体）内嵌了 Instance，所以它实现了
+// instance.Module 接口，可以跟解释器实例化的模块互相导入导出项：
+// - 导出的函数是 *Func，参数和返回值跟解释器一样是 int32/int64/float32/float64；
+// - 导出的内存是 *Memory，导出的全局变量是 *Global，它们直接读写 Module 结构体的字段；
+// - 导入项由生成的 Imports 接口提供，NewModule 则通过 LinkFunc 等函数从其他模块实例获取（见 link.go）；
+// - 生成的函数内部统一使用 uint64 表示 4 种数值类型的值（跟解释器的操作数栈相同）。
+//
+// 生成的代码没有解释器的调用帧，所以陷阱（*instance.Trap）不包含调用栈（Backtrace）。
+
+// 调用栈的最大深度，跟 interpreter.DefaultMaxCallDepth 相同
+const MaxCallDepth = 10000
+
+type Instance struct {
+	exported map[string]interface{}
+	depth    int // 当前调用栈的深度
+}
+
+// 注册导出项，item 可以是 *Func, *Memory, *Global
+func (i *Instance) Export(name string, item interface{}) {
+	if i.exported == nil {
+		i.exported = map[string]interface{}{}
+	}
+	i.exported[name] = item
+}
+
+// 进入函数，调用栈的深度超出 MaxCallDepth 时发生 TrapStackExhausted 陷阱
+func (i *Instance) Enter() {
+	i.depth++
+	if i.depth > MaxCallDepth {
+		panic(instance.NewTrap(instance.TrapStackExhausted))
+	}
+}
+
+// 离开函数
+func (i *Instance) Leave() {
+	i.depth--
+}
+
+// 发生陷阱时函数不会调用 Leave()，所以从宿主进入模块时先记录调用栈的深度，
+// 返回宿主时（包括发生陷阱时）再恢复，比如：
+//
+//	defer m.Restore(m.Depth())
+func (i *Instance) Depth() int {
+	return i.depth
+}
+
+func (i *Instance) Restore(depth int) {
+	i.depth = depth
+}
+
+// 实现接口 instance.Module 的方法
+
+func (i *Instance) GetMember(name string) interface{} {
+	return i.exported[name]
+}
+
+func (i *Instance) EvalFunc(name string, args ...instance.WasmVal) []instance.WasmVal {
+	return i.exported[name].(instance.Function).Eval(args...)
+}
+
+func (i *Instance) TryEvalFunc(name string, args ...instance.WasmVal) ([]instance.WasmVal, error) {
+	f, ok := i.exported[name].(instance.Function)
+	if !ok {
+		return nil, errors.New("function not found: " + name)
+	}
+	return f.TryEval(args...)
+}
+
+func (i *Instance) EvalFuncContext(ctx context.Context, name string, args ...instance.WasmVal) ([]instance.WasmVal, error) {
+	// 生成的代码不检查中断，所以只在调用之前检查
+	if ctx.Err() != nil {
+		return nil, instance.NewTrap(instance.TrapInterrupted)
+	}
+	return i.TryEvalFunc(name, args...)
+}
+
+func (i *Instance) GetGlobalVal(name string) instance.WasmVal {
+	return i.exported[name].(instance.Global).Get()
+}
+
+func (i *Instance) SetGlobalVal(name string, value instance.WasmVal) {
+	i.exported[name].(instance.Global).Set(value)
+}
+
+// 导出项 -- 函数
+type Func struct {
+	inst  *Instance
+	type_ binary.FuncType
+	func_ func(args []uint64) []uint64
+}
+
+func NewFunc(inst *Instance, funcType binary.FuncType, f func(args []uint64) []uint64) *Func {
+	return &Func{inst: inst, type_: funcType, func_: f}
+}
+
+func (f *Func) Type() binary.FuncType {
+	return f.type_
+}
+
+func (f *Func) Eval(args ...instance.WasmVal) []instance.WasmVal {
+	if len(f.type_.ParamTypes) != len(args) {
+		panic(errors.New("incorrect length of arguments"))
+	}
+	vals := make([]uint64, len(args))
+	for i, vt := range f.type_.ParamTypes {
+		vals[i] = unwrapU64(vt, args[i])
+	}
+
+	defer f.inst.Restore(f.inst.Depth())
+	vals = f.func_(vals)
+
+	results := make([]instance.WasmVal, len(vals))
+	for i, vt := range f.type_.ResultTypes {
+		results[i] = wrapU64(vt, vals[i])
+	}
+	return results
+}
+
+func (f *Func) TryEval(args ...instance.WasmVal) (results []instance.WasmVal, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			results = nil
+			err = instance.ToError(r)
+		}
+	}()
+	return f.Eval(args...), nil
+}
+
+// 导出项 -- 全局变量，值存放在生成的 Module 结构体的字段里
+type Global struct {
+	type_ binary.GlobalType
+	val   *uint64
+}
+
+func NewGlobal(globalType binary.GlobalType, val *uint64) *Global {
+	return &Global{type_: globalType, val: val}
+}
+
+func (g *Global) Type() binary.GlobalType {
+	return g.type_
+}
+
+func (g *Global) GetAsU64() uint64 {
+	return *g.val
+}
+
+func (g *Global) SetAsU64(val uint64) {
+	if g.type_.Mut != binary.MutVar {
+		panic(errors.New("immutable global"))
+	}
+	*g.val = val
+}
+
+func (g *Global) Get() instance.WasmVal {
+	return wrapU64(g.type_.ValType, *g.val)
+}
+
+func (g *Global) Set(val instance.WasmVal) {
+	*g.val = unwrapU64(g.type_.ValType, val)
+}
@@ -0,0 +1,137 @@
+package rt
+
+import (
+	"fmt"
+	"wasmvm/binary"
+	"wasmvm/instance"
+)
+
+// 生成的 NewModule 从 mm 里的模块实例获取导入项
+//
+// 导入项的类型匹配规则跟解释器相同（见 WebAssembly 规范的 import subtyping），
+// 找不到导入项或者类型不匹配时抛出 *instance.LinkError。
+//
+// 生成的代码直接读写 Memory.Data 以及 Table.Elems，所以导入的内存和表只能是
+// 其他生成的模块（或者嵌入方）创建的 *Memory 和 *Table，解释器创建的内存和表
+// 视为类型不匹配；全局变量则可以是任意的 instance.Global。
+
+// 获取导入的函数
+func LinkFunc(mm map[string]instance.Module, moduleName string, name string,
+	funcType binary.FuncType) instance.Function {
+
+	item := lookupImport(mm, moduleName, name, funcType.String())
+	if f, ok := item.(instance.Function); ok &&
+		isValTypesEqual(funcType.ParamTypes, f.Type().ParamTypes) &&
+		isValTypesEqual(funcType.ResultTypes, f.Type().ResultTypes) {
+		return f
+	}
+	panic(newIncompatibleError(moduleName, name, funcType.String(), item))
+}
+
+// 获取导入的内存
+func LinkMemory(mm map[string]instance.Module, moduleName string, name string,
+	memType binary.MemType) *Memory {
+
+	expected := "(memory " + memType.String() + ")"
+	item := lookupImport(mm, moduleName, name, expected)
+	if mem, ok := item.(*Memory); ok && isLimitsMatch(memType, mem.limits()) {
+		return mem
+	}
+	panic(newIncompatibleError(moduleName, name, expected, item))
+}
+
+// 获取导入的表
+func LinkTable(mm map[string]instance.Module, moduleName string, name string,
+	tableType binary.TableType) *Table {
+
+	expected := "(table " + tableType.String() + ")"
+	item := lookupImport(mm, moduleName, name, expected)
+	if table, ok := item.(*Table); ok &&
+		tableType.ElemType == binary.FuncRef && isLimitsMatch(tableType.Limits, table.limits()) {
+		return table
+	}
+	panic(newIncompatibleError(moduleName, name, expected, item))
+}
+
+// 获取导入的全局变量
+func LinkGlobal(mm map[string]instance.Module, moduleName string, name string,
+	globalType binary.GlobalType) instance.Global {
+
+	expected := "(global " + globalType.String() + ")"
+	item := lookupImport(mm, moduleName, name, expected)
+	if global, ok := item.(instance.Global); ok && global.Type() == globalType {
+		return global
+	}
+	panic(newIncompatibleError(moduleName, name, expected, item))
+}
+
+func lookupImport(mm map[string]instance.Module, moduleName string, name string,
+	expected string) interface{} {
+
+	var item interface{}
+	if m := mm[moduleName]; m != nil {
+		item = m.GetMember(name)
+	}
+	if item == nil {
+		panic(&instance.LinkError{
+			Module:   moduleName,
+			Field:    name,
+			Reason:   "unknown import",
+			Expected: expected,
+		})
+	}
+	return item
+}
+
+func newIncompatibleError(moduleName string, name string, expected string,
+	item interface{}) *instance.LinkError {
+
+	return &instance.LinkError{
+		Module:   moduleName,
+		Field:    name,
+		Reason:   "incompatible import type",
+		Expected: expected,
+		Actual:   formatExternType(item),
+	}
+}
+
+// 以文本格式的形式表示导出项的类型，注意函数也实现了 instance.Tag 接口
+func formatExternType(item interface{}) string {
+	switch x := item.(type) {
+	case instance.Function:
+		return x.Type().String()
+	case *Table:
+		return "(table " + binary.TableType{ElemType: binary.FuncRef, Limits: x.limits()}.String() + ")"
+	case instance.Table:
+		return "(table " + x.Type().String() + ")"
+	case *Memory:
+		return "(memory " + x.limits().String() + ")"
+	case instance.Memory:
+		return "(memory " + x.Type().String() + ")"
+	case instance.Global:
+		return "(global " + x.Type().String() + ")"
+	case instance.Tag:
+		return "(tag " + x.Type().String() + ")"
+	default:
+		return fmt.Sprintf("%T", x)
+	}
+}
+
+func isValTypesEqual(a, b []binary.ValType) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// 实际的下限不能小于期望的下限；如果期望有上限，则实际也必须有上限，
+// 并且不能大于期望的上限（只支持非共享的内存，所以不需要比较是否共享）
+func isLimitsMatch(expected, actual binary.Limits) bool {
+	return actual.Min >= expected.Min &&
+		(!expected.HasMax() || actual.HasMax() && actual.Max <= expected.Max)
+}
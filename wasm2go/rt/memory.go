@@ -0,0 +1,160 @@
+package rt
+
+import (
+	encoding_binary "encoding/binary"
+	"wasmvm/binary"
+	"wasmvm/instance"
+)
+
+// 线性内存
+//
+// 生成的代码直接读写 Data，加载和存储指令的有效地址是 uint32 + uint32（即 33 位），
+// 所以使用 uint64 计算有效地址，越界时发生 TrapMemoryOutOfBounds 陷阱。
+// 目前只支持非共享的 32 位内存。
+type Memory struct {
+	Data  []byte
+	type_ binary.MemType
+}
+
+func NewMemory(memType binary.MemType) *Memory {
+	return &Memory{
+		Data:  make([]byte, memType.Min*binary.PageSize),
+		type_: memType,
+	}
+}
+
+// 实现接口 instance.Memory 的方法
+
+func (m *Memory) Type() binary.MemType {
+	return m.type_
+}
+
+func (m *Memory) Size() uint32 {
+	return uint32(len(m.Data) / binary.PageSize)
+}
+
+// 以当前的页面数为下限的限制值，用于导入时匹配类型（内存导出之后可能已经增长过）
+func (m *Memory) limits() binary.Limits {
+	limits := m.type_
+	limits.Min = uint64(m.Size())
+	return limits
+}
+
+// 失败时返回被转为 uint32 的 -1
+func (m *Memory) Grow(increaseCount uint32) uint32 {
+	previousSize := m.Size()
+	if increaseCount == 0 {
+		return previousSize
+	}
+
+	maxPages := uint64(binary.MaxPageCount)
+	if m.type_.HasMax() {
+		maxPages = m.type_.Max
+	}
+	if uint64(previousSize)+uint64(increaseCount) > maxPages {
+		n1 := -1
+		return uint32(n1)
+	}
+
+	newData, ok := allocMemory(uint64(previousSize) + uint64(increaseCount))
+	if !ok {
+		n1 := -1
+		return uint32(n1)
+	}
+	copy(newData, m.Data)
+	m.Data = newData
+	return previousSize
+}
+
+// 分配指定页面数的内存，页面数过大（超出了 Go 能分配的范围）时返回 false
+func allocMemory(pages uint64) (data []byte, ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	return make([]byte, pages*binary.PageSize), true
+}
+
+func (m *Memory) Read(effective_address uint64, buf []byte) {
+	m.checkRange(effective_address, uint64(len(buf)))
+	copy(buf, m.Data[effective_address:])
+}
+
+func (m *Memory) Write(effective_address uint64, data []byte) {
+	m.checkRange(effective_address, uint64(len(data)))
+	copy(m.Data[effective_address:], data)
+}
+
+func (m *Memory) checkRange(effective_address uint64, n uint64) {
+	size := uint64(len(m.Data))
+	if n > size || effective_address > size-n {
+		panic(instance.NewTrap(instance.TrapMemoryOutOfBounds))
+	}
+}
+
+// 加载和存储指令
+
+func (m *Memory) Load8(addr uint32, offset uint32) uint8 {
+	ea := uint64(addr) + uint64(offset)
+	m.checkRange(ea, 1)
+	return m.Data[ea]
+}
+
+func (m *Memory) Load16(addr uint32, offset uint32) uint16 {
+	ea := uint64(addr) + uint64(offset)
+	m.checkRange(ea, 2)
+	return encoding_binary.LittleEndian.Uint16(m.Data[ea:])
+}
+
+func (m *Memory) Load32(addr uint32, offset uint32) uint32 {
+	ea := uint64(addr) + uint64(offset)
+	m.checkRange(ea, 4)
+	return encoding_binary.LittleEndian.Uint32(m.Data[ea:])
+}
+
+func (m *Memory) Load64(addr uint32, offset uint32) uint64 {
+	ea := uint64(addr) + uint64(offset)
+	m.checkRange(ea, 8)
+	return encoding_binary.LittleEndian.Uint64(m.Data[ea:])
+}
+
+func (m *Memory) Store8(addr uint32, offset uint32, val uint8) {
+	ea := uint64(addr) + uint64(offset)
+	m.checkRange(ea, 1)
+	m.Data[ea] = val
+}
+
+func (m *Memory) Store16(addr uint32, offset uint32, val uint16) {
+	ea := uint64(addr) + uint64(offset)
+	m.checkRange(ea, 2)
+	encoding_binary.LittleEndian.PutUint16(m.Data[ea:], val)
+}
+
+func (m *Memory) Store32(addr uint32, offset uint32, val uint32) {
+	ea := uint64(addr) + uint64(offset)
+	m.checkRange(ea, 4)
+	encoding_binary.LittleEndian.PutUint32(m.Data[ea:], val)
+}
+
+func (m *Memory) Store64(addr uint32, offset uint32, val uint64) {
+	ea := uint64(addr) + uint64(offset)
+	m.checkRange(ea, 8)
+	encoding_binary.LittleEndian.PutUint64(m.Data[ea:], val)
+}
+
+// memory.copy 指令，从内存 src 的地址 s 处复制 n 个字节到当前内存的地址 d 处
+func (m *Memory) Copy(d uint32, src *Memory, s uint32, n uint32) {
+	src.checkRange(uint64(s), uint64(n))
+	m.checkRange(uint64(d), uint64(n))
+	copy(m.Data[d:uint64(d)+uint64(n)], src.Data[s:]) // 范围重叠时 copy 也能正确处理
+}
+
+// memory.fill 指令
+func (m *Memory) Fill(d uint32, val uint8, n uint32) {
+	m.checkRange(uint64(d), uint64(n))
+	buf := m.Data[d : uint64(d)+uint64(n)]
+	for i := range buf {
+		buf[i] = val
+	}
+}
@@ -0,0 +1,273 @@
+package rt
+
+import (
+	"errors"
+	"math"
+	"wasmvm/binary"
+	"wasmvm/instance"
+)
+
+// 数值指令的辅助函数
+//
+// 生成的代码使用 uint64 存放所有数值类型的值：i32 和 f32 只占用低 32 位，
+// 高 32 位为 0，f32 和 f64 存放的是 IEEE 754 的位模式（bit pattern）。
+// 下面这些函数的语义跟解释器的对应指令完全相同（见 interpreter/inst_numeric_*.go）。
+
+func F32(val uint64) float32 {
+	return math.Float32frombits(uint32(val))
+}
+
+func F32Bits(f float32) uint64 {
+	return uint64(math.Float32bits(f))
+}
+
+func F64(val uint64) float64 {
+	return math.Float64frombits(val)
+}
+
+func F64Bits(f float64) uint64 {
+	return math.Float64bits(f)
+}
+
+// 比较指令的结果，使用 i32 的 1 和 0 表示 true 和 false
+func Bool(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// -------- 整数的除法和求余
+
+func I32DivS(lhs, rhs int32) int32 {
+	if rhs == 0 {
+		panic(instance.NewTrap(instance.TrapIntegerDivideByZero))
+	}
+	if lhs == math.MinInt32 && rhs == -1 {
+		panic(instance.NewTrap(instance.TrapIntegerOverflow))
+	}
+	return lhs / rhs
+}
+
+func I32DivU(lhs, rhs uint32) uint32 {
+	if rhs == 0 {
+		panic(instance.NewTrap(instance.TrapIntegerDivideByZero))
+	}
+	return lhs / rhs
+}
+
+func I32RemS(lhs, rhs int32) int32 {
+	if rhs == 0 {
+		panic(instance.NewTrap(instance.TrapIntegerDivideByZero))
+	}
+	return lhs % rhs
+}
+
+func I32RemU(lhs, rhs uint32) uint32 {
+	if rhs == 0 {
+		panic(instance.NewTrap(instance.TrapIntegerDivideByZero))
+	}
+	return lhs % rhs
+}
+
+func I64DivS(lhs, rhs int64) int64 {
+	if rhs == 0 {
+		panic(instance.NewTrap(instance.TrapIntegerDivideByZero))
+	}
+	if lhs == math.MinInt64 && rhs == -1 {
+		panic(instance.NewTrap(instance.TrapIntegerOverflow))
+	}
+	return lhs / rhs
+}
+
+func I64DivU(lhs, rhs uint64) uint64 {
+	if rhs == 0 {
+		panic(instance.NewTrap(instance.TrapIntegerDivideByZero))
+	}
+	return lhs / rhs
+}
+
+func I64RemS(lhs, rhs int64) int64 {
+	if rhs == 0 {
+		panic(instance.NewTrap(instance.TrapIntegerDivideByZero))
+	}
+	return lhs % rhs
+}
+
+func I64RemU(lhs, rhs uint64) uint64 {
+	if rhs == 0 {
+		panic(instance.NewTrap(instance.TrapIntegerDivideByZero))
+	}
+	return lhs % rhs
+}
+
+// -------- 浮点数的 min 和 max，有操作数为 NaN 时返回第一个 NaN 操作数，并且设置 quiet 位（跟解释器相同）
+
+func f32NaNOperand(lhs, rhs float32) (float32, bool) {
+	switch {
+	case lhs != lhs:
+		return math.Float32frombits(math.Float32bits(lhs) | 1<<22), true
+	case rhs != rhs:
+		return math.Float32frombits(math.Float32bits(rhs) | 1<<22), true
+	}
+	return 0, false
+}
+
+func f64NaNOperand(lhs, rhs float64) (float64, bool) {
+	switch {
+	case lhs != lhs:
+		return math.Float64frombits(math.Float64bits(lhs) | 1<<51), true
+	case rhs != rhs:
+		return math.Float64frombits(math.Float64bits(rhs) | 1<<51), true
+	}
+	return 0, false
+}
+
+func F32Min(lhs, rhs float32) float32 {
+	if nan, ok := f32NaNOperand(lhs, rhs); ok {
+		return nan
+	}
+	return float32(math.Min(float64(lhs), float64(rhs)))
+}
+
+func F32Max(lhs, rhs float32) float32 {
+	if nan, ok := f32NaNOperand(lhs, rhs); ok {
+		return nan
+	}
+	return float32(math.Max(float64(lhs), float64(rhs)))
+}
+
+func F64Min(lhs, rhs float64) float64 {
+	if nan, ok := f64NaNOperand(lhs, rhs); ok {
+		return nan
+	}
+	return math.Min(lhs, rhs)
+}
+
+func F64Max(lhs, rhs float64) float64 {
+	if nan, ok := f64NaNOperand(lhs, rhs); ok {
+		return nan
+	}
+	return math.Max(lhs, rhs)
+}
+
+// -------- 浮点数截断为整数，f32 的操作数先转换为 f64
+
+func TruncS32(z float64) int32 {
+	f := math.Trunc(z)
+	if f > math.MaxInt32 || f < math.MinInt32 {
+		panic(instance.NewTrap(instance.TrapIntegerOverflow))
+	}
+	if math.IsNaN(f) {
+		panic(instance.NewTrap(instance.TrapInvalidConversion))
+	}
+	return int32(f)
+}
+
+func TruncU32(z float64) uint32 {
+	f := math.Trunc(z)
+	if f > math.MaxUint32 || f < 0 {
+		panic(instance.NewTrap(instance.TrapIntegerOverflow))
+	}
+	if math.IsNaN(f) {
+		panic(instance.NewTrap(instance.TrapInvalidConversion))
+	}
+	return uint32(f)
+}
+
+func TruncS64(z float64) int64 {
+	f := math.Trunc(z)
+	if f >= math.MaxInt64 || f < math.MinInt64 {
+		panic(instance.NewTrap(instance.TrapIntegerOverflow))
+	}
+	if math.IsNaN(f) {
+		panic(instance.NewTrap(instance.TrapInvalidConversion))
+	}
+	return int64(f)
+}
+
+func TruncU64(z float64) uint64 {
+	f := math.Trunc(z)
+	if f >= math.MaxUint64 || f < 0 {
+		panic(instance.NewTrap(instance.TrapIntegerOverflow))
+	}
+	if math.IsNaN(f) {
+		panic(instance.NewTrap(instance.TrapInvalidConversion))
+	}
+	return uint64(f)
+}
+
+// 饱和截断，n 是结果的位数（32 或者 64）
+
+func TruncSatU(z float64, n int) uint64 {
+	if math.IsNaN(z) {
+		return 0
+	}
+	if math.IsInf(z, -1) {
+		return 0
+	}
+	max := (uint64(1) << n) - 1
+	if math.IsInf(z, 1) {
+		return max
+	}
+	if x := math.Trunc(z); x < 0 {
+		return 0
+	} else if x >= float64(max) {
+		return max
+	} else {
+		return uint64(x)
+	}
+}
+
+func TruncSatS(z float64, n int) int64 {
+	if math.IsNaN(z) {
+		return 0
+	}
+	min := -(int64(1) << (n - 1))
+	max := (int64(1) << (n - 1)) - 1
+	if math.IsInf(z, -1) {
+		return min
+	}
+	if math.IsInf(z, 1) {
+		return max
+	}
+	if x := math.Trunc(z); x < float64(min) {
+		return min
+	} else if x >= float64(max) {
+		return max
+	} else {
+		return int64(x)
+	}
+}
+
+// -------- 宿主一侧的值（WasmVal）跟 uint64 之间的转换
+
+func wrapU64(vt binary.ValType, val uint64) instance.WasmVal {
+	switch vt {
+	case binary.ValTypeI32:
+		return int32(val)
+	case binary.ValTypeI64:
+		return int64(val)
+	case binary.ValTypeF32:
+		return math.Float32frombits(uint32(val))
+	case binary.ValTypeF64:
+		return math.Float64frombits(val)
+	default:
+		panic(errors.New("unsupported value type: " + binary.GetValTypeName(vt)))
+	}
+}
+
+func unwrapU64(vt binary.ValType, val instance.WasmVal) uint64 {
+	switch vt {
+	case binary.ValTypeI32:
+		return uint64(uint32(val.(int32)))
+	case binary.ValTypeI64:
+		return uint64(val.(int64))
+	case binary.ValTypeF32:
+		return uint64(math.Float32bits(val.(float32)))
+	case binary.ValTypeF64:
+		return math.Float64bits(val.(float64))
+	default:
+		panic(errors.New("unsupported value type: " + binary.GetValTypeName(vt)))
+	}
+}
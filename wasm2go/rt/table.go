@@ -0,0 +1,89 @@
+package rt
+
+import (
+	"sync"
+	"wasmvm/binary"
+	"wasmvm/instance"
+)
+
+// 表（只支持 funcref 类型的表，用于 call_indirect 指令）
+//
+// 表项记录函数的类型以及函数本身，函数是生成的 Go 函数（方法值），
+// 参数和返回值都是 uint64，比如 func(uint64, uint64) uint64。
+// 结构相同的函数类型（不论来自哪个生成的模块）对应同一个类型编号（Sig，见 Sigs），
+// 所以多个模块可以共享同一个表，call_indirect 只需比较类型编号，
+// 然后再将函数断言为对应的 Go 函数类型。
+type Table struct {
+	Elems []Elem
+	max   uint64 // 表的上限，0 表示没有上限
+}
+
+type Elem struct {
+	Sig  int         // 函数类型的编号
+	Func interface{} // 为 nil 时表示表项未初始化（即空引用）
+}
+
+func NewTable(limits binary.Limits) *Table {
+	t := &Table{Elems: make([]Elem, limits.Min)}
+	if limits.HasMax() {
+		t.max = limits.Max
+	}
+	return t
+}
+
+// 以当前的大小为下限的限制值，用于导入时匹配类型
+func (t *Table) limits() binary.Limits {
+	limits := binary.Limits{Min: uint64(len(t.Elems))}
+	if t.max > 0 {
+		limits.Tag = binary.LimitsTagMax
+		limits.Max = t.max
+	}
+	return limits
+}
+
+// 写入主动的元素项，超出表的范围时发生 TrapTableOutOfBounds 陷阱
+func (t *Table) Init(offset uint32, elems ...Elem) {
+	if uint64(offset)+uint64(len(elems)) > uint64(len(t.Elems)) {
+		panic(instance.NewTrap(instance.TrapTableOutOfBounds))
+	}
+	copy(t.Elems[offset:], elems)
+}
+
+// 读取 call_indirect 的目标函数，检查的次序跟解释器相同
+func (t *Table) Get(idx uint32, sig int) interface{} {
+	if idx >= uint32(len(t.Elems)) {
+		panic(instance.NewTrap(instance.TrapTableOutOfBounds))
+	}
+	elem := t.Elems[idx]
+	if elem.Func == nil {
+		panic(instance.NewTrap(instance.TrapUninitializedElement))
+	}
+	if elem.Sig != sig {
+		panic(instance.NewTrap(instance.TrapIndirectCallTypeMismatch))
+	}
+	return elem.Func
+}
+
+// 函数类型的编号，按函数类型的文本格式登记，整个进程共用
+var sigs = struct {
+	sync.Mutex
+	m map[string]int
+}{m: map[string]int{}}
+
+// 返回各个函数类型的编号，生成的包在初始化时调用一次
+func Sigs(types []binary.FuncType) []int {
+	sigs.Lock()
+	defer sigs.Unlock()
+
+	result := make([]int, len(types))
+	for i, ft := range types {
+		key := ft.String()
+		sig, ok := sigs.m[key]
+		if !ok {
+			sig = len(sigs.m)
+			sigs.m[key] = sig
+		}
+		result[i] = sig
+	}
+	return result
+}
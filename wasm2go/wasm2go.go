@@ -0,0 +1,707 @@
+package wasm2go
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"wasmvm/binary"
+)
+
+// 将 wasm 模块预先（ahead-of-time）翻译为 Go 源代码
+//
+// 生成的 Go 包不再需要解释器，模块的各部分对应的 Go 代码如下：
+// - 每个 wasm 函数对应 Module 结构体的一个方法（f0, f1, ...），参数、局部变量
+//   以及操作数栈上的每个槽位都是 uint64 类型的 Go 局部变量（l0, l1, ... 和 s0, s1, ...），
+//   结构化控制指令翻译为 goto 语句和标签；
+// - 线性内存是 *rt.Memory（即一个 []byte），全局变量是 Module 结构体的 uint64 字段
+//   （导入的全局变量则是 instance.Global 字段）；
+// - 导入项是 Imports 接口的方法，由嵌入方实现，方法名由模块名称和导入项的名称转换而来，
+//   比如 "env" "print_int" 对应 EnvPrintInt。导入的函数通过方法调用，导入的内存、表
+//   以及全局变量则在实例化时分别通过方法获取一次（*rt.Memory, *rt.Table, instance.Global）；
+// - 导出的函数除了可以通过 instance.Module 的方法调用，还可以通过 Module 结构体上的
+//   同名（转换为 Go 的命名方式）方法直接调用，比如 "fib_rec" 对应 FibRec。
+//
+// 生成的 Go 包提供两个构造函数：
+// - New(imports Imports) *Module，使用嵌入方实现的导入项实例化模块；
+// - NewModule(mm map[string]instance.Module) instance.Module，跟 interpreter.NewModule
+//   一样从 mm 里的模块实例获取导入项，可以代替解释器加入 executor.NewModulesWithFactories。
+//
+// 目前只支持数值类型（i32, i64, f32, f64）以及 MVP 的指令（包括多返回值、
+// 饱和截断、符号扩展以及 memory.copy 和 memory.fill），模块使用了其他特性
+// （比如 SIMD、异常处理、原子指令、引用类型的指令）时 Generate 返回错误。
+//
+// 注意生成的代码直接读写内存和表，所以通过 NewModule 导入的内存和表只能来自其他
+// 生成的模块（即 *rt.Memory 和 *rt.Table），不能是解释器创建的内存和表（见 rt.LinkMemory）。
+
+// 将模块 m 翻译为 Go 源代码，pkgName 为生成的 Go 包的名称
+func Generate(m binary.Module, pkgName string) (code []byte, err error) {
+	if err := binary.Validate(m); err != nil {
+		return nil, err
+	}
+
+	// 生成器内部以 panic 的方式报告错误，这里统一转换为返回的错误：
+	// 尚未支持的特性是 *unsupportedError，其他的 panic 则是生成器自身的缺陷
+	defer func() {
+		if r := recover(); r != nil {
+			code = nil
+			if e, ok := r.(*unsupportedError); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("wasm2go: internal error: %v", r)
+			}
+		}
+	}()
+
+	g := newGenerator(m)
+	g.genModule()
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by wasm2go. DO NOT EDIT.\n\n")
+	buf.WriteString("package " + pkgName + "\n\n")
+	buf.WriteString("import (\n")
+	for _, path := range g.importPaths() {
+		buf.WriteString(strconv.Quote(path) + "\n")
+	}
+	buf.WriteString(")\n")
+	buf.Write(g.buf.Bytes())
+
+	return format.Source(buf.Bytes())
+}
+
+// 模块使用了尚未支持的特性
+type unsupportedError struct {
+	reason string
+}
+
+func (e *unsupportedError) Error() string {
+	return "wasm2go: unsupported: " + e.reason
+}
+
+// 判断 Generate 返回的错误是否因为模块使用了尚未支持的特性（而不是模块本身无效）
+func IsUnsupported(err error) bool {
+	_, ok := err.(*unsupportedError)
+	return ok
+}
+
+func unsupported(format string, a ...interface{}) {
+	panic(&unsupportedError{reason: fmt.Sprintf(format, a...)})
+}
+
+type generator struct {
+	module binary.Module
+	buf    bytes.Buffer
+
+	funcTypes       []binary.TypeIdx    // 所有函数（包括导入函数）的类型索引
+	importCount     int                 // 导入函数的数量
+	importedMems    int                 // 导入的内存的数量
+	importedTables  int                 // 导入的表的数量
+	importedGlobals []binary.GlobalType // 导入的全局变量的类型
+	funcNames       map[uint32]string
+	importNames     []string // 导入函数在 Imports 接口里的方法名
+	importInits     []string // 实例化时获取导入的内存、表和全局变量的语句
+	packages        map[string]bool
+}
+
+func newGenerator(m binary.Module) *generator {
+	g := &generator{
+		module:    m,
+		funcNames: map[uint32]string{},
+		packages: map[string]bool{
+			"wasmvm/binary":     true,
+			"wasmvm/instance":   true,
+			"wasmvm/wasm2go/rt": true,
+		},
+	}
+
+	for _, item := range m.ImportSec {
+		switch item.Desc.Tag {
+		case binary.ImportTagFunc:
+			g.funcTypes = append(g.funcTypes, item.Desc.FuncType)
+		case binary.ImportTagMem:
+			g.importedMems++
+		case binary.ImportTagTable:
+			g.importedTables++
+		case binary.ImportTagGlobal:
+			g.importedGlobals = append(g.importedGlobals, item.Desc.Global)
+		}
+	}
+	g.importCount = len(g.funcTypes)
+	g.funcTypes = append(g.funcTypes, m.FuncSec...)
+
+	for _, assoc := range m.GetNameSec().FuncNames {
+		g.funcNames[assoc.Idx] = assoc.Name
+	}
+	return g
+}
+
+func (g *generator) importPaths() []string {
+	paths := []string{}
+	for path := range g.packages {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func (g *generator) printf(format string, a ...interface{}) {
+	fmt.Fprintf(&g.buf, format, a...)
+}
+
+func (g *generator) genModule() {
+	g.checkModule()
+	g.genTypes()
+	g.genImports()
+	g.genModuleStruct()
+	g.genNew()
+	g.genExportedFuncs()
+	for idx := range g.funcTypes {
+		if idx < g.importCount {
+			g.genImportedFunc(uint32(idx))
+		} else {
+			g.genFunc(uint32(idx))
+		}
+	}
+}
+
+// 检查模块是否使用了尚未支持的特性（函数体里的指令在翻译时检查）
+func (g *generator) checkModule() {
+	m := g.module
+	for _, item := range m.ImportSec {
+		switch item.Desc.Tag {
+		case binary.ImportTagMem:
+			checkMemType(item.Desc.Mem)
+		case binary.ImportTagTable:
+			checkTableType(item.Desc.Table)
+		case binary.ImportTagGlobal:
+			checkValTypes([]binary.ValType{item.Desc.Global.ValType})
+		case binary.ImportTagTag:
+			unsupported("exception handling")
+		}
+	}
+	for _, ftIdx := range g.funcTypes {
+		ft := m.TypeSec[ftIdx]
+		checkValTypes(ft.ParamTypes)
+		checkValTypes(ft.ResultTypes)
+	}
+	for _, code := range m.CodeSec {
+		for _, locals := range code.Locals {
+			checkValTypes([]binary.ValType{locals.Type})
+		}
+	}
+	if len(m.TagSec) > 0 {
+		unsupported("exception handling")
+	}
+	for _, memType := range m.MemSec {
+		checkMemType(memType)
+	}
+	for _, tableType := range m.TableSec {
+		checkTableType(tableType)
+	}
+	for _, global := range m.GlobalSec {
+		checkValTypes([]binary.ValType{global.Type.ValType})
+		g.constValue(global.Init)
+	}
+	for _, exp := range m.ExportSec {
+		if exp.Desc.Tag == binary.ExportTagTable || exp.Desc.Tag == binary.ExportTagTag {
+			unsupported("export %q: only functions, memories and globals can be exported", exp.Name)
+		}
+	}
+}
+
+func checkMemType(memType binary.MemType) {
+	if memType.IsShared() || memType.Is64() {
+		unsupported("memory %s", memType)
+	}
+}
+
+func checkTableType(tableType binary.TableType) {
+	if tableType.ElemType != binary.FuncRef {
+		unsupported("table %s", tableType)
+	}
+}
+
+func checkValTypes(vts []binary.ValType) {
+	for _, vt := range vts {
+		switch vt {
+		case binary.ValTypeI32, binary.ValTypeI64, binary.ValTypeF32, binary.ValTypeF64:
+		default:
+			unsupported("value type %s", binary.GetValTypeName(vt))
+		}
+	}
+}
+
+// 计算常量表达式（全局变量的初始值、元素项和数据项的偏移值），
+// 只支持单条的常量指令，返回 uint64 形式的值
+func (g *generator) constValue(expr binary.Expr) uint64 {
+	if len(expr) == 1 {
+		switch arg := expr[0].Args; expr[0].Opcode {
+		case binary.I32Const:
+			return uint64(uint32(arg.(int32)))
+		case binary.I64Const:
+			return uint64(arg.(int64))
+		case binary.F32Const:
+			return uint64(math.Float32bits(arg.(float32)))
+		case binary.F64Const:
+			return math.Float64bits(arg.(float64))
+		}
+	}
+	unsupported("constant expression %v", expr)
+	return 0
+}
+
+// -------- 类型
+
+func (g *generator) genTypes() {
+	g.printf("\nvar types = []binary.FuncType{\n")
+	for _, ft := range g.module.TypeSec {
+		g.printf("{Tag: binary.FtTag")
+		if len(ft.ParamTypes) > 0 {
+			g.printf(", ParamTypes: %s", valTypesExpr(ft.ParamTypes))
+		}
+		if len(ft.ResultTypes) > 0 {
+			g.printf(", ResultTypes: %s", valTypesExpr(ft.ResultTypes))
+		}
+		g.printf("},\n")
+	}
+	g.printf("}\n")
+
+	g.printf("\n// 函数类型的编号，用于 call_indirect（见 rt.Table）\n")
+	g.printf("var sigs = rt.Sigs(types)\n")
+}
+
+var valTypeNames = map[binary.ValType]string{
+	binary.ValTypeI32:       "binary.ValTypeI32",
+	binary.ValTypeI64:       "binary.ValTypeI64",
+	binary.ValTypeF32:       "binary.ValTypeF32",
+	binary.ValTypeF64:       "binary.ValTypeF64",
+	binary.ValTypeV128:      "binary.ValTypeV128",
+	binary.ValTypeFuncRef:   "binary.ValTypeFuncRef",
+	binary.ValTypeExternRef: "binary.ValTypeExternRef",
+}
+
+func valTypesExpr(vts []binary.ValType) string {
+	names := make([]string, len(vts))
+	for i, vt := range vts {
+		names[i] = valTypeNames[vt]
+	}
+	return "[]binary.ValType{" + strings.Join(names, ", ") + "}"
+}
+
+// 值类型在嵌入方一侧（即 Imports 接口以及导出函数的方法）对应的 Go 类型
+var goTypeNames = map[binary.ValType]string{
+	binary.ValTypeI32: "int32",
+	binary.ValTypeI64: "int64",
+	binary.ValTypeF32: "float32",
+	binary.ValTypeF64: "float64",
+}
+
+// 将 Go 类型的值转换为 uint64
+func toU64(vt binary.ValType, expr string) string {
+	switch vt {
+	case binary.ValTypeI32:
+		return "uint64(uint32(" + expr + "))"
+	case binary.ValTypeI64:
+		return "uint64(" + expr + ")"
+	case binary.ValTypeF32:
+		return "rt.F32Bits(" + expr + ")"
+	default:
+		return "rt.F64Bits(" + expr + ")"
+	}
+}
+
+// 将 uint64 转换为 Go 类型的值
+func fromU64(vt binary.ValType, expr string) string {
+	switch vt {
+	case binary.ValTypeI32:
+		return "int32(" + expr + ")"
+	case binary.ValTypeI64:
+		return "int64(" + expr + ")"
+	case binary.ValTypeF32:
+		return "rt.F32(" + expr + ")"
+	default:
+		return "rt.F64(" + expr + ")"
+	}
+}
+
+// 方法的签名（不含方法名），比如 "(p0 int32, p1 int32) int32"
+func goSignature(ft binary.FuncType) string {
+	params := make([]string, len(ft.ParamTypes))
+	for i, vt := range ft.ParamTypes {
+		params[i] = fmt.Sprintf("p%d %s", i, goTypeNames[vt])
+	}
+	results := make([]string, len(ft.ResultTypes))
+	for i, vt := range ft.ResultTypes {
+		results[i] = goTypeNames[vt]
+	}
+	return "(" + strings.Join(params, ", ") + ")" + resultsSignature(results)
+}
+
+func resultsSignature(results []string) string {
+	switch len(results) {
+	case 0:
+		return ""
+	case 1:
+		return " " + results[0]
+	default:
+		return " (" + strings.Join(results, ", ") + ")"
+	}
+}
+
+// 将导入导出项的名称转换为 Go 的导出标识符，比如 "fib_rec" 转换为 "FibRec"，
+// 已经被占用的名称加上数字后缀
+func goName(name string, taken map[string]bool) string {
+	var sb strings.Builder
+	upper := true
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if upper {
+				r = unicode.ToUpper(r)
+				upper = false
+			}
+			sb.WriteRune(r)
+		} else {
+			upper = true
+		}
+	}
+
+	base := sb.String()
+	if base == "" || !unicode.IsUpper([]rune(base)[0]) {
+		base = "X" + base
+	}
+
+	goName := base
+	for n := 2; taken[goName]; n++ {
+		goName = fmt.Sprintf("%s%d", base, n)
+	}
+	taken[goName] = true
+	return goName
+}
+
+// -------- 导入项
+
+func (g *generator) genImports() {
+	taken := map[string]bool{}
+	var methods, fields, links, funcs strings.Builder
+	var memIdx, tableIdx, globalIdx int
+
+	for _, item := range g.module.ImportSec {
+		name := goName(item.Module+"_"+item.Name, taken)
+
+		switch item.Desc.Tag {
+		case binary.ImportTagFunc:
+			idx := len(g.importNames)
+			ft := g.module.TypeSec[item.Desc.FuncType]
+			g.importNames = append(g.importNames, name)
+
+			fmt.Fprintf(&methods, "%s%s // %q %q\n", name, goSignature(ft), item.Module, item.Name)
+			fmt.Fprintf(&fields, "f%d instance.Function\n", idx)
+			fmt.Fprintf(&links, "f%d: rt.LinkFunc(mm, %q, %q, types[%d]),\n",
+				idx, item.Module, item.Name, item.Desc.FuncType)
+			g.genLinkerFunc(&funcs, name, ft, idx)
+		case binary.ImportTagMem:
+			field := fmt.Sprintf("mem%d", memIdx)
+			memIdx++
+			g.genImportItem(&methods, &fields, &links, &funcs, item, name, field, "*rt.Memory",
+				fmt.Sprintf("rt.LinkMemory(mm, %q, %q, %s)", item.Module, item.Name, limitsExpr("binary.MemType", item.Desc.Mem)))
+		case binary.ImportTagTable:
+			field := fmt.Sprintf("table%d", tableIdx)
+			tableIdx++
+			tableType := fmt.Sprintf("binary.TableType{ElemType: binary.FuncRef, Limits: %s}",
+				limitsExpr("binary.Limits", item.Desc.Table.Limits))
+			g.genImportItem(&methods, &fields, &links, &funcs, item, name, field, "*rt.Table",
+				fmt.Sprintf("rt.LinkTable(mm, %q, %q, %s)", item.Module, item.Name, tableType))
+		case binary.ImportTagGlobal:
+			field := fmt.Sprintf("g%d", globalIdx)
+			globalIdx++
+			g.genImportItem(&methods, &fields, &links, &funcs, item, name, field, "instance.Global",
+				fmt.Sprintf("rt.LinkGlobal(mm, %q, %q, %s)", item.Module, item.Name, globalTypeExpr(item.Desc.Global)))
+		}
+	}
+
+	g.printf("\n// 导入项，由嵌入方实现（导入的内存、表和全局变量在实例化时获取一次）\n")
+	g.printf("type Imports interface {\n%s}\n", methods.String())
+
+	g.printf("\n// 从其他模块实例获取导入项，见 NewModule\n")
+	g.printf("type linker struct {\n%s}\n", fields.String())
+
+	g.printf("\n// 跟 interpreter.NewModule 相同，导入项从 mm 里的模块实例获取，\n")
+	g.printf("// 找不到导入项或者类型不匹配时抛出 *instance.LinkError\n")
+	g.printf("func NewModule(mm map[string]instance.Module) instance.Module {\n")
+	g.printf("return New(&linker{\n%s})\n", links.String())
+	g.printf("}\n")
+	g.printf("%s", funcs.String())
+}
+
+// 通过 instance.Function 调用其他模块实例的函数
+func (g *generator) genLinkerFunc(funcs *strings.Builder, name string, ft binary.FuncType, idx int) {
+	args := make([]string, len(ft.ParamTypes))
+	for i := range args {
+		args[i] = fmt.Sprintf("p%d", i)
+	}
+	call := fmt.Sprintf("l.f%d.Eval(%s)", idx, strings.Join(args, ", "))
+	fmt.Fprintf(funcs, "\nfunc (l *linker) %s%s {\n", name, goSignature(ft))
+	if len(ft.ResultTypes) == 0 {
+		fmt.Fprintf(funcs, "%s\n", call)
+	} else {
+		results := make([]string, len(ft.ResultTypes))
+		for i, vt := range ft.ResultTypes {
+			results[i] = fmt.Sprintf("r[%d].(%s)", i, goTypeNames[vt])
+		}
+		fmt.Fprintf(funcs, "r := %s\n", call)
+		fmt.Fprintf(funcs, "return %s\n", strings.Join(results, ", "))
+	}
+	funcs.WriteString("}\n")
+}
+
+// 导入的内存、表和全局变量：Imports 接口的方法返回导入项，linker 在 NewModule 里
+// 获取导入项之后保存在同名的字段里，New 将其保存在 Module 结构体的字段里
+func (g *generator) genImportItem(methods, fields, links, funcs *strings.Builder,
+	item binary.Import, name, field, goType, link string) {
+
+	fmt.Fprintf(methods, "%s() %s // %q %q\n", name, goType, item.Module, item.Name)
+	fmt.Fprintf(fields, "%s %s\n", field, goType)
+	fmt.Fprintf(links, "%s: %s,\n", field, link)
+	fmt.Fprintf(funcs, "\nfunc (l *linker) %s() %s {\n", name, goType)
+	fmt.Fprintf(funcs, "return l.%s\n", field)
+	funcs.WriteString("}\n")
+	g.importInits = append(g.importInits, fmt.Sprintf("m.%s = imports.%s()", field, name))
+}
+
+// 限制值的 Go 表达式，比如 "binary.MemType{Tag: binary.LimitsTagMax, Min: 1, Max: 2}"
+func limitsExpr(typeName string, limits binary.Limits) string {
+	if limits.HasMax() {
+		return fmt.Sprintf("%s{Tag: binary.LimitsTagMax, Min: %d, Max: %d}", typeName, limits.Min, limits.Max)
+	}
+	return fmt.Sprintf("%s{Min: %d}", typeName, limits.Min)
+}
+
+func globalTypeExpr(gt binary.GlobalType) string {
+	return fmt.Sprintf("binary.GlobalType{ValType: %s, Mut: %d}", valTypeNames[gt.ValType], gt.Mut)
+}
+
+// 导入函数在模块内部的包装，参数和返回值跟模块内部的函数一样是 uint64
+func (g *generator) genImportedFunc(idx uint32) {
+	ft := g.module.TypeSec[g.funcTypes[idx]]
+
+	params := make([]string, len(ft.ParamTypes))
+	args := make([]string, len(ft.ParamTypes))
+	for i, vt := range ft.ParamTypes {
+		params[i] = fmt.Sprintf("l%d uint64", i)
+		args[i] = fromU64(vt, fmt.Sprintf("l%d", i))
+	}
+	call := fmt.Sprintf("m.imports.%s(%s)", g.importNames[idx], strings.Join(args, ", "))
+
+	g.printf("\n%s", g.funcComment(idx))
+	g.printf("func (m *Module) f%d(%s)%s {\n", idx, strings.Join(params, ", "), u64Results(ft))
+	if len(ft.ResultTypes) == 0 {
+		g.printf("%s\n", call)
+	} else {
+		vars := make([]string, len(ft.ResultTypes))
+		results := make([]string, len(ft.ResultTypes))
+		for i, vt := range ft.ResultTypes {
+			vars[i] = fmt.Sprintf("r%d", i)
+			results[i] = toU64(vt, vars[i])
+		}
+		g.printf("%s := %s\n", strings.Join(vars, ", "), call)
+		g.printf("return %s\n", strings.Join(results, ", "))
+	}
+	g.printf("}\n")
+}
+
+func (g *generator) funcComment(idx uint32) string {
+	if name, ok := g.funcNames[idx]; ok {
+		return fmt.Sprintf("// func %d <%s>\n", idx, name)
+	}
+	return fmt.Sprintf("// func %d\n", idx)
+}
+
+func u64Results(ft binary.FuncType) string {
+	results := make([]string, len(ft.ResultTypes))
+	for i := range results {
+		results[i] = "uint64"
+	}
+	return resultsSignature(results)
+}
+
+// 模块内部的函数的 Go 函数类型，用于 call_indirect，比如 "func(uint64) uint64"
+func u64FuncType(ft binary.FuncType) string {
+	params := make([]string, len(ft.ParamTypes))
+	for i := range params {
+		params[i] = "uint64"
+	}
+	return "func(" + strings.Join(params, ", ") + ")" + u64Results(ft)
+}
+
+// -------- 模块结构体以及实例化
+
+func (g *generator) genModuleStruct() {
+	g.printf("\ntype Module struct {\n")
+	g.printf("rt.Instance\n")
+	g.printf("imports Imports\n")
+	for i := 0; i < g.importedMems+len(g.module.MemSec); i++ {
+		g.printf("mem%d *rt.Memory\n", i)
+	}
+	for i := 0; i < g.importedTables+len(g.module.TableSec); i++ {
+		g.printf("table%d *rt.Table\n", i)
+	}
+	// 导入的全局变量通过 instance.Global 读写，模块自己定义的全局变量直接存放值
+	for i, gt := range g.importedGlobals {
+		g.printf("g%d instance.Global // %s\n", i, gt)
+	}
+	for i, global := range g.module.GlobalSec {
+		g.printf("g%d uint64 // %s\n", len(g.importedGlobals)+i, global.Type)
+	}
+	g.printf("}\n")
+}
+
+func (g *generator) genNew() {
+	m := g.module
+
+	g.printf("\n// 实例化模块，imports 提供导入项\n")
+	g.printf("func New(imports Imports) *Module {\n")
+	g.printf("m := &Module{imports: imports}\n")
+
+	for _, init := range g.importInits {
+		g.printf("%s\n", init)
+	}
+	for i, memType := range m.MemSec {
+		g.printf("m.mem%d = rt.NewMemory(%s)\n", g.importedMems+i, limitsExpr("binary.MemType", memType))
+	}
+	for i, tableType := range m.TableSec {
+		g.printf("m.table%d = rt.NewTable(%s)\n", g.importedTables+i, limitsExpr("binary.Limits", tableType.Limits))
+	}
+	for i, global := range m.GlobalSec {
+		g.printf("m.g%d = %#x\n", len(g.importedGlobals)+i, g.constValue(global.Init))
+	}
+
+	// 主动的元素项和数据项，次序跟解释器相同（先写入表，然后写入内存）
+	for _, elem := range m.ElemSec {
+		if elem.Mode != binary.SegmentModeActive {
+			continue
+		}
+		elems := make([]string, elem.Len())
+		for i, funcIdx := range elem.Init {
+			elems[i] = g.elemExpr(funcIdx)
+		}
+		for i, expr := range elem.Exprs {
+			if len(expr) == 1 && expr[0].Opcode == binary.RefFunc {
+				elems[i] = g.elemExpr(expr[0].Args.(uint32))
+			} else if len(expr) == 1 && expr[0].Opcode == binary.RefNull {
+				elems[i] = "{}"
+			} else {
+				unsupported("element expression %v", expr)
+			}
+		}
+		g.printf("m.table%d.Init(%d, []rt.Elem{%s}...)\n",
+			elem.Table, uint32(g.constValue(elem.Offset)), strings.Join(elems, ", "))
+	}
+	for _, data := range m.DataSec {
+		if data.Mode != binary.SegmentModeActive {
+			continue
+		}
+		g.printf("m.mem%d.Write(%d, []byte(%s))\n",
+			data.Mem, uint32(g.constValue(data.Offset)), strconv.Quote(string(data.Init)))
+	}
+
+	for _, exp := range m.ExportSec {
+		switch exp.Desc.Tag {
+		case binary.ExportTagFunc:
+			g.genExportFunc(exp)
+		case binary.ExportTagMem:
+			g.printf("m.Export(%q, m.mem%d)\n", exp.Name, exp.Desc.Idx)
+		case binary.ExportTagGlobal:
+			if int(exp.Desc.Idx) < len(g.importedGlobals) {
+				// 重新导出导入的全局变量
+				g.printf("m.Export(%q, m.g%d)\n", exp.Name, exp.Desc.Idx)
+			} else {
+				gt := m.GlobalSec[int(exp.Desc.Idx)-len(g.importedGlobals)].Type
+				g.printf("m.Export(%q, rt.NewGlobal(%s, &m.g%d))\n", exp.Name, globalTypeExpr(gt), exp.Desc.Idx)
+			}
+		}
+	}
+
+	if m.StartSec != nil {
+		g.printf("m.f%d() // start\n", *m.StartSec)
+	}
+	g.printf("return m\n")
+	g.printf("}\n")
+}
+
+func (g *generator) elemExpr(funcIdx uint32) string {
+	return fmt.Sprintf("{Sig: sigs[%d], Func: m.f%d}", g.funcTypes[funcIdx], funcIdx)
+}
+
+// 通过 instance.Function 调用导出的函数
+func (g *generator) genExportFunc(exp binary.Export) {
+	ftIdx := g.funcTypes[exp.Desc.Idx]
+	ft := g.module.TypeSec[ftIdx]
+
+	args := make([]string, len(ft.ParamTypes))
+	for i := range args {
+		args[i] = fmt.Sprintf("args[%d]", i)
+	}
+	call := fmt.Sprintf("m.f%d(%s)", exp.Desc.Idx, strings.Join(args, ", "))
+
+	g.printf("m.Export(%q, rt.NewFunc(&m.Instance, types[%d], func(args []uint64) []uint64 {\n", exp.Name, ftIdx)
+	if len(ft.ResultTypes) == 0 {
+		g.printf("%s\n", call)
+		g.printf("return nil\n")
+	} else {
+		vars := make([]string, len(ft.ResultTypes))
+		for i := range vars {
+			vars[i] = fmt.Sprintf("r%d", i)
+		}
+		g.printf("%s := %s\n", strings.Join(vars, ", "), call)
+		g.printf("return []uint64{%s}\n", strings.Join(vars, ", "))
+	}
+	g.printf("}))\n")
+}
+
+// Module 结构体上（包括内嵌的 rt.Instance）已有的方法和字段
+var moduleMembers = []string{
+	"Instance", "Export", "Enter", "Leave", "Depth", "Restore",
+	"GetMember", "EvalFunc", "TryEvalFunc", "EvalFuncContext", "GetGlobalVal", "SetGlobalVal",
+}
+
+// 导出函数对应的 Go 方法，嵌入方可以直接调用，不需要转换为 instance.WasmVal
+func (g *generator) genExportedFuncs() {
+	taken := map[string]bool{}
+	for _, name := range moduleMembers {
+		taken[name] = true
+	}
+
+	for _, exp := range g.module.ExportSec {
+		if exp.Desc.Tag != binary.ExportTagFunc {
+			continue
+		}
+		ft := g.module.TypeSec[g.funcTypes[exp.Desc.Idx]]
+
+		args := make([]string, len(ft.ParamTypes))
+		for i, vt := range ft.ParamTypes {
+			args[i] = toU64(vt, fmt.Sprintf("p%d", i))
+		}
+		call := fmt.Sprintf("m.f%d(%s)", exp.Desc.Idx, strings.Join(args, ", "))
+
+		g.printf("\n// 导出的函数 %q\n", exp.Name)
+		g.printf("func (m *Module) %s%s {\n", goName(exp.Name, taken), goSignature(ft))
+		g.printf("defer m.Restore(m.Depth())\n")
+		if len(ft.ResultTypes) == 0 {
+			g.printf("%s\n", call)
+		} else {
+			vars := make([]string, len(ft.ResultTypes))
+			results := make([]string, len(ft.ResultTypes))
+			for i, vt := range ft.ResultTypes {
+				vars[i] = fmt.Sprintf("r%d", i)
+				results[i] = fromU64(vt, vars[i])
+			}
+			g.printf("%s := %s\n", strings.Join(vars, ", "), call)
+			g.printf("return %s\n", strings.Join(results, ", "))
+		}
+		g.printf("}\n")
+	}
+}
@@ -0,0 +1,505 @@
+package wasm2go
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"wasmvm/assert"
+	"wasmvm/binary"
+	"wasmvm/executor"
+	"wasmvm/instance"
+	"wasmvm/interpreter"
+	"wasmvm/native"
+	"wasmvm/wasm2go/internal/sample"
+	"wasmvm/wasm2go/rt"
+)
+
+// 使用 `go test ./wasm2go -update` 重新生成 internal/sample/sample.go
+var update = flag.Bool("update", false, "update the generated sample package")
+
+// 生成的代码跟已提交的 sample 包相同
+func TestGenerate(t *testing.T) {
+	code, err := Generate(readModule("test-wasm2go.wasm"), "sample")
+	assert.AssertNil(t, err)
+
+	samplePath := filepath.Join("internal", "sample", "sample.go")
+	if *update {
+		assert.AssertNil(t, os.WriteFile(samplePath, code, 0644))
+	}
+
+	expected, err := os.ReadFile(samplePath)
+	assert.AssertNil(t, err)
+	assert.AssertTrue(t, bytes.Equal(expected, code))
+}
+
+func TestGenerateUnsupported(t *testing.T) {
+	_, err := Generate(readModule("test-wasm2go-unsupported.wasm"), "unsupported")
+	assert.AssertEqual(t, "wasm2go: unsupported: value type v128", err.Error())
+	assert.AssertTrue(t, IsUnsupported(err))
+}
+
+// 生成器自身的 panic 也转换为返回的错误
+func TestGenerateInternalError(t *testing.T) {
+	m := readModule("test-wasm2go.wasm")
+	m.GlobalSec[0].Init = binary.Expr{{Opcode: binary.I32Const, Args: "0"}}
+	code, err := Generate(m, "broken")
+	assert.AssertTrue(t, code == nil)
+	assert.AssertTrue(t, strings.HasPrefix(err.Error(), "wasm2go: internal error: "))
+	assert.AssertTrue(t, !IsUnsupported(err))
+}
+
+type call struct {
+	name string
+	args []instance.WasmVal
+}
+
+var sampleCalls = []call{
+	{"import_add", []instance.WasmVal{int32(11), int32(22)}},
+	{"call_indirect", []instance.WasmVal{int32(0), int32(10), int32(3)}},
+	{"call_indirect", []instance.WasmVal{int32(1), int32(10), int32(3)}},
+	{"call_indirect", []instance.WasmVal{int32(2), int32(10), int32(3)}},
+	{"call_indirect", []instance.WasmVal{int32(3), int32(10), int32(3)}},
+	{"call_indirect", []instance.WasmVal{int32(4), int32(10), int32(3)}},
+	{"inc_counter", nil},
+	{"sum", []instance.WasmVal{int32(100000)}},
+	{"fib", []instance.WasmVal{int32(90)}},
+	{"fib_rec", []instance.WasmVal{int32(20)}},
+	{"switch", []instance.WasmVal{int32(0)}},
+	{"switch", []instance.WasmVal{int32(2)}},
+	{"switch", []instance.WasmVal{int32(-1)}},
+	{"divmod", []instance.WasmVal{int32(-7), int32(3)}},
+	{"divmod", []instance.WasmVal{int32(7), int32(0)}},
+	{"swap_sum", []instance.WasmVal{int32(-1), int32(5)}},
+	{"select", []instance.WasmVal{int32(0), int64(1), int64(2)}},
+	{"select", []instance.WasmVal{int32(5), int64(1), int64(2)}},
+	{"max_s", []instance.WasmVal{int32(-3), int32(2)}},
+	{"max_s", []instance.WasmVal{int32(3), int32(2)}},
+	{"div_s", []instance.WasmVal{int32(math.MinInt32), int32(-1)}},
+	{"div_s", []instance.WasmVal{int32(-9), int32(2)}},
+	{"trap_unreachable", nil},
+	{"load_byte", []instance.WasmVal{int32(17)}},
+	{"load_byte", []instance.WasmVal{int32(65536)}},
+	{"store_load", []instance.WasmVal{int32(100), int64(-0x1234_5678_9abc_def0)}},
+	{"store_load", []instance.WasmVal{int32(65530), int64(1)}},
+	{"grow", []instance.WasmVal{int32(2)}},
+	{"grow", []instance.WasmVal{int32(2)}},
+	{"fill_copy", []instance.WasmVal{int32(0xab)}},
+	{"bits", []instance.WasmVal{int32(0x80f0), int64(math.MinInt64)}},
+	{"bits", []instance.WasmVal{int32(-1), int64(0x7fff_ffff)}},
+	{"float", []instance.WasmVal{float32(3.6), float64(-2.5)}},
+	{"float", []instance.WasmVal{float32(math.NaN()), float64(7.5)}},
+	{"convert", []instance.WasmVal{float64(-1e10)}},
+	{"convert", []instance.WasmVal{float64(123.75)}},
+	{"convert", []instance.WasmVal{math.NaN()}},
+}
+
+// 预先翻译的模块跟解释器的执行结果（包括陷阱）相同
+func TestCompareWithInterpreter(t *testing.T) {
+	interpreted := executor.NewModule(readModule("test-wasm2go.wasm"))
+	translated := executor.NewModulesWithFactories(
+		map[string]instance.Module{"env": native.NewEnvModule()},
+		[]string{"user"}, []executor.ModuleFactory{sample.NewModule})["user"]
+
+	for _, c := range sampleCalls {
+		expected := evalFunc(interpreted, c.name, c.args)
+		actual := evalFunc(translated, c.name, c.args)
+		assert.AssertEqual(t, expected, actual)
+	}
+
+	assert.AssertListEqual(t,
+		[]instance.WasmVal{interpreted.GetGlobalVal("counter")},
+		[]instance.WasmVal{translated.GetGlobalVal("counter")})
+}
+
+// 将多个模块翻译为 Go 包，编译后在独立的进程里运行，输出的执行结果（包括陷阱）跟解释器相同
+func TestGenerateAndRun(t *testing.T) {
+	rootDir, dir := newRunModule(t)
+
+	f32NaN := math.Float32frombits(0x7fa0_0001) // signaling NaN
+	f64NaN := math.Float64frombits(0xfff4_0000_0000_0001)
+	fixtures := []struct {
+		path   string // 相对于项目根目录
+		calls  []call
+		sample bool // 是否从第一个模块（模块名称为 "sample"）导入
+	}{
+		{"test/resources/wasm2go/test-wasm2go.wasm", sampleCalls, false},
+		{"test/resources/wasm2go/test-wasm2go-app.wasm", []call{
+			{"test_fib", []instance.WasmVal{int32(20)}},
+			{"read_hello", nil},
+			{"bump", nil},
+			{"bump", nil},
+		}, true},
+		{"test/resources/wasm2go/test-wasm2go-float.wasm", []call{
+			{"f32_min_max", []instance.WasmVal{float32(1.5), float32(-2)}},
+			{"f32_min_max", []instance.WasmVal{float32(0), float32(math.Copysign(0, -1))}},
+			{"f32_min_max", []instance.WasmVal{f32NaN, float32(1)}},
+			{"f32_min_max", []instance.WasmVal{float32(1), f32NaN}},
+			{"f32_min_max", []instance.WasmVal{float32(math.NaN()), f32NaN}},
+			{"f64_min_max", []instance.WasmVal{math.Inf(-1), float64(3)}},
+			{"f64_min_max", []instance.WasmVal{math.Copysign(0, -1), float64(0)}},
+			{"f64_min_max", []instance.WasmVal{f64NaN, float64(1)}},
+			{"f64_min_max", []instance.WasmVal{float64(1), f64NaN}},
+			{"f64_min_max", []instance.WasmVal{f64NaN, math.NaN()}},
+			{"f32_round", []instance.WasmVal{float32(-2.5)}},
+			{"f32_round", []instance.WasmVal{float32(3.5)}},
+			{"f32_round", []instance.WasmVal{f32NaN}},
+			{"f64_round", []instance.WasmVal{float64(-0.5)}},
+			{"f64_round", []instance.WasmVal{float64(4.5)}},
+			{"f64_round", []instance.WasmVal{f64NaN}},
+			{"f32_trunc", []instance.WasmVal{float32(-1.5)}},
+			{"f32_trunc", []instance.WasmVal{float32(3e9)}},
+			{"f32_trunc", []instance.WasmVal{f32NaN}},
+		}, false},
+		{"test/resources/interpreter/test-vm-bench.wasm", []call{
+			{"sum", []instance.WasmVal{int32(1000)}},
+			{"fib", []instance.WasmVal{int32(50)}},
+			{"fib_rec", []instance.WasmVal{int32(15)}},
+			{"sum_mem", []instance.WasmVal{int32(100)}},
+			{"count_global", []instance.WasmVal{int32(10)}},
+			{"count_global", []instance.WasmVal{int32(20)}},
+		}, false},
+		{"test/resources/interpreter/test-vm-exhaustion.wasm", []call{
+			{"depth", []instance.WasmVal{int32(100)}},
+			{"runaway", nil},
+			{"depth", []instance.WasmVal{int32(10)}},
+		}, false},
+		{"test/resources/executor/test-module-lib.wasm", []call{
+			{"add", []instance.WasmVal{int32(math.MaxInt32), int32(1)}},
+			{"sub", []instance.WasmVal{int32(3), int32(5)}},
+		}, false},
+		{"examples/02-rust.wasm", []call{
+			{"add_one", []instance.WasmVal{int32(41)}},
+		}, false},
+		{"examples/03-simple.wasm", []call{
+			{"main", nil},
+		}, false},
+	}
+
+	var main, expected strings.Builder
+	main.WriteString("package main\n\nimport (\n\t\"fmt\"\n\t\"math\"\n\t\"strings\"\n")
+	main.WriteString("\t\"wasmvm/executor\"\n\t\"wasmvm/instance\"\n\t\"wasmvm/native\"\n")
+	for i := range fixtures {
+		fmt.Fprintf(&main, "\t\"wasm2gorun/m%d\"\n", i)
+	}
+	main.WriteString(")\n\nfunc main() {\n")
+
+	for i, fixture := range fixtures {
+		m, err := binary.DecodeFile(filepath.Join(rootDir, filepath.FromSlash(fixture.path)))
+		assert.AssertNil(t, err)
+
+		pkgName := fmt.Sprintf("m%d", i)
+		writePackage(t, dir, pkgName, m)
+
+		// 按相同的顺序在同一个实例上调用，所以模块的状态（内存、全局变量）也会被比较
+		names, factories := []string{"user"}, []executor.ModuleFactory{executor.Interpret(m, interpreter.Config{})}
+		goNames, goFactories := `"user"`, pkgName+".NewModule"
+		if fixture.sample {
+			sampleModule, err := binary.DecodeFile(filepath.Join(rootDir, filepath.FromSlash(fixtures[0].path)))
+			assert.AssertNil(t, err)
+			names = append([]string{"sample"}, names...)
+			factories = append([]executor.ModuleFactory{executor.Interpret(sampleModule, interpreter.Config{})}, factories...)
+			goNames, goFactories = `"sample", `+goNames, "m0.NewModule, "+goFactories
+		}
+		interpreted := executor.NewModulesWithFactories(map[string]instance.Module{"env": native.NewEnvModule()},
+			names, factories)["user"]
+		fmt.Fprintf(&main, "\tmod%d := executor.NewModulesWithFactories(map[string]instance.Module{\"env\": native.NewEnvModule()},\n"+
+			"\t\t[]string{%s}, []executor.ModuleFactory{%s})[\"user\"]\n", i, goNames, goFactories)
+		for _, c := range fixture.calls {
+			fmt.Fprintf(&expected, "%s %s\n", fixture.path, evalFunc(interpreted, c.name, c.args))
+			fmt.Fprintf(&main, "\tfmt.Println(%q, evalFunc(mod%d, %q, %s))\n", fixture.path, i, c.name, valsSource(c.args))
+		}
+	}
+	main.WriteString("}\n\n")
+	main.WriteString(funcSource(t, "evalFunc"))
+	main.WriteString("\n\n")
+	main.WriteString(funcSource(t, "formatVal"))
+	main.WriteString("\n")
+	assert.AssertNil(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte(main.String()), 0644))
+
+	expectedLines := strings.Split(expected.String(), "\n")
+	actualLines := strings.Split(goRun(t, dir), "\n")
+	assert.AssertEqual(t, len(expectedLines), len(actualLines))
+	for i := range expectedLines {
+		if expectedLines[i] != actualLines[i] {
+			t.Errorf("expected %q, got %q", expectedLines[i], actualLines[i])
+		}
+	}
+}
+
+// 嵌入方通过 Imports 接口提供内存、表和全局变量，两个模块实例共享同一组导入项
+func TestGenerateWithImports(t *testing.T) {
+	_, dir := newRunModule(t)
+	writePackage(t, dir, "imports", readModule("test-wasm2go-imports.wasm"))
+
+	main := `package main
+
+import (
+	"fmt"
+	"wasmvm/binary"
+	"wasmvm/instance"
+	"wasmvm/wasm2go/rt"
+	"wasm2gorun/imports"
+)
+
+type env struct {
+	memory        *rt.Memory
+	table         *rt.Table
+	step, counter instance.Global
+}
+
+func (e *env) EnvMemory() *rt.Memory       { return e.memory }
+func (e *env) EnvTable() *rt.Table         { return e.table }
+func (e *env) EnvStep() instance.Global    { return e.step }
+func (e *env) EnvCounter() instance.Global { return e.counter }
+
+func main() {
+	step, counter := uint64(3), uint64(100)
+	e := &env{
+		memory:  rt.NewMemory(binary.MemType{Min: 1}),
+		table:   rt.NewTable(binary.Limits{Min: 2}),
+		step:    rt.NewGlobal(binary.GlobalType{ValType: binary.ValTypeI32}, &step),
+		counter: rt.NewGlobal(binary.GlobalType{ValType: binary.ValTypeI32, Mut: binary.MutVar}, &counter),
+	}
+	a, b := imports.New(e), imports.New(e)
+	a.Store(8, 42)
+	fmt.Println(b.Load(8), a.Apply(0, 21), b.Apply(1, 7))
+	fmt.Println(a.Bump(), b.Bump(), counter, b.GetGlobalVal("counter"))
+	_, err := a.TryEvalFunc("apply", int32(2), int32(1))
+	fmt.Println(err.(*instance.Trap).Code)
+}
+`
+	assert.AssertNil(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte(main), 0644))
+	assert.AssertEqual(t, fmt.Sprintf("42 42 49\n103 106 106 106\n%s\n", instance.TrapTableOutOfBounds), goRun(t, dir))
+}
+
+// 导入项的类型匹配，导入的内存只能是预先翻译的模块的内存
+func TestLinkImports(t *testing.T) {
+	translated := sample.New(multiplier{})
+	interpreted := executor.NewModule(readModule("test-wasm2go.wasm"))
+
+	mm := map[string]instance.Module{"sample": translated}
+	assert.AssertTrue(t, rt.LinkMemory(mm, "sample", "memory", binary.MemType{Min: 1}) == translated.GetMember("memory"))
+	assert.AssertEqual(t, uint64(101), rt.LinkGlobal(mm, "sample", "counter",
+		binary.GlobalType{ValType: binary.ValTypeI32, Mut: binary.MutVar}).GetAsU64())
+
+	// 全局变量可以来自解释执行的模块
+	mm = map[string]instance.Module{"sample": interpreted}
+	assert.AssertEqual(t, uint64(101), rt.LinkGlobal(mm, "sample", "counter",
+		binary.GlobalType{ValType: binary.ValTypeI32, Mut: binary.MutVar}).GetAsU64())
+
+	linkErr := func(f func()) (err *instance.LinkError) {
+		defer func() {
+			err = recover().(*instance.LinkError)
+		}()
+		f()
+		return nil
+	}
+
+	err := linkErr(func() { rt.LinkMemory(mm, "sample", "memory", binary.MemType{Min: 1}) })
+	assert.AssertEqual(t, "link error: incompatible import type: sample.memory: expected (memory 1), got (memory 1 4)", err.Error())
+
+	mm = map[string]instance.Module{"sample": translated}
+	err = linkErr(func() { rt.LinkMemory(mm, "sample", "memory", binary.MemType{Min: 2}) })
+	assert.AssertEqual(t, "link error: incompatible import type: sample.memory: expected (memory 2), got (memory 1 4)", err.Error())
+	err = linkErr(func() { rt.LinkGlobal(mm, "sample", "counter", binary.GlobalType{ValType: binary.ValTypeI32}) })
+	assert.AssertEqual(t, "link error: incompatible import type: sample.counter: expected (global i32), got (global (mut i32))", err.Error())
+	err = linkErr(func() { rt.LinkTable(mm, "sample", "memory", binary.TableType{ElemType: binary.FuncRef}) })
+	assert.AssertEqual(t, "link error: incompatible import type: sample.memory: expected (table 0 funcref), got (memory 1 4)", err.Error())
+	err = linkErr(func() { rt.LinkTable(mm, "sample", "table", binary.TableType{ElemType: binary.FuncRef}) })
+	assert.AssertEqual(t, "link error: unknown import: sample.table", err.Error())
+
+	// 结构相同的函数类型在不同的模块里的编号相同
+	i32 := binary.ValTypeI32
+	unop := binary.FuncType{ParamTypes: []binary.ValType{i32}, ResultTypes: []binary.ValType{i32}}
+	binop := binary.FuncType{ParamTypes: []binary.ValType{i32, i32}, ResultTypes: []binary.ValType{i32}}
+	sigs1, sigs2 := rt.Sigs([]binary.FuncType{unop, binop}), rt.Sigs([]binary.FuncType{binop, unop, binop})
+	assert.AssertSliceEqual(t, []int{sigs1[1], sigs1[0], sigs1[1]}, sigs2)
+	assert.AssertTrue(t, sigs1[0] != sigs1[1])
+}
+
+// 预先翻译的模块跟解释执行的模块在同一组里实例化，并且互相导入
+func TestMixWithInterpreter(t *testing.T) {
+	moduleMap := map[string]instance.Module{"env": native.NewEnvModule()}
+	mods := executor.NewModulesWithFactories(moduleMap,
+		[]string{"sample", "app"},
+		[]executor.ModuleFactory{
+			sample.NewModule,
+			executor.Interpret(readModule("test-wasm2go-app.wasm"), interpreter.Config{}),
+		})
+
+	app := mods["app"]
+	assert.AssertListEqual(t, []instance.WasmVal{int32(6765)}, app.EvalFunc("test_fib", int32(20)))
+	assert.AssertListEqual(t, []instance.WasmVal{int32('H')}, app.EvalFunc("read_hello"))
+	assert.AssertListEqual(t, []instance.WasmVal{int32(111)}, app.EvalFunc("bump"))
+
+	// 预先翻译的模块也可以直接调用 Go 方法
+	s := mods["sample"].(*sample.Module)
+	assert.AssertEqual(t, int32(112), s.IncCounter())
+	assert.AssertEqual(t, int32(6765), s.FibRec(20))
+}
+
+// 使用嵌入方实现的 Imports 实例化模块
+func TestNewWithImports(t *testing.T) {
+	s := sample.New(multiplier{})
+	assert.AssertEqual(t, int32(42), s.ImportAdd(6, 7))
+
+	_, err := s.TryEvalFunc("div_s", int32(1), int32(0))
+	assert.AssertEqual(t, instance.TrapIntegerDivideByZero, err.(*instance.Trap).Code)
+}
+
+// 调用层数过多时发生 TrapStackExhausted 陷阱，之后模块实例仍然可以正常使用
+func TestStackExhausted(t *testing.T) {
+	s := sample.New(multiplier{})
+	_, err := s.TryEvalFunc("fib_rec", int32(math.MaxInt32))
+	assert.AssertEqual(t, instance.TrapStackExhausted, err.(*instance.Trap).Code)
+	assert.AssertEqual(t, 0, s.Depth())
+	assert.AssertEqual(t, int32(55), s.FibRec(10))
+}
+
+type multiplier struct{}
+
+func (multiplier) EnvAddI32(p0 int32, p1 int32) int32 {
+	return p0 * p1
+}
+
+// 创建临时目录里的独立模块，用于编译生成的包，返回项目的根目录以及临时目录
+func newRunModule(t *testing.T) (rootDir string, dir string) {
+	if testing.Short() {
+		t.Skip("skipping building the generated packages in short mode")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command not found")
+	}
+
+	rootDir, err := filepath.Abs("..")
+	assert.AssertNil(t, err)
+
+	// 生成的包通过 replace 引用本项目的 wasmvm 模块
+	dir = t.TempDir()
+	goMod := fmt.Sprintf("module wasm2gorun\n\ngo 1.18\n\nrequire wasmvm v0.0.0\n\nreplace wasmvm => %s\n", rootDir)
+	assert.AssertNil(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0644))
+	return rootDir, dir
+}
+
+// 将模块翻译为临时模块里的包 wasm2gorun/<pkgName>
+func writePackage(t *testing.T, dir string, pkgName string, m binary.Module) {
+	code, err := Generate(m, pkgName)
+	if err != nil {
+		t.Fatalf("%s: %v", pkgName, err)
+	}
+	pkgDir := filepath.Join(dir, pkgName)
+	assert.AssertNil(t, os.Mkdir(pkgDir, 0755))
+	assert.AssertNil(t, os.WriteFile(filepath.Join(pkgDir, pkgName+".go"), code, 0644))
+}
+
+// 运行临时模块的 main 包，返回输出
+func goRun(t *testing.T, dir string) string {
+	cmd := exec.Command("go", "run", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOWORK=off")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("go run: %v\n%s", err, output)
+	}
+	return string(output)
+}
+
+func evalFunc(mod instance.Module, name string, args []instance.WasmVal) string {
+	results, err := mod.TryEvalFunc(name, args...)
+	if trap, ok := err.(*instance.Trap); ok {
+		return fmt.Sprintf("%s: %s", name, trap.Code)
+	} else if err != nil {
+		return fmt.Sprintf("%s: %s", name, err)
+	}
+
+	items := []string{}
+	for _, r := range results {
+		items = append(items, formatVal(r))
+	}
+	return fmt.Sprintf("%s: %s", name, strings.Join(items, ", "))
+}
+
+// 浮点数显示为二进制位，以便比较 NaN 的符号和载荷
+func formatVal(val instance.WasmVal) string {
+	switch v := val.(type) {
+	case float32:
+		return fmt.Sprintf("f32(%#x)", math.Float32bits(v))
+	case float64:
+		return fmt.Sprintf("f64(%#x)", math.Float64bits(v))
+	}
+	return fmt.Sprintf("%T(%v)", val, val)
+}
+
+// 参数的 Go 源代码，浮点数使用二进制位表示，以便保留 NaN 的载荷
+func valsSource(vals []instance.WasmVal) string {
+	items := []string{}
+	for _, val := range vals {
+		switch v := val.(type) {
+		case float32:
+			items = append(items, fmt.Sprintf("math.Float32frombits(%#x)", math.Float32bits(v)))
+		case float64:
+			items = append(items, fmt.Sprintf("math.Float64frombits(%#x)", math.Float64bits(v)))
+		default:
+			items = append(items, fmt.Sprintf("%T(%d)", val, val))
+		}
+	}
+	return "[]instance.WasmVal{" + strings.Join(items, ", ") + "}"
+}
+
+// 本文件里指定函数的源代码，生成的程序使用跟测试相同的函数格式化执行结果
+func funcSource(t *testing.T, name string) string {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "wasm2go_test.go", nil, parser.ParseComments)
+	assert.AssertNil(t, err)
+
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Name.Name == name {
+			var buf bytes.Buffer
+			assert.AssertNil(t, printer.Fprint(&buf, fset, fn))
+			return buf.String()
+		}
+	}
+	t.Fatalf("func %s not found", name)
+	return ""
+}
+
+func BenchmarkFibRec(b *testing.B) {
+	s := sample.New(multiplier{})
+	for i := 0; i < b.N; i++ {
+		s.FibRec(20)
+	}
+}
+
+func BenchmarkSum(b *testing.B) {
+	s := sample.New(multiplier{})
+	for i := 0; i < b.N; i++ {
+		s.Sum(10000)
+	}
+}
+
+func readModule(fileName string) binary.Module {
+	currentDir, err := os.Getwd() // Getwd() 返回当前 package 的目录，比如 `/path/to/project/wasm2go`
+	if err != nil {
+		panic(err)
+	}
+
+	testResourcesDir := filepath.Join(currentDir, "..", "test", "resources", "wasm2go")
+	wasmFilePath := filepath.Join(testResourcesDir, fileName)
+
+	m, err := binary.DecodeFile(wasmFilePath)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
@@ -0,0 +1,72 @@
+package wast
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 脚本的执行报告，记录每一条命令的执行结果
+type Report struct {
+	Results []CommandResult
+}
+
+type CommandResult struct {
+	Line    int    // 命令所在的行号
+	Type    string // 命令的名称，比如 "assert_return"
+	Passed  bool
+	Message string // 失败的原因
+}
+
+func (r *Report) add(line int, cmdType string, err error) {
+	result := CommandResult{Line: line, Type: cmdType, Passed: err == nil}
+	if err != nil {
+		result.Message = err.Error()
+	}
+	r.Results = append(r.Results, result)
+}
+
+// 通过的命令的数量
+func (r *Report) PassedCount() int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Passed {
+			count++
+		}
+	}
+	return count
+}
+
+// 失败的命令的数量
+func (r *Report) FailedCount() int {
+	return len(r.Results) - r.PassedCount()
+}
+
+// 失败的命令的执行结果
+func (r *Report) Failures() []CommandResult {
+	var failures []CommandResult
+	for _, result := range r.Results {
+		if !result.Passed {
+			failures = append(failures, result)
+		}
+	}
+	return failures
+}
+
+// 输出每一条命令的执行结果以及汇总，比如：
+//
+// line 3: module passed
+// line 5: assert_return failed: result 0: expected i32.const 3, got i32.const 4
+// total: 2, passed: 1, failed: 1
+func (r *Report) String() string {
+	var sb strings.Builder
+	for _, result := range r.Results {
+		if result.Passed {
+			fmt.Fprintf(&sb, "line %d: %s passed\n", result.Line, result.Type)
+		} else {
+			fmt.Fprintf(&sb, "line %d: %s failed: %s\n", result.Line, result.Type, result.Message)
+		}
+	}
+	fmt.Fprintf(&sb, "total: %d, passed: %d, failed: %d\n",
+		len(r.Results), r.PassedCount(), r.FailedCount())
+	return sb.String()
+}
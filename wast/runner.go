@@ -0,0 +1,466 @@
+package wast
+
+import (
+	encoding_binary "encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"wasmvm/binary"
+	"wasmvm/executor"
+	"wasmvm/instance"
+	"wasmvm/interpreter"
+	"wasmvm/native"
+	"wasmvm/wat"
+)
+
+// 规范测试（spec test）脚本（*.wast）的执行器
+//
+// 执行器按顺序执行脚本里的命令，并记录每一条命令是否通过：
+// - module: 解析、验证并实例化模块，该模块成为 `当前模块`；
+// - register: 将模块注册为指定的名称，之后的模块可以使用该名称导入它的导出项；
+// - invoke/get: 调用导出的函数/读取导出的全局变量，不发生陷阱即通过；
+// - assert_return: 操作的返回值跟期望的一致即通过，
+//   期望值 nan:canonical/nan:arithmetic 分别匹配规范形式/算术形式的 NaN；
+// - assert_trap: 操作（或者模块的实例化）发生陷阱即通过；
+// - assert_exhaustion: 操作因为资源（比如调用栈）耗尽而发生陷阱即通过；
+// - assert_exception: 操作抛出了未被捕获的异常（*instance.Exception）即通过；
+// - assert_malformed: 模块的内容无法解析（或者解码）即通过；
+// - assert_invalid: 模块能够解析但无法通过验证即通过；
+// - assert_unlinkable: 模块能够通过验证但无法链接（实例化）即通过。
+//
+// assert_trap 和 assert_exhaustion 要求发生的是 *instance.Trap，
+// 并且陷阱的类型跟期望的错误信息一致；assert_unlinkable 要求发生的是
+// *instance.LinkError，并且错误的原因跟期望的错误信息一致。
+//
+// 执行器内置了宿主模块 `spectest`，见 native.NewSpectestModule()。
+
+type runner struct {
+	report *Report
+
+	// 可以被导入的模块实例，即 spectest 以及使用 register 命令注册的模块
+	registry map[string]instance.Module
+
+	modules map[string]instance.Module // 有标识符的模块实例
+	current instance.Module            // 最近定义的模块实例
+
+	config interpreter.Config // 实例化模块时使用的配置
+}
+
+func RunFile(filename string) (*Report, error) {
+	script, err := wat.ParseScriptFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return Run(script), nil
+}
+
+// 执行脚本，脚本里的命令即使失败，也会继续执行后面的命令
+func Run(script *wat.Script) *Report {
+	return RunWithConfig(script, interpreter.Config{})
+}
+
+// 跟 Run 相同，但使用指定的配置（比如寄存器引擎）实例化脚本里的模块
+func RunWithConfig(script *wat.Script, config interpreter.Config) *Report {
+	r := &runner{
+		report:   &Report{},
+		registry: map[string]instance.Module{"spectest": native.NewSpectestModule()},
+		modules:  map[string]instance.Module{},
+		config:   config,
+	}
+
+	for _, cmd := range script.Commands {
+		r.report.add(cmd.Line, cmd.Type, r.runCommand(cmd))
+	}
+	return r.report
+}
+
+// 执行一条命令，命令失败时返回失败的原因
+func (r *runner) runCommand(cmd wat.Command) error {
+	switch cmd.Type {
+	case wat.CmdModule:
+		return r.runModule(cmd.Module)
+	case wat.CmdRegister:
+		return r.runRegister(cmd.Name, cmd.ModuleId)
+	case wat.CmdInvoke, wat.CmdGet:
+		_, err := r.runAction(cmd.Action)
+		return err
+	case wat.CmdAssertReturn:
+		return r.assertReturn(cmd.Action, cmd.Results)
+	case wat.CmdAssertTrap:
+		if cmd.Module != nil {
+			return r.assertUninstantiable(cmd.Module, func(err error) error {
+				return matchTrap(err, cmd.Text)
+			})
+		}
+		return r.assertTrap(cmd.Action, cmd.Text)
+	case wat.CmdAssertExhaustion:
+		return r.assertTrap(cmd.Action, cmd.Text)
+	case wat.CmdAssertException:
+		return r.assertException(cmd.Action)
+	case wat.CmdAssertMalformed:
+		return assertMalformed(cmd.Module)
+	case wat.CmdAssertInvalid:
+		return assertInvalid(cmd.Module)
+	case wat.CmdAssertUnlinkable:
+		return r.assertUninstantiable(cmd.Module, func(err error) error {
+			return matchLinkError(err, cmd.Text)
+		})
+	default:
+		return fmt.Errorf("unsupported command: %s", cmd.Type)
+	}
+}
+
+// ---------------- 模块
+
+func (r *runner) runModule(sm *wat.ScriptModule) error {
+	m, err := decodeAndValidate(sm)
+	if err != nil {
+		return err
+	}
+
+	mod, err := r.instantiate(m)
+	if err != nil {
+		return err
+	}
+
+	r.current = mod
+	if sm.Id != "" {
+		r.modules[sm.Id] = mod
+	}
+	return nil
+}
+
+func (r *runner) runRegister(name string, moduleId string) error {
+	mod, err := r.getModule(moduleId)
+	if err != nil {
+		return err
+	}
+	r.registry[name] = mod
+	return nil
+}
+
+func (r *runner) getModule(moduleId string) (instance.Module, error) {
+	if moduleId == "" {
+		if r.current == nil {
+			return nil, errors.New("no module defined")
+		}
+		return r.current, nil
+	}
+
+	mod, ok := r.modules[moduleId]
+	if !ok {
+		return nil, fmt.Errorf("unknown module $%s", moduleId)
+	}
+	return mod, nil
+}
+
+func decodeAndValidate(sm *wat.ScriptModule) (binary.Module, error) {
+	m, err := sm.Decode()
+	if err != nil {
+		return m, err
+	}
+	return m, binary.Validate(m)
+}
+
+// 实例化模块，模块只能导入已注册的模块
+func (r *runner) instantiate(m binary.Module) (mod instance.Module, err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = instance.ToError(v)
+		}
+	}()
+
+	moduleMap := map[string]instance.Module{}
+	for name, registered := range r.registry {
+		moduleMap[name] = registered
+	}
+
+	// 新模块的名称不会跟注册的名称冲突，因为 moduleMap 是一个副本
+	const name = "\x00current"
+	return executor.NewModulesWithConfig(moduleMap, []string{name}, []binary.Module{m}, r.config)[name], nil
+}
+
+// ---------------- 操作
+
+func (r *runner) runAction(action *wat.Action) (results []instance.WasmVal, err error) {
+	mod, err := r.getModule(action.ModuleId)
+	if err != nil {
+		return nil, err
+	}
+
+	member := mod.GetMember(action.Name)
+	if member == nil {
+		return nil, fmt.Errorf("unknown export %q", action.Name)
+	}
+
+	if action.Type == wat.CmdGet {
+		global, ok := member.(instance.Global)
+		if !ok {
+			return nil, fmt.Errorf("export %q is not a global", action.Name)
+		}
+		return []instance.WasmVal{global.Get()}, nil
+	}
+
+	f, ok := member.(instance.Function)
+	if !ok {
+		return nil, fmt.Errorf("export %q is not a function", action.Name)
+	}
+	if len(f.Type().ParamTypes) != len(action.Args) {
+		return nil, fmt.Errorf("function %q expects %d arguments, got %d",
+			action.Name, len(f.Type().ParamTypes), len(action.Args))
+	}
+
+	return f.TryEval(action.Args...)
+}
+
+// ---------------- 断言
+
+func (r *runner) assertReturn(action *wat.Action, expected []wat.Result) error {
+	results, err := r.runAction(action)
+	if err != nil {
+		return err
+	}
+
+	if len(results) != len(expected) {
+		return fmt.Errorf("expected %d results, got %d", len(expected), len(results))
+	}
+	for i, result := range results {
+		if !matchResult(expected[i], result) {
+			return fmt.Errorf("result %d: expected %s, got %s",
+				i, formatResult(expected[i]), formatValue(result))
+		}
+	}
+	return nil
+}
+
+func (r *runner) assertTrap(action *wat.Action, text string) error {
+	results, err := r.runAction(action)
+	if err == nil {
+		return fmt.Errorf("expected trap %q, got %s", text, formatValues(results))
+	}
+	return matchTrap(err, text)
+}
+
+func (r *runner) assertException(action *wat.Action) error {
+	results, err := r.runAction(action)
+	if err == nil {
+		return fmt.Errorf("expected exception, got %s", formatValues(results))
+	}
+	if _, ok := err.(*instance.Exception); !ok {
+		return err
+	}
+	return nil
+}
+
+// 规范测试里有些陷阱的信息跟 instance.TrapCode.String() 不同，但属于同一类陷阱
+var trapAliases = map[string]instance.TrapCode{
+	"out of bounds table access": instance.TrapTableOutOfBounds,
+}
+
+func matchTrap(err error, text string) error {
+	trap, ok := err.(*instance.Trap)
+	if !ok {
+		// 找不到模块、导出项，或者宿主函数的错误等
+		return err
+	}
+
+	if code, ok := trapAliases[text]; ok && code == trap.Code {
+		return nil
+	}
+	// 规范测试的信息可能带有更多的细节，比如 "uninitialized element 2"，所以只比较前缀
+	if !strings.HasPrefix(text, trap.Code.String()) {
+		return fmt.Errorf("expected trap %q, got %q", text, trap.Code.String())
+	}
+	return nil
+}
+
+func assertMalformed(sm *wat.ScriptModule) error {
+	if _, err := sm.Decode(); err == nil {
+		return errors.New("expected malformed module, but it was decoded successfully")
+	}
+	return nil
+}
+
+func assertInvalid(sm *wat.ScriptModule) error {
+	m, err := sm.Decode()
+	if err != nil {
+		return fmt.Errorf("expected invalid module, but it is malformed: %v", err)
+	}
+	if binary.Validate(m) == nil {
+		return errors.New("expected invalid module, but it was validated successfully")
+	}
+	return nil
+}
+
+// 模块能够通过验证，但实例化失败，match 用于检查实例化时发生的错误
+func (r *runner) assertUninstantiable(sm *wat.ScriptModule, match func(err error) error) error {
+	m, err := decodeAndValidate(sm)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.instantiate(m)
+	if err == nil {
+		return errors.New("expected instantiation failure, but the module was instantiated successfully")
+	}
+	return match(err)
+}
+
+func matchLinkError(err error, text string) error {
+	linkErr, ok := err.(*instance.LinkError)
+	if !ok {
+		return fmt.Errorf("expected link error %q, got %v", text, err)
+	}
+	if linkErr.Reason != text {
+		return fmt.Errorf("expected link error %q, got %q", text, linkErr.Error())
+	}
+	return nil
+}
+
+// ---------------- 比较数值
+
+func matchResult(expected wat.Result, actual instance.WasmVal) bool {
+	switch expected.Ref {
+	case wat.RefNull:
+		return actual == nil
+	case wat.RefFunc:
+		_, ok := actual.(instance.Function)
+		return ok
+	case wat.RefExtern:
+		return actual != nil
+	}
+
+	if expected.Lanes != nil {
+		return matchLanes(expected.Lanes, actual)
+	}
+
+	switch e := expected.Value.(type) {
+	case int32, int64, binary.V128, wat.ExternRef:
+		return e == actual
+	case float32:
+		a, ok := actual.(float32)
+		if !ok {
+			return false
+		}
+		bits := uint64(math.Float32bits(a))
+		if expected.NaN != "" {
+			return matchNaN(expected.NaN, bits, 32)
+		}
+		return bits == uint64(math.Float32bits(e))
+	case float64:
+		a, ok := actual.(float64)
+		if !ok {
+			return false
+		}
+		bits := math.Float64bits(a)
+		if expected.NaN != "" {
+			return matchNaN(expected.NaN, bits, 64)
+		}
+		return bits == math.Float64bits(e)
+	default:
+		return false
+	}
+}
+
+// 按通道比较 f32x4 或者 f64x2 形状的 v128
+func matchLanes(lanes []wat.Result, actual instance.WasmVal) bool {
+	a, ok := actual.(binary.V128)
+	if !ok {
+		return false
+	}
+	width := 16 / len(lanes)
+	for i, lane := range lanes {
+		var val instance.WasmVal
+		if width == 4 {
+			val = math.Float32frombits(encoding_binary.LittleEndian.Uint32(a[i*4:]))
+		} else {
+			val = math.Float64frombits(encoding_binary.LittleEndian.Uint64(a[i*8:]))
+		}
+		if !matchResult(lane, val) {
+			return false
+		}
+	}
+	return true
+}
+
+// 规范形式的 NaN（canonical NaN）：尾数只有最高位为 1；
+// 算术形式的 NaN（arithmetic NaN）：尾数的最高位为 1。
+// 两者的符号位都可以是任意值。
+func matchNaN(kind string, bits uint64, bitSize int) bool {
+	fracBits := 52
+	if bitSize == 32 {
+		fracBits = 23
+	}
+
+	// 指数部分必须全为 1
+	expMask := uint64(1)<<(bitSize-fracBits-1) - 1
+	if (bits>>fracBits)&expMask != expMask {
+		return false
+	}
+
+	payload := bits & (1<<fracBits - 1)
+	quietBit := uint64(1) << (fracBits - 1)
+	if kind == wat.NaNCanonical {
+		return payload == quietBit
+	}
+	return payload&quietBit != 0
+}
+
+func formatResult(result wat.Result) string {
+	if result.Ref != "" {
+		return "ref." + result.Ref
+	}
+	if result.Lanes != nil {
+		shape := "f64x2"
+		if len(result.Lanes) == 4 {
+			shape = "f32x4"
+		}
+		text := "v128.const " + shape
+		for _, lane := range result.Lanes {
+			text += " " + strings.TrimPrefix(strings.TrimPrefix(formatResult(lane), "f32.const "), "f64.const ")
+		}
+		return text
+	}
+	if result.NaN == "" {
+		return formatValue(result.Value)
+	}
+	if _, ok := result.Value.(float32); ok {
+		return "f32.const nan:" + result.NaN
+	}
+	return "f64.const nan:" + result.NaN
+}
+
+func formatValue(val instance.WasmVal) string {
+	switch v := val.(type) {
+	case int32:
+		return fmt.Sprintf("i32.const %d", v)
+	case int64:
+		return fmt.Sprintf("i64.const %d", v)
+	case float32:
+		return fmt.Sprintf("f32.const %v (0x%08x)", v, math.Float32bits(v))
+	case float64:
+		return fmt.Sprintf("f64.const %v (0x%016x)", v, math.Float64bits(v))
+	case binary.V128:
+		return "v128.const " + binary.FormatV128(v)
+	case nil:
+		return "ref.null"
+	case wat.ExternRef:
+		return fmt.Sprintf("ref.extern %d", v)
+	case instance.Function:
+		return "ref.func " + v.Type().String()
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func formatValues(vals []instance.WasmVal) string {
+	text := "["
+	for i, val := range vals {
+		if i > 0 {
+			text += ", "
+		}
+		text += formatValue(val)
+	}
+	return text + "]"
+}
@@ -0,0 +1,96 @@
+package wast
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"wasmvm/assert"
+	"wasmvm/interpreter"
+	"wasmvm/wat"
+)
+
+// 执行 test/resources/wast 里的所有脚本，所有命令都应该通过
+func TestRunResources(t *testing.T) {
+	runResources(t, interpreter.Config{})
+}
+
+// 使用寄存器引擎执行所有脚本，结果应该跟栈式引擎一致（即所有命令都通过）
+func TestRunResourcesWithRegisterEngine(t *testing.T) {
+	runResources(t, interpreter.Config{Engine: interpreter.EngineRegister})
+}
+
+func runResources(t *testing.T, config interpreter.Config) {
+	currentDir, err := os.Getwd()
+	assert.AssertNil(t, err)
+
+	fileNames, err := filepath.Glob(filepath.Join(currentDir, "..", "test", "resources", "wast", "*.wast"))
+	assert.AssertNil(t, err)
+	assert.AssertTrue(t, len(fileNames) > 0)
+
+	for _, fileName := range fileNames {
+		script, err := wat.ParseScriptFile(fileName)
+		if err != nil {
+			t.Fatalf("%s: %v", filepath.Base(fileName), err)
+		}
+		report := RunWithConfig(script, config)
+		assert.AssertTrue(t, len(report.Results) > 0)
+
+		for _, failure := range report.Failures() {
+			t.Errorf("%s: line %d: %s failed: %s",
+				filepath.Base(fileName), failure.Line, failure.Type, failure.Message)
+		}
+	}
+}
+
+func TestRunReport(t *testing.T) {
+	src := `
+	(module
+		(func (export "div") (param i32 i32) (result i32)
+			(i32.div_s (local.get 0) (local.get 1))))
+	(assert_return (invoke "div" (i32.const 6) (i32.const 3)) (i32.const 2))
+	(assert_return (invoke "div" (i32.const 6) (i32.const 3)) (i32.const 3))
+	(assert_trap (invoke "div" (i32.const 1) (i32.const 0)) "integer divide by zero")
+	(assert_trap (invoke "div" (i32.const 1) (i32.const 1)) "integer divide by zero")
+	(assert_return (invoke "mul" (i32.const 1) (i32.const 1)) (i32.const 1))
+	(assert_invalid (module (func (result i32) (i64.const 0))) "type mismatch")
+	(assert_invalid (module (func (result i32) (i32.const 0))) "type mismatch")
+	(assert_malformed (module quote "(func (i32.foo))") "unknown operator")
+	`
+
+	script, err := wat.ParseScript([]byte(src))
+	assert.AssertNil(t, err)
+
+	report := Run(script)
+	assert.AssertEqual(t, 9, len(report.Results))
+	assert.AssertEqual(t, 5, report.PassedCount())
+	assert.AssertEqual(t, 4, report.FailedCount())
+
+	failures := report.Failures()
+	assert.AssertEqual(t, 6, failures[0].Line)
+	assert.AssertEqual(t, "result 0: expected i32.const 3, got i32.const 2", failures[0].Message)
+	assert.AssertEqual(t, 8, failures[1].Line)
+	assert.AssertEqual(t, 9, failures[2].Line)
+	assert.AssertEqual(t, `unknown export "mul"`, failures[2].Message)
+	assert.AssertEqual(t, 11, failures[3].Line)
+	assert.AssertEqual(t, wat.CmdAssertInvalid, failures[3].Type)
+}
+
+// 执行之前的模块的函数发生陷阱之后，模块实例应该仍然可以使用
+func TestRunAfterTrap(t *testing.T) {
+	src := `
+	(module
+		(memory 1)
+		(func $load (param i32) (result i32) (i32.load (local.get 0)))
+		(func (export "sum") (param i32) (result i32)
+			(i32.add (i32.const 1) (call $load (local.get 0)))))
+	(assert_trap (invoke "sum" (i32.const 65536)) "out of bounds memory access")
+	(assert_return (invoke "sum" (i32.const 0)) (i32.const 1))
+	`
+
+	script, err := wat.ParseScript([]byte(src))
+	assert.AssertNil(t, err)
+
+	report := Run(script)
+	assert.AssertEqual(t, 3, report.PassedCount())
+	assert.AssertEqual(t, 0, report.FailedCount())
+}
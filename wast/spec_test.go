@@ -0,0 +1,85 @@
+package wast
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"wasmvm/assert"
+	"wasmvm/interpreter"
+	"wasmvm/wat"
+)
+
+// 官方规范测试里跟虚拟机已实现的提案冲突的命令，键为 "文件名:行号"，值为原因
+var specSkips = map[string]string{
+	"memory.wast:10":   "multi-memory：允许多个内存",
+	"memory.wast:11":   "multi-memory：允许多个内存",
+	"imports.wast:488": "multi-memory：允许多个内存",
+	"imports.wast:492": "multi-memory：允许多个内存",
+	"imports.wast:496": "multi-memory：允许多个内存",
+	"binary.wast:125":  "multi-memory：memory.grow/size 的保留字节是内存索引",
+	"binary.wast:145":  "multi-memory：memory.grow/size 的保留字节是内存索引",
+	"binary.wast:165":  "multi-memory：memory.grow/size 的保留字节是内存索引",
+	"binary.wast:184":  "multi-memory：memory.grow/size 的保留字节是内存索引",
+	"binary.wast:203":  "multi-memory：memory.grow/size 的保留字节是内存索引",
+	"binary.wast:223":  "multi-memory：memory.grow/size 的保留字节是内存索引",
+	"binary.wast:242":  "multi-memory：memory.grow/size 的保留字节是内存索引",
+	"binary.wast:261":  "multi-memory：memory.grow/size 的保留字节是内存索引",
+	"binary.wast:279":  "multi-memory：memory.grow/size 的保留字节是内存索引",
+	"binary.wast:297":  "multi-memory：memory.grow/size 的保留字节是内存索引",
+	"binary.wast:831":  "threads：内存限制值的第 1 位表示共享内存",
+}
+
+// test/resources/spec 里除了 specSkips 之外应该通过的命令数量
+const specPassedCount = 27952
+
+// 执行 test/resources/spec 里的官方规范测试
+func TestSpec(t *testing.T) {
+	runSpec(t, "spec", specPassedCount, interpreter.Config{})
+}
+
+func TestSpecWithRegisterEngine(t *testing.T) {
+	runSpec(t, "spec", specPassedCount, interpreter.Config{Engine: interpreter.EngineRegister})
+}
+
+// test/resources/spec/simd 里除了 specSkips 之外应该通过的命令数量
+const specSIMDPassedCount = 25979
+
+// 执行 test/resources/spec/simd 里的官方 SIMD 规范测试
+func TestSpecSIMD(t *testing.T) {
+	runSpec(t, filepath.Join("spec", "simd"), specSIMDPassedCount, interpreter.Config{})
+}
+
+func TestSpecSIMDWithRegisterEngine(t *testing.T) {
+	runSpec(t, filepath.Join("spec", "simd"), specSIMDPassedCount, interpreter.Config{Engine: interpreter.EngineRegister})
+}
+
+// 执行指定目录里的所有脚本，除了 specSkips 列出的命令之外，所有命令都应该通过，
+// 并且通过的数量跟 passedCount 相同（防止脚本没有被完整执行）
+func runSpec(t *testing.T, dir string, passedCount int, config interpreter.Config) {
+	currentDir, err := os.Getwd()
+	assert.AssertNil(t, err)
+
+	fileNames, err := filepath.Glob(filepath.Join(currentDir, "..", "test", "resources", dir, "*.wast"))
+	assert.AssertNil(t, err)
+	assert.AssertTrue(t, len(fileNames) > 0)
+
+	passed := 0
+	for _, fileName := range fileNames {
+		script, err := wat.ParseScriptFile(fileName)
+		if err != nil {
+			t.Fatalf("%s: %v", filepath.Base(fileName), err)
+		}
+		report := RunWithConfig(script, config)
+		passed += report.PassedCount()
+
+		for _, failure := range report.Failures() {
+			key := fmt.Sprintf("%s:%d", filepath.Base(fileName), failure.Line)
+			if _, ok := specSkips[key]; !ok {
+				t.Errorf("%s: %s failed: %s", key, failure.Type, failure.Message)
+			}
+		}
+	}
+
+	assert.AssertEqual(t, passedCount, passed)
+}
@@ -0,0 +1,17 @@
+package wat
+
+import "fmt"
+
+// 解析错误
+//
+// 当文本格式的源码不合法（比如括号不匹配、未知的指令、未定义的标识符等）时，
+// Parse() 和 ParseFile() 会返回这个类型的错误
+type ParseError struct {
+	Line   int    // 出错的位置的行号，从 1 开始
+	Column int    // 出错的位置的列号（按字节计算），从 1 开始
+	Reason string // 出错的原因
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parse error at %d:%d: %s", e.Line, e.Column, e.Reason)
+}
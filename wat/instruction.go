@@ -0,0 +1,757 @@
+package wat
+
+import (
+	encoding_binary "encoding/binary"
+	"math"
+	"math/bits"
+	"strings"
+	"wasmvm/binary"
+)
+
+// 指令的解析
+//
+// 指令有两种写法，两种写法可以混合使用：
+//
+// 平铺形式（flat）：
+//
+// local.get 0
+// block (result i32)
+//   i32.const 1
+// end
+// if
+//   ...
+// else
+//   ...
+// end
+//
+// 折叠形式（folded），操作数（也是折叠形式的指令）写在指令的立即数后面，
+// 解析时先生成操作数的指令，再生成指令本身：
+//
+// (i32.add (local.get 0) (i32.const 1))
+// (block (result i32) (i32.const 1))
+// (if (local.get 0) (then ...) (else ...))
+// (try (do ...) (catch $e ...) (catch_all ...))
+
+var byteOrder = encoding_binary.LittleEndian
+
+// 指令名称到操作码的映射
+var opcodes = map[string]byte{}
+
+// 0xFC 前缀指令名称到子操作码的映射
+var miscOpcodes = map[string]uint32{}
+
+// 0xFD 前缀指令（SIMD 指令）名称到子操作码的映射
+var simdOpcodes = map[string]uint32{}
+
+// 0xFE 前缀指令（原子指令）名称到子操作码的映射
+var atomicOpcodes = map[string]uint32{}
+
+func init() {
+	for i := 0; i < 256; i++ {
+		// 带类型的 select 跟 select 同名，解析时根据是否有 (result t) 区分
+		if name := binary.GetOpname(byte(i)); name != "" &&
+			byte(i) != binary.MiscPrefix && byte(i) != binary.SIMDPrefix && byte(i) != binary.AtomicPrefix &&
+			byte(i) != binary.SelectT {
+			opcodes[name] = byte(i)
+		}
+	}
+	for i := 0; i < binary.GetMiscOpcodeCount(); i++ {
+		if name := binary.GetMiscOpname(uint32(i)); name != "" {
+			miscOpcodes[name] = uint32(i)
+		}
+	}
+	for i := 0; i < binary.GetSIMDOpcodeCount(); i++ {
+		if name := binary.GetSIMDOpname(uint32(i)); name != "" {
+			simdOpcodes[name] = uint32(i)
+		}
+	}
+	for i := 0; i < binary.GetAtomicOpcodeCount(); i++ {
+		if name := binary.GetAtomicOpname(uint32(i)); name != "" {
+			atomicOpcodes[name] = uint32(i)
+		}
+	}
+}
+
+// 函数（或者常量表达式）的解析上下文
+type funcContext struct {
+	p          *parser
+	localIds   map[string]uint32
+	localNames binary.NameMap
+	labels     []string // 标签栈，没有名称的标签为空字符串
+	labelNames binary.NameMap
+	blockCount uint32 // 结构化指令的数量，用作标签名称的索引
+}
+
+func (fc *funcContext) addLocalName(n *node, idx uint32, id string) {
+	if id == "" {
+		return
+	}
+	if _, exists := fc.localIds[id]; exists {
+		fail(n, "duplicate local $%s", id)
+	}
+	fc.localIds[id] = idx
+	fc.localNames = append(fc.localNames, binary.NameAssoc{Idx: idx, Name: id})
+}
+
+// 解析指令序列，遇到 `end`、`else`、`catch`、`catch_all`、`delegate` 或者到达末尾时停止
+func (fc *funcContext) parseInstrs(c *cursor) []binary.Instruction {
+	var instrs []binary.Instruction
+	for !c.eof() {
+		n := c.peek()
+		if n.isList {
+			instrs = append(instrs, fc.parseFoldedInstr(c.next())...)
+		} else if n.isKeyword("end") || n.isKeyword("else") ||
+			n.isKeyword("catch") || n.isKeyword("catch_all") || n.isKeyword("delegate") {
+			break
+		} else {
+			instrs = append(instrs, fc.parsePlainInstr(c))
+		}
+	}
+	return instrs
+}
+
+// 解析平铺形式的指令
+func (fc *funcContext) parsePlainInstr(c *cursor) binary.Instruction {
+	n := c.next()
+
+	switch {
+	case n.isKeyword("block") || n.isKeyword("loop"):
+		bt := fc.beginBlock(c)
+		instrs := fc.parseInstrs(c)
+		fc.endBlock(c)
+		return binary.Instruction{
+			Opcode: opcodes[n.text],
+			Args:   binary.BlockArgs{BT: bt, Instrs: instrs},
+		}
+
+	case n.isKeyword("if"):
+		args := binary.IfArgs{BT: fc.beginBlock(c)}
+		args.Instrs1 = fc.parseInstrs(c)
+		if c.readOptionalKeyword("else") {
+			fc.readOptionalLabel(c)
+			args.Instrs2 = append([]binary.Instruction{}, fc.parseInstrs(c)...)
+		}
+		fc.endBlock(c)
+		return binary.Instruction{Opcode: binary.If, Args: args}
+
+	case n.isKeyword("try"):
+		// try label? block_type instr* (catch x instr*)* (catch_all instr*)? end
+		// try label? block_type instr* delegate l
+		args := binary.TryArgs{BT: fc.beginBlock(c)}
+		args.Instrs = fc.parseInstrs(c)
+		for c.peek().isKeyword("catch") || c.peek().isKeyword("catch_all") {
+			clause := binary.CatchClause{All: c.next().isKeyword("catch_all")}
+			if !clause.All {
+				clause.Tag = fc.p.resolveIdx(c.next(), kindTag)
+			}
+			clause.Instrs = fc.parseInstrs(c)
+			args.Catches = append(args.Catches, clause)
+		}
+		if c.readOptionalKeyword("delegate") {
+			fc.labels = fc.labels[:len(fc.labels)-1]
+			args.Delegate = fc.parseDelegateLabel(c)
+		} else {
+			fc.endBlock(c)
+		}
+		return binary.Instruction{Opcode: binary.Try, Args: args}
+
+	default:
+		return fc.parseInstrWithArgs(n, c)
+	}
+}
+
+// 解析折叠形式的指令
+func (fc *funcContext) parseFoldedInstr(n *node) []binary.Instruction {
+	head := n.head()
+	if head == "" {
+		fail(n, "expected an instruction, found %s", n)
+	}
+	c := newCursor(n)
+
+	switch head {
+	case "block", "loop":
+		bt := fc.beginBlock(c)
+		instrs := fc.parseInstrs(c)
+		c.expectEnd()
+		fc.labels = fc.labels[:len(fc.labels)-1]
+		return []binary.Instruction{{
+			Opcode: opcodes[head],
+			Args:   binary.BlockArgs{BT: bt, Instrs: instrs},
+		}}
+
+	case "if":
+		// (if label? block_type folded_instr* (then instr*) (else instr*)?)
+		// 条件表达式不在 if 块之内，所以先解析条件表达式
+		label := c.readOptionalId()
+		bt := fc.parseBlockType(c)
+
+		var instrs []binary.Instruction
+		for c.peek().isList && !c.peek().isListOf("then") {
+			instrs = append(instrs, fc.parseFoldedInstr(c.next())...)
+		}
+
+		fc.pushLabel(label)
+		args := binary.IfArgs{BT: bt}
+
+		thenNode := c.next()
+		if !thenNode.isListOf("then") {
+			fail(thenNode, "expected (then ...), found %s", thenNode)
+		}
+		tc := newCursor(thenNode)
+		args.Instrs1 = fc.parseInstrs(tc)
+		tc.expectEnd()
+
+		if c.peek().isListOf("else") {
+			ec := newCursor(c.next())
+			args.Instrs2 = append([]binary.Instruction{}, fc.parseInstrs(ec)...)
+			ec.expectEnd()
+		}
+		c.expectEnd()
+		fc.labels = fc.labels[:len(fc.labels)-1]
+
+		return append(instrs, binary.Instruction{Opcode: binary.If, Args: args})
+
+	case "try":
+		// (try label? block_type (do instr*) (catch x instr*)* (catch_all instr*)?)
+		// (try label? block_type (do instr*) (delegate l))
+		args := binary.TryArgs{BT: fc.beginBlock(c)}
+
+		doNode := c.next()
+		if !doNode.isListOf("do") {
+			fail(doNode, "expected (do ...), found %s", doNode)
+		}
+		dc := newCursor(doNode)
+		args.Instrs = fc.parseInstrs(dc)
+		dc.expectEnd()
+
+		for c.peek().isListOf("catch") || c.peek().isListOf("catch_all") {
+			cc := newCursor(c.next())
+			clause := binary.CatchClause{All: cc.owner.isListOf("catch_all")}
+			if !clause.All {
+				clause.Tag = fc.p.resolveIdx(cc.next(), kindTag)
+			}
+			clause.Instrs = fc.parseInstrs(cc)
+			cc.expectEnd()
+			args.Catches = append(args.Catches, clause)
+		}
+
+		fc.labels = fc.labels[:len(fc.labels)-1]
+		if c.peek().isListOf("delegate") {
+			lc := newCursor(c.next())
+			args.Delegate = fc.parseDelegateLabel(lc)
+			lc.expectEnd()
+		}
+		c.expectEnd()
+
+		return []binary.Instruction{{Opcode: binary.Try, Args: args}}
+
+	default:
+		// (op immediate* folded_instr*)
+		instr := fc.parseInstrWithArgs(n.children[0], c)
+
+		var instrs []binary.Instruction
+		for !c.eof() {
+			operand := c.next()
+			if !operand.isList {
+				fail(operand, "unexpected %s", operand)
+			}
+			instrs = append(instrs, fc.parseFoldedInstr(operand)...)
+		}
+		return append(instrs, instr)
+	}
+}
+
+// ---------------- 结构化指令
+
+// 解析结构化指令的标签和块类型，并将标签压入标签栈
+func (fc *funcContext) beginBlock(c *cursor) binary.BlockType {
+	label := c.readOptionalId()
+	bt := fc.parseBlockType(c)
+	fc.pushLabel(label)
+	return bt
+}
+
+func (fc *funcContext) pushLabel(label string) {
+	if label != "" {
+		fc.labelNames = append(fc.labelNames, binary.NameAssoc{Idx: fc.blockCount, Name: label})
+	}
+	fc.blockCount++
+	fc.labels = append(fc.labels, label)
+}
+
+// 读取平铺形式的结构化指令的结尾 `end $label?`，并将标签弹出标签栈
+func (fc *funcContext) endBlock(c *cursor) {
+	c.readKeyword("end")
+	fc.readOptionalLabel(c)
+	fc.labels = fc.labels[:len(fc.labels)-1]
+}
+
+// delegate 的标签相对于 try 块的外层，所以解析之前 try 块的标签已经弹出标签栈
+func (fc *funcContext) parseDelegateLabel(c *cursor) *binary.LabelIdx {
+	label := fc.resolveLabel(c.next())
+	return &label
+}
+
+// `end` 和 `else` 之后可以重复写一次标签
+func (fc *funcContext) readOptionalLabel(c *cursor) {
+	if c.peek().isId() {
+		n := c.next()
+		if n.text[1:] != fc.labels[len(fc.labels)-1] {
+			fail(n, "mismatching label %s", n.text)
+		}
+	}
+}
+
+// 解析块类型：(type x)? (param ...)* (result ...)*
+// 没有参数并且最多只有一个返回值时使用简写形式，否则使用类型索引
+func (fc *funcContext) parseBlockType(c *cursor) binary.BlockType {
+	if c.peek().isListOf("type") {
+		return binary.BlockType(fc.parseAnonTypeUse(c))
+	}
+
+	if !c.peek().isListOf("param") && !c.peek().isListOf("result") {
+		return binary.BlockTypeEmpty
+	}
+
+	start := c.pos
+	ft, paramIds := fc.p.parseFuncSignature(c)
+	checkAnonParams(c.nodes[start], paramIds)
+	if len(ft.ParamTypes) == 0 && len(ft.ResultTypes) <= 1 {
+		if len(ft.ResultTypes) == 0 {
+			return binary.BlockTypeEmpty
+		}
+		switch ft.ResultTypes[0] {
+		case binary.ValTypeI32:
+			return binary.BlockTypeI32
+		case binary.ValTypeI64:
+			return binary.BlockTypeI64
+		case binary.ValTypeF32:
+			return binary.BlockTypeF32
+		case binary.ValTypeFuncRef:
+			return binary.BlockTypeFuncRef
+		case binary.ValTypeExternRef:
+			return binary.BlockTypeExternRef
+		case binary.ValTypeV128:
+			return binary.BlockTypeV128
+		default:
+			return binary.BlockTypeF64
+		}
+	}
+
+	c.pos = start
+	return binary.BlockType(fc.parseAnonTypeUse(c))
+}
+
+// 块和 call_indirect 的类型引用里的参数不能有名称，比如 `(param $x i32)` 不合法
+func (fc *funcContext) parseAnonTypeUse(c *cursor) uint32 {
+	n := c.peek()
+	typeIdx, paramIds := fc.p.parseTypeUse(c)
+	checkAnonParams(n, paramIds)
+	return typeIdx
+}
+
+func checkAnonParams(n *node, paramIds []string) {
+	for _, id := range paramIds {
+		if id != "" {
+			fail(n, "unexpected token: parameter name $%s", id)
+		}
+	}
+}
+
+// ---------------- 普通指令
+
+// 解析指令的立即数（操作数）
+func (fc *funcContext) parseInstrWithArgs(n *node, c *cursor) binary.Instruction {
+	if sub, ok := miscOpcodes[n.text]; ok && !n.isList && !n.isString {
+		return binary.Instruction{Opcode: binary.MiscPrefix, Args: fc.parseMiscArgs(sub, c)}
+	}
+	if sub, ok := simdOpcodes[n.text]; ok && !n.isList && !n.isString {
+		return binary.Instruction{Opcode: binary.SIMDPrefix, Args: fc.parseSIMDArgs(sub, c)}
+	}
+	if sub, ok := atomicOpcodes[n.text]; ok && !n.isList && !n.isString {
+		args := binary.AtomicArgs{SubOpcode: sub}
+		if sub != binary.AtomicFence {
+			args.Args = fc.parseMemArg(c, binary.GetAtomicNaturalAlign(sub))
+		}
+		return binary.Instruction{Opcode: binary.AtomicPrefix, Args: args}
+	}
+
+	opcode, ok := opcodes[n.text]
+	if !ok || n.isList || n.isString ||
+		opcode == binary.Else_ || opcode == binary.End_ ||
+		opcode == binary.Block || opcode == binary.Loop || opcode == binary.If ||
+		opcode == binary.Try || opcode == binary.Catch_ || opcode == binary.CatchAll_ || opcode == binary.Delegate_ {
+		fail(n, "unknown instruction %s", n)
+	}
+
+	instr := binary.Instruction{Opcode: opcode}
+
+	switch opcode {
+
+	// 数值指令
+
+	case binary.I32Const:
+		instr.Args = int32(parseIntArg(c.next(), 32))
+	case binary.I64Const:
+		instr.Args = int64(parseIntArg(c.next(), 64))
+	case binary.F32Const:
+		arg := c.next()
+		val, ok := parseF32(arg.text)
+		if arg.isList || arg.isString || !ok {
+			fail(arg, "invalid f32 literal %s", arg)
+		}
+		instr.Args = val
+	case binary.F64Const:
+		arg := c.next()
+		val, ok := parseF64(arg.text)
+		if arg.isList || arg.isString || !ok {
+			fail(arg, "invalid f64 literal %s", arg)
+		}
+		instr.Args = val
+
+	// 变量指令
+
+	case binary.LocalGet, binary.LocalSet, binary.LocalTee:
+		instr.Args = fc.resolveLocal(c.next())
+	case binary.GlobalGet, binary.GlobalSet:
+		instr.Args = fc.p.resolveIdx(c.next(), kindGlobal)
+
+	// 操作数指令
+
+	case binary.Select:
+		// select (result t)*
+		var vts []binary.ValType
+		for c.peek().isListOf("result") {
+			rc := newCursor(c.next())
+			for !rc.eof() {
+				vts = append(vts, parseValType(rc.next()))
+			}
+		}
+		if vts != nil {
+			instr.Opcode = binary.SelectT
+			instr.Args = vts
+		}
+
+	// 引用指令和表指令
+
+	case binary.RefNull:
+		instr.Args = parseHeapType(c.next())
+	case binary.RefFunc:
+		instr.Args = fc.p.resolveIdx(c.next(), kindFunc)
+	case binary.TableGet, binary.TableSet:
+		instr.Args = fc.parseOptionalTableIdx(c)
+
+	// 内存指令
+
+	case binary.MemorySize, binary.MemoryGrow:
+		instr.Args = fc.parseOptionalMemIdx(c)
+
+	// 跳转指令
+
+	case binary.Br, binary.BrIf:
+		instr.Args = fc.resolveLabel(c.next())
+	case binary.BrTable:
+		var labels []binary.LabelIdx
+		for c.isIdx() {
+			labels = append(labels, fc.resolveLabel(c.next()))
+		}
+		if len(labels) == 0 {
+			fail(n, "br_table requires at least one label")
+		}
+		instr.Args = binary.BrTableArgs{
+			Labels:  labels[:len(labels)-1],
+			Default: labels[len(labels)-1],
+		}
+
+	// 异常处理指令
+
+	case binary.Throw:
+		instr.Args = fc.p.resolveIdx(c.next(), kindTag)
+	case binary.Rethrow:
+		instr.Args = fc.resolveLabel(c.next())
+
+	// 函数调用指令
+
+	case binary.Call, binary.ReturnCall:
+		instr.Args = fc.p.resolveIdx(c.next(), kindFunc)
+	case binary.CallIndirect, binary.ReturnCallIndirect:
+		// call_indirect table_idx? typeuse
+		tableIdx := fc.parseOptionalTableIdx(c)
+		typeIdx := fc.parseAnonTypeUse(c)
+		instr.Args = binary.CallIndirectArgs{Type: typeIdx, Table: tableIdx}
+
+	default:
+		// 内存指令（续）
+		if opcode >= binary.I32Load && opcode <= binary.I64Store32 {
+			instr.Args = fc.parseMemArg(c, binary.GetNaturalAlign(opcode))
+		}
+	}
+
+	return instr
+}
+
+// 解析 0xFC 前缀指令的立即数
+func (fc *funcContext) parseMiscArgs(sub uint32, c *cursor) binary.MiscArgs {
+	args := binary.MiscArgs{SubOpcode: sub}
+
+	switch sub {
+	case binary.MemoryInit:
+		// memory.init mem_idx? data_idx
+		first := c.next()
+		if c.isIdx() {
+			args.Args = binary.MemoryInitArgs{
+				Mem:  fc.p.resolveIdx(first, kindMem),
+				Data: fc.p.resolveIdx(c.next(), kindData),
+			}
+		} else {
+			args.Args = binary.MemoryInitArgs{Data: fc.p.resolveIdx(first, kindData)}
+		}
+		fc.p.usesDataCount = true
+	case binary.DataDrop:
+		args.Args = fc.p.resolveIdx(c.next(), kindData)
+		fc.p.usesDataCount = true
+	case binary.MemoryCopy:
+		// memory.copy (dst_mem_idx src_mem_idx)?
+		memoryCopyArgs := binary.MemoryCopyArgs{}
+		if c.isIdx() {
+			memoryCopyArgs.Dst = fc.p.resolveIdx(c.next(), kindMem)
+			memoryCopyArgs.Src = fc.p.resolveIdx(c.next(), kindMem)
+		}
+		args.Args = memoryCopyArgs
+	case binary.MemoryFill:
+		args.Args = fc.parseOptionalMemIdx(c)
+	case binary.ElemDrop:
+		args.Args = fc.p.resolveIdx(c.next(), kindElem)
+	case binary.TableInit:
+		// table.init table_idx? elem_idx
+		first := c.next()
+		if c.isIdx() {
+			args.Args = binary.TableInitArgs{
+				Table: fc.p.resolveIdx(first, kindTable),
+				Elem:  fc.p.resolveIdx(c.next(), kindElem),
+			}
+		} else {
+			args.Args = binary.TableInitArgs{Elem: fc.p.resolveIdx(first, kindElem)}
+		}
+	case binary.TableCopy:
+		// table.copy (dst_table_idx src_table_idx)?
+		tableCopyArgs := binary.TableCopyArgs{}
+		if c.isIdx() {
+			tableCopyArgs.Dst = fc.p.resolveIdx(c.next(), kindTable)
+			tableCopyArgs.Src = fc.p.resolveIdx(c.next(), kindTable)
+		}
+		args.Args = tableCopyArgs
+	case binary.TableGrow, binary.TableSize, binary.TableFill:
+		args.Args = fc.parseOptionalTableIdx(c)
+	}
+
+	return args
+}
+
+// 解析 0xFD 前缀指令（SIMD 指令）的立即数
+func (fc *funcContext) parseSIMDArgs(sub uint32, c *cursor) binary.SIMDArgs {
+	args := binary.SIMDArgs{SubOpcode: sub}
+	naturalAlign := binary.GetSIMDNaturalAlign(sub)
+
+	switch {
+	case sub <= binary.V128Store || sub == binary.V128Load32Zero || sub == binary.V128Load64Zero:
+		args.Args = fc.parseMemArg(c, naturalAlign)
+	case sub >= binary.V128Load8Lane && sub <= binary.V128Store64Lane:
+		// v128.load8_lane mem_idx? offset=N? align=N? lane_idx
+		//
+		// 内存索引和通道索引都可以是数字，所以只有后面还有一个数字或者
+		// offset=/align= 时，第一个数字才是内存索引
+		mem := uint32(0)
+		if c.isIdx() && c.pos+1 < len(c.nodes) {
+			if n := c.nodes[c.pos+1]; !n.isList && !n.isString && (isUint(n.text) ||
+				strings.HasPrefix(n.text, "offset=") || strings.HasPrefix(n.text, "align=")) {
+				mem = fc.p.resolveIdx(c.next(), kindMem)
+			}
+		}
+		args.Args = binary.MemLaneArgs{
+			MemArg: fc.parseOffsetAndAlign(c, mem, naturalAlign),
+			Lane:   parseLaneIdx(c.next()),
+		}
+	case sub == binary.V128Const:
+		args.Args = parseV128(c)
+	case sub == binary.I8x16Shuffle:
+		var lanes [16]binary.LaneIdx
+		for i := range lanes {
+			lanes[i] = parseLaneIdx(c.next())
+		}
+		args.Args = lanes
+	case sub >= binary.I8x16ExtractLaneS && sub <= binary.F64x2ReplaceLane:
+		args.Args = parseLaneIdx(c.next())
+	}
+
+	return args
+}
+
+func parseLaneIdx(n *node) binary.LaneIdx {
+	val, ok := parseUint(n.text, 8)
+	if n.isList || n.isString || !ok {
+		fail(n, "malformed lane index %s", n)
+	}
+	return binary.LaneIdx(val)
+}
+
+func isUint(text string) bool {
+	_, ok := parseUint(text, 32)
+	return ok
+}
+
+// v128.const 的立即数：形状以及各个通道的值，比如
+//
+// v128.const i32x4 1 2 3 4
+// v128.const f64x2 0.5 -inf
+func parseV128(c *cursor) binary.V128 {
+	var val binary.V128
+	shape := c.next()
+
+	switch {
+	case shape.isKeyword("i8x16"):
+		for i := 0; i < 16; i++ {
+			val[i] = byte(parseIntArg(c.next(), 8))
+		}
+	case shape.isKeyword("i16x8"):
+		for i := 0; i < 8; i++ {
+			byteOrder.PutUint16(val[i*2:], uint16(parseIntArg(c.next(), 16)))
+		}
+	case shape.isKeyword("i32x4"):
+		for i := 0; i < 4; i++ {
+			byteOrder.PutUint32(val[i*4:], uint32(parseIntArg(c.next(), 32)))
+		}
+	case shape.isKeyword("i64x2"):
+		for i := 0; i < 2; i++ {
+			byteOrder.PutUint64(val[i*8:], parseIntArg(c.next(), 64))
+		}
+	case shape.isKeyword("f32x4"):
+		for i := 0; i < 4; i++ {
+			arg := c.next()
+			f, ok := parseF32(arg.text)
+			if arg.isList || arg.isString || !ok {
+				fail(arg, "invalid f32 literal %s", arg)
+			}
+			byteOrder.PutUint32(val[i*4:], math.Float32bits(f))
+		}
+	case shape.isKeyword("f64x2"):
+		for i := 0; i < 2; i++ {
+			arg := c.next()
+			f, ok := parseF64(arg.text)
+			if arg.isList || arg.isString || !ok {
+				fail(arg, "invalid f64 literal %s", arg)
+			}
+			byteOrder.PutUint64(val[i*8:], math.Float64bits(f))
+		}
+	default:
+		fail(shape, "unexpected v128 shape %s", shape)
+	}
+
+	return val
+}
+
+// 表指令的表索引可以省略，省略时为 0
+func (fc *funcContext) parseOptionalTableIdx(c *cursor) uint32 {
+	if c.isIdx() {
+		return fc.p.resolveIdx(c.next(), kindTable)
+	}
+	return 0
+}
+
+// 内存指令的内存索引可以省略，省略时为 0
+func (fc *funcContext) parseOptionalMemIdx(c *cursor) uint32 {
+	if c.isIdx() {
+		return fc.p.resolveIdx(c.next(), kindMem)
+	}
+	return 0
+}
+
+// ref.null 指令的立即数：func 或者 extern
+func parseHeapType(n *node) binary.ValType {
+	switch {
+	case n.isKeyword("func"):
+		return binary.FuncRef
+	case n.isKeyword("extern"):
+		return binary.ExternRef
+	default:
+		fail(n, "unknown heap type %s", n)
+		return 0
+	}
+}
+
+func parseIntArg(n *node, bitSize int) uint64 {
+	val, ok := parseInt(n.text, bitSize)
+	if n.isList || n.isString || !ok {
+		fail(n, "invalid i%d literal %s", bitSize, n)
+	}
+	return val
+}
+
+// mem_idx? offset=N? align=N?
+func (fc *funcContext) parseMemArg(c *cursor, naturalAlign uint32) binary.MemArg {
+	return fc.parseOffsetAndAlign(c, fc.parseOptionalMemIdx(c), naturalAlign)
+}
+
+// offset=N? align=N?
+func (fc *funcContext) parseOffsetAndAlign(c *cursor, mem uint32, naturalAlign uint32) binary.MemArg {
+	memArg := binary.MemArg{Mem: mem, Align: naturalAlign}
+
+	if n := c.peek(); strings.HasPrefix(n.text, "offset=") && !n.isString {
+		c.next()
+		val, ok := parseUint(strings.TrimPrefix(n.text, "offset="), 64)
+		if !ok {
+			fail(n, "invalid offset %s", n)
+		}
+		if val > math.MaxUint32 {
+			// 只有 64 位的内存才允许超出 u32 范围的偏移量，内存可能在后面才定义，
+			// 所以等所有字段解析完之后再检查
+			fc.p.wideOffsets = append(fc.p.wideOffsets, wideOffset{n, mem})
+		}
+		memArg.Offset = val
+	}
+
+	if n := c.peek(); strings.HasPrefix(n.text, "align=") && !n.isString {
+		c.next()
+		val, ok := parseUint(strings.TrimPrefix(n.text, "align="), 32)
+		if !ok || val == 0 || val&(val-1) != 0 {
+			fail(n, "alignment must be a power of two")
+		}
+		memArg.Align = uint32(bits.TrailingZeros64(val))
+	}
+
+	return memArg
+}
+
+func (fc *funcContext) resolveLocal(n *node) uint32 {
+	if n.isId() {
+		idx, ok := fc.localIds[n.text[1:]]
+		if !ok {
+			fail(n, "unknown local %s", n.text)
+		}
+		return idx
+	}
+
+	val, ok := parseUint(n.text, 32)
+	if n.isList || n.isString || !ok {
+		fail(n, "expected a local index, found %s", n)
+	}
+	return uint32(val)
+}
+
+// 标签使用相对深度表示，最内层的块为 0
+func (fc *funcContext) resolveLabel(n *node) uint32 {
+	if n.isId() {
+		for i := len(fc.labels) - 1; i >= 0; i-- {
+			if fc.labels[i] == n.text[1:] {
+				return uint32(len(fc.labels) - 1 - i)
+			}
+		}
+		fail(n, "unknown label %s", n.text)
+	}
+
+	val, ok := parseUint(n.text, 32)
+	if n.isList || n.isString || !ok {
+		fail(n, "expected a label index, found %s", n)
+	}
+	return uint32(val)
+}
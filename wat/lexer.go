@@ -0,0 +1,267 @@
+package wat
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// 词法分析
+//
+// 文本格式是由 S-表达式组成的，词法分析器将源码转换为一棵由列表节点和
+// 原子节点组成的树，后续的语法分析都是在这棵树上进行的。
+//
+// 原子节点有两种：
+// - 字符串，比如 "hello"，节点的 text 是转义之后的内容（可能不是合法的 utf-8）；
+// - 其他原子（关键字、数字、标识符等），比如 `func`, `i32.const`, `0x10`, `$f1`, `offset=4`。
+//
+// 注释有两种，都会被忽略：
+// - 行注释：`;; ...`
+// - 块注释：`(; ... ;)`，块注释可以嵌套
+
+type node struct {
+	isList   bool
+	children []*node // 列表节点的子节点
+	isString bool    // 原子节点是否字符串
+	text     string  // 原子节点的内容
+	line     int
+	col      int
+}
+
+// 是否指定名称的关键字（原子），比如 `func`
+func (n *node) isKeyword(keyword string) bool {
+	return !n.isList && !n.isString && n.text == keyword
+}
+
+// 是否标识符，比如 `$f1`
+func (n *node) isId() bool {
+	return !n.isList && !n.isString && len(n.text) > 1 && n.text[0] == '$'
+}
+
+// 是否以指定关键字开头的列表，比如 `(func ...)`
+func (n *node) isListOf(keyword string) bool {
+	return n.isList && len(n.children) > 0 && n.children[0].isKeyword(keyword)
+}
+
+// 列表的第一个关键字，如果不是列表或者列表不以关键字开头，则返回空字符串
+func (n *node) head() string {
+	if n.isList && len(n.children) > 0 &&
+		!n.children[0].isList && !n.children[0].isString {
+		return n.children[0].text
+	}
+	return ""
+}
+
+func (n *node) String() string {
+	if n.isList {
+		return "(" + n.head() + " ...)"
+	}
+	if n.isString {
+		return strconv.Quote(n.text)
+	}
+	return n.text
+}
+
+type lexer struct {
+	src  []byte
+	pos  int
+	line int
+	col  int
+}
+
+func failAt(line int, col int, format string, args ...interface{}) {
+	panic(&ParseError{Line: line, Column: col, Reason: fmt.Sprintf(format, args...)})
+}
+
+// 将源码转换为（顶层的）节点列表
+func lex(src []byte) []*node {
+	l := &lexer{src: src, line: 1, col: 1}
+	var nodes []*node
+	for {
+		l.skipSpaces()
+		if l.pos >= len(l.src) {
+			return nodes
+		}
+		if l.src[l.pos] == ')' {
+			failAt(l.line, l.col, "unexpected ')'")
+		}
+		nodes = append(nodes, l.readNode())
+	}
+}
+
+func (l *lexer) advance() {
+	if l.src[l.pos] == '\n' {
+		l.line++
+		l.col = 1
+	} else {
+		l.col++
+	}
+	l.pos++
+}
+
+func (l *lexer) hasPrefix(prefix string) bool {
+	return bytes.HasPrefix(l.src[l.pos:], []byte(prefix))
+}
+
+// 跳过空白字符和注释
+func (l *lexer) skipSpaces() {
+	for l.pos < len(l.src) {
+		switch {
+		case isSpace(l.src[l.pos]):
+			l.advance()
+		case l.hasPrefix(";;"):
+			// 行注释以 LF 或者 CR 结束
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' && l.src[l.pos] != '\r' {
+				l.advance()
+			}
+		case l.hasPrefix("(;"):
+			l.skipBlockComment()
+		default:
+			return
+		}
+	}
+}
+
+func (l *lexer) skipBlockComment() {
+	line, col := l.line, l.col
+	depth := 0
+	for l.pos < len(l.src) {
+		if l.hasPrefix("(;") {
+			depth++
+			l.advance()
+			l.advance()
+		} else if l.hasPrefix(";)") {
+			depth--
+			l.advance()
+			l.advance()
+			if depth == 0 {
+				return
+			}
+		} else {
+			l.advance()
+		}
+	}
+	failAt(line, col, "unclosed block comment")
+}
+
+func (l *lexer) readNode() *node {
+	n := &node{line: l.line, col: l.col}
+
+	switch l.src[l.pos] {
+	case '(':
+		n.isList = true
+		l.advance()
+		for {
+			l.skipSpaces()
+			if l.pos >= len(l.src) {
+				failAt(n.line, n.col, "unclosed '('")
+			}
+			if l.src[l.pos] == ')' {
+				l.advance()
+				return n
+			}
+			n.children = append(n.children, l.readNode())
+		}
+	case '"':
+		n.isString = true
+		n.text = l.readString()
+	default:
+		start := l.pos
+		for l.pos < len(l.src) && isAtomChar(l.src[l.pos]) {
+			l.advance()
+		}
+		if l.pos == start {
+			failAt(l.line, l.col, "unexpected character %q", l.src[l.pos])
+		}
+		n.text = string(l.src[start:l.pos])
+	}
+
+	// 原子之后必须是空白、括号、注释或者源码的结尾，比如 `$l"a"` 和 `"a""b"` 都不合法
+	if l.pos < len(l.src) && l.src[l.pos] != '(' && l.src[l.pos] != ')' &&
+		l.src[l.pos] != ';' && !isSpace(l.src[l.pos]) {
+		failAt(l.line, l.col, "unknown operator: missing space before %q", l.src[l.pos])
+	}
+	return n
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+// 原子可以由除了空白、括号、引号和分号之外的可打印 ASCII 字符组成
+func isAtomChar(c byte) bool {
+	return c > ' ' && c < 0x7f &&
+		c != '(' && c != ')' && c != '"' && c != ';'
+}
+
+// 读取字符串并转义
+func (l *lexer) readString() string {
+	line, col := l.line, l.col
+	l.advance() // 开头的引号
+
+	var sb strings.Builder
+	for {
+		if l.pos >= len(l.src) || l.src[l.pos] == '\n' {
+			failAt(line, col, "unclosed string")
+		}
+
+		c := l.src[l.pos]
+		l.advance()
+
+		switch {
+		case c == '"':
+			return sb.String()
+		case c != '\\':
+			sb.WriteByte(c)
+		case l.pos >= len(l.src):
+			failAt(line, col, "unclosed string")
+		default:
+			l.readEscape(&sb)
+		}
+	}
+}
+
+func (l *lexer) readEscape(sb *strings.Builder) {
+	line, col := l.line, l.col-1
+	c := l.src[l.pos]
+	l.advance()
+
+	switch c {
+	case 't':
+		sb.WriteByte('\t')
+	case 'n':
+		sb.WriteByte('\n')
+	case 'r':
+		sb.WriteByte('\r')
+	case '"', '\'', '\\':
+		sb.WriteByte(c)
+	case 'u':
+		// \u{hex}
+		end := bytes.IndexByte(l.src[l.pos:], '}')
+		if !l.hasPrefix("{") || end < 0 {
+			failAt(line, col, "malformed unicode escape")
+		}
+		hex := string(l.src[l.pos+1 : l.pos+end])
+		code, err := strconv.ParseUint(strings.ReplaceAll(hex, "_", ""), 16, 32)
+		if err != nil || !utf8.ValidRune(rune(code)) {
+			failAt(line, col, "malformed unicode escape")
+		}
+		sb.WriteRune(rune(code))
+		for i := 0; i <= end; i++ {
+			l.advance()
+		}
+	default:
+		// \hh
+		if l.pos >= len(l.src) {
+			failAt(line, col, "unclosed string")
+		}
+		b, err := strconv.ParseUint(string([]byte{c, l.src[l.pos]}), 16, 8)
+		if err != nil {
+			failAt(line, col, "unknown escape sequence")
+		}
+		sb.WriteByte(byte(b))
+		l.advance()
+	}
+}
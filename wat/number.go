@@ -0,0 +1,158 @@
+package wat
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// 数字字面量
+//
+// 整数：可选的符号 + 十进制或者十六进制（0x 开头）数字，数字之间可以有下划线，
+// 比如 `123`, `-1`, `0xff`, `1_000_000`。
+//
+// 浮点数：除了整数的写法之外，还支持小数、指数、十六进制浮点数以及特殊值，
+// 比如 `3.14`, `1e10`, `0x1.8p3`, `inf`, `-inf`, `nan`, `nan:0x200000`。
+//
+// i32/i64 的字面量既可以是有符号数，也可以是无符号数，比如 `(i32.const -1)` 跟
+// `(i32.const 0xffffffff)` 是等价的。
+
+// 解析无符号整数（不能有符号，比如索引、偏移量、通道索引等），失败时 ok 为 false
+func parseUint(text string, bitSize int) (val uint64, ok bool) {
+	if !validDigits(text) {
+		return 0, false
+	}
+	text = strings.ReplaceAll(text, "_", "")
+
+	var err error
+	if strings.HasPrefix(text, "0x") {
+		val, err = strconv.ParseUint(text[2:], 16, bitSize)
+	} else {
+		val, err = strconv.ParseUint(text, 10, bitSize)
+	}
+	return val, err == nil && text != "" && text[0] != '+' && text[0] != '-'
+}
+
+// 解析整数（有符号或者无符号），返回的是补码形式的无符号数
+func parseInt(text string, bitSize int) (val uint64, ok bool) {
+	if strings.HasPrefix(text, "-") {
+		val, ok = parseUint(text[1:], bitSize)
+		if !ok || val > 1<<(bitSize-1) {
+			return 0, false
+		}
+		return -val & (math.MaxUint64 >> (64 - bitSize)), true
+	}
+	return parseUint(strings.TrimPrefix(text, "+"), bitSize)
+}
+
+func parseF32(text string) (float32, bool) {
+	if bits, ok := parseNaN(text, 32); ok {
+		return math.Float32frombits(uint32(bits)), true
+	}
+	f, ok := parseFloat(text, 32)
+	return float32(f), ok
+}
+
+func parseF64(text string) (float64, bool) {
+	if bits, ok := parseNaN(text, 64); ok {
+		return math.Float64frombits(bits), true
+	}
+	return parseFloat(text, 64)
+}
+
+func parseFloat(text string, bitSize int) (float64, bool) {
+	if !validDigits(text) {
+		return 0, false
+	}
+	text = strings.ReplaceAll(text, "_", "")
+
+	sign := ""
+	digits := text
+	if strings.HasPrefix(text, "+") || strings.HasPrefix(text, "-") {
+		sign, digits = text[:1], text[1:]
+	}
+	if digits == "" || strings.HasPrefix(digits, "+") || strings.HasPrefix(digits, "-") {
+		return 0, false
+	}
+
+	switch {
+	case digits == "inf":
+		digits = "Inf"
+	case strings.HasPrefix(digits, "0x") && !strings.ContainsAny(digits, "pP"):
+		// Go 的十六进制浮点数必须有指数部分
+		digits += "p0"
+	case strings.ContainsAny(digits, "Ii"):
+		// 排除 Go 支持但 wat 不支持的写法，比如 "infinity"
+		return 0, false
+	}
+
+	f, err := strconv.ParseFloat(sign+digits, bitSize)
+	if err != nil {
+		// 超出范围的数值不合法
+		return 0, false
+	}
+	return f, true
+}
+
+// 解析 nan 和 nan:0x... 返回浮点数的二进制位
+func parseNaN(text string, bitSize int) (bits uint64, ok bool) {
+	sign := uint64(0)
+	if strings.HasPrefix(text, "+") {
+		text = text[1:]
+	} else if strings.HasPrefix(text, "-") {
+		sign = 1
+		text = text[1:]
+	}
+
+	if !strings.HasPrefix(text, "nan") {
+		return 0, false
+	}
+
+	fracBits := 52
+	if bitSize == 32 {
+		fracBits = 23
+	}
+	exp := uint64(1)<<(bitSize-fracBits-1) - 1 // 指数部分全为 1
+
+	payload := uint64(1) << (fracBits - 1) // 默认的（canonical）nan
+	if text != "nan" {
+		if !strings.HasPrefix(text, "nan:0x") {
+			return 0, false
+		}
+		payload, ok = parseUint(text[len("nan:"):], 64)
+		if !ok || payload == 0 || payload >= 1<<fracBits {
+			return 0, false
+		}
+	}
+
+	return sign<<(bitSize-1) | exp<<fracBits | payload, true
+}
+
+// 检查数字部分的写法：必须以数字开头（比如 `.5` 不合法），
+// 下划线只能出现在两个数字之间（比如 `_1`, `1__0`, `1_.0`, `0x_1` 都不合法）
+func validDigits(text string) bool {
+	if strings.HasPrefix(text, "+") || strings.HasPrefix(text, "-") {
+		text = text[1:]
+	}
+	if text == "inf" {
+		return true
+	}
+
+	isDigit := func(c byte) bool { return c >= '0' && c <= '9' }
+	if strings.HasPrefix(text, "0x") {
+		text = text[2:]
+		isDigit = func(c byte) bool {
+			return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+		}
+	}
+	if text == "" || !isDigit(text[0]) {
+		return false
+	}
+
+	for i := 1; i < len(text); i++ {
+		if text[i] == '_' && (i+1 >= len(text) || !isDigit(text[i-1]) || !isDigit(text[i+1])) {
+			return false
+		}
+	}
+	return true
+}
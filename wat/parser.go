@@ -0,0 +1,1015 @@
+package wat
+
+import (
+	"fmt"
+	"io/ioutil"
+	"unicode/utf8"
+	"wasmvm/binary"
+)
+
+// 文本格式（*.wat）解析器，将源码解析为 binary.Module
+//
+// 解析分两遍进行：
+// 1. 第一遍收集各个索引空间（类型、函数、表、内存、全局变量、元素项、数据项）
+//    里的标识符，并解析显式定义的类型（type 字段）；
+// 2. 第二遍按字段出现的顺序生成各个段的内容，此时所有标识符都已经有了对应的索引，
+//    所以允许引用后面才定义的项目（比如调用后面才定义的函数）。
+//
+// 生成的模块跟 `wasm-tools parse` 生成的一致：
+// - 函数（以及 block 等结构化指令）使用内联的参数和返回值时，如果已存在相同签名的类型，
+//   则使用该类型，否则在类型段的末尾添加新的类型；
+// - 内联的导入、导出项按所在字段出现的位置生成；
+// - 标识符会被写入名称段（即名称为 "name" 的自定义段）；
+// - 仅当函数使用了 memory.init 或者 data.drop 指令时才生成数据计数段。
+
+// 模块的索引空间
+const (
+	kindType = iota
+	kindFunc
+	kindTable
+	kindMem
+	kindGlobal
+	kindElem
+	kindData
+	kindTag
+	kindCount
+)
+
+var kindNames = [kindCount]string{"type", "func", "table", "memory", "global", "elem", "data", "tag"}
+
+type parser struct {
+	module binary.Module
+	names  binary.NameSec
+
+	ids       [kindCount]map[string]uint32 // 各个索引空间里的标识符
+	counts    [kindCount]uint32            // 各个索引空间的项目数量（第一遍）
+	hasDefine [kindCount]bool              // 是否已经出现过非导入的定义（第一遍）
+	funcIdx   uint32                       // 下一个非导入函数的索引（第二遍）
+
+	usesDataCount bool         // 是否使用了需要数据计数段的指令（memory.init、data.drop）
+	wideOffsets   []wideOffset // 超出 u32 范围的内存偏移量（第二遍）
+}
+
+type wideOffset struct {
+	n   *node
+	mem uint32 // 内存索引
+}
+
+func ParseFile(filename string) (binary.Module, error) {
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return binary.Module{}, err
+	}
+	return Parse(src)
+}
+
+func Parse(src []byte) (module binary.Module, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = toParseError(r)
+		}
+	}()
+
+	nodes := lex(src)
+
+	// 源码可以是一个 `(module ...)`，也可以省略 `module` 直接写模块的字段
+	if len(nodes) == 1 && nodes[0].isListOf("module") {
+		module = parseModule(nodes[0])
+	} else {
+		module = parseModule(&node{isList: true, children: append([]*node{{text: "module"}}, nodes...)})
+	}
+	return
+}
+
+// 将 Parse() 过程当中 recover 得到的值转为 *ParseError
+func toParseError(value interface{}) *ParseError {
+	if e, ok := value.(*ParseError); ok {
+		return e
+	}
+	return &ParseError{Reason: fmt.Sprint(value)}
+}
+
+func parseModule(moduleNode *node) binary.Module {
+	p := &parser{}
+	for i := range p.ids {
+		p.ids[i] = map[string]uint32{}
+	}
+
+	fields := moduleNode.children[1:]
+	if len(fields) > 0 && fields[0].isId() {
+		p.names.ModuleName = fields[0].text[1:]
+		fields = fields[1:]
+	}
+
+	for _, field := range fields {
+		p.collectField(field)
+	}
+	for _, field := range fields {
+		p.parseField(field)
+	}
+
+	for _, w := range p.wideOffsets {
+		if !p.isMem64(w.mem) {
+			fail(w.n, "i32 constant out of range: %s", w.n.text)
+		}
+	}
+
+	if p.usesDataCount {
+		count := uint32(len(p.module.DataSec))
+		p.module.DataCountSec = &count
+	}
+
+	p.module.Magic = binary.MagicNumber
+	p.module.Version = binary.Version
+	if !p.names.IsEmpty() {
+		p.module.CustomSecs = append(p.module.CustomSecs, binary.EncodeNameSec(p.names))
+	}
+	return p.module
+}
+
+// ---------------- 辅助函数
+
+// 指定索引的内存（包括导入的内存）是否 64 位的内存
+func (p *parser) isMem64(memIdx uint32) bool {
+	mems := []binary.MemType{}
+	for _, imp := range p.module.ImportSec {
+		if imp.Desc.Tag == binary.ImportTagMem {
+			mems = append(mems, imp.Desc.Mem)
+		}
+	}
+	mems = append(mems, p.module.MemSec...)
+	return int(memIdx) < len(mems) && mems[memIdx].Tag&binary.LimitsTag64 != 0
+}
+
+func fail(n *node, format string, args ...interface{}) {
+	failAt(n.line, n.col, format, args...)
+}
+
+// 节点序列的游标，用于逐个读取列表的子节点
+type cursor struct {
+	nodes []*node
+	pos   int
+	owner *node // 子节点所属的节点，用于报告错误的位置
+}
+
+func newCursor(n *node) *cursor {
+	return &cursor{nodes: n.children[1:], owner: n}
+}
+
+func (c *cursor) eof() bool {
+	return c.pos >= len(c.nodes)
+}
+
+// 查看当前节点，到达末尾时返回一个空的原子节点（方便调用者直接使用 isXXX 方法）
+func (c *cursor) peek() *node {
+	if c.eof() {
+		return &node{line: c.owner.line, col: c.owner.col}
+	}
+	return c.nodes[c.pos]
+}
+
+func (c *cursor) next() *node {
+	if c.eof() {
+		fail(c.owner, "unexpected end of %s", c.owner)
+	}
+	n := c.nodes[c.pos]
+	c.pos++
+	return n
+}
+
+// 如果当前节点是标识符则读取并返回（不包括 `$`），否则返回空字符串
+func (c *cursor) readOptionalId() string {
+	if c.peek().isId() {
+		return c.next().text[1:]
+	}
+	return ""
+}
+
+func (c *cursor) readString() string {
+	n := c.next()
+	if !n.isString {
+		fail(n, "expected a string, found %s", n)
+	}
+	return n.text
+}
+
+// 读取导入导出的名称，名称必须是合法的 utf-8 字符串
+func (c *cursor) readName() string {
+	n := c.peek()
+	name := c.readString()
+	if !utf8.ValidString(name) {
+		fail(n, "malformed UTF-8 encoding")
+	}
+	return name
+}
+
+func (c *cursor) readKeyword(keyword string) {
+	n := c.next()
+	if !n.isKeyword(keyword) {
+		fail(n, "expected '%s', found %s", keyword, n)
+	}
+}
+
+func (c *cursor) readU32() uint32 {
+	n := c.next()
+	val, ok := parseUint(n.text, 32)
+	if n.isList || n.isString || !ok {
+		fail(n, "expected a u32, found %s", n)
+	}
+	return uint32(val)
+}
+
+func (c *cursor) readU64() uint64 {
+	n := c.next()
+	val, ok := parseUint(n.text, 64)
+	if n.isList || n.isString || !ok {
+		fail(n, "expected a u64, found %s", n)
+	}
+	return val
+}
+
+// 检查是否已读取完所有节点
+func (c *cursor) expectEnd() {
+	if !c.eof() {
+		n := c.peek()
+		fail(n, "unexpected %s", n)
+	}
+}
+
+// 解析索引，索引可以是数字或者标识符
+func (p *parser) resolveIdx(n *node, kind int) uint32 {
+	if n.isId() {
+		idx, ok := p.ids[kind][n.text[1:]]
+		if !ok {
+			fail(n, "unknown %s %s", kindNames[kind], n.text)
+		}
+		return idx
+	}
+
+	val, ok := parseUint(n.text, 32)
+	if n.isList || n.isString || !ok {
+		fail(n, "expected a %s index, found %s", kindNames[kind], n)
+	}
+	return uint32(val)
+}
+
+func (c *cursor) isIdx() bool {
+	n := c.peek()
+	if c.eof() || n.isList || n.isString {
+		return false
+	}
+	_, ok := parseUint(n.text, 32)
+	return ok || n.isId()
+}
+
+// ---------------- 第一遍：收集标识符
+
+// 返回字段定义的项目所属的索引空间，以及该项目是否导入项
+func fieldKind(field *node) (kind int, isImport bool) {
+	switch field.head() {
+	case "type":
+		return kindType, false
+	case "import":
+		if len(field.children) == 4 {
+			return descKind(field.children[3]), true
+		}
+		return -1, true
+	case "func", "table", "memory", "global", "tag":
+		for _, child := range field.children[1:] {
+			if child.isListOf("import") {
+				return descKind(field), true
+			}
+		}
+		return descKind(field), false
+	case "elem":
+		return kindElem, false
+	case "data":
+		return kindData, false
+	default:
+		return -1, false
+	}
+}
+
+func descKind(desc *node) int {
+	switch desc.head() {
+	case "func":
+		return kindFunc
+	case "table":
+		return kindTable
+	case "memory":
+		return kindMem
+	case "global":
+		return kindGlobal
+	case "tag":
+		return kindTag
+	default:
+		return -1
+	}
+}
+
+func (p *parser) collectField(field *node) {
+	if !field.isList {
+		fail(field, "expected a module field, found %s", field)
+	}
+
+	kind, isImport := fieldKind(field)
+	if kind < 0 {
+		switch field.head() {
+		case "export", "start":
+			return
+		case "import":
+			fail(field, "malformed import")
+		default:
+			fail(field, "unknown module field %s", field)
+		}
+	}
+
+	// 导入项必须出现在所有函数、表、内存和全局变量的定义之前
+	if isImport {
+		for _, k := range []int{kindFunc, kindTable, kindMem, kindGlobal} {
+			if p.hasDefine[k] {
+				fail(field, "import after %s", kindNames[k])
+			}
+		}
+	}
+	if !isImport {
+		p.hasDefine[kind] = true
+	}
+
+	idNode := field
+	if field.head() == "import" {
+		idNode = field.children[3]
+	}
+	c := newCursor(idNode)
+	idx := p.counts[kind]
+	p.counts[kind]++
+
+	if id := c.readOptionalId(); id != "" {
+		if _, exists := p.ids[kind][id]; exists {
+			fail(idNode, "duplicate %s $%s", kindNames[kind], id)
+		}
+		p.ids[kind][id] = idx
+		p.addName(kind, idx, id)
+	}
+
+	if kind == kindType {
+		p.module.TypeSec = append(p.module.TypeSec, p.parseTypeDef(c))
+	}
+}
+
+func (p *parser) addName(kind int, idx uint32, name string) {
+	assoc := binary.NameAssoc{Idx: idx, Name: name}
+	switch kind {
+	case kindType:
+		p.names.TypeNames = append(p.names.TypeNames, assoc)
+	case kindFunc:
+		p.names.FuncNames = append(p.names.FuncNames, assoc)
+	case kindTable:
+		p.names.TableNames = append(p.names.TableNames, assoc)
+	case kindMem:
+		p.names.MemNames = append(p.names.MemNames, assoc)
+	case kindGlobal:
+		p.names.GlobalNames = append(p.names.GlobalNames, assoc)
+	case kindElem:
+		p.names.ElemNames = append(p.names.ElemNames, assoc)
+	case kindData:
+		p.names.DataNames = append(p.names.DataNames, assoc)
+	}
+}
+
+// ---------------- 第二遍：生成各个段
+
+func (p *parser) parseField(field *node) {
+	c := newCursor(field)
+
+	switch field.head() {
+	case "type":
+		// 已在第一遍解析
+	case "import":
+		p.parseImport(c)
+	case "func":
+		p.parseFunc(c)
+	case "table":
+		p.parseTable(c)
+	case "memory":
+		p.parseMemory(c)
+	case "global":
+		p.parseGlobal(c)
+	case "tag":
+		p.parseTag(c)
+	case "export":
+		p.parseExport(c)
+	case "start":
+		p.parseStart(c)
+	case "elem":
+		p.parseElem(c)
+	case "data":
+		p.parseData(c)
+	}
+}
+
+// ---------------- 类型
+
+// (type $id? (func (param ...)* (result ...)*))
+func (p *parser) parseTypeDef(c *cursor) binary.FuncType {
+	funcNode := c.next()
+	if !funcNode.isListOf("func") {
+		fail(funcNode, "expected (func ...), found %s", funcNode)
+	}
+	c.expectEnd()
+
+	fc := newCursor(funcNode)
+	ft, _ := p.parseFuncSignature(fc)
+	fc.expectEnd()
+	return ft
+}
+
+// 解析 (param ...)* (result ...)*
+// 返回函数类型以及参数的名称（没有名称的参数对应空字符串）
+func (p *parser) parseFuncSignature(c *cursor) (ft binary.FuncType, paramIds []string) {
+	ft.Tag = binary.FtTag
+
+	for c.peek().isListOf("param") {
+		pc := newCursor(c.next())
+		if id := pc.readOptionalId(); id != "" {
+			ft.ParamTypes = append(ft.ParamTypes, parseValType(pc.next()))
+			paramIds = append(paramIds, id)
+			pc.expectEnd()
+			continue
+		}
+		for !pc.eof() {
+			ft.ParamTypes = append(ft.ParamTypes, parseValType(pc.next()))
+			paramIds = append(paramIds, "")
+		}
+	}
+
+	for c.peek().isListOf("result") {
+		rc := newCursor(c.next())
+		for !rc.eof() {
+			ft.ResultTypes = append(ft.ResultTypes, parseValType(rc.next()))
+		}
+	}
+	return
+}
+
+// 解析类型引用（type_use）：(type x)? (param ...)* (result ...)*
+// 如果没有 (type x)，则查找相同签名的类型，找不到时在类型段末尾添加新的类型
+func (p *parser) parseTypeUse(c *cursor) (typeIdx uint32, paramIds []string) {
+	var typeNode *node
+	if c.peek().isListOf("type") {
+		typeNode = c.next()
+		tc := newCursor(typeNode)
+		typeIdx = p.resolveIdx(tc.next(), kindType)
+		tc.expectEnd()
+	}
+
+	hasInline := c.peek().isListOf("param") || c.peek().isListOf("result")
+	ft, paramIds := p.parseFuncSignature(c)
+
+	if typeNode == nil {
+		return p.findOrAddType(ft), paramIds
+	}
+
+	if int(typeIdx) >= len(p.module.TypeSec) {
+		// 没有内联的签名时，超出范围的类型索引留给验证器报告
+		if hasInline {
+			fail(typeNode, "unknown type %d", typeIdx)
+		}
+		return typeIdx, nil
+	}
+
+	if hasInline && !funcTypeEqual(ft, p.module.TypeSec[typeIdx]) {
+		fail(typeNode, "inline function type doesn't match type reference")
+	}
+	if !hasInline {
+		paramIds = make([]string, len(p.module.TypeSec[typeIdx].ParamTypes))
+	}
+	return typeIdx, paramIds
+}
+
+func (p *parser) findOrAddType(ft binary.FuncType) uint32 {
+	for i, t := range p.module.TypeSec {
+		if funcTypeEqual(ft, t) {
+			return uint32(i)
+		}
+	}
+	p.module.TypeSec = append(p.module.TypeSec, ft)
+	return uint32(len(p.module.TypeSec) - 1)
+}
+
+func funcTypeEqual(a, b binary.FuncType) bool {
+	return string(a.ParamTypes) == string(b.ParamTypes) &&
+		string(a.ResultTypes) == string(b.ResultTypes)
+}
+
+func parseValType(n *node) binary.ValType {
+	switch {
+	case n.isKeyword("i32"):
+		return binary.ValTypeI32
+	case n.isKeyword("i64"):
+		return binary.ValTypeI64
+	case n.isKeyword("f32"):
+		return binary.ValTypeF32
+	case n.isKeyword("f64"):
+		return binary.ValTypeF64
+	case n.isKeyword("funcref"):
+		return binary.ValTypeFuncRef
+	case n.isKeyword("externref"):
+		return binary.ValTypeExternRef
+	case n.isKeyword("v128"):
+		return binary.ValTypeV128
+	default:
+		fail(n, "unknown value type %s", n)
+		return 0
+	}
+}
+
+// ---------------- 导入和导出
+
+// (import "module" "name" desc)
+func (p *parser) parseImport(c *cursor) {
+	moduleName := c.readName()
+	name := c.readName()
+	desc := c.next()
+	c.expectEnd()
+
+	dc := newCursor(desc)
+	dc.readOptionalId()
+	p.addImport(moduleName, name, descKind(desc), dc)
+	dc.expectEnd()
+}
+
+// 解析导入项的描述，c 位于标识符之后
+func (p *parser) addImport(moduleName string, name string, kind int, c *cursor) {
+	importItem := binary.Import{Module: moduleName, Name: name}
+
+	switch kind {
+	case kindFunc:
+		importItem.Desc.Tag = binary.ImportTagFunc
+		importItem.Desc.FuncType, _ = p.parseTypeUse(c)
+		p.funcIdx++
+	case kindTable:
+		importItem.Desc.Tag = binary.ImportTagTable
+		importItem.Desc.Table = p.parseTableType(c)
+	case kindMem:
+		importItem.Desc.Tag = binary.ImportTagMem
+		importItem.Desc.Mem = p.parseMemType(c)
+	case kindGlobal:
+		importItem.Desc.Tag = binary.ImportTagGlobal
+		importItem.Desc.Global = p.parseGlobalType(c.next())
+	case kindTag:
+		importItem.Desc.Tag = binary.ImportTagTag
+		importItem.Desc.TagType.Type, _ = p.parseTypeUse(c)
+	}
+
+	p.module.ImportSec = append(p.module.ImportSec, importItem)
+}
+
+// 解析内联的导出和导入项：(export "name")* (import "module" "name")?
+// 如果是导入项，则添加导入项并返回 true
+func (p *parser) parseInlineExportsAndImport(c *cursor, kind int, idx uint32) bool {
+	for c.peek().isListOf("export") {
+		ec := newCursor(c.next())
+		p.addExport(ec.readName(), kind, idx)
+		ec.expectEnd()
+	}
+
+	if c.peek().isListOf("import") {
+		ic := newCursor(c.next())
+		moduleName := ic.readName()
+		name := ic.readName()
+		ic.expectEnd()
+		p.addImport(moduleName, name, kind, c)
+		c.expectEnd()
+		return true
+	}
+	return false
+}
+
+// (export "name" (func x))
+func (p *parser) parseExport(c *cursor) {
+	name := c.readName()
+	desc := c.next()
+	c.expectEnd()
+
+	kind := descKind(desc)
+	if kind < 0 {
+		fail(desc, "unknown export kind %s", desc)
+	}
+	dc := newCursor(desc)
+	p.addExport(name, kind, p.resolveIdx(dc.next(), kind))
+	dc.expectEnd()
+}
+
+func (p *parser) addExport(name string, kind int, idx uint32) {
+	var tag byte
+	switch kind {
+	case kindFunc:
+		tag = binary.ExportTagFunc
+	case kindTable:
+		tag = binary.ExportTagTable
+	case kindMem:
+		tag = binary.ExportTagMem
+	case kindGlobal:
+		tag = binary.ExportTagGlobal
+	case kindTag:
+		tag = binary.ExportTagTag
+	}
+
+	p.module.ExportSec = append(p.module.ExportSec, binary.Export{
+		Name: name,
+		Desc: binary.ExportDesc{Tag: tag, Idx: idx},
+	})
+}
+
+// ---------------- 函数
+
+// (func $id? (export ...)* (import ...)? type_use (local ...)* instr*)
+func (p *parser) parseFunc(c *cursor) {
+	c.readOptionalId()
+	if p.parseInlineExportsAndImport(c, kindFunc, p.funcIdx) {
+		return
+	}
+
+	funcIdx := p.funcIdx
+	p.funcIdx++
+
+	typeIdx, paramIds := p.parseTypeUse(c)
+
+	fc := &funcContext{p: p, localIds: map[string]uint32{}}
+	for i, id := range paramIds {
+		fc.addLocalName(c.owner, uint32(i), id)
+	}
+
+	localIdx := uint32(len(paramIds))
+	var locals []binary.Locals
+	for c.peek().isListOf("local") {
+		lc := newCursor(c.next())
+		if id := lc.readOptionalId(); id != "" {
+			fc.addLocalName(lc.owner, localIdx, id)
+			locals = appendLocal(locals, parseValType(lc.next()))
+			localIdx++
+			lc.expectEnd()
+			continue
+		}
+		for !lc.eof() {
+			locals = appendLocal(locals, parseValType(lc.next()))
+			localIdx++
+		}
+	}
+
+	expr := fc.parseInstrs(c)
+	c.expectEnd()
+
+	p.module.FuncSec = append(p.module.FuncSec, typeIdx)
+	p.module.CodeSec = append(p.module.CodeSec, binary.Code{Locals: locals, Expr: expr})
+
+	if len(fc.localNames) > 0 {
+		p.names.LocalNames = append(p.names.LocalNames,
+			binary.IndirectNameAssoc{Idx: funcIdx, Names: fc.localNames})
+	}
+	if len(fc.labelNames) > 0 {
+		p.names.LabelNames = append(p.names.LabelNames,
+			binary.IndirectNameAssoc{Idx: funcIdx, Names: fc.labelNames})
+	}
+}
+
+// 连续多个相同类型的局部变量合为一组
+func appendLocal(locals []binary.Locals, valType binary.ValType) []binary.Locals {
+	if len(locals) > 0 && locals[len(locals)-1].Type == valType {
+		locals[len(locals)-1].N++
+		return locals
+	}
+	return append(locals, binary.Locals{N: 1, Type: valType})
+}
+
+// ---------------- 表和内存
+
+// (table $id? (export ...)* (import ...)? table_type)
+// (table $id? (export ...)* ref_type (elem func_idx*))
+// (table $id? (export ...)* ref_type (elem elem_expr*))
+func (p *parser) parseTable(c *cursor) {
+	c.readOptionalId()
+	tableIdx := uint32(len(p.module.TableSec)) + p.importCount(binary.ImportTagTable)
+	if p.parseInlineExportsAndImport(c, kindTable, tableIdx) {
+		return
+	}
+
+	if c.peek().isKeyword("funcref") || c.peek().isKeyword("externref") {
+		elemType := parseRefType(c.next())
+		elemNode := c.next()
+		if !elemNode.isListOf("elem") {
+			fail(elemNode, "expected (elem ...), found %s", elemNode)
+		}
+		c.expectEnd()
+
+		elem := binary.Elem{
+			Type:   elemType,
+			Table:  tableIdx,
+			Offset: binary.Expr{{Opcode: binary.I32Const, Args: int32(0)}},
+		}
+		ec := newCursor(elemNode)
+		if ec.peek().isList {
+			elem.Exprs = p.parseElemExprs(ec)
+		} else {
+			ec.readOptionalKeyword("func")
+			elem.Init = p.parseFuncIndices(ec)
+		}
+		n := uint32(elem.Len())
+
+		p.module.TableSec = append(p.module.TableSec, binary.TableType{
+			ElemType: elemType,
+			Limits:   binary.Limits{Tag: 1, Min: uint64(n), Max: uint64(n)},
+		})
+		p.module.ElemSec = append(p.module.ElemSec, elem)
+		return
+	}
+
+	p.module.TableSec = append(p.module.TableSec, p.parseTableType(c))
+	c.expectEnd()
+}
+
+// 如果当前节点是指定的关键字则读取，返回是否读取了
+func (c *cursor) readOptionalKeyword(keyword string) bool {
+	if c.peek().isKeyword(keyword) {
+		c.next()
+		return true
+	}
+	return false
+}
+
+// limits ref_type
+func (p *parser) parseTableType(c *cursor) binary.TableType {
+	limits := p.parseLimits(c)
+	return binary.TableType{ElemType: parseRefType(c.next()), Limits: limits}
+}
+
+func parseRefType(n *node) byte {
+	if n.isKeyword("funcref") || n.isKeyword("anyfunc") {
+		return binary.FuncRef
+	}
+	if n.isKeyword("externref") {
+		return binary.ExternRef
+	}
+	fail(n, "unknown reference type %s", n)
+	return 0
+}
+
+// min max?
+func (p *parser) parseLimits(c *cursor) binary.Limits {
+	limits := binary.Limits{Min: uint64(c.readU32())}
+	if c.isIdx() && !c.peek().isId() {
+		limits.Tag = binary.LimitsTagMax
+		limits.Max = uint64(c.readU32())
+	}
+	return limits
+}
+
+// (i32|i64)? min max? shared?
+func (p *parser) parseMemType(c *cursor) binary.MemType {
+	c.readOptionalKeyword("i32")
+	if !c.readOptionalKeyword("i64") {
+		return p.parseSharedFlag(c, p.parseLimits(c))
+	}
+
+	// 64 位的内存的限制值是 u64
+	limits := binary.Limits{Tag: binary.LimitsTag64, Min: c.readU64()}
+	if c.isIdx() && !c.peek().isId() {
+		limits.Tag |= binary.LimitsTagMax
+		limits.Max = c.readU64()
+	}
+	return p.parseSharedFlag(c, limits)
+}
+
+func (p *parser) parseSharedFlag(c *cursor, limits binary.Limits) binary.MemType {
+	if c.readOptionalKeyword("shared") {
+		limits.Tag |= binary.LimitsTagShared
+	}
+	return limits
+}
+
+func (p *parser) importCount(tag byte) uint32 {
+	n := uint32(0)
+	for _, importItem := range p.module.ImportSec {
+		if importItem.Desc.Tag == tag {
+			n++
+		}
+	}
+	return n
+}
+
+// (memory $id? (export ...)* (import ...)? mem_type)
+// (memory $id? (export ...)* (i32|i64)? (data "..."*))
+func (p *parser) parseMemory(c *cursor) {
+	c.readOptionalId()
+	memIdx := uint32(len(p.module.MemSec)) + p.importCount(binary.ImportTagMem)
+	if p.parseInlineExportsAndImport(c, kindMem, memIdx) {
+		return
+	}
+
+	start := c.pos
+	c.readOptionalKeyword("i32")
+	is64 := c.readOptionalKeyword("i64")
+	if c.peek().isListOf("data") {
+		dc := newCursor(c.next())
+		c.expectEnd()
+
+		var init []byte
+		for !dc.eof() {
+			init = append(init, dc.readString()...)
+		}
+		pages := uint64((len(init) + pageSize - 1) / pageSize)
+
+		memType := binary.Limits{Tag: binary.LimitsTagMax, Min: pages, Max: pages}
+		offset := binary.Expr{{Opcode: binary.I32Const, Args: int32(0)}}
+		if is64 {
+			memType.Tag |= binary.LimitsTag64
+			offset = binary.Expr{{Opcode: binary.I64Const, Args: int64(0)}}
+		}
+
+		p.module.MemSec = append(p.module.MemSec, memType)
+		p.module.DataSec = append(p.module.DataSec, binary.Data{
+			Mem:    memIdx,
+			Offset: offset,
+			Init:   init,
+		})
+		return
+	}
+
+	c.pos = start
+	p.module.MemSec = append(p.module.MemSec, p.parseMemType(c))
+	c.expectEnd()
+}
+
+const pageSize = 65536
+
+// ---------------- 全局变量
+
+// (global $id? (export ...)* (import ...)? global_type expr)
+func (p *parser) parseGlobal(c *cursor) {
+	c.readOptionalId()
+	globalIdx := uint32(len(p.module.GlobalSec)) + p.importCount(binary.ImportTagGlobal)
+	if p.parseInlineExportsAndImport(c, kindGlobal, globalIdx) {
+		return
+	}
+
+	globalType := p.parseGlobalType(c.next())
+	fc := &funcContext{p: p}
+	init := fc.parseInstrs(c)
+	c.expectEnd()
+
+	p.module.GlobalSec = append(p.module.GlobalSec, binary.Global{Type: globalType, Init: init})
+}
+
+// val_type 或者 (mut val_type)
+func (p *parser) parseGlobalType(n *node) binary.GlobalType {
+	if n.isListOf("mut") {
+		mc := newCursor(n)
+		valType := parseValType(mc.next())
+		mc.expectEnd()
+		return binary.GlobalType{ValType: valType, Mut: binary.MutVar}
+	}
+	return binary.GlobalType{ValType: parseValType(n), Mut: binary.MutConst}
+}
+
+// ---------------- 异常标签
+
+// (tag $id? (export ...)* (import ...)? type_use)
+func (p *parser) parseTag(c *cursor) {
+	c.readOptionalId()
+	tagIdx := uint32(len(p.module.TagSec)) + p.importCount(binary.ImportTagTag)
+	if p.parseInlineExportsAndImport(c, kindTag, tagIdx) {
+		return
+	}
+
+	typeIdx, _ := p.parseTypeUse(c)
+	c.expectEnd()
+	p.module.TagSec = append(p.module.TagSec, binary.TagType{
+		Attribute: binary.TagAttributeException,
+		Type:      typeIdx,
+	})
+}
+
+// ---------------- 起始函数、元素和数据
+
+// (start func_idx)
+func (p *parser) parseStart(c *cursor) {
+	if p.module.StartSec != nil {
+		fail(c.owner, "multiple start sections")
+	}
+	funcIdx := p.resolveIdx(c.next(), kindFunc)
+	c.expectEnd()
+	p.module.StartSec = &funcIdx
+}
+
+// (elem $id? (table x)? offset func? func_idx*)   ;; 主动
+// (elem $id? func func_idx*)                      ;; 被动
+// (elem $id? declare func func_idx*)              ;; 声明
+//
+// 函数索引列表也可以写成表达式列表 ref_type elem_expr*，比如
+// funcref (ref.func x) (ref.null func) 或者 externref (item ref.null extern)
+func (p *parser) parseElem(c *cursor) {
+	c.readOptionalId()
+
+	elem := binary.Elem{Type: binary.FuncRef}
+	if c.readOptionalKeyword("declare") {
+		elem.Mode = binary.SegmentModeDeclarative
+	} else if c.peek().isList || c.isIdx() {
+		if c.peek().isListOf("table") {
+			tc := newCursor(c.next())
+			elem.Table = p.resolveIdx(tc.next(), kindTable)
+			tc.expectEnd()
+		} else if c.isIdx() {
+			// 旧的写法，表索引直接写在偏移值之前
+			elem.Table = p.resolveIdx(c.next(), kindTable)
+		}
+		elem.Offset = p.parseOffset(c)
+	} else {
+		elem.Mode = binary.SegmentModePassive
+	}
+
+	if c.peek().isKeyword("funcref") || c.peek().isKeyword("externref") {
+		elem.Type = parseRefType(c.next())
+		elem.Exprs = p.parseElemExprs(c)
+	} else {
+		c.readOptionalKeyword("func")
+		elem.Init = p.parseFuncIndices(c)
+	}
+	c.expectEnd()
+
+	p.module.ElemSec = append(p.module.ElemSec, elem)
+}
+
+// elem_expr: (item instr*) 或者单独一个折叠形式的指令
+func (p *parser) parseElemExprs(c *cursor) []binary.Expr {
+	exprs := []binary.Expr{}
+	for !c.eof() {
+		n := c.next()
+		if !n.isList {
+			fail(n, "expected an element expression, found %s", n)
+		}
+
+		fc := &funcContext{p: p}
+		if n.isListOf("item") {
+			ic := newCursor(n)
+			exprs = append(exprs, fc.parseInstrs(ic))
+			ic.expectEnd()
+		} else {
+			exprs = append(exprs, fc.parseFoldedInstr(n))
+		}
+	}
+	return exprs
+}
+
+func (p *parser) parseFuncIndices(c *cursor) []binary.FuncIdx {
+	var indices []binary.FuncIdx
+	for !c.eof() {
+		indices = append(indices, p.resolveIdx(c.next(), kindFunc))
+	}
+	return indices
+}
+
+// (data $id? (memory x)? offset "..."*)  ;; 主动
+// (data $id? "..."*)                     ;; 被动
+func (p *parser) parseData(c *cursor) {
+	c.readOptionalId()
+
+	data := binary.Data{}
+	if c.peek().isListOf("memory") {
+		mc := newCursor(c.next())
+		data.Mem = p.resolveIdx(mc.next(), kindMem)
+		mc.expectEnd()
+	} else if c.isIdx() {
+		data.Mem = p.resolveIdx(c.next(), kindMem)
+	}
+
+	if c.peek().isList {
+		data.Offset = p.parseOffset(c)
+	} else {
+		// 没有偏移值的是被动数据项
+		data.Mode = binary.SegmentModePassive
+	}
+	data.Init = []byte{}
+	for !c.eof() {
+		data.Init = append(data.Init, c.readString()...)
+	}
+
+	p.module.DataSec = append(p.module.DataSec, data)
+}
+
+// (offset instr*) 或者单独一个折叠形式的指令
+func (p *parser) parseOffset(c *cursor) binary.Expr {
+	n := c.next()
+	if !n.isList {
+		fail(n, "expected an offset expression, found %s", n)
+	}
+
+	fc := &funcContext{p: p}
+	if n.isListOf("offset") {
+		oc := newCursor(n)
+		expr := fc.parseInstrs(oc)
+		oc.expectEnd()
+		return expr
+	}
+	return fc.parseFoldedInstr(n)
+}
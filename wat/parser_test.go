@@ -0,0 +1,212 @@
+package wat
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"wasmvm/assert"
+	"wasmvm/binary"
+)
+
+// 解析 test/resources 里的所有 wat 文件，得到的二进制数据应该跟对应的 wasm 文件一致
+//
+// 注：wasm 文件里除了名称段之外的自定义段（比如编译器生成的 "producers"）在
+// wat 文件里并不存在，所以比较之前先将它们去掉
+func TestParseResources(t *testing.T) {
+	fileNames := getResourceFileNames("*", "*.wat")
+	assert.AssertTrue(t, len(fileNames) > 0)
+
+	for _, fileName := range fileNames {
+		m, err := ParseFile(fileName)
+		if err != nil {
+			t.Fatalf("%s: %v", filepath.Base(fileName), err)
+		}
+
+		wasmFileName := strings.TrimSuffix(fileName, ".wat") + ".wasm"
+		if _, err := os.Stat(wasmFileName); err != nil {
+			continue
+		}
+
+		expected, err := binary.DecodeFile(wasmFileName)
+		assert.AssertNil(t, err)
+
+		var customSecs []binary.CustomSec
+		for _, customSec := range expected.CustomSecs {
+			if customSec.Name == binary.NameSecName {
+				customSecs = append(customSecs, customSec)
+			}
+		}
+		expected.CustomSecs = customSecs
+
+		if !bytes.Equal(binary.Encode(expected), binary.Encode(m)) {
+			t.Errorf("%s: compiled module is different from %s",
+				filepath.Base(fileName), filepath.Base(wasmFileName))
+		}
+	}
+}
+
+func getResourceFileNames(dir string, pattern string) []string {
+	currentDir, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+
+	fileNames, err := filepath.Glob(filepath.Join(currentDir, "..", "test", "resources", dir, pattern))
+	if err != nil {
+		panic(err)
+	}
+	return fileNames
+}
+
+func TestParseFlatAndFolded(t *testing.T) {
+	flat := `
+	(module
+		(func $f (param $n i32) (result i32)
+			block $outer (result i32)
+				local.get $n
+				if (result i32)
+					i32.const 1
+					br $outer
+				else
+					i32.const 2
+				end
+			end
+			loop $l
+				i32.const 0
+				br_if $l
+			end
+		)
+	)`
+	folded := `
+	(module
+		(func $f (param $n i32) (result i32)
+			(block $outer (result i32)
+				(if (result i32) (local.get $n)
+					(then (br $outer (i32.const 1)))
+					(else (i32.const 2))))
+			(loop $l (br_if $l (i32.const 0)))
+		)
+	)`
+
+	m1, err := Parse([]byte(flat))
+	assert.AssertNil(t, err)
+	m2, err := Parse([]byte(folded))
+	assert.AssertNil(t, err)
+	assert.AssertNil(t, binary.Validate(m1))
+	assert.AssertTrue(t, bytes.Equal(binary.Encode(m1), binary.Encode(m2)))
+
+	expr := m1.CodeSec[0].Expr
+	assert.AssertEqual(t, binary.Block, expr[0].Opcode)
+	ifInstr := expr[0].Args.(binary.BlockArgs).Instrs[1]
+	assert.AssertEqual(t, binary.If, ifInstr.Opcode)
+	br := ifInstr.Args.(binary.IfArgs).Instrs1[1]
+	assert.AssertEqual(t, uint32(1), br.Args.(uint32)) // br $outer 跨过了 if 块
+}
+
+func TestParseModuleFields(t *testing.T) {
+	src := `
+	(module $m
+		(type $v (func))
+		(func $imported (import "env" "f") (param i32))
+		(global $g (import "env" "g") (mut i64))
+		(memory (export "mem") 1 2)
+		(table 2 funcref)
+		(global $h f64 (f64.const -0x1.8p1))
+		(func $main (export "main") (export "_start") (result i32)
+			(call $imported (i32.const 0xffffffff))
+			(call_indirect (type $v) (i32.const 1))
+			(i64.store offset=8 align=4 (i32.const 0) (i64.const -1))
+			(i32.const 42))
+		(func $two (type $v))
+		(elem (i32.const 0) $main $two)
+		(data (memory 0) (offset (i32.const 16)) "ab\63\u{64}" "\n")
+		(start $two)
+	)`
+
+	m, err := Parse([]byte(src))
+	assert.AssertNil(t, err)
+	assert.AssertNil(t, binary.Validate(m))
+
+	// 类型段：$v 以及导入函数和 $main 使用的内联类型
+	assert.AssertEqual(t, 3, len(m.TypeSec))
+	assert.AssertEqual(t, 2, len(m.ImportSec))
+	assert.AssertEqual(t, uint32(1), m.ImportSec[0].Desc.FuncType)
+	assert.AssertEqual(t, binary.MutVar, m.ImportSec[1].Desc.Global.Mut)
+	assert.AssertSliceEqual(t, []uint32{2, 0}, m.FuncSec)
+
+	assert.AssertEqual(t, 3, len(m.ExportSec))
+	assert.AssertEqual(t, "mem", m.ExportSec[0].Name)
+	assert.AssertEqual(t, "_start", m.ExportSec[2].Name)
+	assert.AssertEqual(t, uint32(1), m.ExportSec[2].Desc.Idx)
+
+	assert.AssertEqual(t, uint32(2), *m.StartSec)
+	assert.AssertSliceEqual(t, []uint32{1, 2}, m.ElemSec[0].Init)
+	assert.AssertEqual(t, "abcd\n", string(m.DataSec[0].Init))
+	assert.AssertEqual(t, float64(-3), m.GlobalSec[0].Init[0].Args.(float64))
+
+	expr := m.CodeSec[0].Expr
+	assert.AssertEqual(t, int32(-1), expr[0].Args.(int32))
+	assert.AssertEqual(t, binary.MemArg{Align: 2, Offset: 8}, expr[6].Args.(binary.MemArg))
+}
+
+func TestParseSegmentModes(t *testing.T) {
+	src := `
+	(module
+		(table $t 1 funcref)
+		(memory 1)
+		(func $f
+			(table.init $t $e (i32.const 0) (i32.const 0) (i32.const 1))
+			(elem.drop $e))
+		(elem $e func $f)
+		(elem declare funcref (ref.func $f))
+		(data $d "abc"))`
+
+	m, err := Parse([]byte(src))
+	assert.AssertNil(t, err)
+	assert.AssertNil(t, binary.Validate(m))
+
+	assert.AssertEqual(t, binary.SegmentModePassive, m.ElemSec[0].Mode)
+	assert.AssertEqual(t, binary.SegmentModeDeclarative, m.ElemSec[1].Mode)
+	assert.AssertEqual(t, 1, len(m.ElemSec[1].Exprs))
+	assert.AssertEqual(t, binary.RefFunc, m.ElemSec[1].Exprs[0][0].Opcode)
+	assert.AssertEqual(t, uint32(0), m.ElemSec[1].Exprs[0][0].Args.(uint32))
+	assert.AssertEqual(t, binary.SegmentModePassive, m.DataSec[0].Mode)
+
+	// 没有用到 memory.init 和 data.drop 指令时不生成数据计数段
+	assert.AssertTrue(t, m.DataCountSec == nil)
+
+	args := m.CodeSec[0].Expr[3].Args.(binary.MiscArgs)
+	assert.AssertEqual(t, binary.TableInit, args.SubOpcode)
+	assert.AssertEqual(t, binary.TableInitArgs{Elem: 0, Table: 0}, args.Args.(binary.TableInitArgs))
+
+	m, err = Parse([]byte(`(module (memory 1) (data $d "") (func (data.drop $d)))`))
+	assert.AssertNil(t, err)
+	assert.AssertEqual(t, uint32(1), *m.DataCountSec)
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		src    string
+		line   int
+		column int
+	}{
+		{"(module (func (i32.const 1))", 1, 1},             // 括号不匹配
+		{"(module\n  (func (call $nope)))", 2, 15},         // 未定义的函数
+		{"(module (func (i32.foo)))", 1, 16},               // 未知的指令
+		{"(module (func (i32.const 0x100000000)))", 1, 26}, // 整数超出范围
+		{"(module (func br 0 end))", 1, 20},                // 多余的 end
+		{"(module (data \"\\x\"))", 1, 16},                 // 未知的转义字符
+	}
+
+	for _, test := range tests {
+		_, err := Parse([]byte(test.src))
+		parseError, ok := err.(*ParseError)
+		if !ok {
+			t.Fatalf("%q: expected a parse error, got %v", test.src, err)
+		}
+		assert.AssertEqual(t, test.line, parseError.Line)
+		assert.AssertEqual(t, test.column, parseError.Column)
+	}
+}
@@ -0,0 +1,692 @@
+package wat
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"wasmvm/binary"
+)
+
+// 文本格式输出（反汇编），将 binary.Module 输出为文本格式
+//
+// 输出的格式跟 `wasm-tools print` 类似：
+// - 函数体的指令使用平铺形式，结构块内部的指令缩进两个空格；
+// - 模块有名称段时，使用名称段里的名称作为标识符，
+//   没有名称的项目在定义处以注释 `(;索引;)` 注明其索引；
+// - 内存指令的 offset 为 0、align 为自然对齐值时省略。
+//
+// 输出的文本可以再由 Parse() 解析，得到的模块跟原模块一致
+// （名称段之外的自定义段除外）。
+
+type printer struct {
+	m  binary.Module
+	sb strings.Builder
+
+	// 各个索引空间里的项目的标识符（不包括 `$`），无效或者重复的名称会被忽略
+	ids [kindCount]map[uint32]string
+
+	localNames binary.IndirectNameMap
+	labelNames binary.IndirectNameMap
+}
+
+func Print(m binary.Module) string {
+	p := &printer{m: m}
+	p.collectNames()
+	p.printModule()
+	return p.sb.String()
+}
+
+// ---------------- 名称
+
+func (p *printer) collectNames() {
+	ns := p.m.GetNameSec()
+	p.ids[kindType] = toIdMap(ns.TypeNames)
+	p.ids[kindFunc] = toIdMap(ns.FuncNames)
+	p.ids[kindTable] = toIdMap(ns.TableNames)
+	p.ids[kindMem] = toIdMap(ns.MemNames)
+	p.ids[kindGlobal] = toIdMap(ns.GlobalNames)
+	p.ids[kindElem] = toIdMap(ns.ElemNames)
+	p.ids[kindData] = toIdMap(ns.DataNames)
+	p.localNames = ns.LocalNames
+	p.labelNames = ns.LabelNames
+}
+
+// 将名称列表转为索引到标识符的映射，不能作为标识符的名称以及重复的名称会被忽略
+func toIdMap(nameMap binary.NameMap) map[uint32]string {
+	ids := map[uint32]string{}
+	used := map[string]bool{}
+	for _, assoc := range nameMap {
+		if isValidId(assoc.Name) && !used[assoc.Name] {
+			ids[assoc.Idx] = assoc.Name
+			used[assoc.Name] = true
+		}
+	}
+	return ids
+}
+
+func isValidId(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		if !isAtomChar(name[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// 项目定义处的标识符，没有标识符时输出索引注释
+func (p *printer) defId(kind int, idx uint32) string {
+	if id, ok := p.ids[kind][idx]; ok {
+		return fmt.Sprintf(" $%s (;%d;)", id, idx)
+	}
+	return fmt.Sprintf(" (;%d;)", idx)
+}
+
+// 引用项目时使用的标识符或者索引
+func (p *printer) ref(kind int, idx uint32) string {
+	if id, ok := p.ids[kind][idx]; ok {
+		return "$" + id
+	}
+	return strconv.FormatUint(uint64(idx), 10)
+}
+
+// ---------------- 模块
+
+func (p *printer) printModule() {
+	m := p.m
+
+	p.sb.WriteString("(module")
+	if name := m.GetNameSec().ModuleName; isValidId(name) {
+		p.sb.WriteString(" $" + name)
+	}
+	p.sb.WriteString("\n")
+
+	for i, ft := range m.TypeSec {
+		p.line(1, "(type%s %s)", p.defId(kindType, uint32(i)), formatFuncType(ft, nil))
+	}
+
+	var importCounts [5]uint32
+	for _, importItem := range m.ImportSec {
+		tag := importItem.Desc.Tag
+		p.line(1, "(import %s %s %s)", quote(importItem.Module), quote(importItem.Name),
+			p.formatImportDesc(importItem.Desc, importCounts[tag]))
+		importCounts[tag]++
+	}
+
+	for i := range m.FuncSec {
+		p.printFunc(importCounts[binary.ImportTagFunc]+uint32(i), i)
+	}
+	for i, tt := range m.TableSec {
+		idx := importCounts[binary.ImportTagTable] + uint32(i)
+		p.line(1, "(table%s %s)", p.defId(kindTable, idx), formatTableType(tt))
+	}
+	for i, mt := range m.MemSec {
+		idx := importCounts[binary.ImportTagMem] + uint32(i)
+		p.line(1, "(memory%s %s)", p.defId(kindMem, idx), formatLimits(mt))
+	}
+	for i, tt := range m.TagSec {
+		idx := importCounts[binary.ImportTagTag] + uint32(i)
+		p.line(1, "(tag%s %s)", p.defId(kindTag, idx), p.formatTagType(tt))
+	}
+	for i, global := range m.GlobalSec {
+		idx := importCounts[binary.ImportTagGlobal] + uint32(i)
+		p.line(1, "(global%s %s %s)", p.defId(kindGlobal, idx),
+			formatGlobalType(global.Type), p.formatConstExpr(global.Init))
+	}
+
+	for _, exportItem := range m.ExportSec {
+		kind := exportKinds[exportItem.Desc.Tag]
+		p.line(1, "(export %s (%s %s))", quote(exportItem.Name),
+			kindNames[kind], p.ref(kind, exportItem.Desc.Idx))
+	}
+
+	if m.StartSec != nil {
+		p.line(1, "(start %s)", p.ref(kindFunc, *m.StartSec))
+	}
+
+	for i, elem := range m.ElemSec {
+		// 函数索引列表使用 func，表达式列表使用元素的引用类型
+		kind := "func"
+		if elem.Exprs != nil {
+			kind = binary.GetValTypeName(elem.Type)
+		}
+
+		text := "(elem" + p.defId(kindElem, uint32(i))
+		switch elem.Mode {
+		case binary.SegmentModePassive:
+			text += " " + kind
+		case binary.SegmentModeDeclarative:
+			text += " declare " + kind
+		default:
+			if elem.Table != 0 {
+				text += " (table " + p.ref(kindTable, elem.Table) + ")"
+			}
+			text += " " + p.formatOffset(elem.Offset) + " " + kind
+		}
+		for _, funcIdx := range elem.Init {
+			text += " " + p.ref(kindFunc, funcIdx)
+		}
+		for _, expr := range elem.Exprs {
+			text += " " + p.formatElemExpr(expr)
+		}
+		p.line(1, "%s)", text)
+	}
+
+	for i, data := range m.DataSec {
+		text := "(data" + p.defId(kindData, uint32(i))
+		if data.Mode == binary.SegmentModePassive {
+			p.line(1, "%s %s)", text, quote(string(data.Init)))
+			continue
+		}
+		if data.Mem != 0 {
+			text += " (memory " + p.ref(kindMem, data.Mem) + ")"
+		}
+		p.line(1, "%s %s %s)", text, p.formatOffset(data.Offset), quote(string(data.Init)))
+	}
+
+	p.sb.WriteString(")\n")
+}
+
+// 导入/导出项的 tag 对应的索引空间
+var exportKinds = []int{kindFunc, kindTable, kindMem, kindGlobal, kindTag}
+
+func (p *printer) formatImportDesc(desc binary.ImportDesc, idx uint32) string {
+	kind := exportKinds[desc.Tag]
+	text := "(" + kindNames[kind] + p.defId(kind, idx) + " "
+
+	switch desc.Tag {
+	case binary.ImportTagFunc:
+		text += p.formatTypeUse(desc.FuncType, idx)
+	case binary.ImportTagTable:
+		text += formatTableType(desc.Table)
+	case binary.ImportTagMem:
+		text += formatLimits(desc.Mem)
+	case binary.ImportTagGlobal:
+		text += formatGlobalType(desc.Global)
+	case binary.ImportTagTag:
+		text += p.formatTagType(desc.TagType)
+	}
+	return text + ")"
+}
+
+// 输出标签的 (type x) (param ...)
+func (p *printer) formatTagType(tt binary.TagType) string {
+	text := "(type " + p.ref(kindType, tt.Type) + ")"
+	if int(tt.Type) < len(p.m.TypeSec) {
+		if sig := formatSignature(p.m.TypeSec[tt.Type], nil); sig != "" {
+			text += " " + sig
+		}
+	}
+	return text
+}
+
+func (p *printer) line(indent int, format string, args ...interface{}) {
+	p.sb.WriteString(strings.Repeat("  ", indent))
+	fmt.Fprintf(&p.sb, format, args...)
+	p.sb.WriteString("\n")
+}
+
+// ---------------- 类型
+
+// 输出 (func (param ...) (result ...))
+// 有名称的参数单独写成 (param $name type)
+func formatFuncType(ft binary.FuncType, paramIds map[uint32]string) string {
+	sig := formatSignature(ft, paramIds)
+	if sig == "" {
+		return "(func)"
+	}
+	return "(func " + sig + ")"
+}
+
+func formatSignature(ft binary.FuncType, paramIds map[uint32]string) string {
+	var parts []string
+	var unnamed []string
+	flush := func() {
+		if len(unnamed) > 0 {
+			parts = append(parts, "(param "+strings.Join(unnamed, " ")+")")
+			unnamed = nil
+		}
+	}
+
+	for i, vt := range ft.ParamTypes {
+		if id, ok := paramIds[uint32(i)]; ok {
+			flush()
+			parts = append(parts, fmt.Sprintf("(param $%s %s)", id, binary.GetValTypeName(vt)))
+		} else {
+			unnamed = append(unnamed, binary.GetValTypeName(vt))
+		}
+	}
+	flush()
+
+	if len(ft.ResultTypes) > 0 {
+		parts = append(parts, "(result "+formatValTypes(ft.ResultTypes)+")")
+	}
+	return strings.Join(parts, " ")
+}
+
+func formatValTypes(vec []binary.ValType) string {
+	names := make([]string, len(vec))
+	for i, vt := range vec {
+		names[i] = binary.GetValTypeName(vt)
+	}
+	return strings.Join(names, " ")
+}
+
+// 输出 (type x) (param ...) (result ...)
+func (p *printer) formatTypeUse(typeIdx uint32, funcIdx uint32) string {
+	text := "(type " + p.ref(kindType, typeIdx) + ")"
+	if int(typeIdx) < len(p.m.TypeSec) {
+		paramIds := toIdMap(p.localNames.Get(funcIdx))
+		if sig := formatSignature(p.m.TypeSec[typeIdx], paramIds); sig != "" {
+			text += " " + sig
+		}
+	}
+	return text
+}
+
+func formatLimits(limits binary.Limits) string {
+	text := fmt.Sprintf("%d", limits.Min)
+	if limits.Is64() {
+		text = "i64 " + text
+	}
+	if limits.HasMax() {
+		text += fmt.Sprintf(" %d", limits.Max)
+	}
+	if limits.IsShared() {
+		text += " shared"
+	}
+	return text
+}
+
+func formatTableType(tt binary.TableType) string {
+	return formatLimits(tt.Limits) + " " + binary.GetValTypeName(tt.ElemType)
+}
+
+func formatGlobalType(gt binary.GlobalType) string {
+	if gt.Mut == binary.MutVar {
+		return "(mut " + binary.GetValTypeName(gt.ValType) + ")"
+	}
+	return binary.GetValTypeName(gt.ValType)
+}
+
+// 字符串里的可打印 ASCII 字符原样输出，其余的字节使用 \hh 转义
+func quote(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 0x20 && c < 0x7f && c != '"' && c != '\\' {
+			sb.WriteByte(c)
+		} else {
+			fmt.Fprintf(&sb, "\\%02x", c)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// ---------------- 函数
+
+func (p *printer) printFunc(funcIdx uint32, defIdx int) {
+	typeIdx := p.m.FuncSec[defIdx]
+	p.line(1, "(func%s %s", p.defId(kindFunc, funcIdx), p.formatTypeUse(typeIdx, funcIdx))
+
+	localIds := toIdMap(p.localNames.Get(funcIdx))
+	fc := &funcPrinter{
+		p:          p,
+		localIds:   localIds,
+		labelIds:   toIdMap(p.labelNames.Get(funcIdx)),
+		labelDepth: 0,
+	}
+
+	if defIdx < len(p.m.CodeSec) {
+		code := p.m.CodeSec[defIdx]
+
+		localIdx := uint32(0)
+		if int(typeIdx) < len(p.m.TypeSec) {
+			localIdx = uint32(len(p.m.TypeSec[typeIdx].ParamTypes))
+		}
+
+		var unnamed []string
+		flush := func() {
+			if len(unnamed) > 0 {
+				p.line(2, "(local %s)", strings.Join(unnamed, " "))
+				unnamed = nil
+			}
+		}
+		for _, locals := range code.Locals {
+			for i := uint32(0); i < locals.N; i++ {
+				if id, ok := localIds[localIdx]; ok {
+					flush()
+					p.line(2, "(local $%s %s)", id, binary.GetValTypeName(locals.Type))
+				} else {
+					unnamed = append(unnamed, binary.GetValTypeName(locals.Type))
+				}
+				localIdx++
+			}
+		}
+		flush()
+
+		fc.printInstrs(code.Expr, 2)
+	}
+
+	p.line(1, ")")
+}
+
+// 常量表达式写在同一行里
+func (p *printer) formatConstExpr(expr binary.Expr) string {
+	fc := &funcPrinter{p: p}
+	texts := make([]string, len(expr))
+	for i, instr := range expr {
+		texts[i] = fc.formatInstr(instr)
+	}
+	return strings.Join(texts, " ")
+}
+
+// 只有一条指令的偏移值表达式使用折叠形式，比如 (i32.const 0)，
+// 否则使用 (offset ...)
+func (p *printer) formatOffset(expr binary.Expr) string {
+	if len(expr) == 1 {
+		return "(" + p.formatConstExpr(expr) + ")"
+	}
+	return "(offset " + p.formatConstExpr(expr) + ")"
+}
+
+// 元素的表达式跟偏移值表达式类似，多条指令时使用 (item ...)
+func (p *printer) formatElemExpr(expr binary.Expr) string {
+	if len(expr) == 1 {
+		return "(" + p.formatConstExpr(expr) + ")"
+	}
+	return "(item " + p.formatConstExpr(expr) + ")"
+}
+
+// ---------------- 指令
+
+type funcPrinter struct {
+	p          *printer
+	localIds   map[uint32]string
+	labelIds   map[uint32]string
+	labelDepth uint32 // 已经输出的结构化指令的数量，用于查找标签的名称
+}
+
+func (fc *funcPrinter) printInstrs(instrs []binary.Instruction, indent int) {
+	p := fc.p
+	for _, instr := range instrs {
+		switch instr.Opcode {
+		case binary.Block, binary.Loop:
+			args := instr.Args.(binary.BlockArgs)
+			p.line(indent, "%s%s", binary.GetOpname(instr.Opcode), fc.formatBlockHead(args.BT))
+			fc.printInstrs(args.Instrs, indent+1)
+			p.line(indent, "end")
+		case binary.If:
+			args := instr.Args.(binary.IfArgs)
+			p.line(indent, "if%s", fc.formatBlockHead(args.BT))
+			fc.printInstrs(args.Instrs1, indent+1)
+			if args.Instrs2 != nil {
+				p.line(indent, "else")
+				fc.printInstrs(args.Instrs2, indent+1)
+			}
+			p.line(indent, "end")
+		case binary.Try:
+			args := instr.Args.(binary.TryArgs)
+			p.line(indent, "try%s", fc.formatBlockHead(args.BT))
+			fc.printInstrs(args.Instrs, indent+1)
+			for _, clause := range args.Catches {
+				if clause.All {
+					p.line(indent, "catch_all")
+				} else {
+					p.line(indent, "catch %s", p.ref(kindTag, clause.Tag))
+				}
+				fc.printInstrs(clause.Instrs, indent+1)
+			}
+			if args.Delegate != nil {
+				p.line(indent, "delegate %d", *args.Delegate)
+			} else {
+				p.line(indent, "end")
+			}
+		default:
+			p.line(indent, "%s", fc.formatInstr(instr))
+		}
+	}
+}
+
+// 结构化指令的标签和块类型
+func (fc *funcPrinter) formatBlockHead(bt binary.BlockType) string {
+	text := ""
+	if id, ok := fc.labelIds[fc.labelDepth]; ok {
+		text += " $" + id
+	}
+	fc.labelDepth++
+
+	switch bt {
+	case binary.BlockTypeEmpty:
+	case binary.BlockTypeI32:
+		text += " (result i32)"
+	case binary.BlockTypeI64:
+		text += " (result i64)"
+	case binary.BlockTypeF32:
+		text += " (result f32)"
+	case binary.BlockTypeF64:
+		text += " (result f64)"
+	case binary.BlockTypeFuncRef:
+		text += " (result funcref)"
+	case binary.BlockTypeExternRef:
+		text += " (result externref)"
+	case binary.BlockTypeV128:
+		text += " (result v128)"
+	default:
+		text += " (type " + fc.p.ref(kindType, uint32(bt)) + ")"
+	}
+	return text
+}
+
+// 格式化非结构化指令
+func (fc *funcPrinter) formatInstr(instr binary.Instruction) string {
+	p := fc.p
+	name := binary.GetOpname(instr.Opcode)
+
+	switch instr.Opcode {
+	case binary.I32Const:
+		return name + " " + strconv.FormatInt(int64(instr.Args.(int32)), 10)
+	case binary.I64Const:
+		return name + " " + strconv.FormatInt(instr.Args.(int64), 10)
+	case binary.F32Const:
+		return name + " " + formatF32(instr.Args.(float32))
+	case binary.F64Const:
+		return name + " " + formatF64(instr.Args.(float64))
+	case binary.MiscPrefix:
+		return fc.formatMiscInstr(instr.Args.(binary.MiscArgs))
+	case binary.SIMDPrefix:
+		return fc.formatSIMDInstr(instr.Args.(binary.SIMDArgs))
+	case binary.AtomicPrefix:
+		args := instr.Args.(binary.AtomicArgs)
+		name = binary.GetAtomicOpname(args.SubOpcode)
+		if memArg, ok := args.Args.(binary.MemArg); ok {
+			return fc.formatMemArg(name, memArg, binary.GetAtomicNaturalAlign(args.SubOpcode))
+		}
+		return name
+
+	case binary.LocalGet, binary.LocalSet, binary.LocalTee:
+		idx := instr.Args.(uint32)
+		if id, ok := fc.localIds[idx]; ok {
+			return name + " $" + id
+		}
+		return fmt.Sprintf("%s %d", name, idx)
+	case binary.GlobalGet, binary.GlobalSet:
+		return name + " " + p.ref(kindGlobal, instr.Args.(uint32))
+
+	case binary.Br, binary.BrIf:
+		return fmt.Sprintf("%s %d", name, instr.Args.(uint32))
+	case binary.BrTable:
+		args := instr.Args.(binary.BrTableArgs)
+		for _, label := range args.Labels {
+			name += fmt.Sprintf(" %d", label)
+		}
+		return fmt.Sprintf("%s %d", name, args.Default)
+
+	case binary.Throw:
+		return name + " " + p.ref(kindTag, instr.Args.(uint32))
+	case binary.Rethrow:
+		return fmt.Sprintf("%s %d", name, instr.Args.(uint32))
+
+	case binary.Call, binary.ReturnCall:
+		return name + " " + p.ref(kindFunc, instr.Args.(uint32))
+	case binary.CallIndirect, binary.ReturnCallIndirect:
+		args := instr.Args.(binary.CallIndirectArgs)
+		return fc.formatTableIdx(name, args.Table) + " (type " + p.ref(kindType, args.Type) + ")"
+	case binary.MemorySize, binary.MemoryGrow:
+		return fc.formatMemIdx(name, instr.Args.(uint32))
+
+	case binary.SelectT:
+		return name + " (result " + formatValTypes(instr.Args.([]binary.ValType)) + ")"
+	case binary.RefNull:
+		if instr.Args.(binary.ValType) == binary.ExternRef {
+			return name + " extern"
+		}
+		return name + " func"
+	case binary.RefFunc:
+		return name + " " + p.ref(kindFunc, instr.Args.(uint32))
+	case binary.TableGet, binary.TableSet:
+		return fc.formatTableIdx(name, instr.Args.(uint32))
+	}
+
+	if memArg, ok := instr.Args.(binary.MemArg); ok {
+		return fc.formatMemArg(name, memArg, binary.GetNaturalAlign(instr.Opcode))
+	}
+	return name
+}
+
+// 内存索引为 0 时省略，对齐值等于自然对齐时省略
+func (fc *funcPrinter) formatMemArg(name string, memArg binary.MemArg, naturalAlign uint32) string {
+	name = fc.formatMemIdx(name, memArg.Mem)
+	if memArg.Offset != 0 {
+		name += fmt.Sprintf(" offset=%d", memArg.Offset)
+	}
+	if memArg.Align != naturalAlign {
+		name += fmt.Sprintf(" align=%d", uint64(1)<<memArg.Align)
+	}
+	return name
+}
+
+// 格式化 0xFC 前缀指令，表索引和内存索引为 0 时省略
+func (fc *funcPrinter) formatMiscInstr(args binary.MiscArgs) string {
+	p := fc.p
+	name := binary.GetMiscOpname(args.SubOpcode)
+
+	switch args.SubOpcode {
+	case binary.MemoryInit:
+		a := args.Args.(binary.MemoryInitArgs)
+		return fc.formatMemIdx(name, a.Mem) + " " + p.ref(kindData, a.Data)
+	case binary.DataDrop:
+		return name + " " + p.ref(kindData, args.Args.(uint32))
+	case binary.MemoryCopy:
+		a := args.Args.(binary.MemoryCopyArgs)
+		if a.Dst != 0 || a.Src != 0 {
+			name += " " + p.ref(kindMem, a.Dst) + " " + p.ref(kindMem, a.Src)
+		}
+		return name
+	case binary.MemoryFill:
+		return fc.formatMemIdx(name, args.Args.(uint32))
+	case binary.ElemDrop:
+		return name + " " + p.ref(kindElem, args.Args.(uint32))
+	case binary.TableInit:
+		a := args.Args.(binary.TableInitArgs)
+		if a.Table != 0 {
+			name += " " + p.ref(kindTable, a.Table)
+		}
+		return name + " " + p.ref(kindElem, a.Elem)
+	case binary.TableCopy:
+		a := args.Args.(binary.TableCopyArgs)
+		if a.Dst != 0 || a.Src != 0 {
+			name += " " + p.ref(kindTable, a.Dst) + " " + p.ref(kindTable, a.Src)
+		}
+		return name
+	case binary.TableGrow, binary.TableSize, binary.TableFill:
+		return fc.formatTableIdx(name, args.Args.(uint32))
+	default:
+		return name
+	}
+}
+
+// 格式化 0xFD 前缀指令（SIMD 指令），v128.const 统一使用 i32x4 形状
+func (fc *funcPrinter) formatSIMDInstr(args binary.SIMDArgs) string {
+	name := binary.GetSIMDOpname(args.SubOpcode)
+
+	switch a := args.Args.(type) {
+	case binary.MemArg:
+		return fc.formatMemArg(name, a, binary.GetSIMDNaturalAlign(args.SubOpcode))
+	case binary.MemLaneArgs:
+		return fmt.Sprintf("%s %d", fc.formatMemArg(name, a.MemArg, binary.GetSIMDNaturalAlign(args.SubOpcode)), a.Lane)
+	case binary.V128:
+		return name + " " + binary.FormatV128(a)
+	case [16]binary.LaneIdx:
+		for _, lane := range a {
+			name += fmt.Sprintf(" %d", lane)
+		}
+		return name
+	case binary.LaneIdx:
+		return fmt.Sprintf("%s %d", name, a)
+	default:
+		return name
+	}
+}
+
+// 表指令的表索引为 0 时省略
+func (fc *funcPrinter) formatTableIdx(name string, tableIdx uint32) string {
+	if tableIdx != 0 {
+		return name + " " + fc.p.ref(kindTable, tableIdx)
+	}
+	return name
+}
+
+// 内存指令的内存索引为 0 时省略
+func (fc *funcPrinter) formatMemIdx(name string, memIdx uint32) string {
+	if memIdx != 0 {
+		return name + " " + fc.p.ref(kindMem, memIdx)
+	}
+	return name
+}
+
+// ---------------- 浮点数
+
+func formatF32(f float32) string {
+	bits := math.Float32bits(f)
+	if f != f {
+		return formatNaN(uint64(bits>>31), uint64(bits&(1<<23-1)), 1<<22)
+	}
+	return formatFloat(float64(f), 32)
+}
+
+func formatF64(f float64) string {
+	bits := math.Float64bits(f)
+	if f != f {
+		return formatNaN(bits>>63, bits&(1<<52-1), 1<<51)
+	}
+	return formatFloat(f, 64)
+}
+
+// 使用能够精确还原数值的最短的十进制表示
+func formatFloat(f float64, bitSize int) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	default:
+		return strconv.FormatFloat(f, 'g', -1, bitSize)
+	}
+}
+
+func formatNaN(sign uint64, payload uint64, canonical uint64) string {
+	text := "nan"
+	if payload != canonical {
+		text = fmt.Sprintf("nan:0x%x", payload)
+	}
+	if sign != 0 {
+		return "-" + text
+	}
+	return text
+}
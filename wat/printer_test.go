@@ -0,0 +1,117 @@
+package wat
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+	"wasmvm/assert"
+	"wasmvm/binary"
+)
+
+// 将 test/resources 里的所有 wasm 文件输出为文本格式，再解析回来，
+// 得到的二进制数据应该跟原来的一致（名称段之外的自定义段除外）
+func TestPrintResources(t *testing.T) {
+	fileNames := getResourceFileNames("*", "*.wasm")
+	assert.AssertTrue(t, len(fileNames) > 0)
+
+	for _, fileName := range fileNames {
+		expected, err := binary.DecodeFile(fileName)
+		assert.AssertNil(t, err)
+
+		var customSecs []binary.CustomSec
+		for _, customSec := range expected.CustomSecs {
+			if customSec.Name == binary.NameSecName {
+				customSecs = append(customSecs, customSec)
+			}
+		}
+		expected.CustomSecs = customSecs
+
+		text := Print(expected)
+		m, err := Parse([]byte(text))
+		if err != nil {
+			t.Fatalf("%s: %v\n%s", filepath.Base(fileName), err, text)
+		}
+
+		if !bytes.Equal(binary.Encode(expected), binary.Encode(m)) {
+			t.Errorf("%s: printed module is different from the original:\n%s",
+				filepath.Base(fileName), text)
+		}
+	}
+}
+
+func TestPrintModule(t *testing.T) {
+	src := `
+	(module
+		(type $ft (func (param i32 i32) (result i32)))
+		(import "env" "putc" (func $putc (param i32)))
+		(memory 1)
+		(global $g (mut i32) (i32.const -1))
+		(func $add (type $ft) (param $a i32) (param i32) (result i32)
+			(local $x i64) (local f32 f32)
+			block $exit (result i32)
+				local.get $a
+				local.get 1
+				i32.add
+				br 0
+			end
+			i32.const 16
+			i64.load32_u offset=8 align=2
+			drop
+			f64.const nan:0x1
+			drop
+			global.get $g
+			drop
+		)
+		(export "add" (func $add))
+		(data (i32.const 100) "hi\00\"")
+	)`
+
+	m, err := Parse([]byte(src))
+	assert.AssertNil(t, err)
+
+	expected := `(module
+  (type $ft (;0;) (func (param i32 i32) (result i32)))
+  (type (;1;) (func (param i32)))
+  (import "env" "putc" (func $putc (;0;) (type 1) (param i32)))
+  (func $add (;1;) (type $ft) (param $a i32) (param i32) (result i32)
+    (local $x i64)
+    (local f32 f32)
+    block $exit (result i32)
+      local.get $a
+      local.get 1
+      i32.add
+      br 0
+    end
+    i32.const 16
+    i64.load32_u offset=8 align=2
+    drop
+    f64.const nan:0x1
+    drop
+    global.get $g
+    drop
+  )
+  (memory (;0;) 1)
+  (global $g (;0;) (mut i32) i32.const -1)
+  (export "add" (func $add))
+  (data (;0;) (i32.const 100) "hi\00\22")
+)
+`
+	assert.AssertEqual(t, expected, Print(m))
+}
+
+func TestPrintFloats(t *testing.T) {
+	m, err := Parse([]byte(`(module (func
+		f32.const 0x1p-1 drop
+		f32.const -inf drop
+		f32.const -nan drop
+		f64.const 1e100 drop
+		f64.const 0.1 drop))`))
+	assert.AssertNil(t, err)
+
+	text := Print(m)
+	for _, s := range []string{"f32.const 0.5", "f32.const -inf", "f32.const -nan",
+		"f64.const 1e+100", "f64.const 0.1"} {
+		assert.AssertTrue(t, strings.Contains(text, s))
+	}
+}
@@ -0,0 +1,378 @@
+package wat
+
+import (
+	"io/ioutil"
+	"strings"
+	"wasmvm/binary"
+	"wasmvm/instance"
+)
+
+// 脚本（*.wast）解析器
+//
+// 脚本是文本格式的扩展，用于编写 WebAssembly 规范测试（spec test），
+// 它由一系列命令组成，比如：
+//
+// (module $m (func (export "add") (param i32 i32) (result i32) ...))
+// (register "lib" $m)
+// (assert_return (invoke $m "add" (i32.const 1) (i32.const 2)) (i32.const 3))
+// (assert_trap (invoke "div" (i32.const 1) (i32.const 0)) "integer divide by zero")
+// (assert_exception (invoke "throw"))
+// (assert_malformed (module quote "(func (i32.const))") "unexpected token")
+//
+// 模块有三种写法：
+// - (module $id? field*)，普通的文本格式；
+// - (module $id? binary "..."*)，二进制格式，多个字符串会被拼接起来；
+// - (module $id? quote "..."*)，文本格式的源码，多个字符串会被拼接起来。
+//
+// 解析脚本时只检查命令的结构，模块的内容在执行命令时才解析（因为
+// assert_malformed 等命令期望模块的内容是不合法的），见 ScriptModule.Decode()。
+
+// 命令的名称
+const (
+	CmdModule           = "module"
+	CmdRegister         = "register"
+	CmdInvoke           = "invoke"
+	CmdGet              = "get"
+	CmdAssertReturn     = "assert_return"
+	CmdAssertTrap       = "assert_trap"
+	CmdAssertExhaustion = "assert_exhaustion"
+	CmdAssertException  = "assert_exception"
+	CmdAssertMalformed  = "assert_malformed"
+	CmdAssertInvalid    = "assert_invalid"
+	CmdAssertUnlinkable = "assert_unlinkable"
+)
+
+type Script struct {
+	Commands []Command
+}
+
+type Command struct {
+	Type string // 命令的名称，比如 CmdModule、CmdAssertReturn
+	Line int    // 命令所在的行号
+
+	// module, assert_malformed, assert_invalid, assert_unlinkable 的模块，
+	// 对于 assert_trap，如果期望的是实例化模块时发生陷阱，则这个字段不为 nil
+	Module *ScriptModule
+
+	// invoke, get, assert_return, assert_trap, assert_exhaustion, assert_exception 的操作
+	Action *Action
+
+	Name     string   // register: 注册的名称（即其他模块导入时使用的模块名称）
+	ModuleId string   // register: 被注册的模块的标识符（不包括 `$`），空字符串表示最近定义的模块
+	Results  []Result // assert_return: 期望的返回值
+	Text     string   // 断言期望的错误信息
+}
+
+// 脚本里的模块
+type ScriptModule struct {
+	Id   string // 模块的标识符（不包括 `$`），可以为空
+	Line int
+
+	node   *node  // 普通的文本格式
+	bytes  []byte // 二进制格式
+	source []byte // 文本格式的源码
+}
+
+// 操作，即调用导出的函数（invoke）或者读取导出的全局变量（get）
+type Action struct {
+	Type     string // CmdInvoke 或者 CmdGet
+	ModuleId string // 模块的标识符（不包括 `$`），空字符串表示最近定义的模块
+	Name     string // 导出项的名称
+	Args     []instance.WasmVal
+}
+
+// 脚本里的 (ref.extern N) 表示的宿主值，作为 externref 传入模块，
+// 模块返回的 externref 如果是这个类型的值，则按 N 比较
+type ExternRef uint32
+
+// 期望的返回值
+type Result struct {
+	// 期望的值，数据类型为 int32/int64/float32/float64/binary.V128/ExternRef
+	Value instance.WasmVal
+
+	// 期望的值为 NaN 时的形式："canonical" 或者 "arithmetic"，
+	// 此时 Value 只用于表示数据类型，为空字符串时表示需要精确比较 Value
+	NaN string
+
+	// 期望的值为引用但不需要比较具体的值时的形式：
+	// "null"（空引用）、"func"（任意的函数引用）或者 "extern"（任意的非空外部引用），
+	// 此时 Value 为 nil
+	Ref string
+
+	// 期望的值为 f32x4 或者 f64x2 形状的 v128，并且有通道为 nan:canonical 或者
+	// nan:arithmetic 时，按通道分别比较，此时 Value 只用于表示数据类型
+	Lanes []Result
+}
+
+const (
+	NaNCanonical  = "canonical"
+	NaNArithmetic = "arithmetic"
+
+	RefNull   = "null"
+	RefFunc   = "func"
+	RefExtern = "extern"
+)
+
+func ParseScriptFile(filename string) (*Script, error) {
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return ParseScript(src)
+}
+
+func ParseScript(src []byte) (script *Script, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			script = nil
+			err = toParseError(r)
+		}
+	}()
+
+	nodes := lex(src)
+
+	// 跟 Parse() 一样，整个脚本也可以是省略了 `module` 的模块字段，
+	// 此时脚本只有一个定义模块的命令
+	if len(nodes) > 0 && isModuleField(nodes[0]) {
+		moduleNode := &node{isList: true, line: nodes[0].line, col: nodes[0].col,
+			children: append([]*node{{text: CmdModule}}, nodes...)}
+		nodes = []*node{moduleNode}
+	}
+
+	script = &Script{}
+	for _, n := range nodes {
+		script.Commands = append(script.Commands, parseCommand(n))
+	}
+	return
+}
+
+func isModuleField(n *node) bool {
+	switch n.head() {
+	case "type", "import", "func", "table", "memory", "global", "tag",
+		"export", "start", "elem", "data":
+		return true
+	}
+	return false
+}
+
+// 获取模块的内容
+// 模块的内容不合法时返回 *ParseError（文本格式）或者 *binary.DecodeError（二进制格式）
+func (sm *ScriptModule) Decode() (module binary.Module, err error) {
+	if sm.bytes != nil {
+		return binary.Decode(sm.bytes)
+	}
+	if sm.source != nil {
+		return Parse(sm.source)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = toParseError(r)
+		}
+	}()
+	return parseModule(sm.node), nil
+}
+
+func parseCommand(n *node) Command {
+	cmd := Command{Type: n.head(), Line: n.line}
+	c := newCursor(n)
+
+	switch cmd.Type {
+	case CmdModule:
+		cmd.Module = parseScriptModule(n)
+		return cmd
+	case CmdRegister:
+		cmd.Name = c.readString()
+		cmd.ModuleId = c.readOptionalId()
+	case CmdInvoke, CmdGet:
+		cmd.Action = parseAction(n)
+		return cmd
+	case CmdAssertReturn:
+		cmd.Action = parseAction(c.next())
+		for !c.eof() {
+			cmd.Results = append(cmd.Results, parseResult(c.next()))
+		}
+	case CmdAssertTrap:
+		if c.peek().isListOf(CmdModule) {
+			cmd.Module = parseScriptModule(c.next())
+		} else {
+			cmd.Action = parseAction(c.next())
+		}
+		cmd.Text = c.readString()
+	case CmdAssertExhaustion:
+		cmd.Action = parseAction(c.next())
+		cmd.Text = c.readString()
+	case CmdAssertException:
+		cmd.Action = parseAction(c.next())
+	case CmdAssertMalformed, CmdAssertInvalid, CmdAssertUnlinkable:
+		m := c.next()
+		if !m.isListOf(CmdModule) {
+			fail(m, "expected a module, found %s", m)
+		}
+		cmd.Module = parseScriptModule(m)
+		cmd.Text = c.readString()
+	default:
+		fail(n, "unknown command %s", n)
+	}
+
+	c.expectEnd()
+	return cmd
+}
+
+func parseScriptModule(n *node) *ScriptModule {
+	c := newCursor(n)
+	sm := &ScriptModule{Id: c.readOptionalId(), Line: n.line}
+
+	if c.peek().isKeyword("binary") || c.peek().isKeyword("quote") {
+		isBinary := c.next().text == "binary"
+
+		var sb strings.Builder
+		for !c.eof() {
+			sb.WriteString(c.readString())
+		}
+		if isBinary {
+			sm.bytes = []byte(sb.String())
+		} else {
+			sm.source = []byte(sb.String())
+		}
+		return sm
+	}
+
+	sm.node = n
+	return sm
+}
+
+func parseAction(n *node) *Action {
+	action := &Action{Type: n.head()}
+	if action.Type != CmdInvoke && action.Type != CmdGet {
+		fail(n, "expected an action, found %s", n)
+	}
+
+	c := newCursor(n)
+	action.ModuleId = c.readOptionalId()
+	action.Name = c.readString()
+
+	if action.Type == CmdInvoke {
+		for !c.eof() {
+			action.Args = append(action.Args, parseConst(c.next()))
+		}
+	}
+
+	c.expectEnd()
+	return action
+}
+
+// (i32.const 1), (f32.const nan:canonical), (ref.null func), (ref.func) 等
+func parseResult(n *node) Result {
+	switch {
+	case n.isListOf("ref.null"):
+		parseConst(n)
+		return Result{Ref: RefNull}
+	case n.isListOf("ref.func"):
+		// 函数引用无法在脚本里表示，所以只检查是否为非空的函数引用
+		newCursor(n).expectEnd()
+		return Result{Ref: RefFunc}
+	case n.isListOf("ref.extern") && len(n.children) == 1:
+		return Result{Ref: RefExtern}
+	}
+
+	if n.isListOf("f32.const") || n.isListOf("f64.const") {
+		c := newCursor(n)
+		if isNaNPattern(c.peek()) {
+			result := parseFloatResult(c.next(), n.head() == "f32.const")
+			c.expectEnd()
+			return result
+		}
+	}
+
+	// (v128.const f32x4 nan:canonical 1 2 3)
+	if n.isListOf("v128.const") {
+		c := newCursor(n)
+		shape := c.next()
+		if shape.isKeyword("f32x4") || shape.isKeyword("f64x2") {
+			lanes := make([]Result, 2)
+			if shape.isKeyword("f32x4") {
+				lanes = make([]Result, 4)
+			}
+			hasNaNPattern := false
+			for i := range lanes {
+				arg := c.next()
+				hasNaNPattern = hasNaNPattern || isNaNPattern(arg)
+				lanes[i] = parseFloatResult(arg, len(lanes) == 4)
+			}
+			c.expectEnd()
+			if hasNaNPattern {
+				return Result{Value: binary.V128{}, Lanes: lanes}
+			}
+		}
+	}
+
+	return Result{Value: parseConst(n)}
+}
+
+func isNaNPattern(n *node) bool {
+	return n.isKeyword("nan:canonical") || n.isKeyword("nan:arithmetic")
+}
+
+// 浮点数的期望值，可以是 nan:canonical 或者 nan:arithmetic
+func parseFloatResult(arg *node, isF32 bool) Result {
+	if isNaNPattern(arg) {
+		var value instance.WasmVal = float64(0)
+		if isF32 {
+			value = float32(0)
+		}
+		return Result{Value: value, NaN: arg.text[len("nan:"):]}
+	}
+
+	if isF32 {
+		val, ok := parseF32(arg.text)
+		if arg.isList || arg.isString || !ok {
+			fail(arg, "invalid f32 literal %s", arg)
+		}
+		return Result{Value: val}
+	}
+	val, ok := parseF64(arg.text)
+	if arg.isList || arg.isString || !ok {
+		fail(arg, "invalid f64 literal %s", arg)
+	}
+	return Result{Value: val}
+}
+
+// (i32.const 1), (i64.const -1), (f32.const 0x1p-1), (f64.const nan:0x1),
+// (v128.const i32x4 1 2 3 4), (ref.null extern), (ref.extern 1) 等，空引用为 nil
+func parseConst(n *node) instance.WasmVal {
+	c := newCursor(n)
+	var value instance.WasmVal
+
+	switch n.head() {
+	case "i32.const":
+		value = int32(parseIntArg(c.next(), 32))
+	case "i64.const":
+		value = int64(parseIntArg(c.next(), 64))
+	case "f32.const":
+		arg := c.next()
+		val, ok := parseF32(arg.text)
+		if arg.isList || arg.isString || !ok {
+			fail(arg, "invalid f32 literal %s", arg)
+		}
+		value = val
+	case "f64.const":
+		arg := c.next()
+		val, ok := parseF64(arg.text)
+		if arg.isList || arg.isString || !ok {
+			fail(arg, "invalid f64 literal %s", arg)
+		}
+		value = val
+	case "v128.const":
+		value = parseV128(c)
+	case "ref.null":
+		parseHeapType(c.next())
+	case "ref.extern":
+		value = ExternRef(parseIntArg(c.next(), 32))
+	default:
+		fail(n, "expected a constant, found %s", n)
+	}
+
+	c.expectEnd()
+	return value
+}
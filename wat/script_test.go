@@ -0,0 +1,94 @@
+package wat
+
+import (
+	"testing"
+	"wasmvm/assert"
+	"wasmvm/binary"
+)
+
+func TestParseScript(t *testing.T) {
+	src := `
+	(module $m (func (export "add") (param i32 i32) (result i32)
+		(i32.add (local.get 0) (local.get 1))))
+	(register "lib" $m)
+	(invoke "add" (i32.const 1) (i32.const 2))
+	(assert_return (invoke $m "add" (i32.const 1) (i32.const -2)) (i32.const -1))
+	(assert_return (get "g") (f32.const nan:canonical))
+	(assert_trap (invoke "div" (i64.const 1) (i64.const 0)) "integer divide by zero")
+	(assert_trap (module (memory 0) (data (i32.const 1) "a")) "out of bounds memory access")
+	(assert_exhaustion (invoke "loop") "call stack exhausted")
+	(assert_malformed (module quote "(func" " (i32.const))") "unexpected token")
+	(assert_invalid (module binary "\00asm" "\01\00\00\00") "type mismatch")
+	(assert_unlinkable (module (import "a" "b" (func))) "unknown import")
+	`
+
+	script, err := ParseScript([]byte(src))
+	assert.AssertNil(t, err)
+	assert.AssertEqual(t, 11, len(script.Commands))
+
+	cmds := script.Commands
+	assert.AssertEqual(t, CmdModule, cmds[0].Type)
+	assert.AssertEqual(t, 2, cmds[0].Line)
+	assert.AssertEqual(t, "m", cmds[0].Module.Id)
+
+	assert.AssertEqual(t, CmdRegister, cmds[1].Type)
+	assert.AssertEqual(t, "lib", cmds[1].Name)
+	assert.AssertEqual(t, "m", cmds[1].ModuleId)
+
+	assert.AssertEqual(t, CmdInvoke, cmds[2].Type)
+	assert.AssertEqual(t, "add", cmds[2].Action.Name)
+	assert.AssertListEqual(t, []interface{}{int32(1), int32(2)}, cmds[2].Action.Args)
+
+	assert.AssertEqual(t, CmdAssertReturn, cmds[3].Type)
+	assert.AssertEqual(t, "m", cmds[3].Action.ModuleId)
+	assert.AssertListEqual(t, []interface{}{int32(1), int32(-2)}, cmds[3].Action.Args)
+	assert.AssertEqual(t, 1, len(cmds[3].Results))
+	assert.AssertEqual(t, int32(-1), cmds[3].Results[0].Value.(int32))
+
+	assert.AssertEqual(t, CmdGet, cmds[4].Action.Type)
+	assert.AssertEqual(t, NaNCanonical, cmds[4].Results[0].NaN)
+	_, ok := cmds[4].Results[0].Value.(float32)
+	assert.AssertTrue(t, ok)
+
+	assert.AssertEqual(t, "integer divide by zero", cmds[5].Text)
+	assert.AssertTrue(t, cmds[5].Module == nil)
+	assert.AssertTrue(t, cmds[6].Module != nil)
+	assert.AssertTrue(t, cmds[6].Action == nil)
+	assert.AssertEqual(t, CmdAssertExhaustion, cmds[7].Type)
+
+	// quote 模块的内容在 Decode 时才解析
+	_, err = cmds[8].Module.Decode()
+	_, ok = err.(*ParseError)
+	assert.AssertTrue(t, ok)
+
+	m, err := cmds[9].Module.Decode()
+	assert.AssertNil(t, err)
+	assert.AssertEqual(t, binary.MagicNumber, m.Magic)
+
+	m, err = cmds[10].Module.Decode()
+	assert.AssertNil(t, err)
+	assert.AssertEqual(t, 1, len(m.ImportSec))
+}
+
+func TestParseScriptError(t *testing.T) {
+	tests := []struct {
+		src    string
+		line   int
+		column int
+	}{
+		{"(module)\n(assert_foo)", 2, 1},                    // 未知的命令
+		{"(assert_return (module))", 1, 16},                 // 期望的是操作
+		{"(assert_return (invoke \"f\") (i32.add))", 1, 29}, // 期望的是常量
+		{"(assert_invalid (invoke \"f\") \"msg\")", 1, 17},  // 期望的是模块
+	}
+
+	for _, test := range tests {
+		_, err := ParseScript([]byte(test.src))
+		parseError, ok := err.(*ParseError)
+		if !ok {
+			t.Fatalf("%q: expected a parse error, got %v", test.src, err)
+		}
+		assert.AssertEqual(t, test.line, parseError.Line)
+		assert.AssertEqual(t, test.column, parseError.Column)
+	}
+}